@@ -12,8 +12,17 @@ import (
 	ginSwagger "github.com/swaggo/gin-swagger"
 
 	_ "washit-api/docs"
+	addressRoutes "washit-api/internal/address/routes"
+	calendarRoutes "washit-api/internal/calendar/routes"
+	courierRoutes "washit-api/internal/courier/routes"
 	historyRoutes "washit-api/internal/history/routes"
+	ledgerRoutes "washit-api/internal/ledger/routes"
 	orderRoutes "washit-api/internal/order/routes"
+	outletRoutes "washit-api/internal/outlet/routes"
+	pricingRoutes "washit-api/internal/pricing/routes"
+	serviceRoutes "washit-api/internal/service/routes"
+	slotRoutes "washit-api/internal/slot/routes"
+	transactionRoutes "washit-api/internal/transaction/routes"
 	userRoutes "washit-api/internal/user/routes"
 	"washit-api/pkg/configs"
 	"washit-api/pkg/db/dbs"
@@ -71,6 +80,15 @@ func (s Server) MapRoutes() error {
 	userRoutes.Main(v1, s.db, s.cache, s.app, s.validator)
 	orderRoutes.Main(v1, s.db, s.cache, s.validator)
 	historyRoutes.Main(v1, s.db, s.cache, s.validator)
+	pricingRoutes.Main(v1, s.db, s.cache, s.validator)
+	serviceRoutes.Main(v1, s.db, s.cache, s.validator)
+	addressRoutes.Main(v1, s.db, s.cache, s.validator)
+	transactionRoutes.Main(v1, s.db, s.cache, s.validator)
+	ledgerRoutes.Main(v1, s.db, s.cache, s.validator)
+	courierRoutes.Main(v1, s.db, s.cache, s.validator)
+	slotRoutes.Main(v1, s.db, s.cache, s.validator)
+	calendarRoutes.Main(v1, s.db, s.cache, s.validator)
+	outletRoutes.Main(v1, s.db, s.cache, s.validator)
 	return nil
 }
 
@@ -23,8 +23,13 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/auth/login": {
+        "/address": {
             "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
                 "consumes": [
                     "application/json"
                 ],
@@ -32,32 +37,37 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "Address"
                 ],
-                "summary": "Login as a user",
+                "summary": "Create an address",
                 "parameters": [
                     {
-                        "description": "Body",
+                        "description": "Address details",
                         "name": "_",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/userRequest.Login"
+                            "$ref": "#/definitions/addressRequest.Address"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/userResource.WithToken"
+                            "$ref": "#/definitions/addressResource.Address"
                         }
                     }
                 }
             }
         },
-        "/auth/login/google": {
-            "post": {
+        "/address/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
                 "consumes": [
                     "application/json"
                 ],
@@ -65,17 +75,58 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "Address"
                 ],
-                "summary": "Login with Google",
+                "summary": "Get an address by ID",
                 "parameters": [
                     {
-                        "description": "Body",
+                        "type": "string",
+                        "description": "Address ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/addressResource.Address"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Address"
+                ],
+                "summary": "Update an address",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Address ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Address details",
                         "name": "_",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/userRequest.Google"
+                            "$ref": "#/definitions/addressRequest.Address"
                         }
                     }
                 ],
@@ -83,14 +134,45 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/userResource.WithToken"
+                            "$ref": "#/definitions/addressResource.Address"
                         }
                     }
                 }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Address"
+                ],
+                "summary": "Delete an address",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Address ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
             }
         },
-        "/auth/logout": {
-            "post": {
+        "/address/{id}/default": {
+            "put": {
                 "security": [
                     {
                         "ApiKeyAuth": []
@@ -103,21 +185,35 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "Address"
+                ],
+                "summary": "Set the default address",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Address ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Logout the current logged-in user",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/userResource.User"
+                            "$ref": "#/definitions/addressResource.Address"
                         }
                     }
                 }
             }
         },
-        "/auth/refresh-token": {
-            "post": {
+        "/addresses": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
                 "consumes": [
                     "application/json"
                 ],
@@ -125,23 +221,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "Address"
                 ],
-                "summary": "Refresh the user's access token",
+                "summary": "Get my addresses",
                 "responses": {
                     "200": {
-                        "description": "accessToken",
+                        "description": "OK",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/addressResource.Address"
                             }
                         }
                     }
                 }
             }
         },
-        "/auth/register": {
+        "/auth/2fa/verify": {
             "post": {
                 "consumes": [
                     "application/json"
@@ -152,7 +248,7 @@ const docTemplate = `{
                 "tags": [
                     "User"
                 ],
-                "summary": "Register a new user",
+                "summary": "Complete a login with a TOTP or recovery code",
                 "parameters": [
                     {
                         "description": "Body",
@@ -160,27 +256,22 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/userRequest.Register"
+                            "$ref": "#/definitions/userRequest.TwoFactorLogin"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/userResource.User"
+                            "$ref": "#/definitions/userResource.WithToken"
                         }
                     }
                 }
             }
         },
-        "/order": {
+        "/auth/email/verify": {
             "post": {
-                "security": [
-                    {
-                        "ApiKeyAuth": []
-                    }
-                ],
                 "consumes": [
                     "application/json"
                 ],
@@ -188,71 +279,56 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Order"
+                    "User"
                 ],
-                "summary": "Create a new order",
+                "summary": "Verify email address",
                 "parameters": [
                     {
-                        "description": "Order details",
+                        "description": "Body",
                         "name": "_",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/orderRequest.Order"
+                            "$ref": "#/definitions/userRequest.VerifyEmail"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/orderResource.Order"
+                            "$ref": "#/definitions/userResource.User"
                         }
                     }
                 }
             }
         },
-        "/order/{id}": {
-            "get": {
+        "/auth/email/verify/resend": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "consumes": [
-                    "application/json"
-                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Order"
-                ],
-                "summary": "Get order details by ID",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Order ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
+                    "User"
                 ],
+                "summary": "Resend the email verification link",
                 "responses": {
                     "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/orderResource.Order"
-                        }
+                        "description": "OK"
+                    },
+                    "429": {
+                        "description": "Too Many Requests"
                     }
                 }
-            },
-            "put": {
-                "security": [
-                    {
-                        "ApiKeyAuth": []
-                    }
-                ],
+            }
+        },
+        "/auth/login": {
+            "post": {
                 "consumes": [
                     "application/json"
                 ],
@@ -260,24 +336,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Order"
+                    "User"
                 ],
-                "summary": "Edit an existing order",
+                "summary": "Login as a user",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Order ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Order details",
+                        "description": "Body",
                         "name": "_",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/orderRequest.Order"
+                            "$ref": "#/definitions/userRequest.Login"
                         }
                     }
                 ],
@@ -285,19 +354,23 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/orderResource.Order"
+                            "$ref": "#/definitions/userResource.WithToken"
+                        }
+                    },
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/userResource.TwoFactorChallenge"
                         }
+                    },
+                    "429": {
+                        "description": "Too Many Requests"
                     }
                 }
             }
         },
-        "/order/{id}/accept": {
-            "put": {
-                "security": [
-                    {
-                        "ApiKeyAuth": []
-                    }
-                ],
+        "/auth/login/google": {
+            "post": {
                 "consumes": [
                     "application/json"
                 ],
@@ -305,30 +378,38 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Order"
+                    "User"
                 ],
-                "summary": "Accept an order",
+                "summary": "Login with Google",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Order ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Body",
+                        "name": "_",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/userRequest.Google"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/orderResource.Order"
+                            "$ref": "#/definitions/userResource.WithToken"
+                        }
+                    },
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/userResource.TwoFactorChallenge"
                         }
                     }
                 }
             }
         },
-        "/order/{id}/cancel": {
-            "put": {
+        "/auth/logout": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
@@ -341,71 +422,52 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Order"
+                    "User"
                 ],
-                "summary": "Cancel an existing order",
+                "summary": "Logout the current logged-in user",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Order ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Body",
+                        "name": "_",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/userRequest.Logout"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/orderResource.Order"
+                            "$ref": "#/definitions/userResource.User"
                         }
                     }
                 }
             }
         },
-        "/order/{id}/complete": {
-            "put": {
+        "/auth/logout/all": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "consumes": [
-                    "application/json"
-                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Order"
-                ],
-                "summary": "Complete an order",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Order ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
+                    "User"
                 ],
+                "summary": "Logout the current user from all devices",
                 "responses": {
                     "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/orderResource.Order"
-                        }
+                        "description": "OK"
                     }
                 }
             }
         },
-        "/order/{id}/pay": {
-            "put": {
-                "security": [
-                    {
-                        "ApiKeyAuth": []
-                    }
-                ],
+        "/auth/password/forgot": {
+            "post": {
                 "consumes": [
                     "application/json"
                 ],
@@ -413,44 +475,32 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Order"
+                    "User"
                 ],
-                "summary": "Pay for an order",
+                "summary": "Request a password reset email",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Order ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Payment details",
+                        "description": "Body",
                         "name": "_",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/orderRequest.Payment"
+                            "$ref": "#/definitions/userRequest.ForgotPassword"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/orderResource.Order"
-                        }
+                        "description": "OK"
+                    },
+                    "429": {
+                        "description": "Too Many Requests"
                     }
                 }
             }
         },
-        "/order/{id}/reject": {
-            "put": {
-                "security": [
-                    {
-                        "ApiKeyAuth": []
-                    }
-                ],
+        "/auth/password/reset": {
+            "post": {
                 "consumes": [
                     "application/json"
                 ],
@@ -458,30 +508,29 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Order"
+                    "User"
                 ],
-                "summary": "Reject an order",
+                "summary": "Reset password with a token from the reset email",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Order ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Body",
+                        "name": "_",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/userRequest.ResetPassword"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/orderResource.Order"
-                        }
+                        "description": "OK"
                     }
                 }
             }
         },
-        "/order/{id}/weight/{weight}": {
-            "put": {
+        "/auth/refresh": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
@@ -494,42 +543,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Order"
-                ],
-                "summary": "Update the weight of an order",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Order ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Weight",
-                        "name": "weight",
-                        "in": "path",
-                        "required": true
-                    }
+                    "User"
                 ],
+                "summary": "Exchange a refresh token for a new access and refresh token",
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "accessToken, refreshToken",
                         "schema": {
-                            "$ref": "#/definitions/orderResource.Order"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     }
                 }
             }
         },
-        "/orders": {
-            "get": {
-                "security": [
-                    {
-                        "ApiKeyAuth": []
-                    }
-                ],
+        "/auth/register": {
+            "post": {
                 "consumes": [
                     "application/json"
                 ],
@@ -537,21 +568,32 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Order"
+                    "User"
+                ],
+                "summary": "Register a new user",
+                "parameters": [
+                    {
+                        "description": "Body",
+                        "name": "_",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/userRequest.Register"
+                        }
+                    }
                 ],
-                "summary": "Get all orders for the authenticated user",
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/orderResource.Order"
+                            "$ref": "#/definitions/userResource.User"
                         }
                     }
                 }
             }
         },
-        "/orders/all": {
-            "get": {
+        "/calendar/closure": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
@@ -564,21 +606,32 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Order"
+                    "Calendar"
+                ],
+                "summary": "Create a closure",
+                "parameters": [
+                    {
+                        "description": "Closure details",
+                        "name": "_",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/calendarRequest.Closure"
+                        }
+                    }
                 ],
-                "summary": "Get all orders",
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/orderResource.Order"
+                            "$ref": "#/definitions/calendarResource.Closure"
                         }
                     }
                 }
             }
         },
-        "/orders/user/{id}": {
-            "get": {
+        "/calendar/closure/{id}": {
+            "put": {
                 "security": [
                     {
                         "ApiKeyAuth": []
@@ -591,30 +644,37 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Order"
+                    "Calendar"
                 ],
-                "summary": "Get all orders for a specific user",
+                "summary": "Update a closure",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "User ID",
+                        "description": "Closure ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Closure details",
+                        "name": "_",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/calendarRequest.Closure"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/orderResource.Order"
+                            "$ref": "#/definitions/calendarResource.Closure"
                         }
                     }
                 }
-            }
-        },
-        "/profile/me": {
-            "get": {
+            },
+            "delete": {
                 "security": [
                     {
                         "ApiKeyAuth": []
@@ -627,21 +687,27 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "Calendar"
+                ],
+                "summary": "Delete a closure",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Closure ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Get the current logged-in user",
                 "responses": {
                     "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/userResource.User"
-                        }
+                        "description": "OK"
                     }
                 }
             }
         },
-        "/profile/update": {
-            "put": {
+        "/calendar/closures": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
@@ -654,32 +720,43 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "Calendar"
                 ],
-                "summary": "Update the current logged-in user",
-                "parameters": [
-                    {
-                        "description": "Body",
-                        "name": "_",
-                        "in": "body",
-                        "required": true,
+                "summary": "Get closures",
+                "responses": {
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/userRequest.UpdateProfile"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/calendarResource.Closure"
+                            }
                         }
                     }
+                }
+            }
+        },
+        "/calendar/closures.ics": {
+            "get": {
+                "produces": [
+                    "text/calendar"
+                ],
+                "tags": [
+                    "Calendar"
                 ],
+                "summary": "Export closures as iCalendar",
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/userResource.User"
+                            "type": "string"
                         }
                     }
                 }
             }
         },
-        "/profile/update/password": {
-            "put": {
+        "/calendar/holiday": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
@@ -692,17 +769,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "Calendar"
                 ],
-                "summary": "Update the current logged-in user's password",
+                "summary": "Create a holiday",
                 "parameters": [
                     {
-                        "description": "Body",
+                        "description": "Holiday details",
                         "name": "_",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/userRequest.UpdatePassword"
+                            "$ref": "#/definitions/calendarRequest.Holiday"
                         }
                     }
                 ],
@@ -710,13 +787,13 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/userResource.User"
+                            "$ref": "#/definitions/calendarResource.Holiday"
                         }
                     }
                 }
             }
         },
-        "/profile/update/picture": {
+        "/calendar/holiday/{id}": {
             "put": {
                 "security": [
                     {
@@ -730,17 +807,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "Calendar"
                 ],
-                "summary": "Update the current logged-in user's profile picture",
+                "summary": "Update a holiday",
                 "parameters": [
                     {
-                        "description": "Body",
+                        "type": "string",
+                        "description": "Holiday ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Holiday details",
                         "name": "_",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/userRequest.UpdatePicture"
+                            "$ref": "#/definitions/calendarRequest.Holiday"
                         }
                     }
                 ],
@@ -748,14 +832,12 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/userResource.User"
+                            "$ref": "#/definitions/calendarResource.Holiday"
                         }
                     }
                 }
-            }
-        },
-        "/user/{id}": {
-            "get": {
+            },
+            "delete": {
                 "security": [
                     {
                         "ApiKeyAuth": []
@@ -768,13 +850,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "Calendar"
                 ],
-                "summary": "Get a user by ID",
+                "summary": "Delete a holiday",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "User ID",
+                        "description": "Holiday ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -782,16 +864,13 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/userResource.User"
-                        }
+                        "description": "OK"
                     }
                 }
             }
         },
-        "/user/{id}/ban": {
-            "put": {
+        "/calendar/holidays": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
@@ -804,30 +883,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User"
-                ],
-                "summary": "Ban a user",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "User ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
+                    "Calendar"
                 ],
+                "summary": "Get holidays",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/userResource.User"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/calendarResource.Holiday"
+                            }
                         }
                     }
                 }
             }
         },
-        "/user/{id}/unban": {
-            "put": {
+        "/calendar/hours": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
@@ -840,13 +913,126 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "Calendar"
                 ],
-                "summary": "Unban a user",
+                "summary": "Get opening hours",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/calendarResource.OpeningHours"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/calendar/hours/{weekday}": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Calendar"
+                ],
+                "summary": "Update opening hours of a weekday",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "User ID",
+                        "description": "Weekday as 0 (Sunday) to 6 or its name",
+                        "name": "weekday",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Opening hours",
+                        "name": "_",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/calendarRequest.OpeningHours"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/calendarResource.OpeningHours"
+                        }
+                    }
+                }
+            }
+        },
+        "/courier": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Courier"
+                ],
+                "summary": "Create a courier",
+                "parameters": [
+                    {
+                        "description": "Courier details",
+                        "name": "_",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/courierRequest.CreateCourier"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/courierResource.Courier"
+                        }
+                    }
+                }
+            }
+        },
+        "/courier/job/{id}/delivered": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Courier"
+                ],
+                "summary": "Mark a delivery as delivered",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Assignment ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -856,13 +1042,49 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/userResource.User"
+                            "$ref": "#/definitions/courierResource.Assignment"
                         }
                     }
                 }
             }
         },
-        "/users": {
+        "/courier/job/{id}/picked-up": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Courier"
+                ],
+                "summary": "Mark a pickup as picked up",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Assignment ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/courierResource.Assignment"
+                        }
+                    }
+                }
+            }
+        },
+        "/courier/jobs": {
             "get": {
                 "security": [
                     {
@@ -876,20 +1098,31 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "Courier"
+                ],
+                "summary": "Get my courier jobs for a day",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Day as YYYY-MM-DD, defaults to today",
+                        "name": "date",
+                        "in": "query"
+                    }
                 ],
-                "summary": "Get all users",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/userResource.User"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/courierResource.Assignment"
+                            }
                         }
                     }
                 }
             }
         },
-        "/users/banned": {
+        "/courier/{id}": {
             "get": {
                 "security": [
                     {
@@ -903,140 +1136,5194 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "Courier"
+                ],
+                "summary": "Get a courier by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Courier ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Get all banned users",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/userResource.User"
+                            "$ref": "#/definitions/courierResource.Courier"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Courier"
+                ],
+                "summary": "Update a courier",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Courier ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Courier details",
+                        "name": "_",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/courierRequest.UpdateCourier"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/courierResource.Courier"
                         }
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "orderRequest.Order": {
-            "type": "object",
-            "required": [
-                "addressID",
-                "collectDate",
-                "orderType",
-                "serviceType"
-            ],
+        },
+        "/couriers": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Courier"
+                ],
+                "summary": "Get all couriers",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/courierResource.Courier"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/ledger/accounts": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Ledger"
+                ],
+                "summary": "Get ledger account balances",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/ledgerResource.AccountBalance"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/ledger/entries": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Ledger"
+                ],
+                "summary": "Get ledger journal entries",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "name": "account",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "kind",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "order_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "transaction_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ledgerResource.ListEntry"
+                        }
+                    }
+                }
+            }
+        },
+        "/order": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "The collect date may be any time within a pickup window and the\norder is booked at the window's start. Without pickup windows the\ncollect date is kept as given.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Order"
+                ],
+                "summary": "Create a new order",
+                "parameters": [
+                    {
+                        "description": "Order details",
+                        "name": "_",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/orderRequest.Order"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/orderResource.Order"
+                        }
+                    }
+                }
+            }
+        },
+        "/order/estimate": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Order"
+                ],
+                "summary": "Get a ready date estimate for an order",
+                "parameters": [
+                    {
+                        "description": "Estimate details",
+                        "name": "_",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/orderRequest.Estimate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/orderResource.Estimate"
+                        }
+                    }
+                }
+            }
+        },
+        "/order/quote": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Order"
+                ],
+                "summary": "Get a price quote for an order",
+                "parameters": [
+                    {
+                        "description": "Quote details",
+                        "name": "_",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/orderRequest.Quote"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/orderResource.Quote"
+                        }
+                    }
+                }
+            }
+        },
+        "/order/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Order"
+                ],
+                "summary": "Get order details by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Order ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/orderResource.Order"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "A new collect date is booked into its pickup window as on creation.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Order"
+                ],
+                "summary": "Edit an existing order",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Order ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Order details",
+                        "name": "_",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/orderRequest.Order"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/orderResource.Order"
+                        }
+                    }
+                }
+            }
+        },
+        "/order/{id}/accept": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Order"
+                ],
+                "summary": "Accept an order",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Order ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/orderResource.Order"
+                        }
+                    }
+                }
+            }
+        },
+        "/order/{id}/assignment": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Courier"
+                ],
+                "summary": "Assign an order leg to a courier",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Order ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Assignment details",
+                        "name": "_",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/courierRequest.Assignment"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/courierResource.Assignment"
+                        }
+                    }
+                }
+            }
+        },
+        "/order/{id}/assignments": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Courier"
+                ],
+                "summary": "Get the courier assignments of an order",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Order ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/courierResource.Assignment"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/order/{id}/cancel": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Order"
+                ],
+                "summary": "Cancel an existing order",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Order ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/orderResource.Order"
+                        }
+                    }
+                }
+            }
+        },
+        "/order/{id}/complete": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Order"
+                ],
+                "summary": "Complete an order",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Order ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/orderResource.Order"
+                        }
+                    }
+                }
+            }
+        },
+        "/order/{id}/item": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Order"
+                ],
+                "summary": "Add a line item to an order",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Order ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Item details",
+                        "name": "_",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/orderRequest.OrderItem"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/orderResource.Order"
+                        }
+                    }
+                }
+            }
+        },
+        "/order/{id}/item/{itemID}": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Order"
+                ],
+                "summary": "Update a line item of an order",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Order ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Item ID",
+                        "name": "itemID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Item details",
+                        "name": "_",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/orderRequest.OrderItem"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/orderResource.Order"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Order"
+                ],
+                "summary": "Delete a line item of an order",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Order ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Item ID",
+                        "name": "itemID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/orderResource.Order"
+                        }
+                    }
+                }
+            }
+        },
+        "/order/{id}/item/{itemID}/photo": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Order"
+                ],
+                "summary": "Add a photo to a line item of an order",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Order ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Item ID",
+                        "name": "itemID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Photo",
+                        "name": "_",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/orderRequest.ItemPhoto"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/orderResource.OrderItem"
+                        }
+                    }
+                }
+            }
+        },
+        "/order/{id}/labels": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "produces": [
+                    "image/png",
+                    "application/pdf"
+                ],
+                "tags": [
+                    "Order"
+                ],
+                "summary": "Print the tag labels of an order",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Order ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "png",
+                            "pdf"
+                        ],
+                        "type": "string",
+                        "default": "png",
+                        "description": "Label format",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "code128",
+                            "qr"
+                        ],
+                        "type": "string",
+                        "default": "code128",
+                        "description": "Barcode type",
+                        "name": "symbology",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    }
+                }
+            }
+        },
+        "/order/{id}/pay": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Order"
+                ],
+                "summary": "Pay for an order",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Order ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Payment details",
+                        "name": "_",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/orderRequest.Payment"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/orderResource.Order"
+                        }
+                    }
+                }
+            }
+        },
+        "/order/{id}/reject": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Order"
+                ],
+                "summary": "Reject an order",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Order ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/orderResource.Order"
+                        }
+                    }
+                }
+            }
+        },
+        "/order/{id}/status": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Order"
+                ],
+                "summary": "Update the status of an order",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Order ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Status details",
+                        "name": "_",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/orderRequest.UpdateStatus"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/orderResource.Order"
+                        }
+                    }
+                }
+            }
+        },
+        "/order/{id}/timeline": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Order"
+                ],
+                "summary": "Get the status timeline of an order",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Order ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/orderResource.StatusEvent"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/order/{id}/weight/{weight}": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Order"
+                ],
+                "summary": "Update the weight of an order",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Order ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Weight",
+                        "name": "weight",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/orderResource.Order"
+                        }
+                    }
+                }
+            }
+        },
+        "/orders": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Order"
+                ],
+                "summary": "Get all orders for the authenticated user",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/orderResource.Order"
+                        }
+                    }
+                }
+            }
+        },
+        "/orders/all": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Order"
+                ],
+                "summary": "Get all orders",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Outlet ID",
+                        "name": "outlet",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/orderResource.Order"
+                        }
+                    }
+                }
+            }
+        },
+        "/orders/scan/{code}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Order"
+                ],
+                "summary": "Look up an order by its tag code",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Tag code",
+                        "name": "code",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/orderResource.Scan"
+                        }
+                    }
+                }
+            }
+        },
+        "/orders/user/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Order"
+                ],
+                "summary": "Get all orders for a specific user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/orderResource.Order"
+                        }
+                    }
+                }
+            }
+        },
+        "/outlet": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Outlet"
+                ],
+                "summary": "Create an outlet",
+                "parameters": [
+                    {
+                        "description": "Outlet details",
+                        "name": "_",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/outletRequest.Outlet"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/outletResource.Outlet"
+                        }
+                    }
+                }
+            }
+        },
+        "/outlet/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Outlet"
+                ],
+                "summary": "Get an outlet",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Outlet ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/outletResource.Outlet"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Outlet"
+                ],
+                "summary": "Update an outlet",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Outlet ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Outlet details",
+                        "name": "_",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/outletRequest.Outlet"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/outletResource.Outlet"
+                        }
+                    }
+                }
+            }
+        },
+        "/outlet/{id}/staff": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Outlet"
+                ],
+                "summary": "Get the staff of an outlet",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Outlet ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/outletResource.Staff"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/outlet/{id}/staff/{userID}": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Outlet"
+                ],
+                "summary": "Assign a staff member to an outlet",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Outlet ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "userID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/outletResource.Staff"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Outlet"
+                ],
+                "summary": "Remove a staff member from an outlet",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Outlet ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "userID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/outletResource.Staff"
+                        }
+                    }
+                }
+            }
+        },
+        "/outlets": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Outlet"
+                ],
+                "summary": "Get outlets",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/outletResource.Outlet"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/pickup-slots": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pickup Slot"
+                ],
+                "summary": "Get available pickup slots",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Number of days starting today, defaults to 7, at most 30",
+                        "name": "days",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/slotResource.Slot"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/pickup-window": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pickup Slot"
+                ],
+                "summary": "Create a pickup window",
+                "parameters": [
+                    {
+                        "description": "Window details",
+                        "name": "_",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/slotRequest.Window"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/slotResource.Window"
+                        }
+                    }
+                }
+            }
+        },
+        "/pickup-window/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pickup Slot"
+                ],
+                "summary": "Update a pickup window",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Window ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Window details",
+                        "name": "_",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/slotRequest.Window"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/slotResource.Window"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pickup Slot"
+                ],
+                "summary": "Delete a pickup window",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Window ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/pickup-windows": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pickup Slot"
+                ],
+                "summary": "Get pickup windows",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/slotResource.Window"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/pricing/delivery-fee": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pricing"
+                ],
+                "summary": "Create a delivery fee band",
+                "parameters": [
+                    {
+                        "description": "Delivery fee details",
+                        "name": "_",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pricingRequest.DeliveryFee"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/pricingResource.DeliveryFee"
+                        }
+                    }
+                }
+            }
+        },
+        "/pricing/delivery-fee/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pricing"
+                ],
+                "summary": "Update a delivery fee band",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Delivery fee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Delivery fee details",
+                        "name": "_",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pricingRequest.DeliveryFee"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pricingResource.DeliveryFee"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pricing"
+                ],
+                "summary": "Delete a delivery fee band",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Delivery fee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/pricing/delivery-fees": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pricing"
+                ],
+                "summary": "Get the delivery fee bands",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/pricingResource.DeliveryFee"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/pricing/outlet/{outletID}/price/{serviceType}": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pricing"
+                ],
+                "summary": "Override the price of a service type at an outlet",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Outlet ID",
+                        "name": "outletID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Service type",
+                        "name": "serviceType",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Price details",
+                        "name": "_",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pricingRequest.OutletPrice"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pricingResource.OutletPrice"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pricing"
+                ],
+                "summary": "Delete the price override of a service type at an outlet",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Outlet ID",
+                        "name": "outletID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Service type",
+                        "name": "serviceType",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/pricing/outlet/{outletID}/prices": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pricing"
+                ],
+                "summary": "Get the price overrides of an outlet",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Outlet ID",
+                        "name": "outletID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/pricingResource.OutletPrice"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/pricing/price-list": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pricing"
+                ],
+                "summary": "Create a price list",
+                "parameters": [
+                    {
+                        "description": "Price list details",
+                        "name": "_",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pricingRequest.PriceList"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/pricingResource.PriceList"
+                        }
+                    }
+                }
+            }
+        },
+        "/pricing/price-list/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pricing"
+                ],
+                "summary": "Update a price list",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Price list ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Price list details",
+                        "name": "_",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pricingRequest.PriceList"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pricingResource.PriceList"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pricing"
+                ],
+                "summary": "Delete a price list",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Price list ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/pricing/price-lists": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pricing"
+                ],
+                "summary": "Get all price lists",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/pricingResource.PriceList"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/pricing/surcharge/{orderType}": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pricing"
+                ],
+                "summary": "Set the surcharge of an order type",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Order type",
+                        "name": "orderType",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Surcharge details",
+                        "name": "_",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pricingRequest.Surcharge"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pricingResource.Surcharge"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pricing"
+                ],
+                "summary": "Delete the surcharge of an order type",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Order type",
+                        "name": "orderType",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/pricing/surcharges": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pricing"
+                ],
+                "summary": "Get all order type surcharges",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/pricingResource.Surcharge"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/profile/2fa": {
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Disable two-factor authentication",
+                "parameters": [
+                    {
+                        "description": "Body",
+                        "name": "_",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/userRequest.DisableTwoFactor"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/profile/2fa/confirm": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Confirm TOTP enrollment",
+                "parameters": [
+                    {
+                        "description": "Body",
+                        "name": "_",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/userRequest.TwoFactorCode"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/userResource.RecoveryCodes"
+                        }
+                    }
+                }
+            }
+        },
+        "/profile/2fa/enroll": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Start TOTP enrollment",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/userResource.TwoFactorEnrollment"
+                        }
+                    }
+                }
+            }
+        },
+        "/profile/2fa/recovery-codes": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Regenerate recovery codes",
+                "parameters": [
+                    {
+                        "description": "Body",
+                        "name": "_",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/userRequest.TwoFactorCode"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/userResource.RecoveryCodes"
+                        }
+                    }
+                }
+            }
+        },
+        "/profile/me": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Get the current logged-in user",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/userResource.User"
+                        }
+                    }
+                }
+            }
+        },
+        "/profile/sessions": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Get sessions of the current user",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/userResource.Session"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/profile/sessions/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Revoke a session of the current user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Session ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/profile/update": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Update the current logged-in user",
+                "parameters": [
+                    {
+                        "description": "Body",
+                        "name": "_",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/userRequest.UpdateProfile"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/userResource.User"
+                        }
+                    }
+                }
+            }
+        },
+        "/profile/update/password": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Update the current logged-in user's password",
+                "parameters": [
+                    {
+                        "description": "Body",
+                        "name": "_",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/userRequest.UpdatePassword"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/userResource.User"
+                        }
+                    }
+                }
+            }
+        },
+        "/profile/update/picture": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Update the current logged-in user's profile picture",
+                "parameters": [
+                    {
+                        "description": "Body",
+                        "name": "_",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/userRequest.UpdatePicture"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/userResource.User"
+                        }
+                    }
+                }
+            }
+        },
+        "/refund/{id}/approve": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Refund"
+                ],
+                "summary": "Approve a refund",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Refund ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Review details",
+                        "name": "_",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/transactionRequest.ReviewRefund"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/transactionResource.Refund"
+                        }
+                    }
+                }
+            }
+        },
+        "/refund/{id}/reject": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Refund"
+                ],
+                "summary": "Reject a refund",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Refund ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Review details",
+                        "name": "_",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/transactionRequest.ReviewRefund"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/transactionResource.Refund"
+                        }
+                    }
+                }
+            }
+        },
+        "/refunds": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Refund"
+                ],
+                "summary": "Get all refunds",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "name": "order_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "transaction_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/transactionResource.ListRefund"
+                        }
+                    }
+                }
+            }
+        },
+        "/roles": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Get all roles with their permissions",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/userResource.Role"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/service": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Service"
+                ],
+                "summary": "Create a service",
+                "parameters": [
+                    {
+                        "description": "Service details",
+                        "name": "_",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/serviceRequest.Service"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/serviceResource.Service"
+                        }
+                    }
+                }
+            }
+        },
+        "/service/{id}": {
+            "get": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Service"
+                ],
+                "summary": "Get service details by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Service ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/serviceResource.Service"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Service"
+                ],
+                "summary": "Update a service",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Service ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Service details",
+                        "name": "_",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/serviceRequest.Service"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/serviceResource.Service"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Service"
+                ],
+                "summary": "Delete a service",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Service ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/services": {
+            "get": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Service"
+                ],
+                "summary": "Get available services",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "service_type or order_type",
+                        "name": "kind",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/serviceResource.Service"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/services/all": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Service"
+                ],
+                "summary": "Get all services",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "service_type or order_type",
+                        "name": "kind",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/serviceResource.Service"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/transaction": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Transaction"
+                ],
+                "summary": "Create a transaction for an order",
+                "parameters": [
+                    {
+                        "description": "Transaction details",
+                        "name": "_",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/transactionRequest.Transaction"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/transactionResource.Transaction"
+                        }
+                    }
+                }
+            }
+        },
+        "/transaction/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Transaction"
+                ],
+                "summary": "Get a transaction by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Transaction ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/transactionResource.Transaction"
+                        }
+                    }
+                }
+            }
+        },
+        "/transaction/{id}/refund": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Refund"
+                ],
+                "summary": "Request a refund",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Transaction ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Refund details",
+                        "name": "_",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/transactionRequest.Refund"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/transactionResource.Refund"
+                        }
+                    }
+                }
+            }
+        },
+        "/transaction/{id}/refunds": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Refund"
+                ],
+                "summary": "Get the refunds of a transaction",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Transaction ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/transactionResource.Refund"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/transaction/{id}/status": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Transaction"
+                ],
+                "summary": "Update the status of a transaction",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Transaction ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Status details",
+                        "name": "_",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/transactionRequest.UpdateStatus"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/transactionResource.Transaction"
+                        }
+                    }
+                }
+            }
+        },
+        "/transaction/{id}/sync": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Transaction"
+                ],
+                "summary": "Sync a transaction with its payment provider",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Transaction ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/transactionResource.Transaction"
+                        }
+                    }
+                }
+            }
+        },
+        "/transactions": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Transaction"
+                ],
+                "summary": "Get my transactions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "name": "order_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "payment_method",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "status",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/transactionResource.ListTransaction"
+                        }
+                    }
+                }
+            }
+        },
+        "/transactions/all": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Transaction"
+                ],
+                "summary": "Get all transactions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "name": "order_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "payment_method",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "status",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/transactionResource.ListTransaction"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Get a user by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/userResource.User"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/{id}/ban": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Ban a user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/userResource.User"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/{id}/role": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Assign a role to a user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Body",
+                        "name": "_",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/userRequest.AssignRole"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/userResource.User"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/{id}/sessions": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Get sessions of a user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/userResource.Session"
+                            }
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Revoke all sessions of a user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/user/{id}/unban": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Unban a user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/userResource.User"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/{id}/unlock": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Unlock a user locked out by failed logins",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/userResource.User"
+                        }
+                    }
+                }
+            }
+        },
+        "/users": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Get all users",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/userResource.User"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/banned": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Get all banned users",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/userResource.User"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhook-event/{id}/replay": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Transaction"
+                ],
+                "summary": "Replay a payment webhook event",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Webhook event ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/transactionResource.WebhookEvent"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhook-events": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Transaction"
+                ],
+                "summary": "Get payment webhook events",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "name": "provider",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "state",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "transaction_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/transactionResource.ListWebhookEvent"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/payments/{provider}": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Transaction"
+                ],
+                "summary": "Receive a payment provider webhook",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Payment provider",
+                        "name": "provider",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "addressRequest.Address": {
+            "type": "object",
+            "required": [
+                "city",
+                "label",
+                "phone",
+                "recipient",
+                "street"
+            ],
+            "properties": {
+                "city": {
+                    "type": "string"
+                },
+                "courierNote": {
+                    "type": "string",
+                    "maxLength": 500
+                },
+                "isDefault": {
+                    "type": "boolean"
+                },
+                "label": {
+                    "type": "string",
+                    "maxLength": 50
+                },
+                "latitude": {
+                    "type": "number"
+                },
+                "longitude": {
+                    "type": "number"
+                },
+                "phone": {
+                    "type": "string"
+                },
+                "postalCode": {
+                    "type": "string"
+                },
+                "recipient": {
+                    "type": "string"
+                },
+                "street": {
+                    "type": "string"
+                }
+            }
+        },
+        "addressResource.Address": {
+            "type": "object",
+            "properties": {
+                "city": {
+                    "type": "string"
+                },
+                "courierNote": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "isDefault": {
+                    "type": "boolean"
+                },
+                "label": {
+                    "type": "string"
+                },
+                "latitude": {
+                    "type": "number"
+                },
+                "longitude": {
+                    "type": "number"
+                },
+                "phone": {
+                    "type": "string"
+                },
+                "postalCode": {
+                    "type": "string"
+                },
+                "recipient": {
+                    "type": "string"
+                },
+                "street": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "calendarRequest.Closure": {
+            "type": "object",
+            "required": [
+                "endsOn",
+                "startsOn"
+            ],
+            "properties": {
+                "endsOn": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "startsOn": {
+                    "type": "string"
+                }
+            }
+        },
+        "calendarRequest.Holiday": {
+            "type": "object",
+            "required": [
+                "date",
+                "name"
+            ],
+            "properties": {
+                "date": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "recurring": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "calendarRequest.OpeningHours": {
+            "type": "object",
+            "properties": {
+                "closeTime": {
+                    "type": "string"
+                },
+                "isClosed": {
+                    "type": "boolean"
+                },
+                "openTime": {
+                    "type": "string"
+                }
+            }
+        },
+        "calendarResource.Closure": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "endsOn": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "startsOn": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "calendarResource.Holiday": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "date": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "recurring": {
+                    "type": "boolean"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "calendarResource.OpeningHours": {
+            "type": "object",
+            "properties": {
+                "closeTime": {
+                    "type": "string"
+                },
+                "day": {
+                    "type": "string"
+                },
+                "isClosed": {
+                    "type": "boolean"
+                },
+                "openTime": {
+                    "type": "string"
+                },
+                "timezone": {
+                    "type": "string"
+                },
+                "weekday": {
+                    "type": "integer"
+                }
+            }
+        },
+        "courierRequest.Assignment": {
+            "type": "object",
+            "required": [
+                "courierID",
+                "leg"
+            ],
+            "properties": {
+                "courierID": {
+                    "type": "integer"
+                },
+                "leg": {
+                    "type": "string",
+                    "enum": [
+                        "pickup",
+                        "delivery"
+                    ]
+                }
+            }
+        },
+        "courierRequest.CreateCourier": {
+            "type": "object",
+            "required": [
+                "phone",
+                "userID",
+                "vehicleType"
+            ],
+            "properties": {
+                "phone": {
+                    "type": "string"
+                },
+                "userID": {
+                    "type": "integer"
+                },
+                "vehiclePlate": {
+                    "type": "string",
+                    "maxLength": 20
+                },
+                "vehicleType": {
+                    "type": "string",
+                    "maxLength": 50
+                }
+            }
+        },
+        "courierRequest.UpdateCourier": {
+            "type": "object",
+            "required": [
+                "phone",
+                "vehicleType"
+            ],
+            "properties": {
+                "isActive": {
+                    "type": "boolean"
+                },
+                "phone": {
+                    "type": "string"
+                },
+                "vehiclePlate": {
+                    "type": "string",
+                    "maxLength": 20
+                },
+                "vehicleType": {
+                    "type": "string",
+                    "maxLength": 50
+                }
+            }
+        },
+        "courierResource.Assignment": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "$ref": "#/definitions/addressResource.Address"
+                },
+                "completedAt": {
+                    "type": "string"
+                },
+                "courierID": {
+                    "type": "integer"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "leg": {
+                    "type": "string"
+                },
+                "orderID": {
+                    "type": "string"
+                },
+                "scheduledAt": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "courierResource.Courier": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "isActive": {
+                    "type": "boolean"
+                },
+                "phone": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                },
+                "user": {
+                    "$ref": "#/definitions/courierResource.User"
+                },
+                "userID": {
+                    "type": "integer"
+                },
+                "vehiclePlate": {
+                    "type": "string"
+                },
+                "vehicleType": {
+                    "type": "string"
+                }
+            }
+        },
+        "courierResource.User": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "firstName": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "image": {
+                    "type": "string"
+                },
+                "lastName": {
+                    "type": "string"
+                }
+            }
+        },
+        "ledgerResource.AccountBalance": {
+            "type": "object",
+            "properties": {
+                "balance": {
+                    "type": "number"
+                },
+                "code": {
+                    "type": "string"
+                },
+                "credit": {
+                    "type": "number"
+                },
+                "debit": {
+                    "type": "number"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "ledgerResource.Entry": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "kind": {
+                    "type": "string"
+                },
+                "lines": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ledgerResource.Line"
+                    }
+                },
+                "occurredAt": {
+                    "type": "string"
+                },
+                "orderID": {
+                    "type": "string"
+                },
+                "reference": {
+                    "type": "string"
+                },
+                "transactionID": {
+                    "type": "string"
+                }
+            }
+        },
+        "ledgerResource.Line": {
+            "type": "object",
+            "properties": {
+                "accountCode": {
+                    "type": "string"
+                },
+                "credit": {
+                    "type": "number"
+                },
+                "debit": {
+                    "type": "number"
+                },
+                "userID": {
+                    "type": "integer"
+                }
+            }
+        },
+        "ledgerResource.ListEntry": {
+            "type": "object",
+            "properties": {
+                "entries": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ledgerResource.Entry"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/paging.Pagination"
+                }
+            }
+        },
+        "orderRequest.Estimate": {
+            "type": "object",
+            "required": [
+                "orderType",
+                "serviceType"
+            ],
+            "properties": {
+                "collectDate": {
+                    "type": "string"
+                },
+                "orderType": {
+                    "type": "string"
+                },
+                "outletID": {
+                    "description": "OutletID queues the order behind the backlog of that outlet only.",
+                    "type": "integer"
+                },
+                "serviceType": {
+                    "type": "string"
+                },
+                "weight": {
+                    "type": "number",
+                    "minimum": 0
+                }
+            }
+        },
+        "orderRequest.ItemPhoto": {
+            "type": "object",
+            "required": [
+                "image"
+            ],
+            "properties": {
+                "image": {
+                    "type": "array",
+                    "maxItems": 2097152,
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "orderRequest.Order": {
+            "type": "object",
+            "required": [
+                "addressID",
+                "collectDate",
+                "orderType",
+                "serviceType"
+            ],
+            "properties": {
+                "addressID": {
+                    "type": "integer"
+                },
+                "collectDate": {
+                    "description": "CollectDate may be any time within a pickup window; the order is booked\nat the window's start. Without pickup windows it is kept as given.",
+                    "type": "string"
+                },
+                "note": {
+                    "type": "string"
+                },
+                "orderType": {
+                    "type": "string"
+                },
+                "serviceType": {
+                    "type": "string"
+                }
+            }
+        },
+        "orderRequest.OrderItem": {
+            "type": "object",
+            "required": [
+                "garmentType",
+                "quantity"
+            ],
+            "properties": {
+                "brand": {
+                    "type": "string",
+                    "maxLength": 50
+                },
+                "careInstructions": {
+                    "type": "string",
+                    "maxLength": 500
+                },
+                "colour": {
+                    "type": "string",
+                    "maxLength": 50
+                },
+                "damageNotes": {
+                    "type": "string",
+                    "maxLength": 500
+                },
+                "garmentType": {
+                    "type": "string",
+                    "maxLength": 50
+                },
+                "quantity": {
+                    "type": "integer"
+                },
+                "unitPrice": {
+                    "type": "number"
+                }
+            }
+        },
+        "orderRequest.Payment": {
+            "type": "object",
+            "required": [
+                "transactionID"
+            ],
+            "properties": {
+                "transactionID": {
+                    "type": "string"
+                }
+            }
+        },
+        "orderRequest.Quote": {
+            "type": "object",
+            "required": [
+                "orderType",
+                "serviceType"
+            ],
+            "properties": {
+                "addressID": {
+                    "description": "AddressID routes the quote to the outlet serving that saved address and\nadds its delivery fee. It takes precedence over OutletID.",
+                    "type": "integer"
+                },
+                "items": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "orderType": {
+                    "type": "string"
+                },
+                "outletID": {
+                    "description": "OutletID prices the order with the overrides of that outlet, if any.",
+                    "type": "integer"
+                },
+                "serviceType": {
+                    "type": "string"
+                },
+                "weight": {
+                    "type": "number",
+                    "minimum": 0
+                }
+            }
+        },
+        "orderRequest.UpdateStatus": {
+            "type": "object",
+            "required": [
+                "status"
+            ],
+            "properties": {
+                "note": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "orderResource.Estimate": {
+            "type": "object",
+            "properties": {
+                "backlogHours": {
+                    "type": "integer"
+                },
+                "estimateDate": {
+                    "type": "string"
+                },
+                "loadHours": {
+                    "type": "integer"
+                },
+                "orderType": {
+                    "type": "string"
+                },
+                "serviceType": {
+                    "type": "string"
+                },
+                "startsAt": {
+                    "type": "string"
+                },
+                "turnaroundHours": {
+                    "type": "integer"
+                },
+                "weight": {
+                    "type": "number"
+                }
+            }
+        },
+        "orderResource.Order": {
+            "type": "object",
+            "properties": {
+                "addressID": {
+                    "type": "integer"
+                },
+                "collectDate": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "deliveryFee": {
+                    "type": "number"
+                },
+                "distanceKm": {
+                    "type": "number"
+                },
+                "estimateDate": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/orderResource.OrderItem"
+                    }
+                },
+                "note": {
+                    "type": "string"
+                },
+                "orderType": {
+                    "type": "string"
+                },
+                "outletID": {
+                    "type": "integer"
+                },
+                "pickupSlotID": {
+                    "type": "integer"
+                },
+                "price": {
+                    "type": "number"
+                },
+                "serviceType": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "tagCode": {
+                    "type": "string"
+                },
+                "transactionID": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                },
+                "user": {
+                    "description": "UserID        int              ` + "`" + `json:\"userID\" gorm:\"not null;index\"` + "`" + `",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/orderResource.User"
+                        }
+                    ]
+                },
+                "weight": {
+                    "type": "number"
+                }
+            }
+        },
+        "orderResource.OrderItem": {
+            "type": "object",
+            "properties": {
+                "brand": {
+                    "type": "string"
+                },
+                "careInstructions": {
+                    "type": "string"
+                },
+                "colour": {
+                    "type": "string"
+                },
+                "damageNotes": {
+                    "type": "string"
+                },
+                "garmentType": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "photos": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "quantity": {
+                    "type": "integer"
+                },
+                "tagCode": {
+                    "type": "string"
+                },
+                "unitPrice": {
+                    "type": "number"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "orderResource.Quote": {
+            "type": "object",
+            "properties": {
+                "base": {
+                    "type": "number"
+                },
+                "deliveryFee": {
+                    "type": "number"
+                },
+                "distanceKm": {
+                    "type": "number"
+                },
+                "itemCharges": {
+                    "type": "number"
+                },
+                "items": {
+                    "type": "integer"
+                },
+                "minimumApplied": {
+                    "type": "boolean"
+                },
+                "orderType": {
+                    "type": "string"
+                },
+                "serviceType": {
+                    "type": "string"
+                },
+                "surcharge": {
+                    "type": "number"
+                },
+                "total": {
+                    "type": "number"
+                },
+                "weight": {
+                    "type": "number"
+                }
+            }
+        },
+        "orderResource.Scan": {
+            "type": "object",
+            "properties": {
+                "item": {
+                    "$ref": "#/definitions/orderResource.OrderItem"
+                },
+                "order": {
+                    "$ref": "#/definitions/orderResource.Order"
+                }
+            }
+        },
+        "orderResource.StatusEvent": {
+            "type": "object",
+            "properties": {
+                "actorID": {
+                    "type": "integer"
+                },
+                "actorRole": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "fromStatus": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "note": {
+                    "type": "string"
+                },
+                "toStatus": {
+                    "type": "string"
+                }
+            }
+        },
+        "orderResource.User": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "firstName": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "image": {
+                    "type": "string"
+                },
+                "lastName": {
+                    "type": "string"
+                },
+                "role": {
+                    "type": "string"
+                }
+            }
+        },
+        "outletRequest.Outlet": {
+            "type": "object",
+            "required": [
+                "city",
+                "code",
+                "name",
+                "street"
+            ],
+            "properties": {
+                "city": {
+                    "type": "string"
+                },
+                "closeTime": {
+                    "type": "string"
+                },
+                "code": {
+                    "type": "string",
+                    "maxLength": 20
+                },
+                "isActive": {
+                    "type": "boolean"
+                },
+                "latitude": {
+                    "type": "number"
+                },
+                "longitude": {
+                    "type": "number"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "openTime": {
+                    "type": "string"
+                },
+                "phone": {
+                    "type": "string"
+                },
+                "postalCode": {
+                    "type": "string"
+                },
+                "serviceArea": {
+                    "description": "ServiceArea is a GeoJSON Polygon, MultiPolygon, Feature or\nFeatureCollection. It takes precedence over ServiceRadiusKm.",
+                    "type": "object"
+                },
+                "serviceRadiusKm": {
+                    "type": "number",
+                    "minimum": 0
+                },
+                "street": {
+                    "type": "string"
+                }
+            }
+        },
+        "outletResource.Outlet": {
+            "type": "object",
+            "properties": {
+                "city": {
+                    "type": "string"
+                },
+                "closeTime": {
+                    "type": "string"
+                },
+                "code": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "isActive": {
+                    "type": "boolean"
+                },
+                "latitude": {
+                    "type": "number"
+                },
+                "longitude": {
+                    "type": "number"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "openTime": {
+                    "type": "string"
+                },
+                "phone": {
+                    "type": "string"
+                },
+                "postalCode": {
+                    "type": "string"
+                },
+                "serviceArea": {
+                    "type": "object"
+                },
+                "serviceRadiusKm": {
+                    "type": "number"
+                },
+                "street": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "outletResource.Staff": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "firstName": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "image": {
+                    "type": "string"
+                },
+                "lastName": {
+                    "type": "string"
+                },
+                "outletID": {
+                    "type": "integer"
+                },
+                "role": {
+                    "type": "string"
+                }
+            }
+        },
+        "paging.Pagination": {
+            "type": "object",
+            "properties": {
+                "current_page": {
+                    "type": "integer"
+                },
+                "limit": {
+                    "type": "integer"
+                },
+                "skip": {
+                    "type": "integer"
+                },
+                "total": {
+                    "type": "integer"
+                },
+                "total_page": {
+                    "type": "integer"
+                }
+            }
+        },
+        "pricingRequest.DeliveryFee": {
+            "type": "object",
+            "properties": {
+                "fee": {
+                    "type": "number"
+                },
+                "maxKm": {
+                    "type": "number"
+                }
+            }
+        },
+        "pricingRequest.OutletPrice": {
+            "type": "object",
+            "properties": {
+                "minimumCharge": {
+                    "type": "number"
+                },
+                "perItem": {
+                    "type": "number"
+                },
+                "perKg": {
+                    "type": "number"
+                }
+            }
+        },
+        "pricingRequest.PriceList": {
+            "type": "object",
+            "required": [
+                "serviceType"
+            ],
+            "properties": {
+                "minimumCharge": {
+                    "type": "number"
+                },
+                "perItem": {
+                    "type": "number"
+                },
+                "perKg": {
+                    "type": "number"
+                },
+                "roundingMode": {
+                    "type": "string",
+                    "enum": [
+                        "up",
+                        "down",
+                        "nearest"
+                    ]
+                },
+                "roundingUnit": {
+                    "type": "number"
+                },
+                "serviceType": {
+                    "type": "string"
+                }
+            }
+        },
+        "pricingRequest.Surcharge": {
+            "type": "object",
+            "properties": {
+                "flatFee": {
+                    "type": "number"
+                },
+                "percentage": {
+                    "type": "number"
+                }
+            }
+        },
+        "pricingResource.DeliveryFee": {
+            "type": "object",
+            "properties": {
+                "fee": {
+                    "type": "number"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "maxKm": {
+                    "type": "number"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "pricingResource.OutletPrice": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer"
+                },
+                "minimumCharge": {
+                    "type": "number"
+                },
+                "outletID": {
+                    "type": "integer"
+                },
+                "perItem": {
+                    "type": "number"
+                },
+                "perKg": {
+                    "type": "number"
+                },
+                "serviceType": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "pricingResource.PriceList": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer"
+                },
+                "minimumCharge": {
+                    "type": "number"
+                },
+                "perItem": {
+                    "type": "number"
+                },
+                "perKg": {
+                    "type": "number"
+                },
+                "roundingMode": {
+                    "type": "string"
+                },
+                "roundingUnit": {
+                    "type": "number"
+                },
+                "serviceType": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "pricingResource.Surcharge": {
+            "type": "object",
+            "properties": {
+                "flatFee": {
+                    "type": "number"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "orderType": {
+                    "type": "string"
+                },
+                "percentage": {
+                    "type": "number"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "serviceRequest.Service": {
+            "type": "object",
+            "required": [
+                "code",
+                "kind",
+                "name"
+            ],
+            "properties": {
+                "activeFrom": {
+                    "type": "string"
+                },
+                "activeUntil": {
+                    "type": "string"
+                },
+                "code": {
+                    "type": "string",
+                    "maxLength": 50
+                },
+                "description": {
+                    "type": "string"
+                },
+                "image": {
+                    "type": "string"
+                },
+                "isActive": {
+                    "type": "boolean"
+                },
+                "kind": {
+                    "type": "string",
+                    "enum": [
+                        "service_type",
+                        "order_type"
+                    ]
+                },
+                "name": {
+                    "type": "string"
+                },
+                "turnaroundHours": {
+                    "type": "integer",
+                    "minimum": 0
+                }
+            }
+        },
+        "serviceResource.Service": {
+            "type": "object",
+            "properties": {
+                "activeFrom": {
+                    "type": "string"
+                },
+                "activeUntil": {
+                    "type": "string"
+                },
+                "code": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "image": {
+                    "type": "string"
+                },
+                "isActive": {
+                    "type": "boolean"
+                },
+                "kind": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "turnaroundHours": {
+                    "type": "integer"
+                }
+            }
+        },
+        "slotRequest.Window": {
+            "type": "object",
+            "required": [
+                "capacity",
+                "endTime",
+                "startTime"
+            ],
+            "properties": {
+                "capacity": {
+                    "type": "integer"
+                },
+                "endTime": {
+                    "type": "string"
+                },
+                "isActive": {
+                    "type": "boolean"
+                },
+                "startTime": {
+                    "type": "string"
+                },
+                "weekday": {
+                    "type": "integer",
+                    "maximum": 6,
+                    "minimum": 0
+                }
+            }
+        },
+        "slotResource.Slot": {
+            "type": "object",
+            "properties": {
+                "available": {
+                    "type": "integer"
+                },
+                "capacity": {
+                    "type": "integer"
+                },
+                "endsAt": {
+                    "type": "string"
+                },
+                "startsAt": {
+                    "type": "string"
+                },
+                "windowID": {
+                    "type": "integer"
+                }
+            }
+        },
+        "slotResource.Window": {
+            "type": "object",
+            "properties": {
+                "capacity": {
+                    "type": "integer"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "endTime": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "isActive": {
+                    "type": "boolean"
+                },
+                "startTime": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                },
+                "weekday": {
+                    "type": "integer"
+                }
+            }
+        },
+        "transactionRequest.Refund": {
+            "type": "object",
+            "required": [
+                "reason"
+            ],
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "reason": {
+                    "type": "string",
+                    "maxLength": 500
+                }
+            }
+        },
+        "transactionRequest.ReviewRefund": {
+            "type": "object",
+            "properties": {
+                "note": {
+                    "type": "string",
+                    "maxLength": 500
+                }
+            }
+        },
+        "transactionRequest.Transaction": {
+            "type": "object",
+            "required": [
+                "orderID"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "orderID": {
+                    "type": "string"
+                },
+                "paymentMethod": {
+                    "type": "string"
+                }
+            }
+        },
+        "transactionRequest.UpdateStatus": {
+            "type": "object",
+            "required": [
+                "status"
+            ],
+            "properties": {
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "transactionResource.ListRefund": {
+            "type": "object",
+            "properties": {
+                "pagination": {
+                    "$ref": "#/definitions/paging.Pagination"
+                },
+                "refunds": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/transactionResource.Refund"
+                    }
+                }
+            }
+        },
+        "transactionResource.ListTransaction": {
+            "type": "object",
+            "properties": {
+                "pagination": {
+                    "$ref": "#/definitions/paging.Pagination"
+                },
+                "transactions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/transactionResource.Transaction"
+                    }
+                }
+            }
+        },
+        "transactionResource.ListWebhookEvent": {
+            "type": "object",
+            "properties": {
+                "events": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/transactionResource.WebhookEvent"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/paging.Pagination"
+                }
+            }
+        },
+        "transactionResource.Refund": {
+            "type": "object",
             "properties": {
-                "addressID": {
-                    "type": "integer"
+                "amount": {
+                    "type": "number"
                 },
-                "collectDate": {
+                "createdAt": {
                     "type": "string"
                 },
-                "note": {
+                "externalID": {
                     "type": "string"
                 },
-                "orderType": {
+                "failureReason": {
                     "type": "string"
                 },
-                "serviceType": {
+                "id": {
                     "type": "string"
-                }
-            }
-        },
-        "orderRequest.Payment": {
-            "type": "object",
-            "required": [
-                "transactionID"
-            ],
-            "properties": {
+                },
+                "orderID": {
+                    "type": "string"
+                },
+                "processedAt": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "requestedBy": {
+                    "type": "integer"
+                },
+                "reviewNote": {
+                    "type": "string"
+                },
+                "reviewedBy": {
+                    "type": "integer"
+                },
+                "status": {
+                    "type": "string"
+                },
                 "transactionID": {
                     "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                },
+                "userID": {
+                    "type": "integer"
                 }
             }
         },
-        "orderResource.Order": {
+        "transactionResource.Transaction": {
             "type": "object",
             "properties": {
-                "addressID": {
-                    "type": "integer"
+                "amount": {
+                    "type": "number"
                 },
-                "collectDate": {
+                "createdAt": {
                     "type": "string"
                 },
-                "createdAt": {
+                "description": {
                     "type": "string"
                 },
-                "estimateDate": {
+                "expiresAt": {
+                    "type": "string"
+                },
+                "externalID": {
                     "type": "string"
                 },
                 "id": {
                     "type": "string"
                 },
-                "note": {
+                "orderID": {
                     "type": "string"
                 },
-                "orderType": {
+                "paidAt": {
                     "type": "string"
                 },
-                "price": {
-                    "type": "number"
+                "paymentChannel": {
+                    "type": "string"
                 },
-                "serviceType": {
+                "paymentMethod": {
                     "type": "string"
                 },
-                "status": {
+                "paymentURL": {
                     "type": "string"
                 },
-                "transactionID": {
+                "provider": {
                     "type": "string"
                 },
-                "updatedAt": {
+                "refundedAmount": {
+                    "type": "number"
+                },
+                "status": {
                     "type": "string"
                 },
-                "user": {
-                    "description": "UserID        int              ` + "`" + `json:\"userID\" gorm:\"not null;index\"` + "`" + `",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/orderResource.User"
-                        }
-                    ]
+                "updatedAt": {
+                    "type": "string"
                 },
-                "weight": {
-                    "type": "number"
+                "userID": {
+                    "type": "integer"
                 }
             }
         },
-        "orderResource.User": {
+        "transactionResource.WebhookEvent": {
             "type": "object",
             "properties": {
-                "email": {
+                "amount": {
+                    "type": "number"
+                },
+                "createdAt": {
                     "type": "string"
                 },
-                "firstName": {
+                "error": {
+                    "type": "string"
+                },
+                "eventID": {
                     "type": "string"
                 },
+                "externalID": {
+                    "type": "string"
+                },
+                "fee": {
+                    "type": "number"
+                },
                 "id": {
                     "type": "integer"
                 },
-                "image": {
+                "occurredAt": {
                     "type": "string"
                 },
-                "lastName": {
+                "payload": {
+                    "type": "string"
+                },
+                "processedAt": {
+                    "type": "string"
+                },
+                "provider": {
+                    "type": "string"
+                },
+                "state": {
                     "type": "string"
                 },
+                "status": {
+                    "type": "string"
+                },
+                "transactionID": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "userRequest.AssignRole": {
+            "type": "object",
+            "required": [
+                "role"
+            ],
+            "properties": {
                 "role": {
                     "type": "string"
                 }
             }
         },
+        "userRequest.DisableTwoFactor": {
+            "type": "object",
+            "required": [
+                "code",
+                "password"
+            ],
+            "properties": {
+                "code": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                }
+            }
+        },
+        "userRequest.ForgotPassword": {
+            "type": "object",
+            "required": [
+                "email"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string"
+                }
+            }
+        },
         "userRequest.Google": {
             "type": "object",
             "properties": {
+                "deviceName": {
+                    "type": "string"
+                },
                 "fcmToken": {
                     "type": "string"
                 },
-                "idToken": {
+                "platform": {
+                    "type": "string",
+                    "enum": [
+                        "android",
+                        "ios",
+                        "web"
+                    ]
+                },
+                "tokenID": {
                     "type": "string"
                 }
             }
@@ -1048,6 +6335,9 @@ const docTemplate = `{
                 "password"
             ],
             "properties": {
+                "deviceName": {
+                    "type": "string"
+                },
                 "email": {
                     "type": "string"
                 },
@@ -1056,6 +6346,22 @@ const docTemplate = `{
                 },
                 "password": {
                     "type": "string"
+                },
+                "platform": {
+                    "type": "string",
+                    "enum": [
+                        "android",
+                        "ios",
+                        "web"
+                    ]
+                }
+            }
+        },
+        "userRequest.Logout": {
+            "type": "object",
+            "properties": {
+                "refreshToken": {
+                    "type": "string"
                 }
             }
         },
@@ -1084,6 +6390,53 @@ const docTemplate = `{
                 }
             }
         },
+        "userRequest.ResetPassword": {
+            "type": "object",
+            "required": [
+                "confirmPassword",
+                "newPassword",
+                "token"
+            ],
+            "properties": {
+                "confirmPassword": {
+                    "type": "string"
+                },
+                "newPassword": {
+                    "type": "string",
+                    "maxLength": 130,
+                    "minLength": 6
+                },
+                "token": {
+                    "type": "string"
+                }
+            }
+        },
+        "userRequest.TwoFactorCode": {
+            "type": "object",
+            "required": [
+                "code"
+            ],
+            "properties": {
+                "code": {
+                    "type": "string"
+                }
+            }
+        },
+        "userRequest.TwoFactorLogin": {
+            "type": "object",
+            "required": [
+                "challengeToken",
+                "code"
+            ],
+            "properties": {
+                "challengeToken": {
+                    "type": "string"
+                },
+                "code": {
+                    "type": "string"
+                }
+            }
+        },
         "userRequest.UpdatePassword": {
             "type": "object",
             "required": [
@@ -1135,6 +6488,96 @@ const docTemplate = `{
                 }
             }
         },
+        "userRequest.VerifyEmail": {
+            "type": "object",
+            "required": [
+                "token"
+            ],
+            "properties": {
+                "token": {
+                    "type": "string"
+                }
+            }
+        },
+        "userResource.RecoveryCodes": {
+            "type": "object",
+            "properties": {
+                "codes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "userResource.Role": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "permissions": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "userResource.Session": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "current": {
+                    "type": "boolean"
+                },
+                "deviceName": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "ipAddress": {
+                    "type": "string"
+                },
+                "lastSeenAt": {
+                    "type": "string"
+                },
+                "platform": {
+                    "type": "string"
+                },
+                "userAgent": {
+                    "type": "string"
+                }
+            }
+        },
+        "userResource.TwoFactorChallenge": {
+            "type": "object",
+            "properties": {
+                "challengeToken": {
+                    "type": "string"
+                },
+                "expiresAt": {
+                    "type": "string"
+                },
+                "twoFactorRequired": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "userResource.TwoFactorEnrollment": {
+            "type": "object",
+            "properties": {
+                "secret": {
+                    "type": "string"
+                },
+                "uri": {
+                    "type": "string"
+                }
+            }
+        },
         "userResource.User": {
             "type": "object",
             "properties": {
@@ -1144,6 +6587,9 @@ const docTemplate = `{
                 "email": {
                     "type": "string"
                 },
+                "emailVerifiedAt": {
+                    "type": "string"
+                },
                 "firstName": {
                     "type": "string"
                 },
@@ -1156,8 +6602,17 @@ const docTemplate = `{
                 "lastName": {
                     "type": "string"
                 },
+                "outletID": {
+                    "type": "integer"
+                },
+                "pendingEmail": {
+                    "type": "string"
+                },
                 "role": {
                     "type": "string"
+                },
+                "twoFactorEnabledAt": {
+                    "type": "string"
                 }
             }
         },
@@ -4,8 +4,10 @@ go 1.23.3
 
 require (
 	firebase.google.com/go v3.13.0+incompatible
+	github.com/boombuler/barcode v1.1.0
 	github.com/fatih/camelcase v1.0.0
 	github.com/gin-gonic/gin v1.10.0
+	github.com/go-pdf/fpdf v0.9.0
 	github.com/google/uuid v1.6.0
 	github.com/stretchr/testify v1.10.0
 	github.com/swaggo/files v1.0.1
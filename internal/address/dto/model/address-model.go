@@ -0,0 +1,27 @@
+package addressModel
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Address is a labelled pickup/delivery location of a user. Addresses are
+// soft-deleted so orders and histories that reference them stay intact.
+type Address struct {
+	ID          int64          `json:"id" gorm:"primaryKey"`
+	UserID      int64          `json:"userID" gorm:"not null;index"`
+	Label       string         `json:"label"`
+	Recipient   string         `json:"recipient"`
+	Phone       string         `json:"phone"`
+	Street      string         `json:"street"`
+	City        string         `json:"city"`
+	PostalCode  string         `json:"postalCode"`
+	Latitude    float64        `json:"latitude"`
+	Longitude   float64        `json:"longitude"`
+	CourierNote string         `json:"courierNote"`
+	IsDefault   bool           `json:"isDefault" gorm:"not null"`
+	CreatedAt   time.Time      `json:"createdAt"`
+	UpdatedAt   time.Time      `json:"updatedAt"`
+	DeletedAt   gorm.DeletedAt `json:"-" gorm:"index"`
+}
@@ -0,0 +1,14 @@
+package addressRequest
+
+type Address struct {
+	Label       string  `json:"label" validate:"required,max=50"`
+	Recipient   string  `json:"recipient" validate:"required"`
+	Phone       string  `json:"phone" validate:"required"`
+	Street      string  `json:"street" validate:"required"`
+	City        string  `json:"city" validate:"required"`
+	PostalCode  string  `json:"postalCode"`
+	Latitude    float64 `json:"latitude" validate:"latitude"`
+	Longitude   float64 `json:"longitude" validate:"longitude"`
+	CourierNote string  `json:"courierNote" validate:"max=500"`
+	IsDefault   bool    `json:"isDefault"`
+}
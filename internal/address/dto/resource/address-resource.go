@@ -0,0 +1,19 @@
+package addressResource
+
+import "time"
+
+type Address struct {
+	ID          int64     `json:"id"`
+	Label       string    `json:"label"`
+	Recipient   string    `json:"recipient"`
+	Phone       string    `json:"phone"`
+	Street      string    `json:"street"`
+	City        string    `json:"city"`
+	PostalCode  string    `json:"postalCode"`
+	Latitude    float64   `json:"latitude"`
+	Longitude   float64   `json:"longitude"`
+	CourierNote string    `json:"courierNote"`
+	IsDefault   bool      `json:"isDefault"`
+	CreatedAt   time.Time `json:"createdAt"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+}
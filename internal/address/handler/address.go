@@ -0,0 +1,191 @@
+package address
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	addressRequest "washit-api/internal/address/dto/request"
+	addressResource "washit-api/internal/address/dto/resource"
+	addressService "washit-api/internal/address/service"
+	outletService "washit-api/internal/outlet/service"
+	"washit-api/pkg/redis"
+	"washit-api/pkg/response"
+	"washit-api/pkg/utils"
+)
+
+type AddressHandler struct {
+	service addressService.IAddressService
+	cache   redis.IRedis
+}
+
+func NewAddressHandler(service addressService.IAddressService, cache redis.IRedis) *AddressHandler {
+	return &AddressHandler{
+		service: service,
+		cache:   cache,
+	}
+}
+
+// GetAddressesMe retrieves the saved addresses of the logged in user.
+//
+//	@Summary	Get my addresses
+//	@Tags		Address
+//	@Accept		json
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Success	200	{object}	[]addressResource.Address
+//	@Router		/addresses [get]
+func (h *AddressHandler) GetAddressesMe(c *gin.Context) {
+	var res []addressResource.Address
+
+	addresses, err := h.service.GetAddressesMe(c, c.GetString("userID"))
+	if err != nil {
+		log.Println("Failed to get addresses ", err)
+		response.Error(c, http.StatusInternalServerError, "failed to get addresses", err)
+		return
+	}
+
+	utils.CopyTo(&addresses, &res)
+	response.Success(c, http.StatusOK, "addresses are collected successfully", &res, nil)
+}
+
+// GetAddressByID retrieves one of the logged in user's addresses.
+//
+//	@Summary	Get an address by ID
+//	@Tags		Address
+//	@Accept		json
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		id	path		string	true	"Address ID"
+//	@Success	200	{object}	addressResource.Address
+//	@Router		/address/{id} [get]
+func (h *AddressHandler) GetAddressByID(c *gin.Context) {
+	var res addressResource.Address
+
+	address, err := h.service.GetAddressByID(c, c.Param("id"), c.GetString("userID"))
+	if err != nil {
+		log.Println("Failed to get address ", err)
+		response.Error(c, http.StatusNotFound, "failed to get address", err)
+		return
+	}
+
+	utils.CopyTo(&address, &res)
+	response.Success(c, http.StatusOK, "address is collected successfully", &res, nil)
+}
+
+// CreateAddress saves a new address for the logged in user.
+//
+//	@Summary	Create an address
+//	@Tags		Address
+//	@Accept		json
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		_	body		addressRequest.Address	true	"Address details"
+//	@Success	201	{object}	addressResource.Address
+//	@Router		/address [post]
+func (h *AddressHandler) CreateAddress(c *gin.Context) {
+	var req addressRequest.Address
+	var res addressResource.Address
+
+	if err := utils.ParseJson(c, &req); err != nil {
+		log.Println("Failed to parse request body ", err)
+		response.Error(c, http.StatusBadRequest, "failed to parse request body", err)
+		return
+	}
+
+	address, err := h.service.CreateAddress(c, c.GetString("userID"), &req)
+	if err != nil {
+		log.Println("Failed to create address ", err)
+		response.Error(c, statusCode(err), "failed to create address", err)
+		return
+	}
+
+	utils.CopyTo(&address, &res)
+	response.Success(c, http.StatusCreated, "address is created successfully", &res, nil)
+}
+
+// UpdateAddress updates one of the logged in user's addresses.
+//
+//	@Summary	Update an address
+//	@Tags		Address
+//	@Accept		json
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		id	path		string					true	"Address ID"
+//	@Param		_	body		addressRequest.Address	true	"Address details"
+//	@Success	200	{object}	addressResource.Address
+//	@Router		/address/{id} [put]
+func (h *AddressHandler) UpdateAddress(c *gin.Context) {
+	var req addressRequest.Address
+	var res addressResource.Address
+
+	if err := utils.ParseJson(c, &req); err != nil {
+		log.Println("Failed to parse request body ", err)
+		response.Error(c, http.StatusBadRequest, "failed to parse request body", err)
+		return
+	}
+
+	address, err := h.service.UpdateAddress(c, c.Param("id"), c.GetString("userID"), &req)
+	if err != nil {
+		log.Println("Failed to update address ", err)
+		response.Error(c, statusCode(err), "failed to update address", err)
+		return
+	}
+
+	utils.CopyTo(&address, &res)
+	response.Success(c, http.StatusOK, "address is updated successfully", &res, nil)
+}
+
+// SetDefaultAddress marks an address as the logged in user's default.
+//
+//	@Summary	Set the default address
+//	@Tags		Address
+//	@Accept		json
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		id	path		string	true	"Address ID"
+//	@Success	200	{object}	addressResource.Address
+//	@Router		/address/{id}/default [put]
+func (h *AddressHandler) SetDefaultAddress(c *gin.Context) {
+	var res addressResource.Address
+
+	address, err := h.service.SetDefaultAddress(c, c.Param("id"), c.GetString("userID"))
+	if err != nil {
+		log.Println("Failed to set default address ", err)
+		response.Error(c, http.StatusInternalServerError, "failed to set default address", err)
+		return
+	}
+
+	utils.CopyTo(&address, &res)
+	response.Success(c, http.StatusOK, "default address is set successfully", &res, nil)
+}
+
+// DeleteAddress removes one of the logged in user's addresses.
+//
+//	@Summary	Delete an address
+//	@Tags		Address
+//	@Accept		json
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		id	path	string	true	"Address ID"
+//	@Success	200
+//	@Router		/address/{id} [delete]
+func (h *AddressHandler) DeleteAddress(c *gin.Context) {
+	if err := h.service.DeleteAddress(c, c.Param("id"), c.GetString("userID")); err != nil {
+		log.Println("Failed to delete address ", err)
+		response.Error(c, http.StatusInternalServerError, "failed to delete address", err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, "address is deleted successfully", nil, nil)
+}
+
+func statusCode(err error) int {
+	if errors.Is(err, outletService.ErrOutOfArea) {
+		return http.StatusBadRequest
+	}
+
+	return http.StatusInternalServerError
+}
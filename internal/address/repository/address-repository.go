@@ -0,0 +1,74 @@
+package addressRepository
+
+import (
+	"context"
+
+	addressModel "washit-api/internal/address/dto/model"
+	"washit-api/pkg/db/dbs"
+)
+
+type IAddressRepository interface {
+	GetAddressesByUser(ctx context.Context, userID string) ([]*addressModel.Address, error)
+	GetAddressByID(ctx context.Context, addressID string) (*addressModel.Address, error)
+	CreateAddress(ctx context.Context, address *addressModel.Address) error
+	UpdateAddress(ctx context.Context, address *addressModel.Address) error
+	DeleteAddress(ctx context.Context, address *addressModel.Address) error
+	SetDefaultAddress(ctx context.Context, address *addressModel.Address) error
+}
+
+type AddressRepository struct {
+	db dbs.IDatabase
+}
+
+func NewAddressRepository(db dbs.IDatabase) *AddressRepository {
+	return &AddressRepository{db: db}
+}
+
+func (r *AddressRepository) GetAddressesByUser(ctx context.Context, userID string) ([]*addressModel.Address, error) {
+	var addresses []*addressModel.Address
+	query := []dbs.FindOption{
+		dbs.WithQuery(dbs.NewQuery("user_id = ?", userID)),
+		dbs.WithOrder("is_default DESC, created_at DESC"),
+	}
+
+	if err := r.db.Find(ctx, &addresses, query...); err != nil {
+		return nil, err
+	}
+
+	return addresses, nil
+}
+
+func (r *AddressRepository) GetAddressByID(ctx context.Context, addressID string) (*addressModel.Address, error) {
+	var address addressModel.Address
+	if err := r.db.FindByID(ctx, addressID, &address); err != nil {
+		return nil, err
+	}
+
+	return &address, nil
+}
+
+func (r *AddressRepository) CreateAddress(ctx context.Context, address *addressModel.Address) error {
+	return r.db.Create(ctx, address)
+}
+
+func (r *AddressRepository) UpdateAddress(ctx context.Context, address *addressModel.Address) error {
+	return r.db.Update(ctx, address)
+}
+
+func (r *AddressRepository) DeleteAddress(ctx context.Context, address *addressModel.Address) error {
+	return r.db.Delete(ctx, address)
+}
+
+// SetDefaultAddress saves address as the user's only default address.
+func (r *AddressRepository) SetDefaultAddress(ctx context.Context, address *addressModel.Address) error {
+	return r.db.WithTransaction(func(tx dbs.IDatabase) error {
+		if err := tx.GetDB().Model(&addressModel.Address{}).
+			Where("user_id = ? AND id <> ?", address.UserID, address.ID).
+			Update("is_default", false).Error; err != nil {
+			return err
+		}
+
+		address.IsDefault = true
+		return tx.Update(ctx, address)
+	})
+}
@@ -0,0 +1,38 @@
+package addressRoutes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator"
+
+	address "washit-api/internal/address/handler"
+	addressRepository "washit-api/internal/address/repository"
+	addressService "washit-api/internal/address/service"
+	outletRepository "washit-api/internal/outlet/repository"
+	outletService "washit-api/internal/outlet/service"
+	userRepository "washit-api/internal/user/repository"
+	userService "washit-api/internal/user/service"
+	"washit-api/pkg/db/dbs"
+	"washit-api/pkg/mailer"
+	"washit-api/pkg/middleware"
+	"washit-api/pkg/redis"
+)
+
+func Main(r *gin.RouterGroup, db dbs.IDatabase, cache redis.IRedis, validator *validator.Validate) {
+	repository := addressRepository.NewAddressRepository(db)
+	users := userService.NewUserService(userRepository.NewUserRepository(db), cache, mailer.FromEnvs(), validator)
+	outlets := outletService.NewOutletService(outletRepository.NewOutletRepository(db), users, validator)
+	service := addressService.NewAddressService(repository, outlets, validator)
+	handler := address.NewAddressHandler(service, cache)
+
+	authMiddleware := middleware.JWTAuth(cache)
+
+	// Address Get
+	r.GET("/addresses", authMiddleware, handler.GetAddressesMe)
+	r.GET("/address/:id", authMiddleware, handler.GetAddressByID)
+
+	// Address Manage
+	r.POST("/address", authMiddleware, handler.CreateAddress)
+	r.PUT("/address/:id", authMiddleware, handler.UpdateAddress)
+	r.PUT("/address/:id/default", authMiddleware, handler.SetDefaultAddress)
+	r.DELETE("/address/:id", authMiddleware, handler.DeleteAddress)
+}
@@ -0,0 +1,195 @@
+package addressService
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+
+	addressModel "washit-api/internal/address/dto/model"
+	addressRequest "washit-api/internal/address/dto/request"
+	addressRepository "washit-api/internal/address/repository"
+	outletService "washit-api/internal/outlet/service"
+	"washit-api/pkg/geo"
+	"washit-api/pkg/utils"
+
+	"github.com/go-playground/validator"
+)
+
+type IAddressService interface {
+	GetAddressesMe(c context.Context, userID string) ([]*addressModel.Address, error)
+	GetAddressByID(c context.Context, addressID string, userID string) (*addressModel.Address, error)
+	CreateAddress(c context.Context, userID string, req *addressRequest.Address) (*addressModel.Address, error)
+	UpdateAddress(c context.Context, addressID string, userID string, req *addressRequest.Address) (*addressModel.Address, error)
+	SetDefaultAddress(c context.Context, addressID string, userID string) (*addressModel.Address, error)
+	DeleteAddress(c context.Context, addressID string, userID string) error
+}
+
+type AddressService struct {
+	repository addressRepository.IAddressRepository
+	outlets    outletService.IOutletService
+	validator  *validator.Validate
+}
+
+func NewAddressService(
+	repository addressRepository.IAddressRepository, outlets outletService.IOutletService,
+	validator *validator.Validate) *AddressService {
+	return &AddressService{
+		repository: repository,
+		outlets:    outlets,
+		validator:  validator,
+	}
+}
+
+func (s *AddressService) GetAddressesMe(c context.Context, userID string) ([]*addressModel.Address, error) {
+	addresses, err := s.repository.GetAddressesByUser(c, userID)
+	if err != nil {
+		log.Printf("Failed to get addresses for user %s: %v", userID, err)
+		return nil, fmt.Errorf("failed to get addresses for user %s: %w", userID, err)
+	}
+
+	return addresses, nil
+}
+
+// GetAddressByID returns an address of userID. Addresses of other users are
+// reported as not found so their existence is not leaked.
+func (s *AddressService) GetAddressByID(c context.Context, addressID string, userID string) (*addressModel.Address, error) {
+	address, err := s.repository.GetAddressByID(c, addressID)
+	if err != nil {
+		log.Printf("Failed to get address by id: %v", err)
+		return nil, fmt.Errorf("address not found: %v", addressID)
+	}
+
+	if strconv.FormatInt(address.UserID, 10) != userID {
+		log.Printf("User ID mismatch: expected %v, got %v", userID, address.UserID)
+		return nil, fmt.Errorf("address not found: %v", addressID)
+	}
+
+	return address, nil
+}
+
+func (s *AddressService) CreateAddress(c context.Context, userID string, req *addressRequest.Address) (*addressModel.Address, error) {
+	if err := s.validator.Struct(req); err != nil {
+		log.Printf("Failed to validate address request: %v", err)
+		return nil, fmt.Errorf("validation error: %w", err)
+	}
+
+	if err := s.checkServiceArea(c, req); err != nil {
+		return nil, err
+	}
+
+	addressUserID, err := strconv.ParseInt(userID, 10, 64)
+	if err != nil {
+		log.Printf("Failed to parse userID: %v", err)
+		return nil, fmt.Errorf("failed to parse userID: %w", err)
+	}
+
+	existing, err := s.repository.GetAddressesByUser(c, userID)
+	if err != nil {
+		log.Printf("Failed to get addresses for user %s: %v", userID, err)
+		return nil, fmt.Errorf("failed to create address: %w", err)
+	}
+
+	address := &addressModel.Address{}
+	utils.CopyTo(req, address)
+	address.UserID = addressUserID
+
+	if req.IsDefault || len(existing) == 0 {
+		err = s.repository.SetDefaultAddress(c, address)
+	} else {
+		err = s.repository.CreateAddress(c, address)
+	}
+	if err != nil {
+		log.Printf("Failed to create address: %v", err)
+		return nil, fmt.Errorf("failed to create address: %w", err)
+	}
+
+	return address, nil
+}
+
+func (s *AddressService) UpdateAddress(c context.Context, addressID string, userID string, req *addressRequest.Address) (*addressModel.Address, error) {
+	if err := s.validator.Struct(req); err != nil {
+		log.Printf("Failed to validate address request: %v", err)
+		return nil, fmt.Errorf("validation error: %w", err)
+	}
+
+	address, err := s.GetAddressByID(c, addressID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.checkServiceArea(c, req); err != nil {
+		return nil, err
+	}
+
+	wasDefault := address.IsDefault
+	utils.CopyTo(req, address)
+	address.IsDefault = wasDefault
+
+	if req.IsDefault && !wasDefault {
+		err = s.repository.SetDefaultAddress(c, address)
+	} else {
+		err = s.repository.UpdateAddress(c, address)
+	}
+	if err != nil {
+		log.Printf("Failed to update address %s: %v", addressID, err)
+		return nil, fmt.Errorf("failed to update address: %w", err)
+	}
+
+	return address, nil
+}
+
+func (s *AddressService) SetDefaultAddress(c context.Context, addressID string, userID string) (*addressModel.Address, error) {
+	address, err := s.GetAddressByID(c, addressID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.repository.SetDefaultAddress(c, address); err != nil {
+		log.Printf("Failed to set default address %s: %v", addressID, err)
+		return nil, fmt.Errorf("failed to set default address: %w", err)
+	}
+
+	return address, nil
+}
+
+// DeleteAddress soft-deletes an address. When it was the default, the most
+// recently created remaining address becomes the new default.
+func (s *AddressService) DeleteAddress(c context.Context, addressID string, userID string) error {
+	address, err := s.GetAddressByID(c, addressID, userID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.repository.DeleteAddress(c, address); err != nil {
+		log.Printf("Failed to delete address %s: %v", addressID, err)
+		return fmt.Errorf("failed to delete address: %w", err)
+	}
+
+	if !address.IsDefault {
+		return nil
+	}
+
+	remaining, err := s.repository.GetAddressesByUser(c, userID)
+	if err != nil || len(remaining) == 0 {
+		return nil
+	}
+
+	if err := s.repository.SetDefaultAddress(c, remaining[0]); err != nil {
+		log.Printf("Failed to promote default address for user %s: %v", userID, err)
+	}
+
+	return nil
+}
+
+// checkServiceArea ensures some outlet serves the location of req, so
+// customers cannot save addresses no order could be collected from.
+func (s *AddressService) checkServiceArea(c context.Context, req *addressRequest.Address) error {
+	location := geo.Point{Lat: req.Latitude, Lng: req.Longitude}
+	if _, err := s.outlets.Cover(c, location); err != nil {
+		log.Printf("Address at %v is not served: %v", location, err)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,199 @@
+// Code generated by mockery v2.50.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	addressModel "washit-api/internal/address/dto/model"
+	addressRequest "washit-api/internal/address/dto/request"
+
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// IAddressService is an autogenerated mock type for the IAddressService type
+type IAddressService struct {
+	mock.Mock
+}
+
+// CreateAddress provides a mock function with given fields: c, userID, req
+func (_m *IAddressService) CreateAddress(c context.Context, userID string, req *addressRequest.Address) (*addressModel.Address, error) {
+	ret := _m.Called(c, userID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateAddress")
+	}
+
+	var r0 *addressModel.Address
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *addressRequest.Address) (*addressModel.Address, error)); ok {
+		return rf(c, userID, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *addressRequest.Address) *addressModel.Address); ok {
+		r0 = rf(c, userID, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*addressModel.Address)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *addressRequest.Address) error); ok {
+		r1 = rf(c, userID, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteAddress provides a mock function with given fields: c, addressID, userID
+func (_m *IAddressService) DeleteAddress(c context.Context, addressID string, userID string) error {
+	ret := _m.Called(c, addressID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAddress")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(c, addressID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetAddressByID provides a mock function with given fields: c, addressID, userID
+func (_m *IAddressService) GetAddressByID(c context.Context, addressID string, userID string) (*addressModel.Address, error) {
+	ret := _m.Called(c, addressID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAddressByID")
+	}
+
+	var r0 *addressModel.Address
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*addressModel.Address, error)); ok {
+		return rf(c, addressID, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *addressModel.Address); ok {
+		r0 = rf(c, addressID, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*addressModel.Address)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(c, addressID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAddressesMe provides a mock function with given fields: c, userID
+func (_m *IAddressService) GetAddressesMe(c context.Context, userID string) ([]*addressModel.Address, error) {
+	ret := _m.Called(c, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAddressesMe")
+	}
+
+	var r0 []*addressModel.Address
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*addressModel.Address, error)); ok {
+		return rf(c, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*addressModel.Address); ok {
+		r0 = rf(c, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*addressModel.Address)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(c, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetDefaultAddress provides a mock function with given fields: c, addressID, userID
+func (_m *IAddressService) SetDefaultAddress(c context.Context, addressID string, userID string) (*addressModel.Address, error) {
+	ret := _m.Called(c, addressID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetDefaultAddress")
+	}
+
+	var r0 *addressModel.Address
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*addressModel.Address, error)); ok {
+		return rf(c, addressID, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *addressModel.Address); ok {
+		r0 = rf(c, addressID, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*addressModel.Address)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(c, addressID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateAddress provides a mock function with given fields: c, addressID, userID, req
+func (_m *IAddressService) UpdateAddress(c context.Context, addressID string, userID string, req *addressRequest.Address) (*addressModel.Address, error) {
+	ret := _m.Called(c, addressID, userID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateAddress")
+	}
+
+	var r0 *addressModel.Address
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *addressRequest.Address) (*addressModel.Address, error)); ok {
+		return rf(c, addressID, userID, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *addressRequest.Address) *addressModel.Address); ok {
+		r0 = rf(c, addressID, userID, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*addressModel.Address)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, *addressRequest.Address) error); ok {
+		r1 = rf(c, addressID, userID, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewIAddressService creates a new instance of IAddressService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIAddressService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IAddressService {
+	mock := &IAddressService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
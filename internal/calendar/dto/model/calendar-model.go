@@ -0,0 +1,38 @@
+package calendarModel
+
+import "time"
+
+// OpeningHours overrides the configured business hours of one day of the
+// week. OpenTime and CloseTime are HH:MM wall clock times in the business
+// time zone. Weekdays without a row use the BUSINESS_* defaults.
+type OpeningHours struct {
+	ID        int64        `json:"id" gorm:"primaryKey"`
+	Weekday   time.Weekday `json:"weekday" gorm:"not null;uniqueIndex"`
+	OpenTime  string       `json:"openTime"`
+	CloseTime string       `json:"closeTime"`
+	IsClosed  bool         `json:"isClosed" gorm:"not null"`
+	CreatedAt time.Time    `json:"createdAt"`
+	UpdatedAt time.Time    `json:"updatedAt"`
+}
+
+// Holiday is a public holiday the laundry is closed on. Date is YYYY-MM-DD;
+// recurring holidays repeat every year on the same month and day.
+type Holiday struct {
+	ID        int64     `json:"id" gorm:"primaryKey"`
+	Date      string    `json:"date" gorm:"not null;index"`
+	Name      string    `json:"name" gorm:"not null"`
+	Recurring bool      `json:"recurring" gorm:"not null"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// Closure is an ad-hoc closure over whole days, StartsOn to EndsOn inclusive,
+// both YYYY-MM-DD.
+type Closure struct {
+	ID        int64     `json:"id" gorm:"primaryKey"`
+	StartsOn  string    `json:"startsOn" gorm:"not null;index"`
+	EndsOn    string    `json:"endsOn" gorm:"not null;index"`
+	Reason    string    `json:"reason"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
@@ -0,0 +1,19 @@
+package calendarRequest
+
+type OpeningHours struct {
+	OpenTime  string `json:"openTime"`
+	CloseTime string `json:"closeTime"`
+	IsClosed  bool   `json:"isClosed"`
+}
+
+type Holiday struct {
+	Date      string `json:"date" validate:"required"`
+	Name      string `json:"name" validate:"required,max=100"`
+	Recurring bool   `json:"recurring"`
+}
+
+type Closure struct {
+	StartsOn string `json:"startsOn" validate:"required"`
+	EndsOn   string `json:"endsOn" validate:"required"`
+	Reason   string `json:"reason" validate:"max=255"`
+}
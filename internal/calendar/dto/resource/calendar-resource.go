@@ -0,0 +1,30 @@
+package calendarResource
+
+import "time"
+
+type OpeningHours struct {
+	Weekday   int    `json:"weekday"`
+	Day       string `json:"day"`
+	OpenTime  string `json:"openTime"`
+	CloseTime string `json:"closeTime"`
+	IsClosed  bool   `json:"isClosed"`
+	Timezone  string `json:"timezone"`
+}
+
+type Holiday struct {
+	ID        int64     `json:"id"`
+	Date      string    `json:"date"`
+	Name      string    `json:"name"`
+	Recurring bool      `json:"recurring"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+type Closure struct {
+	ID        int64     `json:"id"`
+	StartsOn  string    `json:"startsOn"`
+	EndsOn    string    `json:"endsOn"`
+	Reason    string    `json:"reason"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
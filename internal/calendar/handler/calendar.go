@@ -0,0 +1,323 @@
+package calendar
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	calendarRequest "washit-api/internal/calendar/dto/request"
+	calendarResource "washit-api/internal/calendar/dto/resource"
+	calendarService "washit-api/internal/calendar/service"
+	"washit-api/pkg/redis"
+	"washit-api/pkg/response"
+	"washit-api/pkg/utils"
+)
+
+type CalendarHandler struct {
+	service calendarService.ICalendarService
+	cache   redis.IRedis
+}
+
+func NewCalendarHandler(service calendarService.ICalendarService, cache redis.IRedis) *CalendarHandler {
+	return &CalendarHandler{
+		service: service,
+		cache:   cache,
+	}
+}
+
+// GetOpeningHours lists the opening hours of every day of the week.
+//
+//	@Summary	Get opening hours
+//	@Tags		Calendar
+//	@Accept		json
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Success	200	{object}	[]calendarResource.OpeningHours
+//	@Router		/calendar/hours [get]
+func (h *CalendarHandler) GetOpeningHours(c *gin.Context) {
+	var res []calendarResource.OpeningHours
+
+	days, err := h.service.GetOpeningHours(c)
+	if err != nil {
+		log.Println("Failed to get opening hours ", err)
+		response.Error(c, http.StatusInternalServerError, "failed to get opening hours", err)
+		return
+	}
+
+	utils.CopyTo(&days, &res)
+	response.Success(c, http.StatusOK, "opening hours are collected successfully", &res, nil)
+}
+
+// UpdateOpeningHours sets the opening hours of one day of the week.
+//
+//	@Summary	Update opening hours of a weekday
+//	@Tags		Calendar
+//	@Accept		json
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		weekday	path		string							true	"Weekday as 0 (Sunday) to 6 or its name"
+//	@Param		_		body		calendarRequest.OpeningHours	true	"Opening hours"
+//	@Success	200		{object}	calendarResource.OpeningHours
+//	@Router		/calendar/hours/{weekday} [put]
+func (h *CalendarHandler) UpdateOpeningHours(c *gin.Context) {
+	var req calendarRequest.OpeningHours
+	var res calendarResource.OpeningHours
+
+	if err := utils.ParseJson(c, &req); err != nil {
+		log.Println("Failed to parse request body ", err)
+		response.Error(c, http.StatusBadRequest, "failed to parse request body", err)
+		return
+	}
+
+	day, err := h.service.UpdateOpeningHours(c, c.Param("weekday"), &req)
+	if err != nil {
+		log.Println("Failed to update opening hours ", err)
+		response.Error(c, http.StatusInternalServerError, "failed to update opening hours", err)
+		return
+	}
+
+	utils.CopyTo(&day, &res)
+	response.Success(c, http.StatusOK, "opening hours are updated successfully", &res, nil)
+}
+
+// GetHolidays lists the public holidays the laundry is closed on.
+//
+//	@Summary	Get holidays
+//	@Tags		Calendar
+//	@Accept		json
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Success	200	{object}	[]calendarResource.Holiday
+//	@Router		/calendar/holidays [get]
+func (h *CalendarHandler) GetHolidays(c *gin.Context) {
+	var res []calendarResource.Holiday
+
+	holidays, err := h.service.GetHolidays(c)
+	if err != nil {
+		log.Println("Failed to get holidays ", err)
+		response.Error(c, http.StatusInternalServerError, "failed to get holidays", err)
+		return
+	}
+
+	utils.CopyTo(&holidays, &res)
+	response.Success(c, http.StatusOK, "holidays are collected successfully", &res, nil)
+}
+
+// CreateHoliday adds a public holiday.
+//
+//	@Summary	Create a holiday
+//	@Tags		Calendar
+//	@Accept		json
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		_	body		calendarRequest.Holiday	true	"Holiday details"
+//	@Success	201	{object}	calendarResource.Holiday
+//	@Router		/calendar/holiday [post]
+func (h *CalendarHandler) CreateHoliday(c *gin.Context) {
+	var req calendarRequest.Holiday
+	var res calendarResource.Holiday
+
+	if err := utils.ParseJson(c, &req); err != nil {
+		log.Println("Failed to parse request body ", err)
+		response.Error(c, http.StatusBadRequest, "failed to parse request body", err)
+		return
+	}
+
+	holiday, err := h.service.CreateHoliday(c, &req)
+	if err != nil {
+		log.Println("Failed to create holiday ", err)
+		response.Error(c, statusCode(err), "failed to create holiday", err)
+		return
+	}
+
+	utils.CopyTo(&holiday, &res)
+	response.Success(c, http.StatusCreated, "holiday is created successfully", &res, nil)
+}
+
+// UpdateHoliday changes a public holiday.
+//
+//	@Summary	Update a holiday
+//	@Tags		Calendar
+//	@Accept		json
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		id	path		string					true	"Holiday ID"
+//	@Param		_	body		calendarRequest.Holiday	true	"Holiday details"
+//	@Success	200	{object}	calendarResource.Holiday
+//	@Router		/calendar/holiday/{id} [put]
+func (h *CalendarHandler) UpdateHoliday(c *gin.Context) {
+	var req calendarRequest.Holiday
+	var res calendarResource.Holiday
+
+	if err := utils.ParseJson(c, &req); err != nil {
+		log.Println("Failed to parse request body ", err)
+		response.Error(c, http.StatusBadRequest, "failed to parse request body", err)
+		return
+	}
+
+	holiday, err := h.service.UpdateHoliday(c, c.Param("id"), &req)
+	if err != nil {
+		log.Println("Failed to update holiday ", err)
+		response.Error(c, statusCode(err), "failed to update holiday", err)
+		return
+	}
+
+	utils.CopyTo(&holiday, &res)
+	response.Success(c, http.StatusOK, "holiday is updated successfully", &res, nil)
+}
+
+// DeleteHoliday removes a public holiday.
+//
+//	@Summary	Delete a holiday
+//	@Tags		Calendar
+//	@Accept		json
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		id	path	string	true	"Holiday ID"
+//	@Success	200
+//	@Router		/calendar/holiday/{id} [delete]
+func (h *CalendarHandler) DeleteHoliday(c *gin.Context) {
+	if err := h.service.DeleteHoliday(c, c.Param("id")); err != nil {
+		log.Println("Failed to delete holiday ", err)
+		response.Error(c, statusCode(err), "failed to delete holiday", err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, "holiday is deleted successfully", nil, nil)
+}
+
+// GetClosures lists the ad-hoc closures.
+//
+//	@Summary	Get closures
+//	@Tags		Calendar
+//	@Accept		json
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Success	200	{object}	[]calendarResource.Closure
+//	@Router		/calendar/closures [get]
+func (h *CalendarHandler) GetClosures(c *gin.Context) {
+	var res []calendarResource.Closure
+
+	closures, err := h.service.GetClosures(c)
+	if err != nil {
+		log.Println("Failed to get closures ", err)
+		response.Error(c, http.StatusInternalServerError, "failed to get closures", err)
+		return
+	}
+
+	utils.CopyTo(&closures, &res)
+	response.Success(c, http.StatusOK, "closures are collected successfully", &res, nil)
+}
+
+// ExportClosures serves holidays and closures as an iCalendar feed. It needs
+// no token so calendar apps can subscribe to it.
+//
+//	@Summary	Export closures as iCalendar
+//	@Tags		Calendar
+//	@Produce	text/calendar
+//	@Success	200	{string}	string
+//	@Router		/calendar/closures.ics [get]
+func (h *CalendarHandler) ExportClosures(c *gin.Context) {
+	body, err := h.service.ExportClosures(c)
+	if err != nil {
+		log.Println("Failed to export closures ", err)
+		response.Error(c, http.StatusInternalServerError, "failed to export closures", err)
+		return
+	}
+
+	c.Header("Content-Disposition", `inline; filename="closures.ics"`)
+	c.Data(http.StatusOK, "text/calendar; charset=utf-8", body)
+}
+
+// CreateClosure adds an ad-hoc closure.
+//
+//	@Summary	Create a closure
+//	@Tags		Calendar
+//	@Accept		json
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		_	body		calendarRequest.Closure	true	"Closure details"
+//	@Success	201	{object}	calendarResource.Closure
+//	@Router		/calendar/closure [post]
+func (h *CalendarHandler) CreateClosure(c *gin.Context) {
+	var req calendarRequest.Closure
+	var res calendarResource.Closure
+
+	if err := utils.ParseJson(c, &req); err != nil {
+		log.Println("Failed to parse request body ", err)
+		response.Error(c, http.StatusBadRequest, "failed to parse request body", err)
+		return
+	}
+
+	closure, err := h.service.CreateClosure(c, &req)
+	if err != nil {
+		log.Println("Failed to create closure ", err)
+		response.Error(c, statusCode(err), "failed to create closure", err)
+		return
+	}
+
+	utils.CopyTo(&closure, &res)
+	response.Success(c, http.StatusCreated, "closure is created successfully", &res, nil)
+}
+
+// UpdateClosure changes an ad-hoc closure.
+//
+//	@Summary	Update a closure
+//	@Tags		Calendar
+//	@Accept		json
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		id	path		string					true	"Closure ID"
+//	@Param		_	body		calendarRequest.Closure	true	"Closure details"
+//	@Success	200	{object}	calendarResource.Closure
+//	@Router		/calendar/closure/{id} [put]
+func (h *CalendarHandler) UpdateClosure(c *gin.Context) {
+	var req calendarRequest.Closure
+	var res calendarResource.Closure
+
+	if err := utils.ParseJson(c, &req); err != nil {
+		log.Println("Failed to parse request body ", err)
+		response.Error(c, http.StatusBadRequest, "failed to parse request body", err)
+		return
+	}
+
+	closure, err := h.service.UpdateClosure(c, c.Param("id"), &req)
+	if err != nil {
+		log.Println("Failed to update closure ", err)
+		response.Error(c, statusCode(err), "failed to update closure", err)
+		return
+	}
+
+	utils.CopyTo(&closure, &res)
+	response.Success(c, http.StatusOK, "closure is updated successfully", &res, nil)
+}
+
+// DeleteClosure removes an ad-hoc closure.
+//
+//	@Summary	Delete a closure
+//	@Tags		Calendar
+//	@Accept		json
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		id	path	string	true	"Closure ID"
+//	@Success	200
+//	@Router		/calendar/closure/{id} [delete]
+func (h *CalendarHandler) DeleteClosure(c *gin.Context) {
+	if err := h.service.DeleteClosure(c, c.Param("id")); err != nil {
+		log.Println("Failed to delete closure ", err)
+		response.Error(c, statusCode(err), "failed to delete closure", err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, "closure is deleted successfully", nil, nil)
+}
+
+func statusCode(err error) int {
+	if errors.Is(err, calendarService.ErrHolidayNotFound) || errors.Is(err, calendarService.ErrClosureNotFound) {
+		return http.StatusNotFound
+	}
+
+	return http.StatusInternalServerError
+}
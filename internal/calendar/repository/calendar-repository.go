@@ -0,0 +1,120 @@
+package calendarRepository
+
+import (
+	"context"
+
+	calendarModel "washit-api/internal/calendar/dto/model"
+	"washit-api/pkg/db/dbs"
+)
+
+type ICalendarRepository interface {
+	GetOpeningHours(ctx context.Context) ([]*calendarModel.OpeningHours, error)
+	SaveOpeningHours(ctx context.Context, hours *calendarModel.OpeningHours) error
+	GetHolidays(ctx context.Context) ([]*calendarModel.Holiday, error)
+	GetHolidayByID(ctx context.Context, holidayID string) (*calendarModel.Holiday, error)
+	CreateHoliday(ctx context.Context, holiday *calendarModel.Holiday) error
+	UpdateHoliday(ctx context.Context, holiday *calendarModel.Holiday) error
+	DeleteHoliday(ctx context.Context, holiday *calendarModel.Holiday) error
+	GetClosures(ctx context.Context, from string) ([]*calendarModel.Closure, error)
+	GetClosureByID(ctx context.Context, closureID string) (*calendarModel.Closure, error)
+	CreateClosure(ctx context.Context, closure *calendarModel.Closure) error
+	UpdateClosure(ctx context.Context, closure *calendarModel.Closure) error
+	DeleteClosure(ctx context.Context, closure *calendarModel.Closure) error
+}
+
+type CalendarRepository struct {
+	db dbs.IDatabase
+}
+
+func NewCalendarRepository(db dbs.IDatabase) *CalendarRepository {
+	return &CalendarRepository{db: db}
+}
+
+func (r *CalendarRepository) GetOpeningHours(ctx context.Context) ([]*calendarModel.OpeningHours, error) {
+	var hours []*calendarModel.OpeningHours
+	if err := r.db.Find(ctx, &hours, dbs.WithOrder("weekday ASC")); err != nil {
+		return nil, err
+	}
+
+	return hours, nil
+}
+
+// SaveOpeningHours creates the row of hours.Weekday or, when hours.ID is set,
+// updates it.
+func (r *CalendarRepository) SaveOpeningHours(ctx context.Context, hours *calendarModel.OpeningHours) error {
+	if hours.ID == 0 {
+		return r.db.Create(ctx, hours)
+	}
+
+	return r.db.Update(ctx, hours)
+}
+
+func (r *CalendarRepository) GetHolidays(ctx context.Context) ([]*calendarModel.Holiday, error) {
+	var holidays []*calendarModel.Holiday
+	if err := r.db.Find(ctx, &holidays, dbs.WithOrder("date ASC")); err != nil {
+		return nil, err
+	}
+
+	return holidays, nil
+}
+
+func (r *CalendarRepository) GetHolidayByID(ctx context.Context, holidayID string) (*calendarModel.Holiday, error) {
+	var holiday calendarModel.Holiday
+	if err := r.db.FindByID(ctx, holidayID, &holiday); err != nil {
+		return nil, err
+	}
+
+	return &holiday, nil
+}
+
+func (r *CalendarRepository) CreateHoliday(ctx context.Context, holiday *calendarModel.Holiday) error {
+	return r.db.Create(ctx, holiday)
+}
+
+func (r *CalendarRepository) UpdateHoliday(ctx context.Context, holiday *calendarModel.Holiday) error {
+	return r.db.Update(ctx, holiday)
+}
+
+func (r *CalendarRepository) DeleteHoliday(ctx context.Context, holiday *calendarModel.Holiday) error {
+	return r.db.Delete(ctx, holiday)
+}
+
+// GetClosures returns the closures that end on or after from, a YYYY-MM-DD
+// date, or all of them when from is empty.
+func (r *CalendarRepository) GetClosures(ctx context.Context, from string) ([]*calendarModel.Closure, error) {
+	var closures []*calendarModel.Closure
+	query := []dbs.FindOption{
+		dbs.WithOrder("starts_on ASC"),
+	}
+
+	if from != "" {
+		query = append(query, dbs.WithQuery(dbs.NewQuery("ends_on >= ?", from)))
+	}
+
+	if err := r.db.Find(ctx, &closures, query...); err != nil {
+		return nil, err
+	}
+
+	return closures, nil
+}
+
+func (r *CalendarRepository) GetClosureByID(ctx context.Context, closureID string) (*calendarModel.Closure, error) {
+	var closure calendarModel.Closure
+	if err := r.db.FindByID(ctx, closureID, &closure); err != nil {
+		return nil, err
+	}
+
+	return &closure, nil
+}
+
+func (r *CalendarRepository) CreateClosure(ctx context.Context, closure *calendarModel.Closure) error {
+	return r.db.Create(ctx, closure)
+}
+
+func (r *CalendarRepository) UpdateClosure(ctx context.Context, closure *calendarModel.Closure) error {
+	return r.db.Update(ctx, closure)
+}
+
+func (r *CalendarRepository) DeleteClosure(ctx context.Context, closure *calendarModel.Closure) error {
+	return r.db.Delete(ctx, closure)
+}
@@ -0,0 +1,306 @@
+// Code generated by mockery v2.50.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	calendarModel "washit-api/internal/calendar/dto/model"
+
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ICalendarRepository is an autogenerated mock type for the ICalendarRepository type
+type ICalendarRepository struct {
+	mock.Mock
+}
+
+// CreateClosure provides a mock function with given fields: ctx, closure
+func (_m *ICalendarRepository) CreateClosure(ctx context.Context, closure *calendarModel.Closure) error {
+	ret := _m.Called(ctx, closure)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateClosure")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *calendarModel.Closure) error); ok {
+		r0 = rf(ctx, closure)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateHoliday provides a mock function with given fields: ctx, holiday
+func (_m *ICalendarRepository) CreateHoliday(ctx context.Context, holiday *calendarModel.Holiday) error {
+	ret := _m.Called(ctx, holiday)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateHoliday")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *calendarModel.Holiday) error); ok {
+		r0 = rf(ctx, holiday)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteClosure provides a mock function with given fields: ctx, closure
+func (_m *ICalendarRepository) DeleteClosure(ctx context.Context, closure *calendarModel.Closure) error {
+	ret := _m.Called(ctx, closure)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteClosure")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *calendarModel.Closure) error); ok {
+		r0 = rf(ctx, closure)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteHoliday provides a mock function with given fields: ctx, holiday
+func (_m *ICalendarRepository) DeleteHoliday(ctx context.Context, holiday *calendarModel.Holiday) error {
+	ret := _m.Called(ctx, holiday)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteHoliday")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *calendarModel.Holiday) error); ok {
+		r0 = rf(ctx, holiday)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetClosureByID provides a mock function with given fields: ctx, closureID
+func (_m *ICalendarRepository) GetClosureByID(ctx context.Context, closureID string) (*calendarModel.Closure, error) {
+	ret := _m.Called(ctx, closureID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetClosureByID")
+	}
+
+	var r0 *calendarModel.Closure
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*calendarModel.Closure, error)); ok {
+		return rf(ctx, closureID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *calendarModel.Closure); ok {
+		r0 = rf(ctx, closureID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*calendarModel.Closure)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, closureID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetClosures provides a mock function with given fields: ctx, from
+func (_m *ICalendarRepository) GetClosures(ctx context.Context, from string) ([]*calendarModel.Closure, error) {
+	ret := _m.Called(ctx, from)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetClosures")
+	}
+
+	var r0 []*calendarModel.Closure
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*calendarModel.Closure, error)); ok {
+		return rf(ctx, from)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*calendarModel.Closure); ok {
+		r0 = rf(ctx, from)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*calendarModel.Closure)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, from)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetHolidayByID provides a mock function with given fields: ctx, holidayID
+func (_m *ICalendarRepository) GetHolidayByID(ctx context.Context, holidayID string) (*calendarModel.Holiday, error) {
+	ret := _m.Called(ctx, holidayID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetHolidayByID")
+	}
+
+	var r0 *calendarModel.Holiday
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*calendarModel.Holiday, error)); ok {
+		return rf(ctx, holidayID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *calendarModel.Holiday); ok {
+		r0 = rf(ctx, holidayID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*calendarModel.Holiday)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, holidayID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetHolidays provides a mock function with given fields: ctx
+func (_m *ICalendarRepository) GetHolidays(ctx context.Context) ([]*calendarModel.Holiday, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetHolidays")
+	}
+
+	var r0 []*calendarModel.Holiday
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*calendarModel.Holiday, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*calendarModel.Holiday); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*calendarModel.Holiday)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetOpeningHours provides a mock function with given fields: ctx
+func (_m *ICalendarRepository) GetOpeningHours(ctx context.Context) ([]*calendarModel.OpeningHours, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOpeningHours")
+	}
+
+	var r0 []*calendarModel.OpeningHours
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*calendarModel.OpeningHours, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*calendarModel.OpeningHours); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*calendarModel.OpeningHours)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SaveOpeningHours provides a mock function with given fields: ctx, hours
+func (_m *ICalendarRepository) SaveOpeningHours(ctx context.Context, hours *calendarModel.OpeningHours) error {
+	ret := _m.Called(ctx, hours)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveOpeningHours")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *calendarModel.OpeningHours) error); ok {
+		r0 = rf(ctx, hours)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateClosure provides a mock function with given fields: ctx, closure
+func (_m *ICalendarRepository) UpdateClosure(ctx context.Context, closure *calendarModel.Closure) error {
+	ret := _m.Called(ctx, closure)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateClosure")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *calendarModel.Closure) error); ok {
+		r0 = rf(ctx, closure)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateHoliday provides a mock function with given fields: ctx, holiday
+func (_m *ICalendarRepository) UpdateHoliday(ctx context.Context, holiday *calendarModel.Holiday) error {
+	ret := _m.Called(ctx, holiday)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateHoliday")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *calendarModel.Holiday) error); ok {
+		r0 = rf(ctx, holiday)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewICalendarRepository creates a new instance of ICalendarRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewICalendarRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ICalendarRepository {
+	mock := &ICalendarRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
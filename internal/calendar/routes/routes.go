@@ -0,0 +1,38 @@
+package calendarRoutes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator"
+
+	calendar "washit-api/internal/calendar/handler"
+	calendarRepository "washit-api/internal/calendar/repository"
+	calendarService "washit-api/internal/calendar/service"
+	"washit-api/pkg/db/dbs"
+	"washit-api/pkg/middleware"
+	"washit-api/pkg/rbac"
+	"washit-api/pkg/redis"
+)
+
+func Main(r *gin.RouterGroup, db dbs.IDatabase, cache redis.IRedis, validator *validator.Validate) {
+	repository := calendarRepository.NewCalendarRepository(db)
+	service := calendarService.NewCalendarService(repository, validator)
+	handler := calendar.NewCalendarHandler(service, cache)
+
+	authMiddleware := middleware.JWTAuth(cache)
+	calendarMiddleware := middleware.JWTPermission(cache, rbac.CalendarManage)
+
+	// Calendar Get
+	r.GET("/calendar/hours", authMiddleware, handler.GetOpeningHours)
+	r.GET("/calendar/holidays", authMiddleware, handler.GetHolidays)
+	r.GET("/calendar/closures", authMiddleware, handler.GetClosures)
+	r.GET("/calendar/closures.ics", handler.ExportClosures)
+
+	// Staff Authority
+	r.PUT("/calendar/hours/:weekday", calendarMiddleware, handler.UpdateOpeningHours)
+	r.POST("/calendar/holiday", calendarMiddleware, handler.CreateHoliday)
+	r.PUT("/calendar/holiday/:id", calendarMiddleware, handler.UpdateHoliday)
+	r.DELETE("/calendar/holiday/:id", calendarMiddleware, handler.DeleteHoliday)
+	r.POST("/calendar/closure", calendarMiddleware, handler.CreateClosure)
+	r.PUT("/calendar/closure/:id", calendarMiddleware, handler.UpdateClosure)
+	r.DELETE("/calendar/closure/:id", calendarMiddleware, handler.DeleteClosure)
+}
@@ -0,0 +1,447 @@
+package calendarService
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	calendarModel "washit-api/internal/calendar/dto/model"
+	calendarRequest "washit-api/internal/calendar/dto/request"
+	calendarRepository "washit-api/internal/calendar/repository"
+	"washit-api/pkg/ical"
+	"washit-api/pkg/worktime"
+
+	"github.com/go-playground/validator"
+)
+
+type ICalendarService interface {
+	GetOpeningHours(c context.Context) ([]*Day, error)
+	UpdateOpeningHours(c context.Context, weekday string, req *calendarRequest.OpeningHours) (*Day, error)
+	GetHolidays(c context.Context) ([]*calendarModel.Holiday, error)
+	CreateHoliday(c context.Context, req *calendarRequest.Holiday) (*calendarModel.Holiday, error)
+	UpdateHoliday(c context.Context, holidayID string, req *calendarRequest.Holiday) (*calendarModel.Holiday, error)
+	DeleteHoliday(c context.Context, holidayID string) error
+	GetClosures(c context.Context) ([]*calendarModel.Closure, error)
+	CreateClosure(c context.Context, req *calendarRequest.Closure) (*calendarModel.Closure, error)
+	UpdateClosure(c context.Context, closureID string, req *calendarRequest.Closure) (*calendarModel.Closure, error)
+	DeleteClosure(c context.Context, closureID string) error
+	Load(c context.Context) (worktime.Calendar, error)
+	CheckOpen(c context.Context, t time.Time) error
+	ExportClosures(c context.Context) ([]byte, error)
+}
+
+var (
+	ErrClosed          = errors.New("the laundry is closed at that time")
+	ErrHolidayNotFound = errors.New("holiday not found")
+	ErrClosureNotFound = errors.New("closure not found")
+)
+
+// Day is the effective opening hours of one day of the week.
+type Day struct {
+	Weekday   int
+	Day       string
+	OpenTime  string
+	CloseTime string
+	IsClosed  bool
+	Timezone  string
+}
+
+type CalendarService struct {
+	repository calendarRepository.ICalendarRepository
+	defaults   *worktime.Weekly
+	validator  *validator.Validate
+	now        func() time.Time
+}
+
+func NewCalendarService(repository calendarRepository.ICalendarRepository, validator *validator.Validate) *CalendarService {
+	return &CalendarService{
+		repository: repository,
+		defaults:   worktime.FromEnvs(),
+		validator:  validator,
+		now:        time.Now,
+	}
+}
+
+// GetOpeningHours returns the hours of every day of the week, Sunday first,
+// with overrides applied over the configured defaults.
+func (s *CalendarService) GetOpeningHours(c context.Context) ([]*Day, error) {
+	hours, err := s.repository.GetOpeningHours(c)
+	if err != nil {
+		log.Printf("Failed to get opening hours: %v", err)
+		return nil, fmt.Errorf("failed to get opening hours: %w", err)
+	}
+
+	overrides := make(map[time.Weekday]*calendarModel.OpeningHours, len(hours))
+	for _, day := range hours {
+		overrides[day.Weekday] = day
+	}
+
+	days := make([]*Day, 0, 7)
+	for weekday := time.Sunday; weekday <= time.Saturday; weekday++ {
+		if day, ok := overrides[weekday]; ok {
+			days = append(days, s.day(day))
+			continue
+		}
+
+		days = append(days, s.day(&calendarModel.OpeningHours{
+			Weekday:   weekday,
+			OpenTime:  worktime.FormatClock(s.defaults.Open),
+			CloseTime: worktime.FormatClock(s.defaults.Close),
+			IsClosed:  s.defaults.Closed[weekday],
+		}))
+	}
+
+	return days, nil
+}
+
+// UpdateOpeningHours overrides the hours of weekday, given as a number from
+// 0 (Sunday) to 6 or an English day name.
+func (s *CalendarService) UpdateOpeningHours(c context.Context, weekday string, req *calendarRequest.OpeningHours) (*Day, error) {
+	day, err := parseWeekday(weekday)
+	if err != nil {
+		log.Printf("Failed to parse weekday: %v", err)
+		return nil, fmt.Errorf("validation error: %w", err)
+	}
+
+	hours := &calendarModel.OpeningHours{Weekday: day, IsClosed: req.IsClosed}
+	if !req.IsClosed {
+		open, err := worktime.ParseClock(req.OpenTime)
+		if err != nil {
+			return nil, fmt.Errorf("validation error: openTime must be HH:MM")
+		}
+
+		close, err := worktime.ParseClock(req.CloseTime)
+		if err != nil {
+			return nil, fmt.Errorf("validation error: closeTime must be HH:MM")
+		}
+
+		if close <= open {
+			return nil, fmt.Errorf("validation error: closeTime must be after openTime")
+		}
+
+		hours.OpenTime = worktime.FormatClock(open)
+		hours.CloseTime = worktime.FormatClock(close)
+	}
+
+	existing, err := s.repository.GetOpeningHours(c)
+	if err != nil {
+		log.Printf("Failed to get opening hours: %v", err)
+		return nil, fmt.Errorf("failed to update opening hours: %w", err)
+	}
+
+	for _, current := range existing {
+		if current.Weekday == day {
+			hours.ID = current.ID
+			hours.CreatedAt = current.CreatedAt
+		}
+	}
+
+	if err := s.repository.SaveOpeningHours(c, hours); err != nil {
+		log.Printf("Failed to save opening hours of %s: %v", day, err)
+		return nil, fmt.Errorf("failed to update opening hours: %w", err)
+	}
+
+	return s.day(hours), nil
+}
+
+func (s *CalendarService) GetHolidays(c context.Context) ([]*calendarModel.Holiday, error) {
+	holidays, err := s.repository.GetHolidays(c)
+	if err != nil {
+		log.Printf("Failed to get holidays: %v", err)
+		return nil, fmt.Errorf("failed to get holidays: %w", err)
+	}
+
+	return holidays, nil
+}
+
+func (s *CalendarService) CreateHoliday(c context.Context, req *calendarRequest.Holiday) (*calendarModel.Holiday, error) {
+	holiday := &calendarModel.Holiday{}
+	if err := s.applyHoliday(holiday, req); err != nil {
+		log.Printf("Failed to validate holiday request: %v", err)
+		return nil, err
+	}
+
+	if err := s.repository.CreateHoliday(c, holiday); err != nil {
+		log.Printf("Failed to create holiday: %v", err)
+		return nil, fmt.Errorf("failed to create holiday: %w", err)
+	}
+
+	return holiday, nil
+}
+
+func (s *CalendarService) UpdateHoliday(c context.Context, holidayID string, req *calendarRequest.Holiday) (*calendarModel.Holiday, error) {
+	holiday, err := s.repository.GetHolidayByID(c, holidayID)
+	if err != nil {
+		log.Printf("Failed to get holiday by id: %v", err)
+		return nil, fmt.Errorf("%w: %v", ErrHolidayNotFound, holidayID)
+	}
+
+	if err := s.applyHoliday(holiday, req); err != nil {
+		log.Printf("Failed to validate holiday request: %v", err)
+		return nil, err
+	}
+
+	if err := s.repository.UpdateHoliday(c, holiday); err != nil {
+		log.Printf("Failed to update holiday %s: %v", holidayID, err)
+		return nil, fmt.Errorf("failed to update holiday: %w", err)
+	}
+
+	return holiday, nil
+}
+
+func (s *CalendarService) DeleteHoliday(c context.Context, holidayID string) error {
+	holiday, err := s.repository.GetHolidayByID(c, holidayID)
+	if err != nil {
+		log.Printf("Failed to get holiday by id: %v", err)
+		return fmt.Errorf("%w: %v", ErrHolidayNotFound, holidayID)
+	}
+
+	if err := s.repository.DeleteHoliday(c, holiday); err != nil {
+		log.Printf("Failed to delete holiday %s: %v", holidayID, err)
+		return fmt.Errorf("failed to delete holiday: %w", err)
+	}
+
+	return nil
+}
+
+func (s *CalendarService) GetClosures(c context.Context) ([]*calendarModel.Closure, error) {
+	closures, err := s.repository.GetClosures(c, "")
+	if err != nil {
+		log.Printf("Failed to get closures: %v", err)
+		return nil, fmt.Errorf("failed to get closures: %w", err)
+	}
+
+	return closures, nil
+}
+
+func (s *CalendarService) CreateClosure(c context.Context, req *calendarRequest.Closure) (*calendarModel.Closure, error) {
+	closure := &calendarModel.Closure{}
+	if err := s.applyClosure(closure, req); err != nil {
+		log.Printf("Failed to validate closure request: %v", err)
+		return nil, err
+	}
+
+	if err := s.repository.CreateClosure(c, closure); err != nil {
+		log.Printf("Failed to create closure: %v", err)
+		return nil, fmt.Errorf("failed to create closure: %w", err)
+	}
+
+	return closure, nil
+}
+
+func (s *CalendarService) UpdateClosure(c context.Context, closureID string, req *calendarRequest.Closure) (*calendarModel.Closure, error) {
+	closure, err := s.repository.GetClosureByID(c, closureID)
+	if err != nil {
+		log.Printf("Failed to get closure by id: %v", err)
+		return nil, fmt.Errorf("%w: %v", ErrClosureNotFound, closureID)
+	}
+
+	if err := s.applyClosure(closure, req); err != nil {
+		log.Printf("Failed to validate closure request: %v", err)
+		return nil, err
+	}
+
+	if err := s.repository.UpdateClosure(c, closure); err != nil {
+		log.Printf("Failed to update closure %s: %v", closureID, err)
+		return nil, fmt.Errorf("failed to update closure: %w", err)
+	}
+
+	return closure, nil
+}
+
+func (s *CalendarService) DeleteClosure(c context.Context, closureID string) error {
+	closure, err := s.repository.GetClosureByID(c, closureID)
+	if err != nil {
+		log.Printf("Failed to get closure by id: %v", err)
+		return fmt.Errorf("%w: %v", ErrClosureNotFound, closureID)
+	}
+
+	if err := s.repository.DeleteClosure(c, closure); err != nil {
+		log.Printf("Failed to delete closure %s: %v", closureID, err)
+		return fmt.Errorf("failed to delete closure: %w", err)
+	}
+
+	return nil
+}
+
+// Load takes a snapshot of the calendar from today on, for checking many
+// dates without a query each.
+func (s *CalendarService) Load(c context.Context) (worktime.Calendar, error) {
+	return s.load(c)
+}
+
+func (s *CalendarService) load(c context.Context) (*Calendar, error) {
+	hours, err := s.repository.GetOpeningHours(c)
+	if err != nil {
+		log.Printf("Failed to get opening hours: %v", err)
+		return nil, fmt.Errorf("failed to load calendar: %w", err)
+	}
+
+	holidays, err := s.repository.GetHolidays(c)
+	if err != nil {
+		log.Printf("Failed to get holidays: %v", err)
+		return nil, fmt.Errorf("failed to load calendar: %w", err)
+	}
+
+	today := s.now().In(s.defaults.Zone).Format(time.DateOnly)
+	closures, err := s.repository.GetClosures(c, today)
+	if err != nil {
+		log.Printf("Failed to get closures: %v", err)
+		return nil, fmt.Errorf("failed to load calendar: %w", err)
+	}
+
+	return newCalendar(s.defaults, hours, holidays, closures), nil
+}
+
+// CheckOpen returns ErrClosed unless the laundry is open at t.
+func (s *CalendarService) CheckOpen(c context.Context, t time.Time) error {
+	calendar, err := s.load(c)
+	if err != nil {
+		return err
+	}
+
+	t = t.In(calendar.Location())
+	if name, closed := calendar.ClosedOn(t); closed {
+		return fmt.Errorf("%w: closed on %s (%s)", ErrClosed, t.Format(time.DateOnly), name)
+	}
+
+	open, close, ok := calendar.Hours(t)
+	if !ok {
+		return fmt.Errorf("%w: closed on %s", ErrClosed, t.Weekday())
+	}
+
+	if t.Before(open) || !t.Before(close) {
+		return fmt.Errorf("%w: open %s-%s %s on %s", ErrClosed,
+			open.Format("15:04"), close.Format("15:04"), calendar.Location(), t.Weekday())
+	}
+
+	return nil
+}
+
+// ExportClosures renders holidays and closures as an iCalendar feed that
+// calendar apps can subscribe to.
+func (s *CalendarService) ExportClosures(c context.Context) ([]byte, error) {
+	holidays, err := s.GetHolidays(c)
+	if err != nil {
+		return nil, err
+	}
+
+	closures, err := s.GetClosures(c)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]ical.Event, 0, len(holidays)+len(closures))
+	for _, holiday := range holidays {
+		date, err := time.Parse(time.DateOnly, holiday.Date)
+		if err != nil {
+			log.Printf("Skipping holiday %d with invalid date %q", holiday.ID, holiday.Date)
+			continue
+		}
+
+		events = append(events, ical.Event{
+			UID:     fmt.Sprintf("holiday-%d@washit", holiday.ID),
+			Summary: "Closed: " + holiday.Name,
+			Start:   date,
+			End:     date,
+			Yearly:  holiday.Recurring,
+			Updated: holiday.UpdatedAt,
+		})
+	}
+
+	for _, closure := range closures {
+		start, startErr := time.Parse(time.DateOnly, closure.StartsOn)
+		end, endErr := time.Parse(time.DateOnly, closure.EndsOn)
+		if startErr != nil || endErr != nil {
+			log.Printf("Skipping closure %d with invalid dates %q-%q", closure.ID, closure.StartsOn, closure.EndsOn)
+			continue
+		}
+
+		summary := "Closed"
+		if closure.Reason != "" {
+			summary += ": " + closure.Reason
+		}
+
+		events = append(events, ical.Event{
+			UID:     fmt.Sprintf("closure-%d@washit", closure.ID),
+			Summary: summary,
+			Start:   start,
+			End:     end,
+			Updated: closure.UpdatedAt,
+		})
+	}
+
+	return ical.Encode("Washit closures", events), nil
+}
+
+func (s *CalendarService) applyHoliday(holiday *calendarModel.Holiday, req *calendarRequest.Holiday) error {
+	if err := s.validator.Struct(req); err != nil {
+		return fmt.Errorf("validation error: %w", err)
+	}
+
+	date, err := time.Parse(time.DateOnly, req.Date)
+	if err != nil {
+		return fmt.Errorf("validation error: date must be YYYY-MM-DD")
+	}
+
+	holiday.Date = date.Format(time.DateOnly)
+	holiday.Name = req.Name
+	holiday.Recurring = req.Recurring
+
+	return nil
+}
+
+func (s *CalendarService) applyClosure(closure *calendarModel.Closure, req *calendarRequest.Closure) error {
+	if err := s.validator.Struct(req); err != nil {
+		return fmt.Errorf("validation error: %w", err)
+	}
+
+	start, err := time.Parse(time.DateOnly, req.StartsOn)
+	if err != nil {
+		return fmt.Errorf("validation error: startsOn must be YYYY-MM-DD")
+	}
+
+	end, err := time.Parse(time.DateOnly, req.EndsOn)
+	if err != nil {
+		return fmt.Errorf("validation error: endsOn must be YYYY-MM-DD")
+	}
+
+	if end.Before(start) {
+		return fmt.Errorf("validation error: endsOn must not be before startsOn")
+	}
+
+	closure.StartsOn = start.Format(time.DateOnly)
+	closure.EndsOn = end.Format(time.DateOnly)
+	closure.Reason = req.Reason
+
+	return nil
+}
+
+func (s *CalendarService) day(hours *calendarModel.OpeningHours) *Day {
+	day := &Day{
+		Weekday:  int(hours.Weekday),
+		Day:      hours.Weekday.String(),
+		IsClosed: hours.IsClosed,
+		Timezone: s.defaults.Zone.String(),
+	}
+	if !hours.IsClosed {
+		day.OpenTime = hours.OpenTime
+		day.CloseTime = hours.CloseTime
+	}
+
+	return day
+}
+
+func parseWeekday(value string) (time.Weekday, error) {
+	if n, err := strconv.Atoi(value); err == nil {
+		if n < 0 || n > 6 {
+			return 0, fmt.Errorf("weekday must be between 0 and 6")
+		}
+		return time.Weekday(n), nil
+	}
+
+	return worktime.ParseWeekday(value)
+}
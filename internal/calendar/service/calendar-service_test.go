@@ -0,0 +1,170 @@
+package calendarService
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+	calendarModel "washit-api/internal/calendar/dto/model"
+	calendarRequest "washit-api/internal/calendar/dto/request"
+	mocks "washit-api/internal/calendar/repository/mock"
+	"washit-api/pkg/worktime"
+
+	"github.com/go-playground/validator"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type CalendarServiceTestSuite struct {
+	suite.Suite
+	mockRepo *mocks.ICalendarRepository
+	service  *CalendarService
+}
+
+func (suite *CalendarServiceTestSuite) SetupTest() {
+	suite.mockRepo = new(mocks.ICalendarRepository)
+	suite.service = NewCalendarService(suite.mockRepo, validator.New())
+	suite.service.defaults = &worktime.Weekly{
+		Open: 8 * time.Hour, Close: 20 * time.Hour, Closed: map[time.Weekday]bool{time.Sunday: true}, Zone: time.UTC,
+	}
+	suite.service.now = func() time.Time { return time.Date(2026, time.December, 1, 12, 0, 0, 0, time.UTC) }
+}
+
+func TestCalendarServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(CalendarServiceTestSuite))
+}
+
+// expectCalendar stubs the rows a calendar snapshot is loaded from.
+func (suite *CalendarServiceTestSuite) expectCalendar() {
+	suite.mockRepo.On("GetOpeningHours", mock.Anything).
+		Return([]*calendarModel.OpeningHours{
+			{ID: 1, Weekday: time.Saturday, OpenTime: "09:00", CloseTime: "13:00"},
+		}, nil).Times(1)
+	suite.mockRepo.On("GetHolidays", mock.Anything).
+		Return([]*calendarModel.Holiday{
+			{ID: 1, Date: "2020-12-25", Name: "Christmas", Recurring: true},
+			{ID: 2, Date: "2026-12-07", Name: "Election day"},
+		}, nil).Times(1)
+	suite.mockRepo.On("GetClosures", mock.Anything, "2026-12-01").
+		Return([]*calendarModel.Closure{
+			{ID: 1, StartsOn: "2026-12-14", EndsOn: "2026-12-16", Reason: "Renovation"},
+		}, nil).Times(1)
+}
+
+// CheckOpen
+// =================================================================
+
+func (suite *CalendarServiceTestSuite) TestCheckOpen() {
+	cases := []struct {
+		at     time.Time
+		closed bool
+	}{
+		{time.Date(2026, time.December, 2, 10, 0, 0, 0, time.UTC), false},
+		{time.Date(2026, time.December, 2, 20, 0, 0, 0, time.UTC), true},
+		{time.Date(2026, time.December, 5, 12, 0, 0, 0, time.UTC), false},
+		{time.Date(2026, time.December, 5, 14, 0, 0, 0, time.UTC), true},
+		{time.Date(2026, time.December, 6, 10, 0, 0, 0, time.UTC), true},
+		{time.Date(2026, time.December, 7, 10, 0, 0, 0, time.UTC), true},
+		{time.Date(2026, time.December, 15, 10, 0, 0, 0, time.UTC), true},
+		{time.Date(2026, time.December, 25, 10, 0, 0, 0, time.UTC), true},
+	}
+
+	for _, c := range cases {
+		suite.SetupTest()
+		suite.expectCalendar()
+
+		err := suite.service.CheckOpen(context.Background(), c.at)
+		suite.Equal(c.closed, errors.Is(err, ErrClosed), "at %s: %v", c.at, err)
+	}
+}
+
+// GetOpeningHours
+// =================================================================
+
+func (suite *CalendarServiceTestSuite) TestGetOpeningHoursAppliesOverrides() {
+	suite.mockRepo.On("GetOpeningHours", mock.Anything).
+		Return([]*calendarModel.OpeningHours{
+			{ID: 1, Weekday: time.Saturday, OpenTime: "09:00", CloseTime: "13:00"},
+		}, nil).Times(1)
+
+	days, err := suite.service.GetOpeningHours(context.Background())
+	suite.Nil(err)
+	suite.Len(days, 7)
+	suite.True(days[0].IsClosed)
+	suite.Equal("08:00", days[1].OpenTime)
+	suite.Equal("20:00", days[1].CloseTime)
+	suite.Equal("Saturday", days[6].Day)
+	suite.Equal("13:00", days[6].CloseTime)
+}
+
+// UpdateOpeningHours
+// =================================================================
+
+func (suite *CalendarServiceTestSuite) TestUpdateOpeningHoursReplacesExisting() {
+	suite.mockRepo.On("GetOpeningHours", mock.Anything).
+		Return([]*calendarModel.OpeningHours{{ID: 4, Weekday: time.Saturday}}, nil).Times(1)
+	suite.mockRepo.On("SaveOpeningHours", mock.Anything, mock.MatchedBy(func(hours *calendarModel.OpeningHours) bool {
+		return hours.ID == 4 && hours.Weekday == time.Saturday && hours.OpenTime == "07:30"
+	})).Return(nil).Times(1)
+
+	day, err := suite.service.UpdateOpeningHours(context.Background(), "saturday", &calendarRequest.OpeningHours{
+		OpenTime:  "7:30",
+		CloseTime: "12:00",
+	})
+	suite.Nil(err)
+	suite.Equal(6, day.Weekday)
+}
+
+func (suite *CalendarServiceTestSuite) TestUpdateOpeningHoursInvalid() {
+	day, err := suite.service.UpdateOpeningHours(context.Background(), "1", &calendarRequest.OpeningHours{
+		OpenTime:  "18:00",
+		CloseTime: "08:00",
+	})
+	suite.Nil(day)
+	suite.NotNil(err)
+
+	day, err = suite.service.UpdateOpeningHours(context.Background(), "9", &calendarRequest.OpeningHours{IsClosed: true})
+	suite.Nil(day)
+	suite.NotNil(err)
+	suite.mockRepo.AssertNotCalled(suite.T(), "SaveOpeningHours", mock.Anything, mock.Anything)
+}
+
+// Closures
+// =================================================================
+
+func (suite *CalendarServiceTestSuite) TestCreateClosureEndBeforeStart() {
+	closure, err := suite.service.CreateClosure(context.Background(), &calendarRequest.Closure{
+		StartsOn: "2026-12-16",
+		EndsOn:   "2026-12-14",
+	})
+	suite.Nil(closure)
+	suite.NotNil(err)
+	suite.mockRepo.AssertNotCalled(suite.T(), "CreateClosure", mock.Anything, mock.Anything)
+}
+
+func (suite *CalendarServiceTestSuite) TestUpdateClosureNotFound() {
+	suite.mockRepo.On("GetClosureByID", mock.Anything, "9").
+		Return(nil, errors.New("record not found")).Times(1)
+
+	closure, err := suite.service.UpdateClosure(context.Background(), "9", &calendarRequest.Closure{
+		StartsOn: "2026-12-14",
+		EndsOn:   "2026-12-16",
+	})
+	suite.Nil(closure)
+	suite.ErrorIs(err, ErrClosureNotFound)
+}
+
+func (suite *CalendarServiceTestSuite) TestExportClosures() {
+	suite.mockRepo.On("GetHolidays", mock.Anything).
+		Return([]*calendarModel.Holiday{{ID: 1, Date: "2020-12-25", Name: "Christmas", Recurring: true}}, nil).Times(1)
+	suite.mockRepo.On("GetClosures", mock.Anything, "").
+		Return([]*calendarModel.Closure{{ID: 3, StartsOn: "2026-12-14", EndsOn: "2026-12-16", Reason: "Renovation"}}, nil).Times(1)
+
+	body, err := suite.service.ExportClosures(context.Background())
+	suite.Nil(err)
+	suite.True(strings.Contains(string(body), "UID:holiday-1@washit\r\n"))
+	suite.True(strings.Contains(string(body), "RRULE:FREQ=YEARLY\r\n"))
+	suite.True(strings.Contains(string(body), "DTSTART;VALUE=DATE:20261214\r\nDTEND;VALUE=DATE:20261217\r\n"))
+	suite.True(strings.Contains(string(body), "SUMMARY:Closed: Renovation\r\n"))
+}
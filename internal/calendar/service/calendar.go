@@ -0,0 +1,104 @@
+package calendarService
+
+import (
+	"time"
+
+	calendarModel "washit-api/internal/calendar/dto/model"
+	"washit-api/pkg/worktime"
+)
+
+const monthDay = "01-02"
+
+// Calendar is a snapshot of the business calendar: the configured weekly
+// hours, their per-weekday overrides, holidays and closures.
+type Calendar struct {
+	defaults  *worktime.Weekly
+	hours     map[time.Weekday]*calendarModel.OpeningHours
+	holidays  map[string]string
+	recurring map[string]string
+	closures  []*calendarModel.Closure
+}
+
+func newCalendar(
+	defaults *worktime.Weekly, hours []*calendarModel.OpeningHours,
+	holidays []*calendarModel.Holiday, closures []*calendarModel.Closure) *Calendar {
+	calendar := &Calendar{
+		defaults:  defaults,
+		hours:     make(map[time.Weekday]*calendarModel.OpeningHours, len(hours)),
+		holidays:  make(map[string]string),
+		recurring: make(map[string]string),
+		closures:  closures,
+	}
+
+	for _, day := range hours {
+		calendar.hours[day.Weekday] = day
+	}
+
+	for _, holiday := range holidays {
+		if holiday.Recurring {
+			calendar.recurring[holiday.Date[len("2006-"):]] = holiday.Name
+		} else {
+			calendar.holidays[holiday.Date] = holiday.Name
+		}
+	}
+
+	return calendar
+}
+
+func (c *Calendar) Location() *time.Location {
+	return c.defaults.Zone
+}
+
+// Hours returns the opening hours on the date of t, or false when the
+// laundry is closed all day.
+func (c *Calendar) Hours(t time.Time) (time.Time, time.Time, bool) {
+	t = t.In(c.Location())
+	if _, closed := c.ClosedOn(t); closed {
+		return time.Time{}, time.Time{}, false
+	}
+
+	day, ok := c.hours[t.Weekday()]
+	if !ok {
+		return c.defaults.Hours(t)
+	}
+
+	if day.IsClosed {
+		return time.Time{}, time.Time{}, false
+	}
+
+	open, err := worktime.ParseClock(day.OpenTime)
+	if err != nil {
+		return time.Time{}, time.Time{}, false
+	}
+
+	close, err := worktime.ParseClock(day.CloseTime)
+	if err != nil {
+		return time.Time{}, time.Time{}, false
+	}
+
+	midnight := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, c.Location())
+	return midnight.Add(open), midnight.Add(close), true
+}
+
+// ClosedOn reports whether the date of t is a holiday or inside a closure,
+// and names it.
+func (c *Calendar) ClosedOn(t time.Time) (string, bool) {
+	t = t.In(c.Location())
+	date := t.Format(time.DateOnly)
+
+	if name, ok := c.holidays[date]; ok {
+		return name, true
+	}
+
+	if name, ok := c.recurring[t.Format(monthDay)]; ok {
+		return name, true
+	}
+
+	for _, closure := range c.closures {
+		if closure.StartsOn <= date && date <= closure.EndsOn {
+			return closure.Reason, true
+		}
+	}
+
+	return "", false
+}
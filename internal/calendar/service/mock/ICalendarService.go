@@ -0,0 +1,391 @@
+// Code generated by mockery v2.50.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	calendarModel "washit-api/internal/calendar/dto/model"
+	calendarRequest "washit-api/internal/calendar/dto/request"
+
+	calendarService "washit-api/internal/calendar/service"
+
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	worktime "washit-api/pkg/worktime"
+)
+
+// ICalendarService is an autogenerated mock type for the ICalendarService type
+type ICalendarService struct {
+	mock.Mock
+}
+
+// CheckOpen provides a mock function with given fields: c, t
+func (_m *ICalendarService) CheckOpen(c context.Context, t time.Time) error {
+	ret := _m.Called(c, t)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckOpen")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) error); ok {
+		r0 = rf(c, t)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateClosure provides a mock function with given fields: c, req
+func (_m *ICalendarService) CreateClosure(c context.Context, req *calendarRequest.Closure) (*calendarModel.Closure, error) {
+	ret := _m.Called(c, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateClosure")
+	}
+
+	var r0 *calendarModel.Closure
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *calendarRequest.Closure) (*calendarModel.Closure, error)); ok {
+		return rf(c, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *calendarRequest.Closure) *calendarModel.Closure); ok {
+		r0 = rf(c, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*calendarModel.Closure)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *calendarRequest.Closure) error); ok {
+		r1 = rf(c, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateHoliday provides a mock function with given fields: c, req
+func (_m *ICalendarService) CreateHoliday(c context.Context, req *calendarRequest.Holiday) (*calendarModel.Holiday, error) {
+	ret := _m.Called(c, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateHoliday")
+	}
+
+	var r0 *calendarModel.Holiday
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *calendarRequest.Holiday) (*calendarModel.Holiday, error)); ok {
+		return rf(c, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *calendarRequest.Holiday) *calendarModel.Holiday); ok {
+		r0 = rf(c, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*calendarModel.Holiday)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *calendarRequest.Holiday) error); ok {
+		r1 = rf(c, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteClosure provides a mock function with given fields: c, closureID
+func (_m *ICalendarService) DeleteClosure(c context.Context, closureID string) error {
+	ret := _m.Called(c, closureID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteClosure")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(c, closureID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteHoliday provides a mock function with given fields: c, holidayID
+func (_m *ICalendarService) DeleteHoliday(c context.Context, holidayID string) error {
+	ret := _m.Called(c, holidayID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteHoliday")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(c, holidayID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ExportClosures provides a mock function with given fields: c
+func (_m *ICalendarService) ExportClosures(c context.Context) ([]byte, error) {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportClosures")
+	}
+
+	var r0 []byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]byte, error)); ok {
+		return rf(c)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []byte); ok {
+		r0 = rf(c)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(c)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetClosures provides a mock function with given fields: c
+func (_m *ICalendarService) GetClosures(c context.Context) ([]*calendarModel.Closure, error) {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetClosures")
+	}
+
+	var r0 []*calendarModel.Closure
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*calendarModel.Closure, error)); ok {
+		return rf(c)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*calendarModel.Closure); ok {
+		r0 = rf(c)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*calendarModel.Closure)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(c)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetHolidays provides a mock function with given fields: c
+func (_m *ICalendarService) GetHolidays(c context.Context) ([]*calendarModel.Holiday, error) {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetHolidays")
+	}
+
+	var r0 []*calendarModel.Holiday
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*calendarModel.Holiday, error)); ok {
+		return rf(c)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*calendarModel.Holiday); ok {
+		r0 = rf(c)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*calendarModel.Holiday)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(c)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetOpeningHours provides a mock function with given fields: c
+func (_m *ICalendarService) GetOpeningHours(c context.Context) ([]*calendarService.Day, error) {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOpeningHours")
+	}
+
+	var r0 []*calendarService.Day
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*calendarService.Day, error)); ok {
+		return rf(c)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*calendarService.Day); ok {
+		r0 = rf(c)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*calendarService.Day)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(c)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Load provides a mock function with given fields: c
+func (_m *ICalendarService) Load(c context.Context) (worktime.Calendar, error) {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Load")
+	}
+
+	var r0 worktime.Calendar
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (worktime.Calendar, error)); ok {
+		return rf(c)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) worktime.Calendar); ok {
+		r0 = rf(c)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(worktime.Calendar)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(c)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateClosure provides a mock function with given fields: c, closureID, req
+func (_m *ICalendarService) UpdateClosure(c context.Context, closureID string, req *calendarRequest.Closure) (*calendarModel.Closure, error) {
+	ret := _m.Called(c, closureID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateClosure")
+	}
+
+	var r0 *calendarModel.Closure
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *calendarRequest.Closure) (*calendarModel.Closure, error)); ok {
+		return rf(c, closureID, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *calendarRequest.Closure) *calendarModel.Closure); ok {
+		r0 = rf(c, closureID, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*calendarModel.Closure)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *calendarRequest.Closure) error); ok {
+		r1 = rf(c, closureID, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateHoliday provides a mock function with given fields: c, holidayID, req
+func (_m *ICalendarService) UpdateHoliday(c context.Context, holidayID string, req *calendarRequest.Holiday) (*calendarModel.Holiday, error) {
+	ret := _m.Called(c, holidayID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateHoliday")
+	}
+
+	var r0 *calendarModel.Holiday
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *calendarRequest.Holiday) (*calendarModel.Holiday, error)); ok {
+		return rf(c, holidayID, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *calendarRequest.Holiday) *calendarModel.Holiday); ok {
+		r0 = rf(c, holidayID, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*calendarModel.Holiday)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *calendarRequest.Holiday) error); ok {
+		r1 = rf(c, holidayID, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateOpeningHours provides a mock function with given fields: c, weekday, req
+func (_m *ICalendarService) UpdateOpeningHours(c context.Context, weekday string, req *calendarRequest.OpeningHours) (*calendarService.Day, error) {
+	ret := _m.Called(c, weekday, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateOpeningHours")
+	}
+
+	var r0 *calendarService.Day
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *calendarRequest.OpeningHours) (*calendarService.Day, error)); ok {
+		return rf(c, weekday, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *calendarRequest.OpeningHours) *calendarService.Day); ok {
+		r0 = rf(c, weekday, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*calendarService.Day)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *calendarRequest.OpeningHours) error); ok {
+		r1 = rf(c, weekday, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewICalendarService creates a new instance of ICalendarService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewICalendarService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ICalendarService {
+	mock := &ICalendarService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
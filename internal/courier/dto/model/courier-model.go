@@ -0,0 +1,55 @@
+package courierModel
+
+import (
+	"time"
+
+	addressModel "washit-api/internal/address/dto/model"
+	userModel "washit-api/internal/user/dto/model"
+)
+
+// Leg is one trip a courier makes for an order: collecting the laundry from
+// the customer or bringing it back.
+type Leg string
+
+const (
+	LegPickup   Leg = "pickup"
+	LegDelivery Leg = "delivery"
+)
+
+type AssignmentStatus string
+
+const (
+	AssignmentAssigned  AssignmentStatus = "assigned"
+	AssignmentCompleted AssignmentStatus = "completed"
+)
+
+// Courier is the delivery profile of a user with the courier role.
+type Courier struct {
+	ID           int64          `json:"id" gorm:"primaryKey"`
+	UserID       int64          `json:"userID" gorm:"not null;uniqueIndex"`
+	Phone        string         `json:"phone"`
+	VehicleType  string         `json:"vehicleType"`
+	VehiclePlate string         `json:"vehiclePlate"`
+	IsActive     bool           `json:"isActive" gorm:"not null"`
+	CreatedAt    time.Time      `json:"createdAt"`
+	UpdatedAt    time.Time      `json:"updatedAt"`
+	User         userModel.User `json:"user" gorm:"foreignKey:UserID;references:ID"`
+}
+
+// Assignment makes a courier responsible for one leg of an order. Each order
+// has at most one assignment per leg; reassigning it replaces the courier.
+type Assignment struct {
+	ID          int64                `json:"id" gorm:"primaryKey"`
+	OrderID     string               `json:"orderID" gorm:"not null;index"`
+	Leg         Leg                  `json:"leg" gorm:"not null"`
+	CourierID   int64                `json:"courierID" gorm:"not null;index"`
+	AddressID   int64                `json:"addressID"`
+	Status      AssignmentStatus     `json:"status" gorm:"default:assigned"`
+	ScheduledAt time.Time            `json:"scheduledAt" gorm:"index"`
+	AssignedBy  int64                `json:"assignedBy"`
+	CompletedAt *time.Time           `json:"completedAt"`
+	CreatedAt   time.Time            `json:"createdAt"`
+	UpdatedAt   time.Time            `json:"updatedAt"`
+	Courier     Courier              `json:"courier" gorm:"foreignKey:CourierID;references:ID"`
+	Address     addressModel.Address `json:"address" gorm:"foreignKey:AddressID;references:ID"`
+}
@@ -0,0 +1,20 @@
+package courierRequest
+
+type CreateCourier struct {
+	UserID       int64  `json:"userID" validate:"required"`
+	Phone        string `json:"phone" validate:"required"`
+	VehicleType  string `json:"vehicleType" validate:"required,max=50"`
+	VehiclePlate string `json:"vehiclePlate" validate:"max=20"`
+}
+
+type UpdateCourier struct {
+	Phone        string `json:"phone" validate:"required"`
+	VehicleType  string `json:"vehicleType" validate:"required,max=50"`
+	VehiclePlate string `json:"vehiclePlate" validate:"max=20"`
+	IsActive     *bool  `json:"isActive"`
+}
+
+type Assignment struct {
+	Leg       string `json:"leg" validate:"required,oneof=pickup delivery"`
+	CourierID int64  `json:"courierID" validate:"required"`
+}
@@ -0,0 +1,40 @@
+package courierResource
+
+import (
+	"time"
+
+	addressResource "washit-api/internal/address/dto/resource"
+)
+
+type Courier struct {
+	ID           int64     `json:"id"`
+	UserID       int64     `json:"userID"`
+	Phone        string    `json:"phone"`
+	VehicleType  string    `json:"vehicleType"`
+	VehiclePlate string    `json:"vehiclePlate"`
+	IsActive     bool      `json:"isActive"`
+	CreatedAt    time.Time `json:"createdAt"`
+	UpdatedAt    time.Time `json:"updatedAt"`
+	User         User      `json:"user"`
+}
+
+type User struct {
+	ID        int64  `json:"id"`
+	FirstName string `json:"firstName"`
+	LastName  string `json:"lastName"`
+	Email     string `json:"email"`
+	Image     string `json:"image"`
+}
+
+type Assignment struct {
+	ID          int64                   `json:"id"`
+	OrderID     string                  `json:"orderID"`
+	Leg         string                  `json:"leg"`
+	CourierID   int64                   `json:"courierID"`
+	Status      string                  `json:"status"`
+	ScheduledAt time.Time               `json:"scheduledAt"`
+	CompletedAt *time.Time              `json:"completedAt"`
+	CreatedAt   time.Time               `json:"createdAt"`
+	UpdatedAt   time.Time               `json:"updatedAt"`
+	Address     addressResource.Address `json:"address"`
+}
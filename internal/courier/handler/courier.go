@@ -0,0 +1,301 @@
+package courier
+
+import (
+	"errors"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	courierRequest "washit-api/internal/courier/dto/request"
+	courierResource "washit-api/internal/courier/dto/resource"
+	courierService "washit-api/internal/courier/service"
+	orderService "washit-api/internal/order/service"
+	"washit-api/pkg/redis"
+	"washit-api/pkg/response"
+	"washit-api/pkg/utils"
+	"washit-api/pkg/worktime"
+)
+
+type CourierHandler struct {
+	service courierService.ICourierService
+	cache   redis.IRedis
+}
+
+func NewCourierHandler(service courierService.ICourierService, cache redis.IRedis) *CourierHandler {
+	return &CourierHandler{
+		service: service,
+		cache:   cache,
+	}
+}
+
+// GetCouriers retrieves all courier profiles.
+//
+//	@Summary	Get all couriers
+//	@Tags		Courier
+//	@Accept		json
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Success	200	{object}	[]courierResource.Courier
+//	@Router		/couriers [get]
+func (h *CourierHandler) GetCouriers(c *gin.Context) {
+	var res []courierResource.Courier
+
+	couriers, err := h.service.GetCouriers(c)
+	if err != nil {
+		log.Println("Failed to get couriers ", err)
+		response.Error(c, http.StatusInternalServerError, "failed to get couriers", err)
+		return
+	}
+
+	utils.CopyTo(&couriers, &res)
+	response.Success(c, http.StatusOK, "couriers are collected successfully", &res, nil)
+}
+
+// GetCourierByID retrieves a courier profile.
+//
+//	@Summary	Get a courier by ID
+//	@Tags		Courier
+//	@Accept		json
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		id	path		string	true	"Courier ID"
+//	@Success	200	{object}	courierResource.Courier
+//	@Router		/courier/{id} [get]
+func (h *CourierHandler) GetCourierByID(c *gin.Context) {
+	var res courierResource.Courier
+
+	courier, err := h.service.GetCourierByID(c, c.Param("id"))
+	if err != nil {
+		log.Println("Failed to get courier ", err)
+		response.Error(c, statusCode(err), "failed to get courier", err)
+		return
+	}
+
+	utils.CopyTo(&courier, &res)
+	response.Success(c, http.StatusOK, "courier is collected successfully", &res, nil)
+}
+
+// CreateCourier opens a courier profile for a user.
+//
+//	@Summary	Create a courier
+//	@Tags		Courier
+//	@Accept		json
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		_	body		courierRequest.CreateCourier	true	"Courier details"
+//	@Success	201	{object}	courierResource.Courier
+//	@Router		/courier [post]
+func (h *CourierHandler) CreateCourier(c *gin.Context) {
+	var req courierRequest.CreateCourier
+	var res courierResource.Courier
+
+	if err := utils.ParseJson(c, &req); err != nil {
+		log.Println("Failed to parse request body ", err)
+		response.Error(c, http.StatusBadRequest, "failed to parse request body", err)
+		return
+	}
+
+	courier, err := h.service.CreateCourier(c, c.GetString("userID"), &req)
+	if err != nil {
+		log.Println("Failed to create courier ", err)
+		response.Error(c, statusCode(err), "failed to create courier", err)
+		return
+	}
+
+	utils.CopyTo(&courier, &res)
+	response.Success(c, http.StatusCreated, "courier is created successfully", &res, nil)
+}
+
+// UpdateCourier changes a courier profile.
+//
+//	@Summary	Update a courier
+//	@Tags		Courier
+//	@Accept		json
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		id	path		string							true	"Courier ID"
+//	@Param		_	body		courierRequest.UpdateCourier	true	"Courier details"
+//	@Success	200	{object}	courierResource.Courier
+//	@Router		/courier/{id} [put]
+func (h *CourierHandler) UpdateCourier(c *gin.Context) {
+	var req courierRequest.UpdateCourier
+	var res courierResource.Courier
+
+	if err := utils.ParseJson(c, &req); err != nil {
+		log.Println("Failed to parse request body ", err)
+		response.Error(c, http.StatusBadRequest, "failed to parse request body", err)
+		return
+	}
+
+	courier, err := h.service.UpdateCourier(c, c.Param("id"), &req)
+	if err != nil {
+		log.Println("Failed to update courier ", err)
+		response.Error(c, statusCode(err), "failed to update courier", err)
+		return
+	}
+
+	utils.CopyTo(&courier, &res)
+	response.Success(c, http.StatusOK, "courier is updated successfully", &res, nil)
+}
+
+// AssignOrder assigns or reassigns a leg of an order to a courier.
+//
+//	@Summary	Assign an order leg to a courier
+//	@Tags		Courier
+//	@Accept		json
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		id	path		string						true	"Order ID"
+//	@Param		_	body		courierRequest.Assignment	true	"Assignment details"
+//	@Success	200	{object}	courierResource.Assignment
+//	@Router		/order/{id}/assignment [put]
+func (h *CourierHandler) AssignOrder(c *gin.Context) {
+	var req courierRequest.Assignment
+	var res courierResource.Assignment
+
+	if err := utils.ParseJson(c, &req); err != nil {
+		log.Println("Failed to parse request body ", err)
+		response.Error(c, http.StatusBadRequest, "failed to parse request body", err)
+		return
+	}
+
+	assignment, err := h.service.AssignOrder(c, c.GetString("userID"), c.Param("id"), &req)
+	if err != nil {
+		log.Println("Failed to assign order ", err)
+		response.Error(c, statusCode(err), "failed to assign order", err)
+		return
+	}
+
+	utils.CopyTo(&assignment, &res)
+	response.Success(c, http.StatusOK, "order is assigned successfully", &res, nil)
+}
+
+// GetAssignmentsByOrder retrieves the courier assignments of an order.
+//
+//	@Summary	Get the courier assignments of an order
+//	@Tags		Courier
+//	@Accept		json
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		id	path		string	true	"Order ID"
+//	@Success	200	{object}	[]courierResource.Assignment
+//	@Router		/order/{id}/assignments [get]
+func (h *CourierHandler) GetAssignmentsByOrder(c *gin.Context) {
+	var res []courierResource.Assignment
+
+	assignments, err := h.service.GetAssignmentsByOrder(c, c.Param("id"))
+	if err != nil {
+		log.Println("Failed to get assignments ", err)
+		response.Error(c, http.StatusInternalServerError, "failed to get assignments", err)
+		return
+	}
+
+	utils.CopyTo(&assignments, &res)
+	response.Success(c, http.StatusOK, "assignments are collected successfully", &res, nil)
+}
+
+// GetJobs retrieves the pickups and deliveries of the logged in courier.
+//
+//	@Summary	Get my courier jobs for a day
+//	@Tags		Courier
+//	@Accept		json
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		date	query		string	false	"Day as YYYY-MM-DD, defaults to today"
+//	@Success	200		{object}	[]courierResource.Assignment
+//	@Router		/courier/jobs [get]
+func (h *CourierHandler) GetJobs(c *gin.Context) {
+	var res []courierResource.Assignment
+
+	day := time.Now().In(worktime.Zone())
+	if date := c.Query("date"); date != "" {
+		parsed, err := time.ParseInLocation(time.DateOnly, date, worktime.Zone())
+		if err != nil {
+			log.Println("Failed to parse date ", err)
+			response.Error(c, http.StatusBadRequest, "invalid date, expected YYYY-MM-DD", err)
+			return
+		}
+		day = parsed
+	}
+
+	assignments, err := h.service.GetJobs(c, c.GetString("userID"), day)
+	if err != nil {
+		log.Println("Failed to get jobs ", err)
+		response.Error(c, statusCode(err), "failed to get jobs", err)
+		return
+	}
+
+	utils.CopyTo(&assignments, &res)
+	response.Success(c, http.StatusOK, "jobs are collected successfully", &res, nil)
+}
+
+// MarkPickedUp marks a pickup job as done.
+//
+//	@Summary	Mark a pickup as picked up
+//	@Tags		Courier
+//	@Accept		json
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		id	path		string	true	"Assignment ID"
+//	@Success	200	{object}	courierResource.Assignment
+//	@Router		/courier/job/{id}/picked-up [put]
+func (h *CourierHandler) MarkPickedUp(c *gin.Context) {
+	var res courierResource.Assignment
+
+	assignment, err := h.service.MarkPickedUp(c, c.GetString("userID"), c.GetString("userRole"), c.Param("id"))
+	if err != nil {
+		log.Println("Failed to mark job as picked up ", err)
+		response.Error(c, statusCode(err), "failed to mark job as picked up", err)
+		return
+	}
+
+	utils.CopyTo(&assignment, &res)
+	response.Success(c, http.StatusOK, "laundry is picked up successfully", &res, nil)
+}
+
+// MarkDelivered marks a delivery job as done.
+//
+//	@Summary	Mark a delivery as delivered
+//	@Tags		Courier
+//	@Accept		json
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		id	path		string	true	"Assignment ID"
+//	@Success	200	{object}	courierResource.Assignment
+//	@Router		/courier/job/{id}/delivered [put]
+func (h *CourierHandler) MarkDelivered(c *gin.Context) {
+	var res courierResource.Assignment
+
+	assignment, err := h.service.MarkDelivered(c, c.GetString("userID"), c.GetString("userRole"), c.Param("id"))
+	if err != nil {
+		log.Println("Failed to mark job as delivered ", err)
+		response.Error(c, statusCode(err), "failed to mark job as delivered", err)
+		return
+	}
+
+	utils.CopyTo(&assignment, &res)
+	response.Success(c, http.StatusOK, "laundry is delivered successfully", &res, nil)
+}
+
+// statusCode maps courier and order lifecycle errors to the HTTP status
+// returned to the client.
+func statusCode(err error) int {
+	switch {
+	case errors.Is(err, courierService.ErrCourierNotFound),
+		errors.Is(err, courierService.ErrAssignmentNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, courierService.ErrCourierExists),
+		errors.Is(err, courierService.ErrCourierInactive),
+		errors.Is(err, courierService.ErrLegCompleted),
+		errors.Is(err, courierService.ErrLegNotAllowed),
+		errors.Is(err, courierService.ErrLegNotScheduled),
+		errors.Is(err, orderService.ErrInvalidTransition):
+		return http.StatusConflict
+	case errors.Is(err, orderService.ErrTransitionNotAllowed):
+		return http.StatusForbidden
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,173 @@
+package courierRepository
+
+import (
+	"context"
+	"time"
+
+	courierModel "washit-api/internal/courier/dto/model"
+	"washit-api/pkg/db/dbs"
+)
+
+type ICourierRepository interface {
+	GetCouriers(ctx context.Context) ([]*courierModel.Courier, error)
+	GetCourierByID(ctx context.Context, courierID string) (*courierModel.Courier, error)
+	GetCourierByUser(ctx context.Context, userID string) (*courierModel.Courier, error)
+	CreateCourier(ctx context.Context, courier *courierModel.Courier) error
+	UpdateCourier(ctx context.Context, courier *courierModel.Courier) error
+	GetAssignmentByID(ctx context.Context, assignmentID string) (*courierModel.Assignment, error)
+	GetAssignment(ctx context.Context, orderID string, leg courierModel.Leg) (*courierModel.Assignment, error)
+	GetAssignmentsByOrder(ctx context.Context, orderID string) ([]*courierModel.Assignment, error)
+	GetAssignmentsByCourier(ctx context.Context, courierID int64, from time.Time, to time.Time) ([]*courierModel.Assignment, error)
+	CreateAssignment(ctx context.Context, assignment *courierModel.Assignment) error
+	UpdateAssignment(ctx context.Context, assignment *courierModel.Assignment) error
+	UpdateAssignments(ctx context.Context, assignments []*courierModel.Assignment) error
+}
+
+type CourierRepository struct {
+	db dbs.IDatabase
+}
+
+func NewCourierRepository(db dbs.IDatabase) *CourierRepository {
+	return &CourierRepository{db: db}
+}
+
+func (r *CourierRepository) GetCouriers(ctx context.Context) ([]*courierModel.Courier, error) {
+	var couriers []*courierModel.Courier
+	query := []dbs.FindOption{
+		dbs.WithPreload([]string{"User"}),
+		dbs.WithOrder("is_active DESC, created_at DESC"),
+	}
+
+	if err := r.db.Find(ctx, &couriers, query...); err != nil {
+		return nil, err
+	}
+
+	return couriers, nil
+}
+
+func (r *CourierRepository) GetCourierByID(ctx context.Context, courierID string) (*courierModel.Courier, error) {
+	var courier courierModel.Courier
+	query := []dbs.FindOption{
+		dbs.WithPreload([]string{"User"}),
+		dbs.WithQuery(dbs.NewQuery("id = ?", courierID)),
+	}
+
+	if err := r.db.FindOne(ctx, &courier, query...); err != nil {
+		return nil, err
+	}
+
+	return &courier, nil
+}
+
+func (r *CourierRepository) GetCourierByUser(ctx context.Context, userID string) (*courierModel.Courier, error) {
+	var courier courierModel.Courier
+	query := []dbs.FindOption{
+		dbs.WithPreload([]string{"User"}),
+		dbs.WithQuery(dbs.NewQuery("user_id = ?", userID)),
+	}
+
+	if err := r.db.FindOne(ctx, &courier, query...); err != nil {
+		return nil, err
+	}
+
+	return &courier, nil
+}
+
+func (r *CourierRepository) CreateCourier(ctx context.Context, courier *courierModel.Courier) error {
+	return r.db.Create(ctx, courier)
+}
+
+func (r *CourierRepository) UpdateCourier(ctx context.Context, courier *courierModel.Courier) error {
+	return r.db.Update(ctx, courier)
+}
+
+func (r *CourierRepository) GetAssignmentByID(ctx context.Context, assignmentID string) (*courierModel.Assignment, error) {
+	var assignment courierModel.Assignment
+	query := []dbs.FindOption{
+		dbs.WithPreload([]string{"Address"}),
+		dbs.WithQuery(dbs.NewQuery("id = ?", assignmentID)),
+	}
+
+	if err := r.db.FindOne(ctx, &assignment, query...); err != nil {
+		return nil, err
+	}
+
+	return &assignment, nil
+}
+
+func (r *CourierRepository) GetAssignment(ctx context.Context, orderID string, leg courierModel.Leg) (*courierModel.Assignment, error) {
+	var assignment courierModel.Assignment
+	query := []dbs.FindOption{
+		dbs.WithQuery(
+			dbs.NewQuery("order_id = ?", orderID),
+			dbs.NewQuery("leg = ?", leg),
+		),
+	}
+
+	if err := r.db.FindOne(ctx, &assignment, query...); err != nil {
+		return nil, err
+	}
+
+	return &assignment, nil
+}
+
+func (r *CourierRepository) GetAssignmentsByOrder(ctx context.Context, orderID string) ([]*courierModel.Assignment, error) {
+	var assignments []*courierModel.Assignment
+	query := []dbs.FindOption{
+		dbs.WithPreload([]string{"Courier", "Courier.User"}),
+		dbs.WithQuery(dbs.NewQuery("order_id = ?", orderID)),
+		dbs.WithOrder("scheduled_at ASC"),
+	}
+
+	if err := r.db.Find(ctx, &assignments, query...); err != nil {
+		return nil, err
+	}
+
+	return assignments, nil
+}
+
+// GetAssignmentsByCourier returns the open assignments of a courier, whatever
+// their stored schedule, and those completed that were scheduled in
+// [from, to), earliest first. Open assignments are rescheduled by the caller.
+func (r *CourierRepository) GetAssignmentsByCourier(ctx context.Context, courierID int64, from time.Time, to time.Time) ([]*courierModel.Assignment, error) {
+	var assignments []*courierModel.Assignment
+	query := []dbs.FindOption{
+		dbs.WithPreload([]string{"Address"}),
+		dbs.WithQuery(
+			dbs.NewQuery("courier_id = ?", courierID),
+			dbs.NewQuery("status = ? OR (scheduled_at >= ? AND scheduled_at < ?)", courierModel.AssignmentAssigned, from, to),
+		),
+		dbs.WithOrder("scheduled_at ASC"),
+	}
+
+	if err := r.db.Find(ctx, &assignments, query...); err != nil {
+		return nil, err
+	}
+
+	return assignments, nil
+}
+
+func (r *CourierRepository) CreateAssignment(ctx context.Context, assignment *courierModel.Assignment) error {
+	return r.db.Create(ctx, assignment)
+}
+
+func (r *CourierRepository) UpdateAssignment(ctx context.Context, assignment *courierModel.Assignment) error {
+	return r.db.Update(ctx, assignment)
+}
+
+// UpdateAssignments stores several assignments in one transaction.
+func (r *CourierRepository) UpdateAssignments(ctx context.Context, assignments []*courierModel.Assignment) error {
+	if len(assignments) == 0 {
+		return nil
+	}
+
+	return r.db.WithTransaction(func(tx dbs.IDatabase) error {
+		for _, assignment := range assignments {
+			if err := tx.Update(ctx, assignment); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
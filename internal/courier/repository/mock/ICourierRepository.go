@@ -0,0 +1,331 @@
+// Code generated by mockery v2.50.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	courierModel "washit-api/internal/courier/dto/model"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// ICourierRepository is an autogenerated mock type for the ICourierRepository type
+type ICourierRepository struct {
+	mock.Mock
+}
+
+// CreateAssignment provides a mock function with given fields: ctx, assignment
+func (_m *ICourierRepository) CreateAssignment(ctx context.Context, assignment *courierModel.Assignment) error {
+	ret := _m.Called(ctx, assignment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateAssignment")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *courierModel.Assignment) error); ok {
+		r0 = rf(ctx, assignment)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateCourier provides a mock function with given fields: ctx, courier
+func (_m *ICourierRepository) CreateCourier(ctx context.Context, courier *courierModel.Courier) error {
+	ret := _m.Called(ctx, courier)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateCourier")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *courierModel.Courier) error); ok {
+		r0 = rf(ctx, courier)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetAssignment provides a mock function with given fields: ctx, orderID, leg
+func (_m *ICourierRepository) GetAssignment(ctx context.Context, orderID string, leg courierModel.Leg) (*courierModel.Assignment, error) {
+	ret := _m.Called(ctx, orderID, leg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAssignment")
+	}
+
+	var r0 *courierModel.Assignment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, courierModel.Leg) (*courierModel.Assignment, error)); ok {
+		return rf(ctx, orderID, leg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, courierModel.Leg) *courierModel.Assignment); ok {
+		r0 = rf(ctx, orderID, leg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*courierModel.Assignment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, courierModel.Leg) error); ok {
+		r1 = rf(ctx, orderID, leg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAssignmentByID provides a mock function with given fields: ctx, assignmentID
+func (_m *ICourierRepository) GetAssignmentByID(ctx context.Context, assignmentID string) (*courierModel.Assignment, error) {
+	ret := _m.Called(ctx, assignmentID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAssignmentByID")
+	}
+
+	var r0 *courierModel.Assignment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*courierModel.Assignment, error)); ok {
+		return rf(ctx, assignmentID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *courierModel.Assignment); ok {
+		r0 = rf(ctx, assignmentID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*courierModel.Assignment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, assignmentID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAssignmentsByCourier provides a mock function with given fields: ctx, courierID, from, to
+func (_m *ICourierRepository) GetAssignmentsByCourier(ctx context.Context, courierID int64, from time.Time, to time.Time) ([]*courierModel.Assignment, error) {
+	ret := _m.Called(ctx, courierID, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAssignmentsByCourier")
+	}
+
+	var r0 []*courierModel.Assignment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, time.Time, time.Time) ([]*courierModel.Assignment, error)); ok {
+		return rf(ctx, courierID, from, to)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, time.Time, time.Time) []*courierModel.Assignment); ok {
+		r0 = rf(ctx, courierID, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*courierModel.Assignment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, courierID, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAssignmentsByOrder provides a mock function with given fields: ctx, orderID
+func (_m *ICourierRepository) GetAssignmentsByOrder(ctx context.Context, orderID string) ([]*courierModel.Assignment, error) {
+	ret := _m.Called(ctx, orderID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAssignmentsByOrder")
+	}
+
+	var r0 []*courierModel.Assignment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*courierModel.Assignment, error)); ok {
+		return rf(ctx, orderID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*courierModel.Assignment); ok {
+		r0 = rf(ctx, orderID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*courierModel.Assignment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, orderID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCourierByID provides a mock function with given fields: ctx, courierID
+func (_m *ICourierRepository) GetCourierByID(ctx context.Context, courierID string) (*courierModel.Courier, error) {
+	ret := _m.Called(ctx, courierID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCourierByID")
+	}
+
+	var r0 *courierModel.Courier
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*courierModel.Courier, error)); ok {
+		return rf(ctx, courierID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *courierModel.Courier); ok {
+		r0 = rf(ctx, courierID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*courierModel.Courier)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, courierID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCourierByUser provides a mock function with given fields: ctx, userID
+func (_m *ICourierRepository) GetCourierByUser(ctx context.Context, userID string) (*courierModel.Courier, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCourierByUser")
+	}
+
+	var r0 *courierModel.Courier
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*courierModel.Courier, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *courierModel.Courier); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*courierModel.Courier)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCouriers provides a mock function with given fields: ctx
+func (_m *ICourierRepository) GetCouriers(ctx context.Context) ([]*courierModel.Courier, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCouriers")
+	}
+
+	var r0 []*courierModel.Courier
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*courierModel.Courier, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*courierModel.Courier); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*courierModel.Courier)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateAssignment provides a mock function with given fields: ctx, assignment
+func (_m *ICourierRepository) UpdateAssignment(ctx context.Context, assignment *courierModel.Assignment) error {
+	ret := _m.Called(ctx, assignment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateAssignment")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *courierModel.Assignment) error); ok {
+		r0 = rf(ctx, assignment)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateAssignments provides a mock function with given fields: ctx, assignments
+func (_m *ICourierRepository) UpdateAssignments(ctx context.Context, assignments []*courierModel.Assignment) error {
+	ret := _m.Called(ctx, assignments)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateAssignments")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []*courierModel.Assignment) error); ok {
+		r0 = rf(ctx, assignments)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateCourier provides a mock function with given fields: ctx, courier
+func (_m *ICourierRepository) UpdateCourier(ctx context.Context, courier *courierModel.Courier) error {
+	ret := _m.Called(ctx, courier)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateCourier")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *courierModel.Courier) error); ok {
+		r0 = rf(ctx, courier)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewICourierRepository creates a new instance of ICourierRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewICourierRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ICourierRepository {
+	mock := &ICourierRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
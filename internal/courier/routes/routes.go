@@ -0,0 +1,46 @@
+package courierRoutes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator"
+
+	courier "washit-api/internal/courier/handler"
+	courierRepository "washit-api/internal/courier/repository"
+	courierService "washit-api/internal/courier/service"
+	orderService "washit-api/internal/order/service"
+	userRepository "washit-api/internal/user/repository"
+	userService "washit-api/internal/user/service"
+	"washit-api/pkg/db/dbs"
+	"washit-api/pkg/mailer"
+	"washit-api/pkg/middleware"
+	"washit-api/pkg/rbac"
+	"washit-api/pkg/redis"
+)
+
+func Main(r *gin.RouterGroup, db dbs.IDatabase, cache redis.IRedis, validator *validator.Validate) {
+	repository := courierRepository.NewCourierRepository(db)
+	users := userService.NewUserService(userRepository.NewUserRepository(db), cache, mailer.FromEnvs(), validator)
+	orders := orderService.NewOrderServiceFromDB(db, users, validator)
+	service := courierService.NewCourierService(repository, orders, users, validator)
+	handler := courier.NewCourierHandler(service, cache)
+
+	courierMiddleware := middleware.JWTPermission(cache, rbac.CourierJobs)
+	manageMiddleware := middleware.JWTPermission(cache, rbac.CourierManage)
+
+	// Courier Jobs
+	r.GET("/courier/jobs", courierMiddleware, handler.GetJobs)
+	r.PUT("/courier/job/:id/picked-up", courierMiddleware, handler.MarkPickedUp)
+	r.PUT("/courier/job/:id/delivered", courierMiddleware, handler.MarkDelivered)
+
+	// Staff Authority
+
+	// Courier
+	r.GET("/couriers", manageMiddleware, handler.GetCouriers)
+	r.GET("/courier/:id", manageMiddleware, handler.GetCourierByID)
+	r.POST("/courier", manageMiddleware, handler.CreateCourier)
+	r.PUT("/courier/:id", manageMiddleware, handler.UpdateCourier)
+
+	// Assignment
+	r.PUT("/order/:id/assignment", manageMiddleware, handler.AssignOrder)
+	r.GET("/order/:id/assignments", manageMiddleware, handler.GetAssignmentsByOrder)
+}
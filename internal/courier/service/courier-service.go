@@ -0,0 +1,456 @@
+package courierService
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"time"
+
+	courierModel "washit-api/internal/courier/dto/model"
+	courierRequest "washit-api/internal/courier/dto/request"
+	courierRepository "washit-api/internal/courier/repository"
+	orderModel "washit-api/internal/order/dto/model"
+	orderService "washit-api/internal/order/service"
+	userRequest "washit-api/internal/user/dto/request"
+	userService "washit-api/internal/user/service"
+	"washit-api/pkg/rbac"
+
+	"github.com/go-playground/validator"
+)
+
+type ICourierService interface {
+	GetCouriers(c context.Context) ([]*courierModel.Courier, error)
+	GetCourierByID(c context.Context, courierID string) (*courierModel.Courier, error)
+	CreateCourier(c context.Context, actorID string, req *courierRequest.CreateCourier) (*courierModel.Courier, error)
+	UpdateCourier(c context.Context, courierID string, req *courierRequest.UpdateCourier) (*courierModel.Courier, error)
+	AssignOrder(c context.Context, actorID string, orderID string, req *courierRequest.Assignment) (*courierModel.Assignment, error)
+	GetAssignmentsByOrder(c context.Context, orderID string) ([]*courierModel.Assignment, error)
+	GetJobs(c context.Context, userID string, day time.Time) ([]*courierModel.Assignment, error)
+	MarkPickedUp(c context.Context, userID string, role string, assignmentID string) (*courierModel.Assignment, error)
+	MarkDelivered(c context.Context, userID string, role string, assignmentID string) (*courierModel.Assignment, error)
+}
+
+var (
+	ErrCourierNotFound    = errors.New("courier not found")
+	ErrCourierInactive    = errors.New("courier is inactive")
+	ErrCourierExists      = errors.New("user already has a courier profile")
+	ErrAssignmentNotFound = errors.New("assignment not found")
+	ErrLegCompleted       = errors.New("leg is already completed")
+	ErrLegNotAllowed      = errors.New("leg cannot be assigned in the current order status")
+	ErrLegNotScheduled    = errors.New("leg has no scheduled time yet")
+)
+
+// legStatuses lists the order statuses in which each leg may still be
+// assigned or handed to another courier.
+var legStatuses = map[courierModel.Leg][]orderModel.Status{
+	courierModel.LegPickup: {
+		orderModel.StatusCreated,
+		orderModel.StatusAccepted,
+	},
+	courierModel.LegDelivery: {
+		orderModel.StatusCreated,
+		orderModel.StatusAccepted,
+		orderModel.StatusPickedUp,
+		orderModel.StatusWashing,
+		orderModel.StatusReady,
+		orderModel.StatusOutForDelivery,
+	},
+}
+
+type CourierService struct {
+	repository courierRepository.ICourierRepository
+	orders     orderService.IOrderService
+	users      userService.IUserService
+	validator  *validator.Validate
+}
+
+func NewCourierService(
+	repository courierRepository.ICourierRepository, orders orderService.IOrderService,
+	users userService.IUserService, validator *validator.Validate) *CourierService {
+	return &CourierService{
+		repository: repository,
+		orders:     orders,
+		users:      users,
+		validator:  validator,
+	}
+}
+
+func (s *CourierService) GetCouriers(c context.Context) ([]*courierModel.Courier, error) {
+	couriers, err := s.repository.GetCouriers(c)
+	if err != nil {
+		log.Printf("Failed to get couriers: %v", err)
+		return nil, fmt.Errorf("failed to get couriers: %w", err)
+	}
+
+	return couriers, nil
+}
+
+func (s *CourierService) GetCourierByID(c context.Context, courierID string) (*courierModel.Courier, error) {
+	courier, err := s.repository.GetCourierByID(c, courierID)
+	if err != nil {
+		log.Printf("Failed to get courier by id: %v", err)
+		return nil, fmt.Errorf("%w: %v", ErrCourierNotFound, courierID)
+	}
+
+	return courier, nil
+}
+
+// CreateCourier opens a courier profile for an existing user. Customers are
+// given the courier role; staff keep their role so they do not lose access.
+func (s *CourierService) CreateCourier(c context.Context, actorID string, req *courierRequest.CreateCourier) (*courierModel.Courier, error) {
+	if err := s.validator.Struct(req); err != nil {
+		log.Printf("Failed to validate courier request: %v", err)
+		return nil, fmt.Errorf("validation error: %w", err)
+	}
+
+	userID := strconv.FormatInt(req.UserID, 10)
+	if _, err := s.repository.GetCourierByUser(c, userID); err == nil {
+		return nil, fmt.Errorf("%w: %v", ErrCourierExists, userID)
+	}
+
+	user, err := s.users.GetUserByID(c, userID)
+	if err != nil {
+		log.Printf("Failed to get user %s: %v", userID, err)
+		return nil, fmt.Errorf("user not found: %v", userID)
+	}
+
+	if user.Role == rbac.RoleCustomer {
+		if _, err := s.users.AssignRole(c, actorID, userID, &userRequest.AssignRole{Role: rbac.RoleCourier}); err != nil {
+			log.Printf("Failed to give user %s the courier role: %v", userID, err)
+			return nil, fmt.Errorf("failed to create courier: %w", err)
+		}
+	}
+
+	courier := &courierModel.Courier{
+		UserID:       req.UserID,
+		Phone:        req.Phone,
+		VehicleType:  req.VehicleType,
+		VehiclePlate: req.VehiclePlate,
+		IsActive:     true,
+	}
+
+	if err := s.repository.CreateCourier(c, courier); err != nil {
+		log.Printf("Failed to create courier: %v", err)
+		return nil, fmt.Errorf("failed to create courier: %w", err)
+	}
+
+	return courier, nil
+}
+
+func (s *CourierService) UpdateCourier(c context.Context, courierID string, req *courierRequest.UpdateCourier) (*courierModel.Courier, error) {
+	if err := s.validator.Struct(req); err != nil {
+		log.Printf("Failed to validate courier request: %v", err)
+		return nil, fmt.Errorf("validation error: %w", err)
+	}
+
+	courier, err := s.GetCourierByID(c, courierID)
+	if err != nil {
+		return nil, err
+	}
+
+	courier.Phone = req.Phone
+	courier.VehicleType = req.VehicleType
+	courier.VehiclePlate = req.VehiclePlate
+	if req.IsActive != nil {
+		courier.IsActive = *req.IsActive
+	}
+
+	if err := s.repository.UpdateCourier(c, courier); err != nil {
+		log.Printf("Failed to update courier %s: %v", courierID, err)
+		return nil, fmt.Errorf("failed to update courier: %w", err)
+	}
+
+	return courier, nil
+}
+
+// AssignOrder makes a courier responsible for a leg of an order, or hands an
+// open leg to another courier. The pickup is scheduled at the order's collect
+// date and the delivery at its estimate date, so a delivery can only be
+// assigned once the order was accepted and estimated.
+func (s *CourierService) AssignOrder(c context.Context, actorID string, orderID string, req *courierRequest.Assignment) (*courierModel.Assignment, error) {
+	if err := s.validator.Struct(req); err != nil {
+		log.Printf("Failed to validate assignment request: %v", err)
+		return nil, fmt.Errorf("validation error: %w", err)
+	}
+
+	order, err := s.orders.GetOrderByID(c, orderID, "", "")
+	if err != nil {
+		return nil, err
+	}
+
+	leg := courierModel.Leg(req.Leg)
+	if !legOpen(leg, order.Status) {
+		log.Printf("Order %s is %s, cannot assign %s", order.ID, order.Status, leg)
+		return nil, fmt.Errorf("%w: %s is %s", ErrLegNotAllowed, order.ID, order.Status)
+	}
+
+	scheduledAt := legSchedule(leg, order)
+	if scheduledAt.IsZero() {
+		log.Printf("Order %s has no time for its %s yet", order.ID, leg)
+		return nil, fmt.Errorf("%w: %s of %s", ErrLegNotScheduled, leg, order.ID)
+	}
+
+	courier, err := s.GetCourierByID(c, strconv.FormatInt(req.CourierID, 10))
+	if err != nil {
+		return nil, err
+	}
+
+	if !courier.IsActive {
+		return nil, fmt.Errorf("%w: %v", ErrCourierInactive, courier.ID)
+	}
+
+	assignedBy, _ := strconv.ParseInt(actorID, 10, 64)
+
+	assignment, err := s.repository.GetAssignment(c, order.ID, leg)
+	if err != nil {
+		assignment = &courierModel.Assignment{
+			OrderID:     order.ID,
+			Leg:         leg,
+			CourierID:   courier.ID,
+			AddressID:   order.AddressID,
+			Status:      courierModel.AssignmentAssigned,
+			ScheduledAt: scheduledAt,
+			AssignedBy:  assignedBy,
+		}
+
+		if err := s.repository.CreateAssignment(c, assignment); err != nil {
+			log.Printf("Failed to assign %s of order %s: %v", leg, order.ID, err)
+			return nil, fmt.Errorf("failed to assign order: %w", err)
+		}
+
+		assignment.Courier = *courier
+		return assignment, nil
+	}
+
+	if assignment.Status == courierModel.AssignmentCompleted {
+		return nil, fmt.Errorf("%w: %s of %s", ErrLegCompleted, leg, order.ID)
+	}
+
+	log.Printf("Reassigning %s of order %s from courier %d to %d", leg, order.ID, assignment.CourierID, courier.ID)
+	assignment.CourierID = courier.ID
+	assignment.AssignedBy = assignedBy
+	assignment.ScheduledAt = scheduledAt
+
+	if err := s.repository.UpdateAssignment(c, assignment); err != nil {
+		log.Printf("Failed to reassign %s of order %s: %v", leg, order.ID, err)
+		return nil, fmt.Errorf("failed to reassign order: %w", err)
+	}
+
+	assignment.Courier = *courier
+	return assignment, nil
+}
+
+func (s *CourierService) GetAssignmentsByOrder(c context.Context, orderID string) ([]*courierModel.Assignment, error) {
+	assignments, err := s.repository.GetAssignmentsByOrder(c, orderID)
+	if err != nil {
+		log.Printf("Failed to get assignments of order %s: %v", orderID, err)
+		return nil, fmt.Errorf("failed to get assignments: %w", err)
+	}
+
+	return assignments, nil
+}
+
+// GetJobs returns the legs the courier profile of userID is scheduled for on
+// the calendar day of day. Open legs follow their order, whose collect or
+// estimate date may have moved since the leg was assigned; completed legs
+// keep the time they were done for.
+func (s *CourierService) GetJobs(c context.Context, userID string, day time.Time) ([]*courierModel.Assignment, error) {
+	courier, err := s.courierOf(c, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	from := time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, day.Location())
+	to := from.AddDate(0, 0, 1)
+	assignments, err := s.repository.GetAssignmentsByCourier(c, courier.ID, from, to)
+	if err != nil {
+		log.Printf("Failed to get jobs of courier %d: %v", courier.ID, err)
+		return nil, fmt.Errorf("failed to get jobs: %w", err)
+	}
+
+	orders, err := s.openOrders(c, assignments)
+	if err != nil {
+		return nil, err
+	}
+
+	jobs := make([]*courierModel.Assignment, 0, len(assignments))
+	var rescheduled []*courierModel.Assignment
+	for _, assignment := range assignments {
+		if assignment.Status != courierModel.AssignmentCompleted {
+			order, ok := orders[assignment.OrderID]
+			if !ok {
+				log.Printf("Failed to reschedule assignment %d: order %s not found", assignment.ID, assignment.OrderID)
+				continue
+			}
+
+			if reschedule(assignment, order) {
+				rescheduled = append(rescheduled, assignment)
+			}
+		}
+
+		if !assignment.ScheduledAt.Before(from) && assignment.ScheduledAt.Before(to) {
+			jobs = append(jobs, assignment)
+		}
+	}
+
+	if err := s.repository.UpdateAssignments(c, rescheduled); err != nil {
+		log.Printf("Failed to store new schedules of courier %d: %v", courier.ID, err)
+	}
+
+	sort.SliceStable(jobs, func(i, j int) bool {
+		return jobs[i].ScheduledAt.Before(jobs[j].ScheduledAt)
+	})
+
+	return jobs, nil
+}
+
+// openOrders loads the orders of the open assignments in one query, keyed by
+// their ID.
+func (s *CourierService) openOrders(c context.Context, assignments []*courierModel.Assignment) (map[string]*orderModel.Order, error) {
+	var orderIDs []string
+	for _, assignment := range assignments {
+		if assignment.Status != courierModel.AssignmentCompleted {
+			orderIDs = append(orderIDs, assignment.OrderID)
+		}
+	}
+
+	orders := make(map[string]*orderModel.Order, len(orderIDs))
+	if len(orderIDs) == 0 {
+		return orders, nil
+	}
+
+	found, err := s.orders.GetOrdersByIDs(c, orderIDs)
+	if err != nil {
+		log.Printf("Failed to get orders of jobs: %v", err)
+		return nil, fmt.Errorf("failed to get jobs: %w", err)
+	}
+
+	for _, order := range found {
+		orders[order.ID] = order
+	}
+
+	return orders, nil
+}
+
+// reschedule moves an open leg to the current time of its order and reports
+// whether it changed. Legs of orders without that time yet are left alone.
+func reschedule(assignment *courierModel.Assignment, order *orderModel.Order) bool {
+	scheduledAt := legSchedule(assignment.Leg, order)
+	if scheduledAt.IsZero() || scheduledAt.Equal(assignment.ScheduledAt) {
+		return false
+	}
+
+	assignment.ScheduledAt = scheduledAt
+
+	return true
+}
+
+// MarkPickedUp records that the courier collected the laundry, moving the
+// order to picked up. The job and the order change together or not at all.
+func (s *CourierService) MarkPickedUp(c context.Context, userID string, role string, assignmentID string) (*courierModel.Assignment, error) {
+	assignment, err := s.openJob(c, userID, assignmentID, courierModel.LegPickup)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.complete(c, assignment, userID, role, orderModel.StatusPickedUp)
+}
+
+// MarkDelivered records that the courier handed the laundry back, moving the
+// order to delivered. Orders still waiting at the outlet are sent out for
+// delivery first so the timeline stays complete. The job and the order change
+// together or not at all.
+func (s *CourierService) MarkDelivered(c context.Context, userID string, role string, assignmentID string) (*courierModel.Assignment, error) {
+	assignment, err := s.openJob(c, userID, assignmentID, courierModel.LegDelivery)
+	if err != nil {
+		return nil, err
+	}
+
+	order, err := s.orders.GetOrderByID(c, assignment.OrderID, "", "")
+	if err != nil {
+		return nil, err
+	}
+
+	path := []orderModel.Status{orderModel.StatusDelivered}
+	if order.Status == orderModel.StatusReady {
+		path = append([]orderModel.Status{orderModel.StatusOutForDelivery}, path...)
+	}
+
+	return s.complete(c, assignment, userID, role, path...)
+}
+
+func (s *CourierService) courierOf(c context.Context, userID string) (*courierModel.Courier, error) {
+	courier, err := s.repository.GetCourierByUser(c, userID)
+	if err != nil {
+		log.Printf("User %s has no courier profile: %v", userID, err)
+		return nil, fmt.Errorf("%w: user %v", ErrCourierNotFound, userID)
+	}
+
+	return courier, nil
+}
+
+// openJob returns an assignment of the courier profile of userID that is
+// still open for the given leg. Assignments of other couriers are reported as
+// not found.
+func (s *CourierService) openJob(c context.Context, userID string, assignmentID string, leg courierModel.Leg) (*courierModel.Assignment, error) {
+	courier, err := s.courierOf(c, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	assignment, err := s.repository.GetAssignmentByID(c, assignmentID)
+	if err != nil || assignment.CourierID != courier.ID {
+		log.Printf("Assignment %s not found for courier %d: %v", assignmentID, courier.ID, err)
+		return nil, fmt.Errorf("%w: %v", ErrAssignmentNotFound, assignmentID)
+	}
+
+	if assignment.Leg != leg {
+		return nil, fmt.Errorf("%w: %v is a %s", ErrAssignmentNotFound, assignmentID, assignment.Leg)
+	}
+
+	if assignment.Status == courierModel.AssignmentCompleted {
+		return nil, fmt.Errorf("%w: %s of %s", ErrLegCompleted, leg, assignment.OrderID)
+	}
+
+	return assignment, nil
+}
+
+// complete closes assignment and moves its order through path in one
+// database transaction.
+func (s *CourierService) complete(c context.Context, assignment *courierModel.Assignment, userID string, role string, path ...orderModel.Status) (*courierModel.Assignment, error) {
+	now := time.Now()
+	assignment.Status = courierModel.AssignmentCompleted
+	assignment.CompletedAt = &now
+
+	if _, err := s.orders.MoveOrder(c, assignment.OrderID, userID, role, path, assignment); err != nil {
+		log.Printf("Failed to complete assignment %d: %v", assignment.ID, err)
+		assignment.Status = courierModel.AssignmentAssigned
+		assignment.CompletedAt = nil
+		return nil, err
+	}
+
+	return assignment, nil
+}
+
+// legSchedule is when a leg of order takes place: the pickup at its collect
+// date, the delivery at its estimate date. Zero means not known yet.
+func legSchedule(leg courierModel.Leg, order *orderModel.Order) time.Time {
+	if leg == courierModel.LegDelivery {
+		return order.EstimateDate
+	}
+
+	return order.CollectDate
+}
+
+func legOpen(leg courierModel.Leg, status orderModel.Status) bool {
+	for _, open := range legStatuses[leg] {
+		if open == status {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,265 @@
+package courierService
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+	courierModel "washit-api/internal/courier/dto/model"
+	courierRequest "washit-api/internal/courier/dto/request"
+	mocks "washit-api/internal/courier/repository/mock"
+	orderModel "washit-api/internal/order/dto/model"
+	orderService "washit-api/internal/order/service"
+	orderMocks "washit-api/internal/order/service/mock"
+	userModel "washit-api/internal/user/dto/model"
+	userRequest "washit-api/internal/user/dto/request"
+	userMocks "washit-api/internal/user/service/mock"
+
+	"github.com/go-playground/validator"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type CourierServiceTestSuite struct {
+	suite.Suite
+	mockRepo   *mocks.ICourierRepository
+	mockOrders *orderMocks.IOrderService
+	mockUsers  *userMocks.IUserService
+	service    ICourierService
+}
+
+func (suite *CourierServiceTestSuite) SetupTest() {
+	suite.mockRepo = new(mocks.ICourierRepository)
+	suite.mockOrders = new(orderMocks.IOrderService)
+	suite.mockUsers = new(userMocks.IUserService)
+	suite.service = NewCourierService(suite.mockRepo, suite.mockOrders, suite.mockUsers, validator.New())
+}
+
+func TestCourierServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(CourierServiceTestSuite))
+}
+
+func completed() interface{} {
+	return mock.MatchedBy(func(assignment *courierModel.Assignment) bool {
+		return assignment.Status == courierModel.AssignmentCompleted && assignment.CompletedAt != nil
+	})
+}
+
+// CreateCourier
+// =================================================================
+
+func (suite *CourierServiceTestSuite) TestCreateCourierAssignsRole() {
+	suite.mockRepo.On("GetCourierByUser", mock.Anything, "5").
+		Return(nil, errors.New("record not found")).Times(1)
+	suite.mockUsers.On("GetUserByID", mock.Anything, "5").
+		Return(&userModel.User{ID: 5, Role: "customer"}, nil).Times(1)
+	suite.mockUsers.On("AssignRole", mock.Anything, "1", "5", &userRequest.AssignRole{Role: "courier"}).
+		Return(&userModel.User{ID: 5, Role: "courier"}, nil).Times(1)
+	suite.mockRepo.On("CreateCourier", mock.Anything, mock.MatchedBy(func(courier *courierModel.Courier) bool {
+		return courier.UserID == 5 && courier.IsActive
+	})).Return(nil).Times(1)
+
+	courier, err := suite.service.CreateCourier(context.Background(), "1", &courierRequest.CreateCourier{
+		UserID: 5, Phone: "0812", VehicleType: "motorcycle",
+	})
+	suite.Nil(err)
+	suite.Equal(int64(5), courier.UserID)
+	suite.mockUsers.AssertExpectations(suite.T())
+}
+
+func (suite *CourierServiceTestSuite) TestCreateCourierExists() {
+	suite.mockRepo.On("GetCourierByUser", mock.Anything, "5").
+		Return(&courierModel.Courier{ID: 1, UserID: 5}, nil).Times(1)
+
+	courier, err := suite.service.CreateCourier(context.Background(), "1", &courierRequest.CreateCourier{
+		UserID: 5, Phone: "0812", VehicleType: "motorcycle",
+	})
+	suite.Nil(courier)
+	suite.ErrorIs(err, ErrCourierExists)
+}
+
+// AssignOrder
+// =================================================================
+
+func (suite *CourierServiceTestSuite) TestAssignOrderPickup() {
+	collectDate := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	suite.mockOrders.On("GetOrderByID", mock.Anything, "ORD-1", "", "").
+		Return(&orderModel.Order{ID: "ORD-1", AddressID: 7, Status: orderModel.StatusAccepted, CollectDate: collectDate}, nil).Times(1)
+	suite.mockRepo.On("GetCourierByID", mock.Anything, "3").
+		Return(&courierModel.Courier{ID: 3, IsActive: true}, nil).Times(1)
+	suite.mockRepo.On("GetAssignment", mock.Anything, "ORD-1", courierModel.LegPickup).
+		Return(nil, errors.New("record not found")).Times(1)
+	suite.mockRepo.On("CreateAssignment", mock.Anything, mock.MatchedBy(func(assignment *courierModel.Assignment) bool {
+		return assignment.CourierID == 3 && assignment.AddressID == 7 &&
+			assignment.ScheduledAt.Equal(collectDate) && assignment.AssignedBy == 1
+	})).Return(nil).Times(1)
+
+	assignment, err := suite.service.AssignOrder(context.Background(), "1", "ORD-1",
+		&courierRequest.Assignment{Leg: "pickup", CourierID: 3})
+	suite.Nil(err)
+	suite.Equal(courierModel.AssignmentAssigned, assignment.Status)
+}
+
+func (suite *CourierServiceTestSuite) TestAssignOrderReassigns() {
+	estimateDate := time.Date(2026, 3, 4, 16, 0, 0, 0, time.UTC)
+	suite.mockOrders.On("GetOrderByID", mock.Anything, "ORD-1", "", "").
+		Return(&orderModel.Order{ID: "ORD-1", Status: orderModel.StatusWashing, EstimateDate: estimateDate}, nil).Times(1)
+	suite.mockRepo.On("GetCourierByID", mock.Anything, "4").
+		Return(&courierModel.Courier{ID: 4, IsActive: true}, nil).Times(1)
+	suite.mockRepo.On("GetAssignment", mock.Anything, "ORD-1", courierModel.LegDelivery).
+		Return(&courierModel.Assignment{ID: 9, CourierID: 3, Status: courierModel.AssignmentAssigned}, nil).Times(1)
+	suite.mockRepo.On("UpdateAssignment", mock.Anything, mock.MatchedBy(func(assignment *courierModel.Assignment) bool {
+		return assignment.ID == 9 && assignment.CourierID == 4 && assignment.ScheduledAt.Equal(estimateDate)
+	})).Return(nil).Times(1)
+
+	assignment, err := suite.service.AssignOrder(context.Background(), "1", "ORD-1",
+		&courierRequest.Assignment{Leg: "delivery", CourierID: 4})
+	suite.Nil(err)
+	suite.Equal(int64(4), assignment.CourierID)
+}
+
+func (suite *CourierServiceTestSuite) TestAssignOrderDeliveryBeforeEstimate() {
+	suite.mockOrders.On("GetOrderByID", mock.Anything, "ORD-1", "", "").
+		Return(&orderModel.Order{ID: "ORD-1", Status: orderModel.StatusCreated}, nil).Times(1)
+
+	assignment, err := suite.service.AssignOrder(context.Background(), "1", "ORD-1",
+		&courierRequest.Assignment{Leg: "delivery", CourierID: 3})
+	suite.Nil(assignment)
+	suite.ErrorIs(err, ErrLegNotScheduled)
+	suite.mockRepo.AssertNotCalled(suite.T(), "CreateAssignment", mock.Anything, mock.Anything)
+}
+
+func (suite *CourierServiceTestSuite) TestAssignOrderPickupAfterCollection() {
+	suite.mockOrders.On("GetOrderByID", mock.Anything, "ORD-1", "", "").
+		Return(&orderModel.Order{ID: "ORD-1", Status: orderModel.StatusPickedUp}, nil).Times(1)
+
+	assignment, err := suite.service.AssignOrder(context.Background(), "1", "ORD-1",
+		&courierRequest.Assignment{Leg: "pickup", CourierID: 3})
+	suite.Nil(assignment)
+	suite.ErrorIs(err, ErrLegNotAllowed)
+}
+
+func (suite *CourierServiceTestSuite) TestAssignOrderInactiveCourier() {
+	suite.mockOrders.On("GetOrderByID", mock.Anything, "ORD-1", "", "").
+		Return(&orderModel.Order{ID: "ORD-1", Status: orderModel.StatusCreated, CollectDate: time.Now()}, nil).Times(1)
+	suite.mockRepo.On("GetCourierByID", mock.Anything, "3").
+		Return(&courierModel.Courier{ID: 3}, nil).Times(1)
+
+	assignment, err := suite.service.AssignOrder(context.Background(), "1", "ORD-1",
+		&courierRequest.Assignment{Leg: "pickup", CourierID: 3})
+	suite.Nil(assignment)
+	suite.ErrorIs(err, ErrCourierInactive)
+}
+
+// GetJobs
+// =================================================================
+
+func (suite *CourierServiceTestSuite) TestGetJobsForDay() {
+	day := time.Date(2026, 3, 2, 15, 30, 0, 0, time.UTC)
+	done := time.Date(2026, 3, 2, 11, 0, 0, 0, time.UTC)
+	estimated := time.Date(2026, 3, 2, 16, 0, 0, 0, time.UTC)
+	suite.mockRepo.On("GetCourierByUser", mock.Anything, "8").
+		Return(&courierModel.Courier{ID: 3, UserID: 8}, nil).Times(1)
+	suite.mockRepo.On("GetAssignmentsByCourier", mock.Anything, int64(3),
+		time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC), time.Date(2026, 3, 3, 0, 0, 0, 0, time.UTC)).
+		Return([]*courierModel.Assignment{
+			{ID: 1, OrderID: "ORD-1", Leg: courierModel.LegDelivery, Status: courierModel.AssignmentAssigned},
+			{ID: 2, OrderID: "ORD-2", Leg: courierModel.LegPickup, Status: courierModel.AssignmentAssigned},
+			{ID: 3, OrderID: "ORD-3", Leg: courierModel.LegPickup, Status: courierModel.AssignmentCompleted, ScheduledAt: done},
+		}, nil).Times(1)
+
+	// The estimate of ORD-1 was set after its delivery was assigned; the
+	// pickup of ORD-2 moved to the next day.
+	suite.mockOrders.On("GetOrdersByIDs", mock.Anything, []string{"ORD-1", "ORD-2"}).
+		Return([]*orderModel.Order{
+			{ID: "ORD-1", EstimateDate: estimated},
+			{ID: "ORD-2", CollectDate: day.AddDate(0, 0, 1)},
+		}, nil).Times(1)
+	suite.mockRepo.On("UpdateAssignments", mock.Anything, mock.MatchedBy(func(assignments []*courierModel.Assignment) bool {
+		return len(assignments) == 2 &&
+			assignments[0].ScheduledAt.Equal(estimated) && assignments[1].ScheduledAt.Equal(day.AddDate(0, 0, 1))
+	})).Return(nil).Times(1)
+
+	jobs, err := suite.service.GetJobs(context.Background(), "8", day)
+	suite.Nil(err)
+	suite.Len(jobs, 2)
+	suite.Equal(int64(3), jobs[0].ID)
+	suite.Equal(int64(1), jobs[1].ID)
+	suite.mockOrders.AssertNotCalled(suite.T(), "GetOrderByID", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	suite.mockRepo.AssertNotCalled(suite.T(), "UpdateAssignment", mock.Anything, mock.Anything)
+}
+
+// MarkPickedUp
+// =================================================================
+
+func (suite *CourierServiceTestSuite) TestMarkPickedUp() {
+	suite.mockRepo.On("GetCourierByUser", mock.Anything, "8").
+		Return(&courierModel.Courier{ID: 3, UserID: 8}, nil).Times(1)
+	suite.mockRepo.On("GetAssignmentByID", mock.Anything, "9").
+		Return(&courierModel.Assignment{ID: 9, OrderID: "ORD-1", CourierID: 3, Leg: courierModel.LegPickup, Status: courierModel.AssignmentAssigned}, nil).Times(1)
+	suite.mockOrders.On("MoveOrder", mock.Anything, "ORD-1", "8", "courier",
+		[]orderModel.Status{orderModel.StatusPickedUp}, completed()).
+		Return(&orderModel.Order{ID: "ORD-1", Status: orderModel.StatusPickedUp}, nil).Times(1)
+
+	assignment, err := suite.service.MarkPickedUp(context.Background(), "8", "courier", "9")
+	suite.Nil(err)
+	suite.NotNil(assignment.CompletedAt)
+	suite.mockRepo.AssertNotCalled(suite.T(), "UpdateAssignment", mock.Anything, mock.Anything)
+}
+
+func (suite *CourierServiceTestSuite) TestMarkPickedUpOrderNotMoved() {
+	suite.mockRepo.On("GetCourierByUser", mock.Anything, "8").
+		Return(&courierModel.Courier{ID: 3, UserID: 8}, nil).Times(1)
+	suite.mockRepo.On("GetAssignmentByID", mock.Anything, "9").
+		Return(&courierModel.Assignment{ID: 9, OrderID: "ORD-1", CourierID: 3, Leg: courierModel.LegPickup, Status: courierModel.AssignmentAssigned}, nil).Times(1)
+	suite.mockOrders.On("MoveOrder", mock.Anything, "ORD-1", "8", "courier",
+		[]orderModel.Status{orderModel.StatusPickedUp}, mock.Anything).
+		Return(nil, orderService.ErrInvalidTransition).Times(1)
+
+	assignment, err := suite.service.MarkPickedUp(context.Background(), "8", "courier", "9")
+	suite.Nil(assignment)
+	suite.ErrorIs(err, orderService.ErrInvalidTransition)
+}
+
+func (suite *CourierServiceTestSuite) TestMarkPickedUpOtherCourier() {
+	suite.mockRepo.On("GetCourierByUser", mock.Anything, "8").
+		Return(&courierModel.Courier{ID: 3, UserID: 8}, nil).Times(1)
+	suite.mockRepo.On("GetAssignmentByID", mock.Anything, "9").
+		Return(&courierModel.Assignment{ID: 9, OrderID: "ORD-1", CourierID: 4, Leg: courierModel.LegPickup}, nil).Times(1)
+
+	assignment, err := suite.service.MarkPickedUp(context.Background(), "8", "courier", "9")
+	suite.Nil(assignment)
+	suite.ErrorIs(err, ErrAssignmentNotFound)
+	suite.mockOrders.AssertNotCalled(suite.T(), "MoveOrder", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+// MarkDelivered
+// =================================================================
+
+func (suite *CourierServiceTestSuite) TestMarkDeliveredFromReady() {
+	suite.mockRepo.On("GetCourierByUser", mock.Anything, "8").
+		Return(&courierModel.Courier{ID: 3, UserID: 8}, nil).Times(1)
+	suite.mockRepo.On("GetAssignmentByID", mock.Anything, "9").
+		Return(&courierModel.Assignment{ID: 9, OrderID: "ORD-1", CourierID: 3, Leg: courierModel.LegDelivery, Status: courierModel.AssignmentAssigned}, nil).Times(1)
+	suite.mockOrders.On("GetOrderByID", mock.Anything, "ORD-1", "", "").
+		Return(&orderModel.Order{ID: "ORD-1", Status: orderModel.StatusReady}, nil).Times(1)
+	suite.mockOrders.On("MoveOrder", mock.Anything, "ORD-1", "8", "courier",
+		[]orderModel.Status{orderModel.StatusOutForDelivery, orderModel.StatusDelivered}, completed()).
+		Return(&orderModel.Order{}, nil).Times(1)
+
+	assignment, err := suite.service.MarkDelivered(context.Background(), "8", "courier", "9")
+	suite.Nil(err)
+	suite.Equal(courierModel.AssignmentCompleted, assignment.Status)
+	suite.mockOrders.AssertExpectations(suite.T())
+}
+
+func (suite *CourierServiceTestSuite) TestMarkDeliveredTwice() {
+	suite.mockRepo.On("GetCourierByUser", mock.Anything, "8").
+		Return(&courierModel.Courier{ID: 3, UserID: 8}, nil).Times(1)
+	suite.mockRepo.On("GetAssignmentByID", mock.Anything, "9").
+		Return(&courierModel.Assignment{ID: 9, OrderID: "ORD-1", CourierID: 3, Leg: courierModel.LegDelivery, Status: courierModel.AssignmentCompleted}, nil).Times(1)
+
+	assignment, err := suite.service.MarkDelivered(context.Background(), "8", "courier", "9")
+	suite.Nil(assignment)
+	suite.ErrorIs(err, ErrLegCompleted)
+}
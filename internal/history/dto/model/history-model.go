@@ -3,21 +3,44 @@ package historyModel
 import (
 	"time"
 	userModel "washit-api/internal/user/dto/model"
+
+	"github.com/shopspring/decimal"
 )
 
 type History struct {
-	ID            string         `json:"id" gorm:"primaryKey unique"`
-	UserID        int64          `json:"userID" gorm:"not null;index"`
-	TransactionID int            `json:"transactionID"`
-	AddressID     int            `json:"addressID"`
-	Status        string         `json:"status"`
-	Note          string         `json:"note"`
-	ServiceType   string         `json:"serviceType"`
-	OrderType     string         `json:"orderType"`
-	Price         float64        `json:"price"`
-	CollectDate   time.Time      `json:"collectDate"`
-	EstimateDate  time.Time      `json:"estimateDate"`
-	DeletedAt     time.Time      `json:"deletedAt"`
-	Reason        string         `json:"reason"`
-	User          userModel.User `json:"user" gorm:"foreignKey:UserID;references:ID"`
+	ID             string           `json:"id" gorm:"primaryKey unique"`
+	UserID         int64            `json:"userID" gorm:"not null;index"`
+	TransactionID  string           `json:"transactionID"`
+	AddressID      int64            `json:"addressID"`
+	OutletID       *int64           `json:"outletID" gorm:"index"`
+	DistanceKm     *float64         `json:"distanceKm"`
+	DeliveryFee    *decimal.Decimal `json:"deliveryFee" gorm:"type:numeric"`
+	Status         string           `json:"status"`
+	Note           string           `json:"note"`
+	ServiceType    string           `json:"serviceType"`
+	OrderType      string           `json:"orderType"`
+	Price          *decimal.Decimal `json:"price" gorm:"type:numeric"`
+	PaidAmount     *decimal.Decimal `json:"paidAmount" gorm:"type:numeric"`
+	RefundedAmount *decimal.Decimal `json:"refundedAmount" gorm:"type:numeric"`
+	NetAmount      *decimal.Decimal `json:"netAmount" gorm:"type:numeric"`
+	CollectDate    time.Time        `json:"collectDate"`
+	EstimateDate   time.Time        `json:"estimateDate"`
+	DeletedAt      time.Time        `json:"deletedAt"`
+	Reason         string           `json:"reason"`
+	Items          []Item           `json:"items" gorm:"serializer:json"`
+	User           userModel.User   `json:"user" gorm:"foreignKey:UserID;references:ID"`
+}
+
+// Item is the snapshot of an order line item taken when the order is
+// archived.
+type Item struct {
+	ID               int64            `json:"id"`
+	GarmentType      string           `json:"garmentType"`
+	Quantity         int              `json:"quantity"`
+	Colour           string           `json:"colour"`
+	Brand            string           `json:"brand"`
+	CareInstructions string           `json:"careInstructions"`
+	UnitPrice        *decimal.Decimal `json:"unitPrice"`
+	DamageNotes      string           `json:"damageNotes"`
+	Photos           []string         `json:"photos"`
 }
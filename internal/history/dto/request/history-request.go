@@ -5,6 +5,7 @@ type History struct {
 
 type ListHistory struct {
 	UserID    int64 `json:"-"`
+	OutletID  string `json:"-"`
 	Code      string `json:"code,omitempty" form:"code"`
 	Status    string `json:"status,omitempty" form:"status"`
 	Page      int64  `json:"-" form:"page"`
@@ -3,27 +3,48 @@ package historyResource
 import (
 	"time"
 	"washit-api/pkg/paging"
+
+	"github.com/shopspring/decimal"
 )
 
 type ListHistory struct {
-	Histories     []*History           `json:"histories,omitempty"`
+	Histories  []*History         `json:"histories,omitempty"`
 	Pagination *paging.Pagination `json:"pagination,omitempty"`
 }
 
 type History struct {
-	ID            string    `json:"id" gorm:"primaryKey unique"`
-	User          User      `json:"user" gorm:"foreignKey:UserID;references:ID"`
-	TransactionID int       `json:"transactionID"`
-	AddressID     int       `json:"addressID"`
-	Status        string    `json:"status"`
-	Note          string    `json:"note"`
-	ServiceType   string    `json:"serviceType"`
-	OrderType     string    `json:"orderType"`
-	Price         float64   `json:"price"`
-	CollectDate   time.Time `json:"collectDate"`
-	EstimateDate  time.Time `json:"estimateDate"`
-	DeletedAt     time.Time `json:"deletedAt"`
-	Reason        string    `json:"reason"`
+	ID             string           `json:"id" gorm:"primaryKey unique"`
+	User           User             `json:"user" gorm:"foreignKey:UserID;references:ID"`
+	TransactionID  string           `json:"transactionID"`
+	AddressID      int64            `json:"addressID"`
+	OutletID       *int64           `json:"outletID"`
+	DistanceKm     *float64         `json:"distanceKm"`
+	DeliveryFee    *decimal.Decimal `json:"deliveryFee"`
+	Status         string           `json:"status"`
+	Note           string           `json:"note"`
+	ServiceType    string           `json:"serviceType"`
+	OrderType      string           `json:"orderType"`
+	Price          *decimal.Decimal `json:"price"`
+	PaidAmount     *decimal.Decimal `json:"paidAmount"`
+	RefundedAmount *decimal.Decimal `json:"refundedAmount"`
+	NetAmount      *decimal.Decimal `json:"netAmount"`
+	CollectDate    time.Time        `json:"collectDate"`
+	EstimateDate   time.Time        `json:"estimateDate"`
+	DeletedAt      time.Time        `json:"deletedAt"`
+	Reason         string           `json:"reason"`
+	Items          []Item           `json:"items"`
+}
+
+type Item struct {
+	ID               int64            `json:"id"`
+	GarmentType      string           `json:"garmentType"`
+	Quantity         int              `json:"quantity"`
+	Colour           string           `json:"colour"`
+	Brand            string           `json:"brand"`
+	CareInstructions string           `json:"careInstructions"`
+	UnitPrice        *decimal.Decimal `json:"unitPrice"`
+	DamageNotes      string           `json:"damageNotes"`
+	Photos           []string         `json:"photos"`
 }
 
 type User struct {
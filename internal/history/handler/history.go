@@ -1,6 +1,7 @@
 package history
 
 import (
+	"errors"
 	"log"
 	"net/http"
 	"strconv"
@@ -10,6 +11,8 @@ import (
 	historyRequest "washit-api/internal/history/dto/request"
 	historyResource "washit-api/internal/history/dto/resource"
 	historyService "washit-api/internal/history/service"
+	"washit-api/pkg/middleware"
+	"washit-api/pkg/rbac"
 	"washit-api/pkg/redis"
 	"washit-api/pkg/response"
 	"washit-api/pkg/utils"
@@ -31,16 +34,21 @@ func (h *HistoryHandler) GetHistoryByID(c *gin.Context) {
 	var res historyResource.History
 	var userID string
 
-	if c.GetString("userRole") == "admin" {
+	if rbac.Can(c.GetString("userRole"), rbac.HistoryReadAll) {
 		userID = ""
 	} else {
 		userID = c.GetString("userID")
 	}
 
-	history, err := h.service.GetHistoryByID(c, c.Param("id"), userID)
+	history, err := h.service.GetHistoryByID(c, c.Param("id"), userID, c.GetString("outletID"))
 	if err != nil {
 		log.Println("Failed to get history by ID", err)
-		response.Error(c, http.StatusInternalServerError, "failed to get history by ID", err)
+		status := http.StatusInternalServerError
+		if errors.Is(err, historyService.ErrOtherOutlet) {
+			status = http.StatusForbidden
+		}
+		response.Error(c, status, "failed to get history by ID", err)
+		return
 	}
 
 	utils.CopyTo(&history, &res)
@@ -84,6 +92,7 @@ func (h *HistoryHandler) GetHistoriesByUser(c *gin.Context) {
 	}
 
 	req.UserID = userID
+	req.OutletID = middleware.OutletScope(c)
 
 	histories, pagination, err := h.service.GetHistoriesByUser(c, &req)
 	if err != nil {
@@ -101,6 +110,8 @@ func (h * HistoryHandler) GetAllHistories (c *gin.Context) {
 	var res historyResource.ListHistory
 	var req historyRequest.ListHistory
 
+	req.OutletID = middleware.OutletScope(c)
+
 	histories, pagination, err := h.service.GetAllHistories(c, &req)
 	if err != nil {
 		log.Println("Failed to get all histories", err)
@@ -32,10 +32,14 @@ func (r *HistoryRepository) GetHistoryByID(c *gin.Context, historyID string) (*h
 }
 
 func (r *HistoryRepository) GetHistories(c *gin.Context, req *historyRequest.ListHistory) ([]*historyModel.History, *paging.Pagination, error) {
-	query := []dbs.Query{
-		dbs.NewQuery("user_id = ?", req.UserID),
-	}
+	var query []dbs.Query
 
+	if req.UserID != 0 {
+		query = append(query, dbs.NewQuery("user_id = ?", req.UserID))
+	}
+	if req.OutletID != "" {
+		query = append(query, dbs.NewQuery("outlet_id = ?", req.OutletID))
+	}
 	if req.Code != "" {
 		query = append(query, dbs.NewQuery("code = ?", req.Code))
 	}
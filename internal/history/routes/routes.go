@@ -6,6 +6,7 @@ import (
 	historyService "washit-api/internal/history/service"
 	"washit-api/pkg/db/dbs"
 	"washit-api/pkg/middleware"
+	"washit-api/pkg/rbac"
 	"washit-api/pkg/redis"
 
 	"github.com/gin-gonic/gin"
@@ -17,13 +18,12 @@ func Main(r *gin.RouterGroup, db dbs.IDatabase, cache redis.IRedis, validator *v
 	service := historyService.NewHistoryService(repository, validator)
 	handler := history.NewHistoryHandler(service, cache)
 
-	authMiddleware := middleware.JWTAuth()
-	adminAuthMiddleware := middleware.JWTAuthAdmin()
+	authMiddleware := middleware.JWTAuth(cache)
 
 	r.GET("/histories/me", authMiddleware, handler.GetHistoriesMe)
 	r.GET("/history/:id", authMiddleware, handler.GetHistoryByID)
 
-	//ADMIN
-	r.GET("/histories/user/:id", adminAuthMiddleware, handler.GetHistoriesByUser)
-	r.GET("/histories/all", adminAuthMiddleware, handler.GetAllHistories)
+	// Staff Authority
+	r.GET("/histories/user/:id", middleware.JWTPermission(cache, rbac.HistoryReadAll), handler.GetHistoriesByUser)
+	r.GET("/histories/all", middleware.JWTPermission(cache, rbac.HistoryReadAll), handler.GetAllHistories)
 }
@@ -1,6 +1,7 @@
 package historyService
 
 import (
+	"errors"
 	"fmt"
 	"log"
 	"strconv"
@@ -13,8 +14,10 @@ import (
 	"github.com/go-playground/validator"
 )
 
+var ErrOtherOutlet = errors.New("history belongs to another outlet")
+
 type IHistoryService interface {
-	GetHistoryByID(c *gin.Context, historyID string, userID string) (*historyModel.History, error)
+	GetHistoryByID(c *gin.Context, historyID string, userID string, outletID string) (*historyModel.History, error)
 	GetHistoriesMe(c *gin.Context, req *historyRequest.ListHistory) ([]*historyModel.History, *paging.Pagination, error)
 	GetHistoriesByUser(c *gin.Context, req *historyRequest.ListHistory) ([]*historyModel.History,*paging.Pagination, error)
 	GetAllHistories(c *gin.Context, req *historyRequest.ListHistory) ([]*historyModel.History,*paging.Pagination, error)
@@ -32,7 +35,9 @@ func NewHistoryService(repository historyRepository.IHistoryRepository, validato
 	}
 }
 
-func (s *HistoryService) GetHistoryByID(c *gin.Context, historyID string, userID string) (*historyModel.History, error) {
+// GetHistoryByID returns an archived order. Staff bound to outletID only get
+// those of their own outlet.
+func (s *HistoryService) GetHistoryByID(c *gin.Context, historyID string, userID string, outletID string) (*historyModel.History, error) {
 	history, err := s.repository.GetHistoryByID(c, historyID)
 	if err != nil {
 		log.Printf("Failed to get history by ID: %v", err)
@@ -44,6 +49,11 @@ func (s *HistoryService) GetHistoryByID(c *gin.Context, historyID string, userID
 		return nil, fmt.Errorf("user ID mismatch: %v", userID)
 	}
 
+	if outletID != "" && (history.OutletID == nil || strconv.FormatInt(*history.OutletID, 10) != outletID) {
+		log.Printf("History %s belongs to another outlet than %s", historyID, outletID)
+		return nil, fmt.Errorf("%w: %s", ErrOtherOutlet, historyID)
+	}
+
 	return history, err
 }
 
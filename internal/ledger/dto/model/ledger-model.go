@@ -0,0 +1,120 @@
+package ledgerModel
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+type AccountType string
+
+const (
+	AccountAsset     AccountType = "asset"
+	AccountLiability AccountType = "liability"
+	AccountRevenue   AccountType = "revenue"
+	AccountExpense   AccountType = "expense"
+)
+
+const (
+	AccountProviderClearing = "provider_clearing"
+	AccountCustomerWallet   = "customer_wallet"
+	AccountRevenueSales     = "revenue"
+	AccountRefundsPayable   = "refunds_payable"
+	AccountTaxPayable       = "tax_payable"
+	AccountProviderFees     = "provider_fees"
+)
+
+type EntryKind string
+
+const (
+	// EntryPayment records money received from the customer.
+	EntryPayment EntryKind = "payment"
+	// EntrySale recognises a payment as revenue and tax.
+	EntrySale EntryKind = "sale"
+	// EntryRefund reverses revenue and tax into refunds payable.
+	EntryRefund EntryKind = "refund"
+	// EntryRefundSettlement pays refunds payable out through the provider.
+	EntryRefundSettlement EntryKind = "refund_settlement"
+	// EntryFee records a fee withheld by the payment provider.
+	EntryFee EntryKind = "fee"
+)
+
+// Account is an entry of the chart of accounts. Debit-normal accounts
+// (assets, expenses) grow with debits, the others with credits.
+type Account struct {
+	Code string      `json:"code"`
+	Name string      `json:"name"`
+	Type AccountType `json:"type"`
+}
+
+func (a Account) DebitNormal() bool {
+	return a.Type == AccountAsset || a.Type == AccountExpense
+}
+
+var Accounts = []Account{
+	{Code: AccountProviderClearing, Name: "Provider clearing", Type: AccountAsset},
+	{Code: AccountCustomerWallet, Name: "Customer wallet", Type: AccountLiability},
+	{Code: AccountRevenueSales, Name: "Revenue", Type: AccountRevenue},
+	{Code: AccountRefundsPayable, Name: "Refunds payable", Type: AccountLiability},
+	{Code: AccountTaxPayable, Name: "Tax payable", Type: AccountLiability},
+	{Code: AccountProviderFees, Name: "Provider fees", Type: AccountExpense},
+}
+
+func GetAccount(code string) (Account, bool) {
+	for _, account := range Accounts {
+		if account.Code == code {
+			return account, true
+		}
+	}
+
+	return Account{}, false
+}
+
+// JournalEntry is a balanced set of ledger lines caused by one money
+// movement. (Kind, Reference) is unique so a movement is only posted once.
+type JournalEntry struct {
+	ID            string        `json:"id" gorm:"primaryKey unique"`
+	Kind          EntryKind     `json:"kind" gorm:"not null;uniqueIndex:idx_journal_kind_reference"`
+	Reference     string        `json:"reference" gorm:"not null;uniqueIndex:idx_journal_kind_reference"`
+	TransactionID string        `json:"transactionID" gorm:"index"`
+	OrderID       string        `json:"orderID" gorm:"index"`
+	Description   string        `json:"description"`
+	OccurredAt    time.Time     `json:"occurredAt" gorm:"not null;index"`
+	Lines         []JournalLine `json:"lines" gorm:"foreignKey:EntryID"`
+	CreatedAt     time.Time     `json:"createdAt"`
+}
+
+// JournalLine moves an amount into (debit) or out of (credit) one account.
+// UserID is set on customer wallet lines so wallets can be read per user.
+type JournalLine struct {
+	ID          int64           `json:"id" gorm:"primaryKey"`
+	EntryID     string          `json:"entryID" gorm:"not null;index"`
+	AccountCode string          `json:"accountCode" gorm:"not null;index"`
+	UserID      int64           `json:"userID" gorm:"index"`
+	Debit       decimal.Decimal `json:"debit" gorm:"type:numeric;not null"`
+	Credit      decimal.Decimal `json:"credit" gorm:"type:numeric;not null"`
+	OccurredAt  time.Time       `json:"occurredAt" gorm:"not null;index"`
+}
+
+// Balanced reports whether the entry has lines and its debits equal its
+// credits.
+func (e *JournalEntry) Balanced() bool {
+	if len(e.Lines) == 0 {
+		return false
+	}
+
+	debit, credit := decimal.Zero, decimal.Zero
+	for _, line := range e.Lines {
+		debit = debit.Add(line.Debit)
+		credit = credit.Add(line.Credit)
+	}
+
+	return debit.Equal(credit)
+}
+
+// AccountTotal is the sum of the lines of one account.
+type AccountTotal struct {
+	AccountCode string          `json:"accountCode"`
+	Debit       decimal.Decimal `json:"debit"`
+	Credit      decimal.Decimal `json:"credit"`
+}
@@ -0,0 +1,20 @@
+package ledgerRequest
+
+import "time"
+
+type ListEntry struct {
+	Account       string    `json:"account,omitempty" form:"account"`
+	Kind          string    `json:"kind,omitempty" form:"kind"`
+	TransactionID string    `json:"transactionID,omitempty" form:"transaction_id"`
+	OrderID       string    `json:"orderID,omitempty" form:"order_id"`
+	From          time.Time `json:"-" form:"from" time_format:"2006-01-02"`
+	To            time.Time `json:"-" form:"to" time_format:"2006-01-02"`
+	Page          int64     `json:"-" form:"page"`
+	Limit         int64     `json:"-" form:"limit"`
+}
+
+type Balance struct {
+	UserID int64     `json:"-" form:"user_id"`
+	From   time.Time `json:"-" form:"from" time_format:"2006-01-02"`
+	To     time.Time `json:"-" form:"to" time_format:"2006-01-02"`
+}
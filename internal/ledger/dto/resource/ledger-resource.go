@@ -0,0 +1,40 @@
+package ledgerResource
+
+import (
+	"time"
+	"washit-api/pkg/paging"
+
+	"github.com/shopspring/decimal"
+)
+
+type AccountBalance struct {
+	Code    string          `json:"code"`
+	Name    string          `json:"name"`
+	Type    string          `json:"type"`
+	Debit   decimal.Decimal `json:"debit"`
+	Credit  decimal.Decimal `json:"credit"`
+	Balance decimal.Decimal `json:"balance"`
+}
+
+type ListEntry struct {
+	Entries    []*Entry           `json:"entries,omitempty"`
+	Pagination *paging.Pagination `json:"pagination,omitempty"`
+}
+
+type Entry struct {
+	ID            string    `json:"id"`
+	Kind          string    `json:"kind"`
+	Reference     string    `json:"reference"`
+	TransactionID string    `json:"transactionID"`
+	OrderID       string    `json:"orderID"`
+	Description   string    `json:"description"`
+	OccurredAt    time.Time `json:"occurredAt"`
+	Lines         []Line    `json:"lines"`
+}
+
+type Line struct {
+	AccountCode string          `json:"accountCode"`
+	UserID      int64           `json:"userID,omitempty"`
+	Debit       decimal.Decimal `json:"debit"`
+	Credit      decimal.Decimal `json:"credit"`
+}
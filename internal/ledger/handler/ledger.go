@@ -0,0 +1,91 @@
+package ledger
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	ledgerRequest "washit-api/internal/ledger/dto/request"
+	ledgerResource "washit-api/internal/ledger/dto/resource"
+	ledgerService "washit-api/internal/ledger/service"
+	"washit-api/pkg/redis"
+	"washit-api/pkg/response"
+	"washit-api/pkg/utils"
+)
+
+type LedgerHandler struct {
+	service ledgerService.ILedgerService
+	cache   redis.IRedis
+}
+
+func NewLedgerHandler(service ledgerService.ILedgerService, cache redis.IRedis) *LedgerHandler {
+	return &LedgerHandler{
+		service: service,
+		cache:   cache,
+	}
+}
+
+// GetAccountBalances retrieves the balance of every ledger account.
+//
+//	@Summary	Get ledger account balances
+//	@Tags		Ledger
+//	@Accept		json
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		_	query		ledgerRequest.Balance	false	"Filters"
+//	@Success	200	{object}	[]ledgerResource.AccountBalance
+//	@Router		/ledger/accounts [get]
+func (h *LedgerHandler) GetAccountBalances(c *gin.Context) {
+	var req ledgerRequest.Balance
+	var res []ledgerResource.AccountBalance
+
+	if err := c.ShouldBindQuery(&req); err != nil {
+		log.Println("Failed to parse query ", err)
+		response.Error(c, http.StatusBadRequest, "failed to parse query", err)
+		return
+	}
+
+	balances, err := h.service.GetAccountBalances(c, &req)
+	if err != nil {
+		log.Println("Failed to get account balances ", err)
+		response.Error(c, http.StatusInternalServerError, "failed to get account balances", err)
+		return
+	}
+
+	utils.CopyTo(&balances, &res)
+	response.Success(c, http.StatusOK, "account balances are collected successfully", &res, nil)
+}
+
+// GetEntries retrieves journal entries, optionally filtered by account,
+// transaction, order and date range.
+//
+//	@Summary	Get ledger journal entries
+//	@Tags		Ledger
+//	@Accept		json
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		_	query		ledgerRequest.ListEntry	false	"Filters"
+//	@Success	200	{object}	ledgerResource.ListEntry
+//	@Router		/ledger/entries [get]
+func (h *LedgerHandler) GetEntries(c *gin.Context) {
+	var req ledgerRequest.ListEntry
+	var res ledgerResource.ListEntry
+
+	if err := c.ShouldBindQuery(&req); err != nil {
+		log.Println("Failed to parse query ", err)
+		response.Error(c, http.StatusBadRequest, "failed to parse query", err)
+		return
+	}
+
+	entries, pagination, err := h.service.GetEntries(c, &req)
+	if err != nil {
+		log.Println("Failed to get journal entries ", err)
+		response.Error(c, http.StatusInternalServerError, "failed to get journal entries", err)
+		return
+	}
+
+	utils.CopyTo(&entries, &res.Entries)
+	res.Pagination = pagination
+	response.Success(c, http.StatusOK, "journal entries are collected successfully", &res, nil)
+}
@@ -0,0 +1,114 @@
+package ledgerRepository
+
+import (
+	"context"
+
+	ledgerModel "washit-api/internal/ledger/dto/model"
+	ledgerRequest "washit-api/internal/ledger/dto/request"
+	"washit-api/pkg/db/dbs"
+	"washit-api/pkg/paging"
+)
+
+type ILedgerRepository interface {
+	GetEntries(ctx context.Context, req *ledgerRequest.ListEntry) ([]*ledgerModel.JournalEntry, *paging.Pagination, error)
+	GetEntryByReference(ctx context.Context, kind ledgerModel.EntryKind, reference string) (*ledgerModel.JournalEntry, error)
+	CreateEntry(ctx context.Context, entry *ledgerModel.JournalEntry) error
+	GetAccountTotals(ctx context.Context, req *ledgerRequest.Balance) ([]*ledgerModel.AccountTotal, error)
+}
+
+type LedgerRepository struct {
+	db dbs.IDatabase
+}
+
+func NewLedgerRepository(db dbs.IDatabase) *LedgerRepository {
+	return &LedgerRepository{db: db}
+}
+
+func (r *LedgerRepository) GetEntries(ctx context.Context, req *ledgerRequest.ListEntry) ([]*ledgerModel.JournalEntry, *paging.Pagination, error) {
+	var query []dbs.Query
+	if req.Account != "" {
+		query = append(query, dbs.NewQuery("id IN (SELECT entry_id FROM journal_lines WHERE account_code = ?)", req.Account))
+	}
+	if req.Kind != "" {
+		query = append(query, dbs.NewQuery("kind = ?", req.Kind))
+	}
+	if req.TransactionID != "" {
+		query = append(query, dbs.NewQuery("transaction_id = ?", req.TransactionID))
+	}
+	if req.OrderID != "" {
+		query = append(query, dbs.NewQuery("order_id = ?", req.OrderID))
+	}
+	if !req.From.IsZero() {
+		query = append(query, dbs.NewQuery("occurred_at >= ?", req.From))
+	}
+	if !req.To.IsZero() {
+		query = append(query, dbs.NewQuery("occurred_at < ?", req.To.AddDate(0, 0, 1)))
+	}
+
+	var total int64
+	if err := r.db.Count(ctx, &ledgerModel.JournalEntry{}, &total, dbs.WithQuery(query...)); err != nil {
+		return nil, nil, err
+	}
+
+	pagination := paging.New(req.Page, req.Limit, total)
+
+	var entries []*ledgerModel.JournalEntry
+	if err := r.db.Find(
+		ctx,
+		&entries,
+		dbs.WithPreload([]string{"Lines"}),
+		dbs.WithQuery(query...),
+		dbs.WithLimit(int(pagination.Limit)),
+		dbs.WithOffset(int(pagination.Skip)),
+		dbs.WithOrder("occurred_at DESC, id"),
+	); err != nil {
+		return nil, nil, err
+	}
+
+	return entries, pagination, nil
+}
+
+func (r *LedgerRepository) GetEntryByReference(ctx context.Context, kind ledgerModel.EntryKind, reference string) (*ledgerModel.JournalEntry, error) {
+	var entry ledgerModel.JournalEntry
+	query := dbs.WithQuery(
+		dbs.NewQuery("kind = ?", kind),
+		dbs.NewQuery("reference = ?", reference),
+	)
+
+	if err := r.db.FindOne(ctx, &entry, query); err != nil {
+		return nil, err
+	}
+
+	return &entry, nil
+}
+
+// CreateEntry writes an entry and its lines in one database transaction.
+func (r *LedgerRepository) CreateEntry(ctx context.Context, entry *ledgerModel.JournalEntry) error {
+	return r.db.WithTransaction(func(tx dbs.IDatabase) error {
+		return tx.Create(ctx, entry)
+	})
+}
+
+func (r *LedgerRepository) GetAccountTotals(ctx context.Context, req *ledgerRequest.Balance) ([]*ledgerModel.AccountTotal, error) {
+	query := r.db.GetDB().WithContext(ctx).
+		Model(&ledgerModel.JournalLine{}).
+		Select("account_code, COALESCE(SUM(debit), 0) AS debit, COALESCE(SUM(credit), 0) AS credit").
+		Group("account_code")
+
+	if req.UserID != 0 {
+		query = query.Where("user_id = ?", req.UserID)
+	}
+	if !req.From.IsZero() {
+		query = query.Where("occurred_at >= ?", req.From)
+	}
+	if !req.To.IsZero() {
+		query = query.Where("occurred_at < ?", req.To.AddDate(0, 0, 1))
+	}
+
+	var totals []*ledgerModel.AccountTotal
+	if err := query.Scan(&totals).Error; err != nil {
+		return nil, err
+	}
+
+	return totals, nil
+}
@@ -0,0 +1,150 @@
+// Code generated by mockery v2.50.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	ledgerModel "washit-api/internal/ledger/dto/model"
+
+	ledgerRequest "washit-api/internal/ledger/dto/request"
+
+	mock "github.com/stretchr/testify/mock"
+
+	paging "washit-api/pkg/paging"
+)
+
+// ILedgerRepository is an autogenerated mock type for the ILedgerRepository type
+type ILedgerRepository struct {
+	mock.Mock
+}
+
+// CreateEntry provides a mock function with given fields: ctx, entry
+func (_m *ILedgerRepository) CreateEntry(ctx context.Context, entry *ledgerModel.JournalEntry) error {
+	ret := _m.Called(ctx, entry)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateEntry")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *ledgerModel.JournalEntry) error); ok {
+		r0 = rf(ctx, entry)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetAccountTotals provides a mock function with given fields: ctx, req
+func (_m *ILedgerRepository) GetAccountTotals(ctx context.Context, req *ledgerRequest.Balance) ([]*ledgerModel.AccountTotal, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAccountTotals")
+	}
+
+	var r0 []*ledgerModel.AccountTotal
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *ledgerRequest.Balance) ([]*ledgerModel.AccountTotal, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *ledgerRequest.Balance) []*ledgerModel.AccountTotal); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*ledgerModel.AccountTotal)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *ledgerRequest.Balance) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetEntries provides a mock function with given fields: ctx, req
+func (_m *ILedgerRepository) GetEntries(ctx context.Context, req *ledgerRequest.ListEntry) ([]*ledgerModel.JournalEntry, *paging.Pagination, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEntries")
+	}
+
+	var r0 []*ledgerModel.JournalEntry
+	var r1 *paging.Pagination
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *ledgerRequest.ListEntry) ([]*ledgerModel.JournalEntry, *paging.Pagination, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *ledgerRequest.ListEntry) []*ledgerModel.JournalEntry); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*ledgerModel.JournalEntry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *ledgerRequest.ListEntry) *paging.Pagination); ok {
+		r1 = rf(ctx, req)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*paging.Pagination)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, *ledgerRequest.ListEntry) error); ok {
+		r2 = rf(ctx, req)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetEntryByReference provides a mock function with given fields: ctx, kind, reference
+func (_m *ILedgerRepository) GetEntryByReference(ctx context.Context, kind ledgerModel.EntryKind, reference string) (*ledgerModel.JournalEntry, error) {
+	ret := _m.Called(ctx, kind, reference)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEntryByReference")
+	}
+
+	var r0 *ledgerModel.JournalEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, ledgerModel.EntryKind, string) (*ledgerModel.JournalEntry, error)); ok {
+		return rf(ctx, kind, reference)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, ledgerModel.EntryKind, string) *ledgerModel.JournalEntry); ok {
+		r0 = rf(ctx, kind, reference)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ledgerModel.JournalEntry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, ledgerModel.EntryKind, string) error); ok {
+		r1 = rf(ctx, kind, reference)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewILedgerRepository creates a new instance of ILedgerRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewILedgerRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ILedgerRepository {
+	mock := &ILedgerRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,28 @@
+package ledgerRoutes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator"
+	"github.com/shopspring/decimal"
+
+	ledger "washit-api/internal/ledger/handler"
+	ledgerRepository "washit-api/internal/ledger/repository"
+	ledgerService "washit-api/internal/ledger/service"
+	"washit-api/pkg/configs"
+	"washit-api/pkg/db/dbs"
+	"washit-api/pkg/middleware"
+	"washit-api/pkg/rbac"
+	"washit-api/pkg/redis"
+)
+
+func Main(r *gin.RouterGroup, db dbs.IDatabase, cache redis.IRedis, validator *validator.Validate) {
+	repository := ledgerRepository.NewLedgerRepository(db)
+	service := ledgerService.NewLedgerService(repository, decimal.NewFromFloat(configs.Envs.TaxRate))
+	handler := ledger.NewLedgerHandler(service, cache)
+
+	ledgerMiddleware := middleware.JWTPermission(cache, rbac.LedgerRead)
+
+	// Staff Authority
+	r.GET("/ledger/accounts", ledgerMiddleware, handler.GetAccountBalances)
+	r.GET("/ledger/entries", ledgerMiddleware, handler.GetEntries)
+}
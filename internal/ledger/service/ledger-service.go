@@ -0,0 +1,303 @@
+package ledgerService
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	ledgerModel "washit-api/internal/ledger/dto/model"
+	ledgerRequest "washit-api/internal/ledger/dto/request"
+	ledgerRepository "washit-api/internal/ledger/repository"
+	transactionModel "washit-api/internal/transaction/dto/model"
+	generate "washit-api/pkg/generator"
+	"washit-api/pkg/paging"
+
+	"github.com/shopspring/decimal"
+)
+
+var ErrUnbalancedEntry = errors.New("journal entry is not balanced")
+
+type ILedgerService interface {
+	GetAccountBalances(c context.Context, req *ledgerRequest.Balance) ([]*AccountBalance, error)
+	GetEntries(c context.Context, req *ledgerRequest.ListEntry) ([]*ledgerModel.JournalEntry, *paging.Pagination, error)
+	RecordPayment(c context.Context, transaction *transactionModel.Transaction) error
+	PaymentEntries(c context.Context, transaction *transactionModel.Transaction) ([]*ledgerModel.JournalEntry, error)
+	RecordRefund(c context.Context, refund *transactionModel.Refund) error
+	RecordFee(c context.Context, transaction *transactionModel.Transaction, reference string, fee decimal.Decimal) error
+	FeeEntries(c context.Context, transaction *transactionModel.Transaction, reference string, fee decimal.Decimal) ([]*ledgerModel.JournalEntry, error)
+}
+
+type AccountBalance struct {
+	ledgerModel.Account
+	Debit   decimal.Decimal
+	Credit  decimal.Decimal
+	Balance decimal.Decimal
+}
+
+type LedgerService struct {
+	repository ledgerRepository.ILedgerRepository
+	taxRate    decimal.Decimal
+}
+
+// NewLedgerService creates the ledger. taxRate is the tax percentage that
+// is included in every order price.
+func NewLedgerService(repository ledgerRepository.ILedgerRepository, taxRate decimal.Decimal) *LedgerService {
+	return &LedgerService{
+		repository: repository,
+		taxRate:    taxRate,
+	}
+}
+
+// GetAccountBalances returns every account of the chart with its totals.
+// Balances are positive on the account's normal side.
+func (s *LedgerService) GetAccountBalances(c context.Context, req *ledgerRequest.Balance) ([]*AccountBalance, error) {
+	totals, err := s.repository.GetAccountTotals(c, req)
+	if err != nil {
+		log.Printf("Failed to get account totals: %v", err)
+		return nil, fmt.Errorf("failed to get account balances: %w", err)
+	}
+
+	byCode := make(map[string]*ledgerModel.AccountTotal, len(totals))
+	for _, total := range totals {
+		byCode[total.AccountCode] = total
+	}
+
+	balances := make([]*AccountBalance, 0, len(ledgerModel.Accounts))
+	for _, account := range ledgerModel.Accounts {
+		balance := &AccountBalance{Account: account}
+		if total, ok := byCode[account.Code]; ok {
+			balance.Debit = total.Debit
+			balance.Credit = total.Credit
+		}
+
+		balance.Balance = balance.Credit.Sub(balance.Debit)
+		if account.DebitNormal() {
+			balance.Balance = balance.Balance.Neg()
+		}
+
+		balances = append(balances, balance)
+	}
+
+	return balances, nil
+}
+
+func (s *LedgerService) GetEntries(c context.Context, req *ledgerRequest.ListEntry) ([]*ledgerModel.JournalEntry, *paging.Pagination, error) {
+	entries, pagination, err := s.repository.GetEntries(c, req)
+	if err != nil {
+		log.Printf("Failed to get journal entries: %v", err)
+		return nil, nil, fmt.Errorf("failed to get journal entries: %w", err)
+	}
+
+	return entries, pagination, nil
+}
+
+// RecordPayment posts a paid transaction: the money lands in provider
+// clearing on the customer's behalf and is then recognised as revenue and
+// the tax included in it.
+func (s *LedgerService) RecordPayment(c context.Context, transaction *transactionModel.Transaction) error {
+	entries, err := s.PaymentEntries(c, transaction)
+	if err != nil {
+		return err
+	}
+
+	return s.create(c, entries)
+}
+
+// PaymentEntries prepares the entries RecordPayment posts without writing
+// them, so they can be saved in the same database transaction as the
+// payment. Entries that were already posted are left out.
+func (s *LedgerService) PaymentEntries(c context.Context, transaction *transactionModel.Transaction) ([]*ledgerModel.JournalEntry, error) {
+	if transaction.Amount == nil {
+		return nil, fmt.Errorf("transaction %s has no amount", transaction.ID)
+	}
+
+	amount := *transaction.Amount
+	occurredAt := time.Now()
+	if transaction.PaidAt != nil {
+		occurredAt = *transaction.PaidAt
+	}
+
+	payment := &ledgerModel.JournalEntry{
+		Kind:          ledgerModel.EntryPayment,
+		Reference:     transaction.ID,
+		TransactionID: transaction.ID,
+		OrderID:       transaction.OrderID,
+		Description:   fmt.Sprintf("Payment %s for order %s", transaction.ID, transaction.OrderID),
+		OccurredAt:    occurredAt,
+		Lines: []ledgerModel.JournalLine{
+			debit(ledgerModel.AccountProviderClearing, 0, amount),
+			credit(ledgerModel.AccountCustomerWallet, transaction.UserID, amount),
+		},
+	}
+
+	tax := s.includedTax(amount)
+	sale := &ledgerModel.JournalEntry{
+		Kind:          ledgerModel.EntrySale,
+		Reference:     transaction.ID,
+		TransactionID: transaction.ID,
+		OrderID:       transaction.OrderID,
+		Description:   fmt.Sprintf("Sale of order %s", transaction.OrderID),
+		OccurredAt:    occurredAt,
+		Lines: []ledgerModel.JournalLine{
+			debit(ledgerModel.AccountCustomerWallet, transaction.UserID, amount),
+			credit(ledgerModel.AccountRevenueSales, 0, amount.Sub(tax)),
+			credit(ledgerModel.AccountTaxPayable, 0, tax),
+		},
+	}
+
+	return s.prepare(c, payment, sale)
+}
+
+// RecordRefund posts a completed refund: revenue and tax are reversed into
+// refunds payable, which the provider then pays out of clearing.
+func (s *LedgerService) RecordRefund(c context.Context, refund *transactionModel.Refund) error {
+	if refund.Amount == nil {
+		return fmt.Errorf("refund %s has no amount", refund.ID)
+	}
+
+	amount := *refund.Amount
+	occurredAt := time.Now()
+	if refund.ProcessedAt != nil {
+		occurredAt = *refund.ProcessedAt
+	}
+
+	tax := s.includedTax(amount)
+	reversal := &ledgerModel.JournalEntry{
+		Kind:          ledgerModel.EntryRefund,
+		Reference:     refund.ID,
+		TransactionID: refund.TransactionID,
+		OrderID:       refund.OrderID,
+		Description:   fmt.Sprintf("Refund %s for order %s: %s", refund.ID, refund.OrderID, refund.Reason),
+		OccurredAt:    occurredAt,
+		Lines: []ledgerModel.JournalLine{
+			debit(ledgerModel.AccountRevenueSales, 0, amount.Sub(tax)),
+			debit(ledgerModel.AccountTaxPayable, 0, tax),
+			credit(ledgerModel.AccountRefundsPayable, 0, amount),
+		},
+	}
+
+	settlement := &ledgerModel.JournalEntry{
+		Kind:          ledgerModel.EntryRefundSettlement,
+		Reference:     refund.ID,
+		TransactionID: refund.TransactionID,
+		OrderID:       refund.OrderID,
+		Description:   fmt.Sprintf("Refund %s paid out by provider", refund.ID),
+		OccurredAt:    occurredAt,
+		Lines: []ledgerModel.JournalLine{
+			debit(ledgerModel.AccountRefundsPayable, 0, amount),
+			credit(ledgerModel.AccountProviderClearing, 0, amount),
+		},
+	}
+
+	return s.post(c, reversal, settlement)
+}
+
+// RecordFee posts a fee the provider withheld from a payment. reference
+// identifies the fee, e.g. the webhook event that reported it.
+func (s *LedgerService) RecordFee(c context.Context, transaction *transactionModel.Transaction, reference string, fee decimal.Decimal) error {
+	entries, err := s.FeeEntries(c, transaction, reference, fee)
+	if err != nil {
+		return err
+	}
+
+	return s.create(c, entries)
+}
+
+// FeeEntries prepares the entry RecordFee posts without writing it, like
+// PaymentEntries.
+func (s *LedgerService) FeeEntries(c context.Context, transaction *transactionModel.Transaction, reference string, fee decimal.Decimal) ([]*ledgerModel.JournalEntry, error) {
+	entry := &ledgerModel.JournalEntry{
+		Kind:          ledgerModel.EntryFee,
+		Reference:     reference,
+		TransactionID: transaction.ID,
+		OrderID:       transaction.OrderID,
+		Description:   fmt.Sprintf("%s fee for payment %s", transaction.Provider, transaction.ID),
+		OccurredAt:    time.Now(),
+		Lines: []ledgerModel.JournalLine{
+			debit(ledgerModel.AccountProviderFees, 0, fee),
+			credit(ledgerModel.AccountProviderClearing, 0, fee),
+		},
+	}
+
+	return s.prepare(c, entry)
+}
+
+// post writes entries that were not posted before. Zero lines are dropped
+// and entries that do not balance are refused.
+func (s *LedgerService) post(c context.Context, entries ...*ledgerModel.JournalEntry) error {
+	prepared, err := s.prepare(c, entries...)
+	if err != nil {
+		return err
+	}
+
+	return s.create(c, prepared)
+}
+
+// prepare checks entries and gives them IDs, leaving out the ones already
+// posted under the same kind and reference.
+func (s *LedgerService) prepare(c context.Context, entries ...*ledgerModel.JournalEntry) ([]*ledgerModel.JournalEntry, error) {
+	prepared := make([]*ledgerModel.JournalEntry, 0, len(entries))
+	for _, entry := range entries {
+		if _, err := s.repository.GetEntryByReference(c, entry.Kind, entry.Reference); err == nil {
+			continue
+		}
+
+		lines := entry.Lines[:0]
+		for _, line := range entry.Lines {
+			if line.Debit.IsZero() && line.Credit.IsZero() {
+				continue
+			}
+
+			line.OccurredAt = entry.OccurredAt
+			lines = append(lines, line)
+		}
+		entry.Lines = lines
+
+		if !entry.Balanced() {
+			log.Printf("Refusing unbalanced %s entry for %s", entry.Kind, entry.Reference)
+			return nil, fmt.Errorf("%w: %s %s", ErrUnbalancedEntry, entry.Kind, entry.Reference)
+		}
+
+		entryID, err := generate.AlphaNumericID("JNL")
+		if err != nil {
+			log.Printf("Failed to generate Journal ID: %v", err)
+			return nil, fmt.Errorf("failed to generate journal ID: %w", err)
+		}
+		entry.ID = entryID
+
+		prepared = append(prepared, entry)
+	}
+
+	return prepared, nil
+}
+
+// create writes prepared entries.
+func (s *LedgerService) create(c context.Context, entries []*ledgerModel.JournalEntry) error {
+	for _, entry := range entries {
+		if err := s.repository.CreateEntry(c, entry); err != nil {
+			log.Printf("Failed to post %s entry for %s: %v", entry.Kind, entry.Reference, err)
+			return fmt.Errorf("failed to post journal entry: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// includedTax returns the tax contained in a tax-inclusive amount.
+func (s *LedgerService) includedTax(amount decimal.Decimal) decimal.Decimal {
+	if !s.taxRate.IsPositive() {
+		return decimal.Zero
+	}
+
+	return amount.Mul(s.taxRate).Div(s.taxRate.Add(decimal.NewFromInt(100))).Round(2)
+}
+
+func debit(account string, userID int64, amount decimal.Decimal) ledgerModel.JournalLine {
+	return ledgerModel.JournalLine{AccountCode: account, UserID: userID, Debit: amount, Credit: decimal.Zero}
+}
+
+func credit(account string, userID int64, amount decimal.Decimal) ledgerModel.JournalLine {
+	return ledgerModel.JournalLine{AccountCode: account, UserID: userID, Debit: decimal.Zero, Credit: amount}
+}
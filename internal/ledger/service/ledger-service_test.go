@@ -0,0 +1,146 @@
+package ledgerService
+
+import (
+	"context"
+	"errors"
+	"testing"
+	ledgerModel "washit-api/internal/ledger/dto/model"
+	ledgerRequest "washit-api/internal/ledger/dto/request"
+	mocks "washit-api/internal/ledger/repository/mock"
+	transactionModel "washit-api/internal/transaction/dto/model"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type LedgerServiceTestSuite struct {
+	suite.Suite
+	mockRepo *mocks.ILedgerRepository
+	service  ILedgerService
+}
+
+func (suite *LedgerServiceTestSuite) SetupTest() {
+	suite.mockRepo = new(mocks.ILedgerRepository)
+	suite.service = NewLedgerService(suite.mockRepo, decimal.NewFromInt(11))
+}
+
+func TestLedgerServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(LedgerServiceTestSuite))
+}
+
+func lineFor(entry *ledgerModel.JournalEntry, account string) *ledgerModel.JournalLine {
+	for i := range entry.Lines {
+		if entry.Lines[i].AccountCode == account {
+			return &entry.Lines[i]
+		}
+	}
+
+	return nil
+}
+
+// RecordPayment
+// =================================================================
+
+func (suite *LedgerServiceTestSuite) TestRecordPaymentPostsBalancedEntries() {
+	amount := decimal.NewFromInt(111000)
+	transaction := &transactionModel.Transaction{ID: "TRX-1", OrderID: "ORD-1", UserID: 7, Amount: &amount}
+
+	var posted []*ledgerModel.JournalEntry
+	suite.mockRepo.On("GetEntryByReference", mock.Anything, mock.Anything, "TRX-1").
+		Return(nil, errors.New("record not found")).Times(2)
+	suite.mockRepo.On("CreateEntry", mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			posted = append(posted, args.Get(1).(*ledgerModel.JournalEntry))
+		}).
+		Return(nil).Times(2)
+
+	suite.Nil(suite.service.RecordPayment(context.Background(), transaction))
+	suite.Len(posted, 2)
+
+	for _, entry := range posted {
+		suite.True(entry.Balanced())
+	}
+
+	sale := posted[1]
+	suite.Equal(ledgerModel.EntrySale, sale.Kind)
+	suite.True(decimal.NewFromInt(100000).Equal(lineFor(sale, ledgerModel.AccountRevenueSales).Credit))
+	suite.True(decimal.NewFromInt(11000).Equal(lineFor(sale, ledgerModel.AccountTaxPayable).Credit))
+	suite.Equal(int64(7), lineFor(sale, ledgerModel.AccountCustomerWallet).UserID)
+}
+
+func (suite *LedgerServiceTestSuite) TestRecordPaymentIsIdempotent() {
+	amount := decimal.NewFromInt(1000)
+	transaction := &transactionModel.Transaction{ID: "TRX-1", Amount: &amount}
+
+	suite.mockRepo.On("GetEntryByReference", mock.Anything, mock.Anything, "TRX-1").
+		Return(&ledgerModel.JournalEntry{ID: "JNL-1"}, nil).Times(2)
+
+	suite.Nil(suite.service.RecordPayment(context.Background(), transaction))
+	suite.mockRepo.AssertNotCalled(suite.T(), "CreateEntry", mock.Anything, mock.Anything)
+}
+
+func (suite *LedgerServiceTestSuite) TestPaymentEntriesSkipsPosted() {
+	amount := decimal.NewFromInt(1000)
+	transaction := &transactionModel.Transaction{ID: "TRX-1", Amount: &amount}
+
+	suite.mockRepo.On("GetEntryByReference", mock.Anything, ledgerModel.EntryPayment, "TRX-1").
+		Return(&ledgerModel.JournalEntry{ID: "JNL-1"}, nil).Times(1)
+	suite.mockRepo.On("GetEntryByReference", mock.Anything, ledgerModel.EntrySale, "TRX-1").
+		Return(nil, errors.New("record not found")).Times(1)
+
+	entries, err := suite.service.PaymentEntries(context.Background(), transaction)
+	suite.Nil(err)
+	suite.Len(entries, 1)
+	suite.Equal(ledgerModel.EntrySale, entries[0].Kind)
+	suite.NotEmpty(entries[0].ID)
+	suite.mockRepo.AssertNotCalled(suite.T(), "CreateEntry", mock.Anything, mock.Anything)
+}
+
+// RecordRefund
+// =================================================================
+
+func (suite *LedgerServiceTestSuite) TestRecordRefundReversesRevenue() {
+	amount := decimal.NewFromInt(22200)
+	refund := &transactionModel.Refund{ID: "RFD-1", TransactionID: "TRX-1", Amount: &amount}
+
+	var posted []*ledgerModel.JournalEntry
+	suite.mockRepo.On("GetEntryByReference", mock.Anything, mock.Anything, "RFD-1").
+		Return(nil, errors.New("record not found")).Times(2)
+	suite.mockRepo.On("CreateEntry", mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			posted = append(posted, args.Get(1).(*ledgerModel.JournalEntry))
+		}).
+		Return(nil).Times(2)
+
+	suite.Nil(suite.service.RecordRefund(context.Background(), refund))
+	suite.True(posted[0].Balanced())
+	suite.True(decimal.NewFromInt(20000).Equal(lineFor(posted[0], ledgerModel.AccountRevenueSales).Debit))
+	suite.True(amount.Equal(lineFor(posted[1], ledgerModel.AccountProviderClearing).Credit))
+}
+
+// GetAccountBalances
+// =================================================================
+
+func (suite *LedgerServiceTestSuite) TestGetAccountBalancesUsesNormalSide() {
+	suite.mockRepo.On("GetAccountTotals", mock.Anything, mock.Anything).
+		Return([]*ledgerModel.AccountTotal{
+			{AccountCode: ledgerModel.AccountProviderClearing, Debit: decimal.NewFromInt(100), Credit: decimal.NewFromInt(30)},
+			{AccountCode: ledgerModel.AccountRevenueSales, Debit: decimal.NewFromInt(20), Credit: decimal.NewFromInt(90)},
+		}, nil).Times(1)
+
+	balances, err := suite.service.GetAccountBalances(context.Background(), &ledgerRequest.Balance{})
+	suite.Nil(err)
+	suite.Len(balances, len(ledgerModel.Accounts))
+
+	for _, balance := range balances {
+		switch balance.Code {
+		case ledgerModel.AccountProviderClearing:
+			suite.True(decimal.NewFromInt(70).Equal(balance.Balance))
+		case ledgerModel.AccountRevenueSales:
+			suite.True(decimal.NewFromInt(70).Equal(balance.Balance))
+		default:
+			suite.True(balance.Balance.IsZero())
+		}
+	}
+}
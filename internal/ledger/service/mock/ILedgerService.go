@@ -0,0 +1,222 @@
+// Code generated by mockery v2.50.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	ledgerModel "washit-api/internal/ledger/dto/model"
+
+	decimal "github.com/shopspring/decimal"
+
+	ledgerRequest "washit-api/internal/ledger/dto/request"
+
+	ledgerService "washit-api/internal/ledger/service"
+
+	mock "github.com/stretchr/testify/mock"
+
+	paging "washit-api/pkg/paging"
+
+	transactionModel "washit-api/internal/transaction/dto/model"
+)
+
+// ILedgerService is an autogenerated mock type for the ILedgerService type
+type ILedgerService struct {
+	mock.Mock
+}
+
+// FeeEntries provides a mock function with given fields: c, transaction, reference, fee
+func (_m *ILedgerService) FeeEntries(c context.Context, transaction *transactionModel.Transaction, reference string, fee decimal.Decimal) ([]*ledgerModel.JournalEntry, error) {
+	ret := _m.Called(c, transaction, reference, fee)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FeeEntries")
+	}
+
+	var r0 []*ledgerModel.JournalEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *transactionModel.Transaction, string, decimal.Decimal) ([]*ledgerModel.JournalEntry, error)); ok {
+		return rf(c, transaction, reference, fee)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *transactionModel.Transaction, string, decimal.Decimal) []*ledgerModel.JournalEntry); ok {
+		r0 = rf(c, transaction, reference, fee)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*ledgerModel.JournalEntry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *transactionModel.Transaction, string, decimal.Decimal) error); ok {
+		r1 = rf(c, transaction, reference, fee)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAccountBalances provides a mock function with given fields: c, req
+func (_m *ILedgerService) GetAccountBalances(c context.Context, req *ledgerRequest.Balance) ([]*ledgerService.AccountBalance, error) {
+	ret := _m.Called(c, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAccountBalances")
+	}
+
+	var r0 []*ledgerService.AccountBalance
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *ledgerRequest.Balance) ([]*ledgerService.AccountBalance, error)); ok {
+		return rf(c, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *ledgerRequest.Balance) []*ledgerService.AccountBalance); ok {
+		r0 = rf(c, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*ledgerService.AccountBalance)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *ledgerRequest.Balance) error); ok {
+		r1 = rf(c, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetEntries provides a mock function with given fields: c, req
+func (_m *ILedgerService) GetEntries(c context.Context, req *ledgerRequest.ListEntry) ([]*ledgerModel.JournalEntry, *paging.Pagination, error) {
+	ret := _m.Called(c, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEntries")
+	}
+
+	var r0 []*ledgerModel.JournalEntry
+	var r1 *paging.Pagination
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *ledgerRequest.ListEntry) ([]*ledgerModel.JournalEntry, *paging.Pagination, error)); ok {
+		return rf(c, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *ledgerRequest.ListEntry) []*ledgerModel.JournalEntry); ok {
+		r0 = rf(c, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*ledgerModel.JournalEntry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *ledgerRequest.ListEntry) *paging.Pagination); ok {
+		r1 = rf(c, req)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*paging.Pagination)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, *ledgerRequest.ListEntry) error); ok {
+		r2 = rf(c, req)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// PaymentEntries provides a mock function with given fields: c, transaction
+func (_m *ILedgerService) PaymentEntries(c context.Context, transaction *transactionModel.Transaction) ([]*ledgerModel.JournalEntry, error) {
+	ret := _m.Called(c, transaction)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PaymentEntries")
+	}
+
+	var r0 []*ledgerModel.JournalEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *transactionModel.Transaction) ([]*ledgerModel.JournalEntry, error)); ok {
+		return rf(c, transaction)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *transactionModel.Transaction) []*ledgerModel.JournalEntry); ok {
+		r0 = rf(c, transaction)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*ledgerModel.JournalEntry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *transactionModel.Transaction) error); ok {
+		r1 = rf(c, transaction)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RecordFee provides a mock function with given fields: c, transaction, reference, fee
+func (_m *ILedgerService) RecordFee(c context.Context, transaction *transactionModel.Transaction, reference string, fee decimal.Decimal) error {
+	ret := _m.Called(c, transaction, reference, fee)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordFee")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *transactionModel.Transaction, string, decimal.Decimal) error); ok {
+		r0 = rf(c, transaction, reference, fee)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RecordPayment provides a mock function with given fields: c, transaction
+func (_m *ILedgerService) RecordPayment(c context.Context, transaction *transactionModel.Transaction) error {
+	ret := _m.Called(c, transaction)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordPayment")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *transactionModel.Transaction) error); ok {
+		r0 = rf(c, transaction)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RecordRefund provides a mock function with given fields: c, refund
+func (_m *ILedgerService) RecordRefund(c context.Context, refund *transactionModel.Refund) error {
+	ret := _m.Called(c, refund)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordRefund")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *transactionModel.Refund) error); ok {
+		r0 = rf(c, refund)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewILedgerService creates a new instance of ILedgerService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewILedgerService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ILedgerService {
+	mock := &ILedgerService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
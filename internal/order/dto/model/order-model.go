@@ -8,20 +8,80 @@ import (
 	"github.com/shopspring/decimal"
 )
 
+type Status string
+
+const (
+	StatusCreated        Status = "created"
+	StatusAccepted       Status = "accepted"
+	StatusPickedUp       Status = "picked_up"
+	StatusWashing        Status = "washing"
+	StatusReady          Status = "ready"
+	StatusOutForDelivery Status = "out_for_delivery"
+	StatusDelivered      Status = "delivered"
+	StatusCompleted      Status = "completed"
+	StatusRejected       Status = "rejected"
+	StatusCancelled      Status = "cancelled"
+)
+
+// Tag codes are printed on the bag of an order and on each garment so they
+// can be scanned at the outlet.
+const (
+	TagPrefixOrder = "BAG"
+	TagPrefixItem  = "GMT"
+)
+
 type Order struct {
 	ID            string           `json:"id" gorm:"primaryKey unique"`
 	UserID        int64            `json:"userID" gorm:"not null;index"`
 	TransactionID string           `json:"transactionID"`
-	AddressID     int              `json:"addressID"`
-	Status        string           `json:"status" gorm:"default:created"`
+	AddressID     int64            `json:"addressID"`
+	Status        Status           `json:"status" gorm:"default:created"`
 	Note          string           `json:"note"`
 	ServiceType   string           `json:"serviceType"`
 	OrderType     string           `json:"orderType" gorm:"default:regular"`
 	Weight        *float64         `json:"weight"`
 	Price         *decimal.Decimal `json:"price" gorm:"type:numeric"`
 	CollectDate   time.Time        `json:"collectDate"`
+	PickupSlotID  *int64           `json:"pickupSlotID" gorm:"index"`
+	OutletID      *int64           `json:"outletID" gorm:"index"`
+	DistanceKm    *float64         `json:"distanceKm"`
+	DeliveryFee   *decimal.Decimal `json:"deliveryFee" gorm:"type:numeric"`
 	EstimateDate  time.Time        `json:"estimateDate"`
+	TagCode       *string          `json:"tagCode" gorm:"uniqueIndex"`
 	CreatedAt     time.Time        `json:"createdAt"`
 	UpdatedAt     time.Time        `json:"updatedAt"`
 	User          userModel.User   `json:"user" gorm:"foreignKey:UserID;references:ID"`
+	Items         []OrderItem      `json:"items" gorm:"foreignKey:OrderID;constraint:OnDelete:CASCADE"`
+}
+
+// OrderItem is one garment line recorded at intake. A zero UnitPrice charges
+// the item at the per-item rate of the order's price list. Items are removed
+// with their order; the history record keeps a copy.
+type OrderItem struct {
+	ID               int64           `json:"id" gorm:"primaryKey"`
+	OrderID          string          `json:"orderID" gorm:"not null;index"`
+	GarmentType      string          `json:"garmentType" gorm:"not null"`
+	Quantity         int             `json:"quantity" gorm:"not null;default:1"`
+	Colour           string          `json:"colour"`
+	Brand            string          `json:"brand"`
+	CareInstructions string          `json:"careInstructions"`
+	UnitPrice        decimal.Decimal `json:"unitPrice" gorm:"type:numeric;default:0"`
+	DamageNotes      string          `json:"damageNotes"`
+	Photos           []string        `json:"photos" gorm:"serializer:json"`
+	TagCode          *string         `json:"tagCode" gorm:"uniqueIndex"`
+	CreatedAt        time.Time       `json:"createdAt"`
+	UpdatedAt        time.Time       `json:"updatedAt"`
+}
+
+// OrderStatusEvent is an audit record of a single status change. Events are
+// kept after the order is archived to history so the timeline stays readable.
+type OrderStatusEvent struct {
+	ID         int64     `json:"id" gorm:"primaryKey"`
+	OrderID    string    `json:"orderID" gorm:"not null;index"`
+	FromStatus Status    `json:"fromStatus"`
+	ToStatus   Status    `json:"toStatus" gorm:"not null"`
+	ActorID    int64     `json:"actorID"`
+	ActorRole  string    `json:"actorRole"`
+	Note       string    `json:"note"`
+	CreatedAt  time.Time `json:"createdAt"`
 }
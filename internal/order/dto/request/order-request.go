@@ -2,16 +2,61 @@ package orderRequest
 
 import (
 	"time"
+
+	"github.com/shopspring/decimal"
 )
 
 type Order struct {
-	AddressID   int       `json:"addressID" validate:"required"`
-	Note        string    `json:"note"`
-	ServiceType string    `json:"serviceType" validate:"required"`
-	OrderType   string    `json:"orderType" validate:"required"`
+	AddressID   int64  `json:"addressID" validate:"required"`
+	Note        string `json:"note"`
+	ServiceType string `json:"serviceType" validate:"required"`
+	OrderType   string `json:"orderType" validate:"required"`
+	// CollectDate may be any time within a pickup window; the order is booked
+	// at the window's start. Without pickup windows it is kept as given.
 	CollectDate time.Time `json:"collectDate" validate:"required"`
 }
 
+type OrderItem struct {
+	GarmentType      string          `json:"garmentType" validate:"required,max=50"`
+	Quantity         int             `json:"quantity" validate:"required,gt=0"`
+	Colour           string          `json:"colour" validate:"max=50"`
+	Brand            string          `json:"brand" validate:"max=50"`
+	CareInstructions string          `json:"careInstructions" validate:"max=500"`
+	UnitPrice        decimal.Decimal `json:"unitPrice"`
+	DamageNotes      string          `json:"damageNotes" validate:"max=500"`
+}
+
+// ItemPhoto carries a JPEG, PNG or WebP image of at most 2MB.
+type ItemPhoto struct {
+	Image []byte `json:"image" validate:"required,max=2097152"`
+}
+
 type Payment struct {
 	TransactionID string `json:"transactionID" validate:"required"`
 }
+
+type UpdateStatus struct {
+	Status string `json:"status" validate:"required"`
+	Note   string `json:"note"`
+}
+
+type Quote struct {
+	ServiceType string  `json:"serviceType" validate:"required"`
+	OrderType   string  `json:"orderType" validate:"required"`
+	Weight      float64 `json:"weight" validate:"gte=0"`
+	Items       int     `json:"items" validate:"gte=0"`
+	// OutletID prices the order with the overrides of that outlet, if any.
+	OutletID int64 `json:"outletID"`
+	// AddressID routes the quote to the outlet serving that saved address and
+	// adds its delivery fee. It takes precedence over OutletID.
+	AddressID int64 `json:"addressID"`
+}
+
+type Estimate struct {
+	ServiceType string    `json:"serviceType" validate:"required"`
+	OrderType   string    `json:"orderType" validate:"required"`
+	Weight      float64   `json:"weight" validate:"gte=0"`
+	CollectDate time.Time `json:"collectDate"`
+	// OutletID queues the order behind the backlog of that outlet only.
+	OutletID int64 `json:"outletID"`
+}
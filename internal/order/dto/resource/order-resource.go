@@ -11,7 +11,7 @@ type Order struct {
 	// UserID        int              `json:"userID" gorm:"not null;index"`
 	User          User             `json:"user" gorm:"foreignKey:UserID;references:ID"`
 	TransactionID string           `json:"transactionID"`
-	AddressID     int              `json:"addressID"`
+	AddressID     int64            `json:"addressID"`
 	Status        string           `json:"status"`
 	Note          string           `json:"note"`
 	ServiceType   string           `json:"serviceType"`
@@ -19,9 +19,36 @@ type Order struct {
 	Weight        *float64         `json:"weight"`
 	Price         *decimal.Decimal `json:"price" gorm:"type:numeric"`
 	CollectDate   time.Time        `json:"collectDate"`
+	PickupSlotID  *int64           `json:"pickupSlotID"`
+	OutletID      *int64           `json:"outletID"`
+	DistanceKm    *float64         `json:"distanceKm"`
+	DeliveryFee   *decimal.Decimal `json:"deliveryFee"`
 	EstimateDate  time.Time        `json:"estimateDate"`
+	TagCode       *string          `json:"tagCode"`
 	CreatedAt     time.Time        `json:"createdAt"`
 	UpdatedAt     time.Time        `json:"updatedAt"`
+	Items         []OrderItem      `json:"items"`
+}
+
+type OrderItem struct {
+	ID               int64           `json:"id"`
+	GarmentType      string          `json:"garmentType"`
+	Quantity         int             `json:"quantity"`
+	Colour           string          `json:"colour"`
+	Brand            string          `json:"brand"`
+	CareInstructions string          `json:"careInstructions"`
+	UnitPrice        decimal.Decimal `json:"unitPrice"`
+	DamageNotes      string          `json:"damageNotes"`
+	Photos           []string        `json:"photos"`
+	TagCode          *string         `json:"tagCode"`
+	UpdatedAt        time.Time       `json:"updatedAt"`
+}
+
+// Scan is the result of looking up a tag code. Item is set when the code is
+// on a garment rather than on the bag of the order.
+type Scan struct {
+	Order Order      `json:"order"`
+	Item  *OrderItem `json:"item,omitempty"`
 }
 
 type User struct {
@@ -33,3 +60,38 @@ type User struct {
 	Image     string `json:"image"`
 	// CreatedAt time.Time `json:"createdAt"`
 }
+
+type StatusEvent struct {
+	ID         int64     `json:"id"`
+	FromStatus string    `json:"fromStatus"`
+	ToStatus   string    `json:"toStatus"`
+	ActorID    int64     `json:"actorID"`
+	ActorRole  string    `json:"actorRole"`
+	Note       string    `json:"note"`
+	CreatedAt  time.Time `json:"createdAt"`
+}
+
+type Quote struct {
+	ServiceType    string          `json:"serviceType"`
+	OrderType      string          `json:"orderType"`
+	Weight         float64         `json:"weight"`
+	Items          int             `json:"items"`
+	ItemCharges    decimal.Decimal `json:"itemCharges"`
+	Base           decimal.Decimal `json:"base"`
+	Surcharge      decimal.Decimal `json:"surcharge"`
+	MinimumApplied bool            `json:"minimumApplied"`
+	DistanceKm     *float64        `json:"distanceKm,omitempty"`
+	DeliveryFee    decimal.Decimal `json:"deliveryFee"`
+	Total          decimal.Decimal `json:"total"`
+}
+
+type Estimate struct {
+	ServiceType     string    `json:"serviceType"`
+	OrderType       string    `json:"orderType"`
+	Weight          float64   `json:"weight"`
+	StartsAt        time.Time `json:"startsAt"`
+	TurnaroundHours int       `json:"turnaroundHours"`
+	LoadHours       int       `json:"loadHours"`
+	BacklogHours    int       `json:"backlogHours"`
+	EstimateDate    time.Time `json:"estimateDate"`
+}
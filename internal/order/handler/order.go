@@ -1,16 +1,26 @@
 package order
 
 import (
+	"errors"
+	"fmt"
 	"log"
 	"net/http"
 	"strconv"
 
 	"github.com/gin-gonic/gin"
 
+	calendarService "washit-api/internal/calendar/service"
 	orderRequest "washit-api/internal/order/dto/request"
 	orderResource "washit-api/internal/order/dto/resource"
 	orderService "washit-api/internal/order/service"
+	outletService "washit-api/internal/outlet/service"
+	pricingService "washit-api/internal/pricing/service"
+	slotService "washit-api/internal/slot/service"
+	userService "washit-api/internal/user/service"
 	"washit-api/pkg/configs"
+	"washit-api/pkg/label"
+	"washit-api/pkg/middleware"
+	"washit-api/pkg/rbac"
 	"washit-api/pkg/redis"
 	"washit-api/pkg/response"
 	"washit-api/pkg/utils"
@@ -32,14 +42,17 @@ var ordersCacheKey string = "/api/v1/orders"
 
 // CreateOrder handles the creation of a new order.
 //
-//	@Summary	Create a new order
-//	@Tags		Order
-//	@Accept		json
-//	@Produce	json
-//	@Security	ApiKeyAuth
-//	@Param		_	body		orderRequest.Order	true	"Order details"
-//	@Success	201	{object}	orderResource.Order
-//	@Router		/order [post]
+//	@Summary		Create a new order
+//	@Description	The collect date may be any time within a pickup window and the
+//	@Description	order is booked at the window's start. Without pickup windows the
+//	@Description	collect date is kept as given.
+//	@Tags			Order
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			_	body		orderRequest.Order	true	"Order details"
+//	@Success		201	{object}	orderResource.Order
+//	@Router			/order [post]
 func (h *OrderHandler) CreateOrder(c *gin.Context) {
 	var req orderRequest.Order
 	var res orderResource.Order
@@ -53,7 +66,11 @@ func (h *OrderHandler) CreateOrder(c *gin.Context) {
 	order, err := h.service.CreateOrder(c, c.GetString("userID"), &req)
 	if err != nil {
 		log.Println("Failed to create order ", err)
-		response.Error(c, http.StatusInternalServerError, "failed to create order", err)
+		code := scheduleStatusCode(err)
+		if errors.Is(err, userService.ErrEmailNotVerified) {
+			code = http.StatusForbidden
+		}
+		response.Error(c, code, "failed to create order", err)
 		return
 	}
 
@@ -63,6 +80,68 @@ func (h *OrderHandler) CreateOrder(c *gin.Context) {
 	_ = h.cache.Remove(ordersCacheKey)
 }
 
+// QuoteOrder prices an order before it is created.
+//
+//	@Summary	Get a price quote for an order
+//	@Tags		Order
+//	@Accept		json
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		_	body		orderRequest.Quote	true	"Quote details"
+//	@Success	200	{object}	orderResource.Quote
+//	@Router		/order/quote [post]
+func (h *OrderHandler) QuoteOrder(c *gin.Context) {
+	var req orderRequest.Quote
+	var res orderResource.Quote
+
+	if err := utils.ParseJson(c, &req); err != nil {
+		log.Println("Failed to parse request body ", err)
+		response.Error(c, http.StatusBadRequest, "failed to parse request body", err)
+		return
+	}
+
+	quote, err := h.service.QuoteOrder(c, c.GetString("userID"), &req)
+	if err != nil {
+		log.Println("Failed to quote order ", err)
+		response.Error(c, scheduleStatusCode(err), "failed to quote order", err)
+		return
+	}
+
+	utils.CopyTo(&quote, &res)
+	response.Success(c, http.StatusOK, "order is quoted successfully", &res, nil)
+}
+
+// EstimateOrder previews when an order would be ready before it is created.
+//
+//	@Summary	Get a ready date estimate for an order
+//	@Tags		Order
+//	@Accept		json
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		_	body		orderRequest.Estimate	true	"Estimate details"
+//	@Success	200	{object}	orderResource.Estimate
+//	@Router		/order/estimate [post]
+func (h *OrderHandler) EstimateOrder(c *gin.Context) {
+	var req orderRequest.Estimate
+	var res orderResource.Estimate
+
+	if err := utils.ParseJson(c, &req); err != nil {
+		log.Println("Failed to parse request body ", err)
+		response.Error(c, http.StatusBadRequest, "failed to parse request body", err)
+		return
+	}
+
+	estimate, err := h.service.EstimateOrder(c, &req)
+	if err != nil {
+		log.Println("Failed to estimate order ", err)
+		response.Error(c, http.StatusInternalServerError, "failed to estimate order", err)
+		return
+	}
+
+	utils.CopyTo(&estimate, &res)
+	response.Success(c, http.StatusOK, "order is estimated successfully", &res, nil)
+}
+
 // CancelOrder handles the cancellation of an existing order.
 //
 //	@Summary	Cancel an existing order
@@ -76,12 +155,10 @@ func (h *OrderHandler) CreateOrder(c *gin.Context) {
 func (h *OrderHandler) CancelOrder(c *gin.Context) {
 	var res orderResource.Order
 
-
-
-	order, err := h.service.CancelOrder(c, c.Param("id"), c.GetString("userID"))
+	order, err := h.service.CancelOrder(c, c.Param("id"), c.GetString("userID"), c.GetString("userRole"), c.GetString("outletID"))
 	if err != nil {
-		log.Println("Failed to get order ", err)
-		response.Error(c, http.StatusInternalServerError, "failed to get order", err)
+		log.Println("Failed to cancel order ", err)
+		response.Error(c, transitionStatusCode(err), "failed to cancel order", err)
 		return
 	}
 
@@ -105,16 +182,16 @@ func (h *OrderHandler) GetOrderByID(c *gin.Context) {
 	var res orderResource.Order
 	var userID string
 
-	if c.GetString("userRole") == "admin" {
+	if rbac.Can(c.GetString("userRole"), rbac.OrderReadAll) {
 		userID = ""
 	} else {
 		userID = c.GetString("userID")
 	}
 
-	order, err := h.service.GetOrderByID(c, c.Param("id"), userID)
+	order, err := h.service.GetOrderByID(c, c.Param("id"), userID, c.GetString("outletID"))
 	if err != nil {
 		log.Println("Failed to get order ", err)
-		response.Error(c, http.StatusInternalServerError, "failed to get order", err)
+		response.Error(c, orderStatusCode(err), "failed to get order", err)
 		return
 	}
 
@@ -153,26 +230,31 @@ func (h *OrderHandler) GetOrdersMe(c *gin.Context) {
 	_ = h.cache.SetWithExpiration(ordersCacheKey, &res, configs.ProductCachingTime)
 }
 
-// GetOrdersAll retrieves all orders.
+// GetOrdersAll retrieves all orders. Staff bound to an outlet only see the
+// orders of their outlet; only the unscoped listing is cached.
 //
 //	@Summary	Get all orders
 //	@Tags		Order
 //	@Accept		json
 //	@Produce	json
 //	@Security	ApiKeyAuth
-//	@Success	200	{object}	orderResource.Order
+//	@Param		outlet	query		string	false	"Outlet ID"
+//	@Success	200		{object}	orderResource.Order
 //	@Router		/orders/all [get]
 func (h *OrderHandler) GetOrdersAll(c *gin.Context) {
 	var res []orderResource.Order
 
-	err := h.cache.Get(ordersCacheKey, &res)
-	if err == nil {
-		log.Println("Failed to get orders ", err)
-		response.Success(c, http.StatusOK, "orders are collected successfully", &res, nil)
-		return
+	outletID := middleware.OutletScope(c)
+	if outletID == "" {
+		err := h.cache.Get(ordersCacheKey, &res)
+		if err == nil {
+			log.Println("Failed to get orders ", err)
+			response.Success(c, http.StatusOK, "orders are collected successfully", &res, nil)
+			return
+		}
 	}
 
-	orders, err := h.service.GetOrdersAll(c)
+	orders, err := h.service.GetOrdersAll(c, outletID)
 	if err != nil {
 		log.Println("Failed to get orders ", err)
 		response.Error(c, http.StatusInternalServerError, "failed to get orders", err)
@@ -182,7 +264,9 @@ func (h *OrderHandler) GetOrdersAll(c *gin.Context) {
 	utils.CopyTo(&orders, &res)
 	response.Success(c, http.StatusOK, "orders are collected successfully", &res, nil)
 
-	_ = h.cache.SetWithExpiration(ordersCacheKey, &res, configs.ProductCachingTime)
+	if outletID == "" {
+		_ = h.cache.SetWithExpiration(ordersCacheKey, &res, configs.ProductCachingTime)
+	}
 }
 
 // GetOrdersByUser retrieves all orders for a specific user.
@@ -198,7 +282,7 @@ func (h *OrderHandler) GetOrdersAll(c *gin.Context) {
 func (h *OrderHandler) GetOrdersByUser(c *gin.Context) {
 	var res []orderResource.Order
 
-	orders, err := h.service.GetOrdersByUser(c, c.Param("id"))
+	orders, err := h.service.GetOrdersByUser(c, c.Param("id"), middleware.OutletScope(c))
 	if err != nil {
 		log.Println("Failed to get orders ", err)
 		response.Error(c, http.StatusInternalServerError, "failed to get orders", err)
@@ -211,15 +295,16 @@ func (h *OrderHandler) GetOrdersByUser(c *gin.Context) {
 
 // EditOrder handles the editing of an existing order.
 //
-//	@Summary	Edit an existing order
-//	@Tags		Order
-//	@Accept		json
-//	@Produce	json
-//	@Security	ApiKeyAuth
-//	@Param		id	path		string				true	"Order ID"
-//	@Param		_	body		orderRequest.Order	true	"Order details"
-//	@Success	200	{object}	orderResource.Order
-//	@Router		/order/{id} [put]
+//	@Summary		Edit an existing order
+//	@Description	A new collect date is booked into its pickup window as on creation.
+//	@Tags			Order
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			id	path		string				true	"Order ID"
+//	@Param			_	body		orderRequest.Order	true	"Order details"
+//	@Success		200	{object}	orderResource.Order
+//	@Router			/order/{id} [put]
 func (h *OrderHandler) EditOrder(c *gin.Context) {
 	var req orderRequest.Order
 	var res orderResource.Order
@@ -233,7 +318,7 @@ func (h *OrderHandler) EditOrder(c *gin.Context) {
 	order, err := h.service.EditOrder(c, c.Param("id"), c.GetString("userID"), &req)
 	if err != nil {
 		log.Println("Failed to update order ", err)
-		response.Error(c, http.StatusInternalServerError, "failed to update order", err)
+		response.Error(c, scheduleStatusCode(err), "failed to update order", err)
 		return
 	}
 
@@ -256,10 +341,11 @@ func (h *OrderHandler) EditOrder(c *gin.Context) {
 func (h *OrderHandler) AcceptOrder(c *gin.Context) {
 	var res orderResource.Order
 
-	order, err := h.service.AcceptOrder(c, c.Param("id"))
+	order, err := h.service.AcceptOrder(c, c.Param("id"), c.GetString("userID"), c.GetString("userRole"), c.GetString("outletID"))
 	if err != nil {
 		log.Println("Failed to accept order ", err)
-		response.Error(c, http.StatusInternalServerError, "failed to accept order", err)
+		response.Error(c, transitionStatusCode(err), "failed to accept order", err)
+		return
 	}
 
 	utils.CopyTo(&order, &res)
@@ -279,10 +365,11 @@ func (h *OrderHandler) AcceptOrder(c *gin.Context) {
 func (h *OrderHandler) CompleteOrder(c *gin.Context) {
 	var res orderResource.Order
 
-	order, err := h.service.CompleteOrder(c, c.Param("id"), c.GetString("userID"))
+	order, err := h.service.CompleteOrder(c, c.Param("id"), c.GetString("userID"), c.GetString("userRole"), c.GetString("outletID"))
 	if err != nil {
 		log.Println("Failed to complete order ", err)
-		response.Error(c, http.StatusInternalServerError, "failed to complete order", err)
+		response.Error(c, transitionStatusCode(err), "failed to complete order", err)
+		return
 	}
 
 	utils.CopyTo(&order, &res)
@@ -302,10 +389,10 @@ func (h *OrderHandler) CompleteOrder(c *gin.Context) {
 func (h *OrderHandler) RejectOrder(c *gin.Context) {
 	var res orderResource.Order
 
-	order, err := h.service.RejectOrder(c, c.Param("id"))
+	order, err := h.service.RejectOrder(c, c.Param("id"), c.GetString("userID"), c.GetString("userRole"), c.GetString("outletID"))
 	if err != nil {
 		log.Println("Failed to reject order ", err)
-		response.Error(c, http.StatusInternalServerError, "failed to reject order", err)
+		response.Error(c, transitionStatusCode(err), "failed to reject order", err)
 		return
 	}
 
@@ -332,10 +419,10 @@ func (h *OrderHandler) UpdateWeight(c *gin.Context) {
 		return
 	}
 
-	order, err := h.service.UpdateWeight(c, c.Param("id"), c.Param("weight"))
+	order, err := h.service.UpdateWeight(c, c.Param("id"), c.Param("weight"), c.GetString("outletID"))
 	if err != nil {
 		log.Println("Failed to update weight ", err)
-		response.Error(c, http.StatusInternalServerError, "failed to update weight", err)
+		response.Error(c, orderStatusCode(err), "failed to update weight", err)
 		return
 	}
 
@@ -358,16 +445,337 @@ func (h *OrderHandler) PayOrder(c *gin.Context) {
 	var res orderResource.Order
 	var req orderRequest.Payment
 
-	order, err := h.service.PayOrder(c, c.Param("id"), &req)
+	if err := utils.ParseJson(c, &req); err != nil {
+		log.Println("Failed to parse request body ", err)
+		response.Error(c, http.StatusBadRequest, "failed to parse request body", err)
+		return
+	}
+
+	order, err := h.service.PayOrder(c, c.Param("id"), c.GetString("userID"), &req)
 	if err != nil {
 		log.Println("Failed to pay order ", err)
 		response.Error(c, http.StatusInternalServerError, "failed to pay order", err)
+		return
 	}
 
 	utils.CopyTo(&order, &res)
 	response.Success(c, http.StatusOK, "order is paid successfully", &res, links(res.ID))
 }
 
+// UpdateOrderStatus moves an order along its lifecycle.
+//
+//	@Summary	Update the status of an order
+//	@Tags		Order
+//	@Accept		json
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		id	path		string						true	"Order ID"
+//	@Param		_	body		orderRequest.UpdateStatus	true	"Status details"
+//	@Success	200	{object}	orderResource.Order
+//	@Router		/order/{id}/status [put]
+func (h *OrderHandler) UpdateOrderStatus(c *gin.Context) {
+	var req orderRequest.UpdateStatus
+	var res orderResource.Order
+
+	if err := utils.ParseJson(c, &req); err != nil {
+		log.Println("Failed to parse request body ", err)
+		response.Error(c, http.StatusBadRequest, "failed to parse request body", err)
+		return
+	}
+
+	order, err := h.service.UpdateOrderStatus(c, c.Param("id"), c.GetString("userID"), c.GetString("userRole"), c.GetString("outletID"), &req)
+	if err != nil {
+		log.Println("Failed to update order status ", err)
+		response.Error(c, transitionStatusCode(err), "failed to update order status", err)
+		return
+	}
+
+	utils.CopyTo(&order, &res)
+	response.Success(c, http.StatusOK, "order status is updated successfully", &res, links(res.ID))
+
+	_ = h.cache.Remove(ordersCacheKey)
+}
+
+// GetOrderTimeline retrieves the status history of an order.
+//
+//	@Summary	Get the status timeline of an order
+//	@Tags		Order
+//	@Accept		json
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		id	path		string	true	"Order ID"
+//	@Success	200	{object}	[]orderResource.StatusEvent
+//	@Router		/order/{id}/timeline [get]
+func (h *OrderHandler) GetOrderTimeline(c *gin.Context) {
+	var res []orderResource.StatusEvent
+	var userID string
+
+	if rbac.Can(c.GetString("userRole"), rbac.OrderReadAll) {
+		userID = ""
+	} else {
+		userID = c.GetString("userID")
+	}
+
+	events, err := h.service.GetOrderTimeline(c, c.Param("id"), userID, c.GetString("outletID"))
+	if err != nil {
+		log.Println("Failed to get order timeline ", err)
+		response.Error(c, orderStatusCode(err), "failed to get order timeline", err)
+		return
+	}
+
+	utils.CopyTo(&events, &res)
+	response.Success(c, http.StatusOK, "order timeline is collected successfully", &res, links(c.Param("id")))
+}
+
+// AddOrderItem records a garment of an order at intake.
+//
+//	@Summary	Add a line item to an order
+//	@Tags		Order
+//	@Accept		json
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		id	path		string					true	"Order ID"
+//	@Param		_	body		orderRequest.OrderItem	true	"Item details"
+//	@Success	201	{object}	orderResource.Order
+//	@Router		/order/{id}/item [post]
+func (h *OrderHandler) AddOrderItem(c *gin.Context) {
+	var req orderRequest.OrderItem
+	var res orderResource.Order
+
+	if err := utils.ParseJson(c, &req); err != nil {
+		log.Println("Failed to parse request body ", err)
+		response.Error(c, http.StatusBadRequest, "failed to parse request body", err)
+		return
+	}
+
+	order, err := h.service.AddOrderItem(c, c.Param("id"), c.GetString("outletID"), &req)
+	if err != nil {
+		log.Println("Failed to add order item ", err)
+		response.Error(c, itemStatusCode(err), "failed to add order item", err)
+		return
+	}
+
+	utils.CopyTo(&order, &res)
+	response.Success(c, http.StatusCreated, "order item is added successfully", &res, links(res.ID))
+}
+
+// UpdateOrderItem updates the details of a garment of an order.
+//
+//	@Summary	Update a line item of an order
+//	@Tags		Order
+//	@Accept		json
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		id		path		string					true	"Order ID"
+//	@Param		itemID	path		string					true	"Item ID"
+//	@Param		_		body		orderRequest.OrderItem	true	"Item details"
+//	@Success	200		{object}	orderResource.Order
+//	@Router		/order/{id}/item/{itemID} [put]
+func (h *OrderHandler) UpdateOrderItem(c *gin.Context) {
+	var req orderRequest.OrderItem
+	var res orderResource.Order
+
+	if err := utils.ParseJson(c, &req); err != nil {
+		log.Println("Failed to parse request body ", err)
+		response.Error(c, http.StatusBadRequest, "failed to parse request body", err)
+		return
+	}
+
+	order, err := h.service.UpdateOrderItem(c, c.Param("id"), c.Param("itemID"), c.GetString("outletID"), &req)
+	if err != nil {
+		log.Println("Failed to update order item ", err)
+		response.Error(c, itemStatusCode(err), "failed to update order item", err)
+		return
+	}
+
+	utils.CopyTo(&order, &res)
+	response.Success(c, http.StatusOK, "order item is updated successfully", &res, links(res.ID))
+}
+
+// DeleteOrderItem removes a garment from an order.
+//
+//	@Summary	Delete a line item of an order
+//	@Tags		Order
+//	@Accept		json
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		id		path		string	true	"Order ID"
+//	@Param		itemID	path		string	true	"Item ID"
+//	@Success	200		{object}	orderResource.Order
+//	@Router		/order/{id}/item/{itemID} [delete]
+func (h *OrderHandler) DeleteOrderItem(c *gin.Context) {
+	var res orderResource.Order
+
+	order, err := h.service.DeleteOrderItem(c, c.Param("id"), c.Param("itemID"), c.GetString("outletID"))
+	if err != nil {
+		log.Println("Failed to delete order item ", err)
+		response.Error(c, itemStatusCode(err), "failed to delete order item", err)
+		return
+	}
+
+	utils.CopyTo(&order, &res)
+	response.Success(c, http.StatusOK, "order item is deleted successfully", &res, links(res.ID))
+}
+
+// AddOrderItemPhoto attaches an intake photo to a garment of an order.
+//
+//	@Summary	Add a photo to a line item of an order
+//	@Tags		Order
+//	@Accept		json
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		id		path		string					true	"Order ID"
+//	@Param		itemID	path		string					true	"Item ID"
+//	@Param		_		body		orderRequest.ItemPhoto	true	"Photo"
+//	@Success	201		{object}	orderResource.OrderItem
+//	@Router		/order/{id}/item/{itemID}/photo [post]
+func (h *OrderHandler) AddOrderItemPhoto(c *gin.Context) {
+	var req orderRequest.ItemPhoto
+	var res orderResource.OrderItem
+
+	if err := utils.ParseJson(c, &req); err != nil {
+		log.Println("Failed to parse request body ", err)
+		response.Error(c, http.StatusBadRequest, "failed to parse request body", err)
+		return
+	}
+
+	item, err := h.service.AddOrderItemPhoto(c, c.Param("id"), c.Param("itemID"), c.GetString("outletID"), &req)
+	if err != nil {
+		log.Println("Failed to add order item photo ", err)
+		response.Error(c, itemStatusCode(err), "failed to add order item photo", err)
+		return
+	}
+
+	utils.CopyTo(&item, &res)
+	response.Success(c, http.StatusCreated, "order item photo is added successfully", &res, links(c.Param("id")))
+}
+
+// GetOrderLabels renders the tag labels of an order, the bag first and then
+// one per garment, for printing at intake.
+//
+//	@Summary	Print the tag labels of an order
+//	@Tags		Order
+//	@Produce	png
+//	@Produce	application/pdf
+//	@Security	ApiKeyAuth
+//	@Param		id			path	string	true	"Order ID"
+//	@Param		format		query	string	false	"Label format"	Enums(png, pdf)		default(png)
+//	@Param		symbology	query	string	false	"Barcode type"	Enums(code128, qr)	default(code128)
+//	@Success	200			{file}	binary
+//	@Router		/order/{id}/labels [get]
+func (h *OrderHandler) GetOrderLabels(c *gin.Context) {
+	format := c.DefaultQuery("format", orderService.LabelPNG)
+	symbology := label.Symbology(c.DefaultQuery("symbology", string(label.Code128)))
+
+	body, err := h.service.OrderLabels(c, c.Param("id"), c.GetString("outletID"), format, symbology)
+	if err != nil {
+		log.Println("Failed to render order labels ", err)
+		response.Error(c, labelStatusCode(err), "failed to render order labels", err)
+		return
+	}
+
+	contentType := "image/png"
+	if format == orderService.LabelPDF {
+		contentType = "application/pdf"
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf(`inline; filename="%s-labels.%s"`, c.Param("id"), format))
+	c.Data(http.StatusOK, contentType, body)
+}
+
+// ScanTag looks up the order, and the garment if any, of a scanned tag code.
+//
+//	@Summary	Look up an order by its tag code
+//	@Tags		Order
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		code	path		string	true	"Tag code"
+//	@Success	200		{object}	orderResource.Scan
+//	@Router		/orders/scan/{code} [get]
+func (h *OrderHandler) ScanTag(c *gin.Context) {
+	var res orderResource.Scan
+
+	scan, err := h.service.ScanTag(c, c.Param("code"), c.GetString("outletID"))
+	if err != nil {
+		log.Println("Failed to scan tag ", err)
+		response.Error(c, labelStatusCode(err), "failed to scan tag", err)
+		return
+	}
+
+	utils.CopyTo(&scan, &res)
+	response.Success(c, http.StatusOK, "tag is found", &res, links(res.Order.ID))
+}
+
+// orderStatusCode maps errors of staff working on a single order to the HTTP
+// status returned to the client.
+func orderStatusCode(err error) int {
+	switch {
+	case errors.Is(err, orderService.ErrOtherOutlet):
+		return http.StatusForbidden
+	case errors.Is(err, orderService.ErrWeightLocked):
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// labelStatusCode maps tag and label errors to the HTTP status returned to the client.
+func labelStatusCode(err error) int {
+	switch {
+	case errors.Is(err, orderService.ErrTagNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, orderService.ErrLabelFormat), errors.Is(err, label.ErrSymbology):
+		return http.StatusBadRequest
+	case errors.Is(err, orderService.ErrOtherOutlet):
+		return http.StatusForbidden
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// itemStatusCode maps line item errors to the HTTP status returned to the client.
+func itemStatusCode(err error) int {
+	switch {
+	case errors.Is(err, orderService.ErrItemNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, orderService.ErrItemsLocked):
+		return http.StatusConflict
+	case errors.Is(err, orderService.ErrOtherOutlet):
+		return http.StatusForbidden
+	case errors.Is(err, orderService.ErrPhotoType):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// transitionStatusCode maps lifecycle errors to the HTTP status returned to the client.
+func transitionStatusCode(err error) int {
+	switch {
+	case errors.Is(err, orderService.ErrInvalidTransition):
+		return http.StatusConflict
+	case errors.Is(err, orderService.ErrTransitionNotAllowed), errors.Is(err, orderService.ErrOtherOutlet):
+		return http.StatusForbidden
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// scheduleStatusCode maps opening hours, service area and pickup slot errors to
+// the HTTP status returned to the client.
+func scheduleStatusCode(err error) int {
+	switch {
+	case errors.Is(err, slotService.ErrSlotFull):
+		return http.StatusConflict
+	case errors.Is(err, calendarService.ErrClosed), errors.Is(err, outletService.ErrNoOutlet),
+		errors.Is(err, outletService.ErrOutOfArea), errors.Is(err, pricingService.ErrOutOfDeliveryRange),
+		errors.Is(err, slotService.ErrNoSlot), errors.Is(err, slotService.ErrSlotPassed):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
 var links = func(orderID string) map[string]response.HypermediaLink {
 	return map[string]response.HypermediaLink{
 		"self": {
@@ -0,0 +1,506 @@
+// Code generated by mockery v2.50.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	historyModel "washit-api/internal/history/dto/model"
+
+	mock "github.com/stretchr/testify/mock"
+
+	orderModel "washit-api/internal/order/dto/model"
+)
+
+// IOrderRepository is an autogenerated mock type for the IOrderRepository type
+type IOrderRepository struct {
+	mock.Mock
+}
+
+// CountOrdersByStatus provides a mock function with given fields: ctx, statuses, outletID
+func (_m *IOrderRepository) CountOrdersByStatus(ctx context.Context, statuses []orderModel.Status, outletID string) (int64, error) {
+	ret := _m.Called(ctx, statuses, outletID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountOrdersByStatus")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []orderModel.Status, string) (int64, error)); ok {
+		return rf(ctx, statuses, outletID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []orderModel.Status, string) int64); ok {
+		r0 = rf(ctx, statuses, outletID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []orderModel.Status, string) error); ok {
+		r1 = rf(ctx, statuses, outletID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateHistory provides a mock function with given fields: ctx, history
+func (_m *IOrderRepository) CreateHistory(ctx context.Context, history *historyModel.History) error {
+	ret := _m.Called(ctx, history)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateHistory")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *historyModel.History) error); ok {
+		r0 = rf(ctx, history)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateOrder provides a mock function with given fields: ctx, order, event
+func (_m *IOrderRepository) CreateOrder(ctx context.Context, order *orderModel.Order, event *orderModel.OrderStatusEvent) (*orderModel.Order, error) {
+	ret := _m.Called(ctx, order, event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateOrder")
+	}
+
+	var r0 *orderModel.Order
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *orderModel.Order, *orderModel.OrderStatusEvent) (*orderModel.Order, error)); ok {
+		return rf(ctx, order, event)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *orderModel.Order, *orderModel.OrderStatusEvent) *orderModel.Order); ok {
+		r0 = rf(ctx, order, event)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*orderModel.Order)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *orderModel.Order, *orderModel.OrderStatusEvent) error); ok {
+		r1 = rf(ctx, order, event)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateOrderItem provides a mock function with given fields: ctx, order, item
+func (_m *IOrderRepository) CreateOrderItem(ctx context.Context, order *orderModel.Order, item *orderModel.OrderItem) error {
+	ret := _m.Called(ctx, order, item)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateOrderItem")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *orderModel.Order, *orderModel.OrderItem) error); ok {
+		r0 = rf(ctx, order, item)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteOrder provides a mock function with given fields: ctx, order
+func (_m *IOrderRepository) DeleteOrder(ctx context.Context, order *orderModel.Order) error {
+	ret := _m.Called(ctx, order)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteOrder")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *orderModel.Order) error); ok {
+		r0 = rf(ctx, order)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteOrderItem provides a mock function with given fields: ctx, order, item
+func (_m *IOrderRepository) DeleteOrderItem(ctx context.Context, order *orderModel.Order, item *orderModel.OrderItem) error {
+	ret := _m.Called(ctx, order, item)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteOrderItem")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *orderModel.Order, *orderModel.OrderItem) error); ok {
+		r0 = rf(ctx, order, item)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetAllOrders provides a mock function with given fields: ctx, outletID
+func (_m *IOrderRepository) GetAllOrders(ctx context.Context, outletID string) ([]*orderModel.Order, error) {
+	ret := _m.Called(ctx, outletID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllOrders")
+	}
+
+	var r0 []*orderModel.Order
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*orderModel.Order, error)); ok {
+		return rf(ctx, outletID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*orderModel.Order); ok {
+		r0 = rf(ctx, outletID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*orderModel.Order)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, outletID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetHistoryByID provides a mock function with given fields: ctx, historyID
+func (_m *IOrderRepository) GetHistoryByID(ctx context.Context, historyID string) (*historyModel.History, error) {
+	ret := _m.Called(ctx, historyID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetHistoryByID")
+	}
+
+	var r0 *historyModel.History
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*historyModel.History, error)); ok {
+		return rf(ctx, historyID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *historyModel.History); ok {
+		r0 = rf(ctx, historyID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*historyModel.History)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, historyID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetOrderByID provides a mock function with given fields: ctx, orderID
+func (_m *IOrderRepository) GetOrderByID(ctx context.Context, orderID string) (*orderModel.Order, error) {
+	ret := _m.Called(ctx, orderID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrderByID")
+	}
+
+	var r0 *orderModel.Order
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*orderModel.Order, error)); ok {
+		return rf(ctx, orderID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *orderModel.Order); ok {
+		r0 = rf(ctx, orderID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*orderModel.Order)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, orderID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetOrderByTag provides a mock function with given fields: ctx, tag
+func (_m *IOrderRepository) GetOrderByTag(ctx context.Context, tag string) (*orderModel.Order, error) {
+	ret := _m.Called(ctx, tag)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrderByTag")
+	}
+
+	var r0 *orderModel.Order
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*orderModel.Order, error)); ok {
+		return rf(ctx, tag)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *orderModel.Order); ok {
+		r0 = rf(ctx, tag)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*orderModel.Order)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, tag)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetOrderItem provides a mock function with given fields: ctx, orderID, itemID
+func (_m *IOrderRepository) GetOrderItem(ctx context.Context, orderID string, itemID string) (*orderModel.OrderItem, error) {
+	ret := _m.Called(ctx, orderID, itemID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrderItem")
+	}
+
+	var r0 *orderModel.OrderItem
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*orderModel.OrderItem, error)); ok {
+		return rf(ctx, orderID, itemID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *orderModel.OrderItem); ok {
+		r0 = rf(ctx, orderID, itemID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*orderModel.OrderItem)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, orderID, itemID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetOrderItemByTag provides a mock function with given fields: ctx, tag
+func (_m *IOrderRepository) GetOrderItemByTag(ctx context.Context, tag string) (*orderModel.OrderItem, error) {
+	ret := _m.Called(ctx, tag)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrderItemByTag")
+	}
+
+	var r0 *orderModel.OrderItem
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*orderModel.OrderItem, error)); ok {
+		return rf(ctx, tag)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *orderModel.OrderItem); ok {
+		r0 = rf(ctx, tag)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*orderModel.OrderItem)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, tag)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetOrdersByIDs provides a mock function with given fields: ctx, orderIDs
+func (_m *IOrderRepository) GetOrdersByIDs(ctx context.Context, orderIDs []string) ([]*orderModel.Order, error) {
+	ret := _m.Called(ctx, orderIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrdersByIDs")
+	}
+
+	var r0 []*orderModel.Order
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) ([]*orderModel.Order, error)); ok {
+		return rf(ctx, orderIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) []*orderModel.Order); ok {
+		r0 = rf(ctx, orderIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*orderModel.Order)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, orderIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetOrdersByUser provides a mock function with given fields: ctx, userID, outletID
+func (_m *IOrderRepository) GetOrdersByUser(ctx context.Context, userID string, outletID string) ([]*orderModel.Order, error) {
+	ret := _m.Called(ctx, userID, outletID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrdersByUser")
+	}
+
+	var r0 []*orderModel.Order
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) ([]*orderModel.Order, error)); ok {
+		return rf(ctx, userID, outletID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) []*orderModel.Order); ok {
+		r0 = rf(ctx, userID, outletID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*orderModel.Order)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, userID, outletID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStatusEvents provides a mock function with given fields: ctx, orderID
+func (_m *IOrderRepository) GetStatusEvents(ctx context.Context, orderID string) ([]*orderModel.OrderStatusEvent, error) {
+	ret := _m.Called(ctx, orderID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStatusEvents")
+	}
+
+	var r0 []*orderModel.OrderStatusEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*orderModel.OrderStatusEvent, error)); ok {
+		return rf(ctx, orderID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*orderModel.OrderStatusEvent); ok {
+		r0 = rf(ctx, orderID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*orderModel.OrderStatusEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, orderID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SaveOrderItem provides a mock function with given fields: ctx, order, item
+func (_m *IOrderRepository) SaveOrderItem(ctx context.Context, order *orderModel.Order, item *orderModel.OrderItem) error {
+	ret := _m.Called(ctx, order, item)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveOrderItem")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *orderModel.Order, *orderModel.OrderItem) error); ok {
+		r0 = rf(ctx, order, item)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TransitionOrder provides a mock function with given fields: ctx, order, event, history, records
+func (_m *IOrderRepository) TransitionOrder(ctx context.Context, order *orderModel.Order, event *orderModel.OrderStatusEvent, history *historyModel.History, records ...interface{}) error {
+	var _ca []interface{}
+	_ca = append(_ca, ctx, order, event, history)
+	_ca = append(_ca, records...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TransitionOrder")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *orderModel.Order, *orderModel.OrderStatusEvent, *historyModel.History, ...interface{}) error); ok {
+		r0 = rf(ctx, order, event, history, records...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateOrder provides a mock function with given fields: ctx, order
+func (_m *IOrderRepository) UpdateOrder(ctx context.Context, order *orderModel.Order) error {
+	ret := _m.Called(ctx, order)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateOrder")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *orderModel.Order) error); ok {
+		r0 = rf(ctx, order)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateOrderItem provides a mock function with given fields: ctx, item
+func (_m *IOrderRepository) UpdateOrderItem(ctx context.Context, item *orderModel.OrderItem) error {
+	ret := _m.Called(ctx, item)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateOrderItem")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *orderModel.OrderItem) error); ok {
+		r0 = rf(ctx, item)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewIOrderRepository creates a new instance of IOrderRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIOrderRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IOrderRepository {
+	mock := &IOrderRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
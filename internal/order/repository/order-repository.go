@@ -2,20 +2,35 @@ package orderRepository
 
 import (
 	"context"
+	"errors"
 
 	historyModel "washit-api/internal/history/dto/model"
 	orderModel "washit-api/internal/order/dto/model"
 	"washit-api/pkg/db/dbs"
+
+	"gorm.io/gorm"
 )
 
 type IOrderRepository interface {
-	GetAllOrders(ctx context.Context) ([]*orderModel.Order, error)
-	GetOrdersByUser(ctx context.Context, userID string) ([]*orderModel.Order, error)
+	GetAllOrders(ctx context.Context, outletID string) ([]*orderModel.Order, error)
+	GetOrdersByUser(ctx context.Context, userID string, outletID string) ([]*orderModel.Order, error)
 	GetOrderByID(ctx context.Context, orderID string) (*orderModel.Order, error)
-	CreateOrder(ctx context.Context, order *orderModel.Order) (*orderModel.Order, error)
+	GetOrdersByIDs(ctx context.Context, orderIDs []string) ([]*orderModel.Order, error)
+	CreateOrder(ctx context.Context, order *orderModel.Order, event *orderModel.OrderStatusEvent) (*orderModel.Order, error)
 	CreateHistory(ctx context.Context, history *historyModel.History) error
+	GetHistoryByID(ctx context.Context, historyID string) (*historyModel.History, error)
 	DeleteOrder(ctx context.Context, order *orderModel.Order) error
 	UpdateOrder(ctx context.Context, order *orderModel.Order) error
+	TransitionOrder(ctx context.Context, order *orderModel.Order, event *orderModel.OrderStatusEvent, history *historyModel.History, records ...any) error
+	GetStatusEvents(ctx context.Context, orderID string) ([]*orderModel.OrderStatusEvent, error)
+	CountOrdersByStatus(ctx context.Context, statuses []orderModel.Status, outletID string) (int64, error)
+	GetOrderByTag(ctx context.Context, tag string) (*orderModel.Order, error)
+	GetOrderItemByTag(ctx context.Context, tag string) (*orderModel.OrderItem, error)
+	GetOrderItem(ctx context.Context, orderID string, itemID string) (*orderModel.OrderItem, error)
+	CreateOrderItem(ctx context.Context, order *orderModel.Order, item *orderModel.OrderItem) error
+	UpdateOrderItem(ctx context.Context, item *orderModel.OrderItem) error
+	SaveOrderItem(ctx context.Context, order *orderModel.Order, item *orderModel.OrderItem) error
+	DeleteOrderItem(ctx context.Context, order *orderModel.Order, item *orderModel.OrderItem) error
 }
 
 type OrderRepository struct {
@@ -26,20 +41,30 @@ func NewOrderRepository(db dbs.IDatabase) *OrderRepository {
 	return &OrderRepository{db: db}
 }
 
-func (r *OrderRepository) CreateOrder(ctx context.Context, order *orderModel.Order) (*orderModel.Order, error) {
-	if err := r.db.Create(ctx, order); err != nil {
+func (r *OrderRepository) CreateOrder(ctx context.Context, order *orderModel.Order, event *orderModel.OrderStatusEvent) (*orderModel.Order, error) {
+	err := r.db.WithTransaction(func(tx dbs.IDatabase) error {
+		if err := tx.Create(ctx, order); err != nil {
+			return err
+		}
+
+		return tx.Create(ctx, event)
+	})
+	if err != nil {
 		return nil, err
 	}
 
 	return order, nil
 }
 
-func (r *OrderRepository) GetAllOrders(ctx context.Context) ([]*orderModel.Order, error) {
+func (r *OrderRepository) GetAllOrders(ctx context.Context, outletID string) ([]*orderModel.Order, error) {
 	var orders []*orderModel.Order
 	query := []dbs.FindOption{
 		dbs.WithLimit(10),
 		dbs.WithOrder("created_at DESC"),
-		dbs.WithPreload([]string{"User"}),
+		dbs.WithPreload([]string{"User", "Items"}),
+	}
+	if outletID != "" {
+		query = append(query, dbs.WithQuery(dbs.NewQuery("outlet_id = ?", outletID)))
 	}
 
 	if err := r.db.Find(ctx, &orders, query...); err != nil {
@@ -49,16 +74,25 @@ func (r *OrderRepository) GetAllOrders(ctx context.Context) ([]*orderModel.Order
 	return orders, nil
 }
 
-func (r *OrderRepository) GetOrdersByUser(ctx context.Context, userID string) ([]*orderModel.Order, error) {
+// GetOrdersByUser lists the latest orders of userID, only those of outletID
+// when it is set.
+func (r *OrderRepository) GetOrdersByUser(ctx context.Context, userID string, outletID string) ([]*orderModel.Order, error) {
 	var orders []*orderModel.Order
 	query := []dbs.FindOption{
 		dbs.WithLimit(10),
 		dbs.WithOrder("created_at DESC"),
-		dbs.WithPreload([]string{"User"}),
+		dbs.WithPreload([]string{"User", "Items"}),
 	}
 
+	var conditions []dbs.Query
 	if userID != "" {
-		query = append(query, dbs.WithQuery(dbs.NewQuery("user_id = ?", userID)))
+		conditions = append(conditions, dbs.NewQuery("user_id = ?", userID))
+	}
+	if outletID != "" {
+		conditions = append(conditions, dbs.NewQuery("outlet_id = ?", outletID))
+	}
+	if len(conditions) > 0 {
+		query = append(query, dbs.WithQuery(conditions...))
 	}
 
 	if err := r.db.Find(ctx, &orders, query...); err != nil {
@@ -71,7 +105,7 @@ func (r *OrderRepository) GetOrdersByUser(ctx context.Context, userID string) ([
 func (r *OrderRepository) GetOrderByID(ctx context.Context, orderID string) (*orderModel.Order, error) {
 	var order orderModel.Order
 	query := []dbs.FindOption{
-		dbs.WithPreload([]string{"User"}),
+		dbs.WithPreload([]string{"User", "Items"}),
 		dbs.WithQuery(dbs.NewQuery("id = ?", orderID)),
 	}
 	if err := r.db.FindOne(ctx, &order, query...); err != nil {
@@ -81,6 +115,21 @@ func (r *OrderRepository) GetOrderByID(ctx context.Context, orderID string) (*or
 	return &order, nil
 }
 
+// GetOrdersByIDs returns the orders among orderIDs that are still open, in
+// no particular order.
+func (r *OrderRepository) GetOrdersByIDs(ctx context.Context, orderIDs []string) ([]*orderModel.Order, error) {
+	var orders []*orderModel.Order
+	if len(orderIDs) == 0 {
+		return orders, nil
+	}
+
+	if err := r.db.Find(ctx, &orders, dbs.WithQuery(dbs.NewQuery("id IN ?", orderIDs))); err != nil {
+		return nil, err
+	}
+
+	return orders, nil
+}
+
 func (r *OrderRepository) CreateHistory(ctx context.Context, history *historyModel.History) error {
 	if err := r.db.Create(ctx, history); err != nil {
 		return err
@@ -89,6 +138,15 @@ func (r *OrderRepository) CreateHistory(ctx context.Context, history *historyMod
 	return nil
 }
 
+func (r *OrderRepository) GetHistoryByID(ctx context.Context, historyID string) (*historyModel.History, error) {
+	var history historyModel.History
+	if err := r.db.FindByID(ctx, historyID, &history); err != nil {
+		return nil, err
+	}
+
+	return &history, nil
+}
+
 func (r *OrderRepository) DeleteOrder(ctx context.Context, order *orderModel.Order) error {
 	if err := r.db.Delete(ctx, order); err != nil {
 		return err
@@ -104,3 +162,145 @@ func (r *OrderRepository) UpdateOrder(ctx context.Context, order *orderModel.Ord
 
 	return nil
 }
+
+// TransitionOrder persists a status change together with its audit event.
+// When history is given the order is archived: the history row is written and
+// the order row removed in the same transaction. records, such as the events
+// of earlier steps or a courier job the change closes, are saved with it in
+// the given order before event.
+func (r *OrderRepository) TransitionOrder(ctx context.Context, order *orderModel.Order, event *orderModel.OrderStatusEvent, history *historyModel.History, records ...any) error {
+	return r.db.WithTransaction(func(tx dbs.IDatabase) error {
+		if history != nil {
+			if err := tx.Create(ctx, history); err != nil {
+				return err
+			}
+			if err := tx.Delete(ctx, order); err != nil {
+				return err
+			}
+		} else if err := tx.Update(ctx, order); err != nil {
+			return err
+		}
+
+		for _, record := range records {
+			if err := tx.Update(ctx, record); err != nil {
+				return err
+			}
+		}
+
+		return tx.Create(ctx, event)
+	})
+}
+
+func (r *OrderRepository) GetStatusEvents(ctx context.Context, orderID string) ([]*orderModel.OrderStatusEvent, error) {
+	var events []*orderModel.OrderStatusEvent
+	query := []dbs.FindOption{
+		dbs.WithQuery(dbs.NewQuery("order_id = ?", orderID)),
+		dbs.WithOrder("created_at ASC, id ASC"),
+	}
+
+	if err := r.db.Find(ctx, &events, query...); err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+// CountOrdersByStatus counts the orders in one of statuses, only those of
+// outletID when it is set.
+func (r *OrderRepository) CountOrdersByStatus(ctx context.Context, statuses []orderModel.Status, outletID string) (int64, error) {
+	var total int64
+	conditions := []dbs.Query{dbs.NewQuery("status IN ?", statuses)}
+	if outletID != "" {
+		conditions = append(conditions, dbs.NewQuery("outlet_id = ?", outletID))
+	}
+
+	if err := r.db.Count(ctx, &orderModel.Order{}, &total, dbs.WithQuery(conditions...)); err != nil {
+		return 0, err
+	}
+
+	return total, nil
+}
+
+// GetOrderByTag returns nil without an error when no order carries tag.
+func (r *OrderRepository) GetOrderByTag(ctx context.Context, tag string) (*orderModel.Order, error) {
+	var order orderModel.Order
+	query := []dbs.FindOption{
+		dbs.WithPreload([]string{"User", "Items"}),
+		dbs.WithQuery(dbs.NewQuery("tag_code = ?", tag)),
+	}
+	if err := r.db.FindOne(ctx, &order, query...); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &order, nil
+}
+
+// GetOrderItemByTag returns nil without an error when no item carries tag.
+func (r *OrderRepository) GetOrderItemByTag(ctx context.Context, tag string) (*orderModel.OrderItem, error) {
+	var item orderModel.OrderItem
+	if err := r.db.FindOne(ctx, &item, dbs.WithQuery(dbs.NewQuery("tag_code = ?", tag))); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &item, nil
+}
+
+func (r *OrderRepository) GetOrderItem(ctx context.Context, orderID string, itemID string) (*orderModel.OrderItem, error) {
+	var item orderModel.OrderItem
+	query := []dbs.Query{
+		dbs.NewQuery("id = ?", itemID),
+		dbs.NewQuery("order_id = ?", orderID),
+	}
+	if err := r.db.FindOne(ctx, &item, dbs.WithQuery(query...)); err != nil {
+		return nil, err
+	}
+
+	return &item, nil
+}
+
+// CreateOrderItem adds item to order and saves the repriced order in the same
+// transaction.
+func (r *OrderRepository) CreateOrderItem(ctx context.Context, order *orderModel.Order, item *orderModel.OrderItem) error {
+	return r.db.WithTransaction(func(tx dbs.IDatabase) error {
+		if err := tx.Create(ctx, item); err != nil {
+			return err
+		}
+
+		return tx.Update(ctx, order)
+	})
+}
+
+// UpdateOrderItem saves item alone, for changes that leave the price of its
+// order as it is.
+func (r *OrderRepository) UpdateOrderItem(ctx context.Context, item *orderModel.OrderItem) error {
+	return r.db.Update(ctx, item)
+}
+
+// SaveOrderItem saves item and the repriced order in the same transaction.
+func (r *OrderRepository) SaveOrderItem(ctx context.Context, order *orderModel.Order, item *orderModel.OrderItem) error {
+	return r.db.WithTransaction(func(tx dbs.IDatabase) error {
+		if err := tx.Update(ctx, item); err != nil {
+			return err
+		}
+
+		return tx.Update(ctx, order)
+	})
+}
+
+// DeleteOrderItem removes item from order and saves the repriced order in the
+// same transaction.
+func (r *OrderRepository) DeleteOrderItem(ctx context.Context, order *orderModel.Order, item *orderModel.OrderItem) error {
+	return r.db.WithTransaction(func(tx dbs.IDatabase) error {
+		if err := tx.Delete(ctx, item); err != nil {
+			return err
+		}
+
+		return tx.Update(ctx, order)
+	})
+}
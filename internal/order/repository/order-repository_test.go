@@ -0,0 +1,100 @@
+package orderRepository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	orderModel "washit-api/internal/order/dto/model"
+	"washit-api/pkg/db/dbs"
+
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	gormLogger "gorm.io/gorm/logger"
+)
+
+// sqlRecorder keeps the SQL gorm builds, with its arguments inlined.
+type sqlRecorder struct {
+	statements []string
+}
+
+func (r *sqlRecorder) LogMode(gormLogger.LogLevel) gormLogger.Interface { return r }
+func (r *sqlRecorder) Info(context.Context, string, ...interface{})     {}
+func (r *sqlRecorder) Warn(context.Context, string, ...interface{})     {}
+func (r *sqlRecorder) Error(context.Context, string, ...interface{})    {}
+
+func (r *sqlRecorder) Trace(_ context.Context, _ time.Time, fc func() (string, int64), _ error) {
+	sql, _ := fc()
+	r.statements = append(r.statements, sql)
+}
+
+// dryRun returns a repository whose queries are built for postgres but never
+// sent, so the generated SQL can be checked without a database.
+func dryRun(t *testing.T) (*OrderRepository, *sqlRecorder) {
+	t.Helper()
+
+	recorder := &sqlRecorder{}
+	db, err := gorm.Open(postgres.Open("host=localhost"), &gorm.Config{
+		DryRun:               true,
+		DisableAutomaticPing: true,
+		Logger:               recorder,
+	})
+	if err != nil {
+		t.Fatalf("open: %v", err)
+	}
+
+	return NewOrderRepository(dbs.Wrap(db)), recorder
+}
+
+func TestCountOrdersByStatusQuery(t *testing.T) {
+	repository, recorder := dryRun(t)
+
+	statuses := []orderModel.Status{orderModel.StatusCreated, orderModel.StatusAccepted}
+	if _, err := repository.CountOrdersByStatus(context.Background(), statuses, "5"); err != nil {
+		t.Fatalf("CountOrdersByStatus: %v", err)
+	}
+
+	want := `SELECT count(*) FROM "orders" WHERE status IN ('created','accepted') AND outlet_id = '5' LIMIT 1000`
+	if len(recorder.statements) != 1 || recorder.statements[0] != want {
+		t.Errorf("got %q, want %q", recorder.statements, want)
+	}
+}
+
+func TestGetOrderItemQuery(t *testing.T) {
+	repository, recorder := dryRun(t)
+
+	if _, err := repository.GetOrderItem(context.Background(), "ORD-1", "7"); err != nil {
+		t.Fatalf("GetOrderItem: %v", err)
+	}
+
+	want := `SELECT * FROM "order_items" WHERE id = '7' AND order_id = 'ORD-1' ORDER BY id,"order_items"."id" LIMIT 1`
+	if len(recorder.statements) != 1 || recorder.statements[0] != want {
+		t.Errorf("got %q, want %q", recorder.statements, want)
+	}
+}
+
+func TestGetOrdersByUserOfOutletQuery(t *testing.T) {
+	repository, recorder := dryRun(t)
+
+	if _, err := repository.GetOrdersByUser(context.Background(), "1", "5"); err != nil {
+		t.Fatalf("GetOrdersByUser: %v", err)
+	}
+
+	want := `SELECT * FROM "orders" WHERE user_id = '1' AND outlet_id = '5' ORDER BY created_at DESC LIMIT 10`
+	if len(recorder.statements) == 0 || recorder.statements[0] != want {
+		t.Errorf("got %q, want %q", recorder.statements, want)
+	}
+}
+
+func TestGetOrdersByIDsQuery(t *testing.T) {
+	repository, recorder := dryRun(t)
+
+	if _, err := repository.GetOrdersByIDs(context.Background(), []string{"ORD-1", "ORD-2"}); err != nil {
+		t.Fatalf("GetOrdersByIDs: %v", err)
+	}
+
+	want := `SELECT * FROM "orders" WHERE id IN ('ORD-1','ORD-2') ORDER BY id LIMIT 1000`
+	if len(recorder.statements) != 1 || recorder.statements[0] != want {
+		t.Errorf("got %q, want %q", recorder.statements, want)
+	}
+}
@@ -5,44 +5,57 @@ import (
 	"github.com/go-playground/validator"
 
 	order "washit-api/internal/order/handler"
-	orderRepository "washit-api/internal/order/repository"
 	orderService "washit-api/internal/order/service"
+	userRepository "washit-api/internal/user/repository"
+	userService "washit-api/internal/user/service"
 	"washit-api/pkg/db/dbs"
+	"washit-api/pkg/mailer"
 	"washit-api/pkg/middleware"
+	"washit-api/pkg/rbac"
 	"washit-api/pkg/redis"
 )
 
 func Main(r *gin.RouterGroup, db dbs.IDatabase, cache redis.IRedis, validator *validator.Validate) {
-	repository := orderRepository.NewOrderRepository(db)
-	service := orderService.NewOrderService(repository, validator)
+	users := userService.NewUserService(userRepository.NewUserRepository(db), cache, mailer.FromEnvs(), validator)
+	service := orderService.NewOrderServiceFromDB(db, users, validator)
 	handler := order.NewOrderHandler(service, cache)
 
-	authMiddleware := middleware.JWTAuth()
-	adminAuthMiddleware := middleware.JWTAuthAdmin()
+	authMiddleware := middleware.JWTAuth(cache)
 
 	// Order Get
 	r.GET("/orders", authMiddleware, handler.GetOrdersMe)
 	r.GET("/order/:id", authMiddleware, handler.GetOrderByID)
+	r.GET("/order/:id/timeline", authMiddleware, handler.GetOrderTimeline)
 
 	// Order Post
 	r.POST("/order", authMiddleware, handler.CreateOrder)
+	r.POST("/order/quote", authMiddleware, handler.QuoteOrder)
+	r.POST("/order/estimate", authMiddleware, handler.EstimateOrder)
 
 	// Order Update
 	r.PUT("/order/:id/edit", authMiddleware, handler.EditOrder)
 	r.PUT("/order/:id/cancel", authMiddleware, handler.CancelOrder)
 	r.PUT("/order/:id/complete", authMiddleware, handler.CompleteOrder)
 	r.PUT("/order/:id/pay", authMiddleware, handler.PayOrder)
+	r.PUT("/order/:id/status", authMiddleware, handler.UpdateOrderStatus)
 
-	// Admin Authority
+	// Staff Authority
 
 	// Order Get
-	r.GET("/orders/all", adminAuthMiddleware, handler.GetOrdersAll)
-	r.GET("/orders/user/:id", adminAuthMiddleware, handler.GetOrdersByUser)
+	r.GET("/orders/all", middleware.JWTPermission(cache, rbac.OrderReadAll), handler.GetOrdersAll)
+	r.GET("/orders/user/:id", middleware.JWTPermission(cache, rbac.OrderReadAll), handler.GetOrdersByUser)
+	r.GET("/orders/scan/:code", middleware.JWTPermission(cache, rbac.OrderReadAll), handler.ScanTag)
+	r.GET("/order/:id/labels", middleware.JWTPermission(cache, rbac.OrderReadAll), handler.GetOrderLabels)
 
 	// Order Update
 	// r.PUT("/order/:id/update", )
-	r.PUT("/order/:id/accept", adminAuthMiddleware, handler.AcceptOrder)
-	r.PUT("/order/:id/reject", adminAuthMiddleware, handler.RejectOrder)
-	// r.PUT("/order/:id/status", adminAuthMiddleware, handler.UpdateOrderStatus)
-	r.PUT("/order/:id/weight/:weight", adminAuthMiddleware, handler.UpdateWeight)
+	r.PUT("/order/:id/accept", middleware.JWTPermission(cache, rbac.OrderAccept), handler.AcceptOrder)
+	r.PUT("/order/:id/reject", middleware.JWTPermission(cache, rbac.OrderAccept), handler.RejectOrder)
+	r.PUT("/order/:id/weight/:weight", middleware.JWTPermission(cache, rbac.OrderWeigh), handler.UpdateWeight)
+
+	// Order Item
+	r.POST("/order/:id/item", middleware.JWTPermission(cache, rbac.OrderWeigh), handler.AddOrderItem)
+	r.PUT("/order/:id/item/:itemID", middleware.JWTPermission(cache, rbac.OrderWeigh), handler.UpdateOrderItem)
+	r.DELETE("/order/:id/item/:itemID", middleware.JWTPermission(cache, rbac.OrderWeigh), handler.DeleteOrderItem)
+	r.POST("/order/:id/item/:itemID/photo", middleware.JWTPermission(cache, rbac.OrderWeigh), handler.AddOrderItemPhoto)
 }
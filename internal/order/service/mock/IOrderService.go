@@ -0,0 +1,760 @@
+// Code generated by mockery v2.50.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	label "washit-api/pkg/label"
+
+	mock "github.com/stretchr/testify/mock"
+
+	orderModel "washit-api/internal/order/dto/model"
+
+	orderRequest "washit-api/internal/order/dto/request"
+
+	orderService "washit-api/internal/order/service"
+
+	pricingService "washit-api/internal/pricing/service"
+)
+
+// IOrderService is an autogenerated mock type for the IOrderService type
+type IOrderService struct {
+	mock.Mock
+}
+
+// AcceptOrder provides a mock function with given fields: c, orderID, userID, role, outletID
+func (_m *IOrderService) AcceptOrder(c context.Context, orderID string, userID string, role string, outletID string) (*orderModel.Order, error) {
+	ret := _m.Called(c, orderID, userID, role, outletID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AcceptOrder")
+	}
+
+	var r0 *orderModel.Order
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string) (*orderModel.Order, error)); ok {
+		return rf(c, orderID, userID, role, outletID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string) *orderModel.Order); ok {
+		r0 = rf(c, orderID, userID, role, outletID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*orderModel.Order)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, string) error); ok {
+		r1 = rf(c, orderID, userID, role, outletID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AddOrderItem provides a mock function with given fields: c, orderID, outletID, req
+func (_m *IOrderService) AddOrderItem(c context.Context, orderID string, outletID string, req *orderRequest.OrderItem) (*orderModel.Order, error) {
+	ret := _m.Called(c, orderID, outletID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddOrderItem")
+	}
+
+	var r0 *orderModel.Order
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *orderRequest.OrderItem) (*orderModel.Order, error)); ok {
+		return rf(c, orderID, outletID, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *orderRequest.OrderItem) *orderModel.Order); ok {
+		r0 = rf(c, orderID, outletID, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*orderModel.Order)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, *orderRequest.OrderItem) error); ok {
+		r1 = rf(c, orderID, outletID, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AddOrderItemPhoto provides a mock function with given fields: c, orderID, itemID, outletID, req
+func (_m *IOrderService) AddOrderItemPhoto(c context.Context, orderID string, itemID string, outletID string, req *orderRequest.ItemPhoto) (*orderModel.OrderItem, error) {
+	ret := _m.Called(c, orderID, itemID, outletID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddOrderItemPhoto")
+	}
+
+	var r0 *orderModel.OrderItem
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, *orderRequest.ItemPhoto) (*orderModel.OrderItem, error)); ok {
+		return rf(c, orderID, itemID, outletID, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, *orderRequest.ItemPhoto) *orderModel.OrderItem); ok {
+		r0 = rf(c, orderID, itemID, outletID, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*orderModel.OrderItem)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, *orderRequest.ItemPhoto) error); ok {
+		r1 = rf(c, orderID, itemID, outletID, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CancelOrder provides a mock function with given fields: c, orderID, userID, role, outletID
+func (_m *IOrderService) CancelOrder(c context.Context, orderID string, userID string, role string, outletID string) (*orderModel.Order, error) {
+	ret := _m.Called(c, orderID, userID, role, outletID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelOrder")
+	}
+
+	var r0 *orderModel.Order
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string) (*orderModel.Order, error)); ok {
+		return rf(c, orderID, userID, role, outletID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string) *orderModel.Order); ok {
+		r0 = rf(c, orderID, userID, role, outletID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*orderModel.Order)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, string) error); ok {
+		r1 = rf(c, orderID, userID, role, outletID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CompleteOrder provides a mock function with given fields: c, orderID, userID, role, outletID
+func (_m *IOrderService) CompleteOrder(c context.Context, orderID string, userID string, role string, outletID string) (*orderModel.Order, error) {
+	ret := _m.Called(c, orderID, userID, role, outletID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CompleteOrder")
+	}
+
+	var r0 *orderModel.Order
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string) (*orderModel.Order, error)); ok {
+		return rf(c, orderID, userID, role, outletID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string) *orderModel.Order); ok {
+		r0 = rf(c, orderID, userID, role, outletID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*orderModel.Order)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, string) error); ok {
+		r1 = rf(c, orderID, userID, role, outletID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateOrder provides a mock function with given fields: c, userID, req
+func (_m *IOrderService) CreateOrder(c context.Context, userID string, req *orderRequest.Order) (*orderModel.Order, error) {
+	ret := _m.Called(c, userID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateOrder")
+	}
+
+	var r0 *orderModel.Order
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *orderRequest.Order) (*orderModel.Order, error)); ok {
+		return rf(c, userID, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *orderRequest.Order) *orderModel.Order); ok {
+		r0 = rf(c, userID, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*orderModel.Order)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *orderRequest.Order) error); ok {
+		r1 = rf(c, userID, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteOrderItem provides a mock function with given fields: c, orderID, itemID, outletID
+func (_m *IOrderService) DeleteOrderItem(c context.Context, orderID string, itemID string, outletID string) (*orderModel.Order, error) {
+	ret := _m.Called(c, orderID, itemID, outletID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteOrderItem")
+	}
+
+	var r0 *orderModel.Order
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (*orderModel.Order, error)); ok {
+		return rf(c, orderID, itemID, outletID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) *orderModel.Order); ok {
+		r0 = rf(c, orderID, itemID, outletID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*orderModel.Order)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(c, orderID, itemID, outletID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EditOrder provides a mock function with given fields: c, orderID, userID, req
+func (_m *IOrderService) EditOrder(c context.Context, orderID string, userID string, req *orderRequest.Order) (*orderModel.Order, error) {
+	ret := _m.Called(c, orderID, userID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EditOrder")
+	}
+
+	var r0 *orderModel.Order
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *orderRequest.Order) (*orderModel.Order, error)); ok {
+		return rf(c, orderID, userID, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *orderRequest.Order) *orderModel.Order); ok {
+		r0 = rf(c, orderID, userID, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*orderModel.Order)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, *orderRequest.Order) error); ok {
+		r1 = rf(c, orderID, userID, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EstimateOrder provides a mock function with given fields: c, req
+func (_m *IOrderService) EstimateOrder(c context.Context, req *orderRequest.Estimate) (*orderService.Estimate, error) {
+	ret := _m.Called(c, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EstimateOrder")
+	}
+
+	var r0 *orderService.Estimate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *orderRequest.Estimate) (*orderService.Estimate, error)); ok {
+		return rf(c, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *orderRequest.Estimate) *orderService.Estimate); ok {
+		r0 = rf(c, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*orderService.Estimate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *orderRequest.Estimate) error); ok {
+		r1 = rf(c, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetOrderByID provides a mock function with given fields: c, orderID, userID, outletID
+func (_m *IOrderService) GetOrderByID(c context.Context, orderID string, userID string, outletID string) (*orderModel.Order, error) {
+	ret := _m.Called(c, orderID, userID, outletID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrderByID")
+	}
+
+	var r0 *orderModel.Order
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (*orderModel.Order, error)); ok {
+		return rf(c, orderID, userID, outletID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) *orderModel.Order); ok {
+		r0 = rf(c, orderID, userID, outletID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*orderModel.Order)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(c, orderID, userID, outletID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetOrderTimeline provides a mock function with given fields: c, orderID, userID, outletID
+func (_m *IOrderService) GetOrderTimeline(c context.Context, orderID string, userID string, outletID string) ([]*orderModel.OrderStatusEvent, error) {
+	ret := _m.Called(c, orderID, userID, outletID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrderTimeline")
+	}
+
+	var r0 []*orderModel.OrderStatusEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) ([]*orderModel.OrderStatusEvent, error)); ok {
+		return rf(c, orderID, userID, outletID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) []*orderModel.OrderStatusEvent); ok {
+		r0 = rf(c, orderID, userID, outletID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*orderModel.OrderStatusEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(c, orderID, userID, outletID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetOrdersAll provides a mock function with given fields: c, outletID
+func (_m *IOrderService) GetOrdersAll(c context.Context, outletID string) ([]*orderModel.Order, error) {
+	ret := _m.Called(c, outletID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrdersAll")
+	}
+
+	var r0 []*orderModel.Order
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*orderModel.Order, error)); ok {
+		return rf(c, outletID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*orderModel.Order); ok {
+		r0 = rf(c, outletID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*orderModel.Order)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(c, outletID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetOrdersByIDs provides a mock function with given fields: c, orderIDs
+func (_m *IOrderService) GetOrdersByIDs(c context.Context, orderIDs []string) ([]*orderModel.Order, error) {
+	ret := _m.Called(c, orderIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrdersByIDs")
+	}
+
+	var r0 []*orderModel.Order
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) ([]*orderModel.Order, error)); ok {
+		return rf(c, orderIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) []*orderModel.Order); ok {
+		r0 = rf(c, orderIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*orderModel.Order)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(c, orderIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetOrdersByUser provides a mock function with given fields: c, userID, outletID
+func (_m *IOrderService) GetOrdersByUser(c context.Context, userID string, outletID string) ([]*orderModel.Order, error) {
+	ret := _m.Called(c, userID, outletID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrdersByUser")
+	}
+
+	var r0 []*orderModel.Order
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) ([]*orderModel.Order, error)); ok {
+		return rf(c, userID, outletID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) []*orderModel.Order); ok {
+		r0 = rf(c, userID, outletID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*orderModel.Order)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(c, userID, outletID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetOrdersMe provides a mock function with given fields: c, userID
+func (_m *IOrderService) GetOrdersMe(c context.Context, userID string) ([]*orderModel.Order, error) {
+	ret := _m.Called(c, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrdersMe")
+	}
+
+	var r0 []*orderModel.Order
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*orderModel.Order, error)); ok {
+		return rf(c, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*orderModel.Order); ok {
+		r0 = rf(c, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*orderModel.Order)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(c, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MoveOrder provides a mock function with given fields: c, orderID, userID, role, path, records
+func (_m *IOrderService) MoveOrder(c context.Context, orderID string, userID string, role string, path []orderModel.Status, records ...interface{}) (*orderModel.Order, error) {
+	var _ca []interface{}
+	_ca = append(_ca, c, orderID, userID, role, path)
+	_ca = append(_ca, records...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MoveOrder")
+	}
+
+	var r0 *orderModel.Order
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, []orderModel.Status, ...interface{}) (*orderModel.Order, error)); ok {
+		return rf(c, orderID, userID, role, path, records...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, []orderModel.Status, ...interface{}) *orderModel.Order); ok {
+		r0 = rf(c, orderID, userID, role, path, records...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*orderModel.Order)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, []orderModel.Status, ...interface{}) error); ok {
+		r1 = rf(c, orderID, userID, role, path, records...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OrderLabels provides a mock function with given fields: c, orderID, outletID, format, symbology
+func (_m *IOrderService) OrderLabels(c context.Context, orderID string, outletID string, format string, symbology label.Symbology) ([]byte, error) {
+	ret := _m.Called(c, orderID, outletID, format, symbology)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OrderLabels")
+	}
+
+	var r0 []byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, label.Symbology) ([]byte, error)); ok {
+		return rf(c, orderID, outletID, format, symbology)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, label.Symbology) []byte); ok {
+		r0 = rf(c, orderID, outletID, format, symbology)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, label.Symbology) error); ok {
+		r1 = rf(c, orderID, outletID, format, symbology)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PayOrder provides a mock function with given fields: c, orderID, userID, req
+func (_m *IOrderService) PayOrder(c context.Context, orderID string, userID string, req *orderRequest.Payment) (*orderModel.Order, error) {
+	ret := _m.Called(c, orderID, userID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PayOrder")
+	}
+
+	var r0 *orderModel.Order
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *orderRequest.Payment) (*orderModel.Order, error)); ok {
+		return rf(c, orderID, userID, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *orderRequest.Payment) *orderModel.Order); ok {
+		r0 = rf(c, orderID, userID, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*orderModel.Order)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, *orderRequest.Payment) error); ok {
+		r1 = rf(c, orderID, userID, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// QuoteOrder provides a mock function with given fields: c, userID, req
+func (_m *IOrderService) QuoteOrder(c context.Context, userID string, req *orderRequest.Quote) (*pricingService.Quote, error) {
+	ret := _m.Called(c, userID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for QuoteOrder")
+	}
+
+	var r0 *pricingService.Quote
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *orderRequest.Quote) (*pricingService.Quote, error)); ok {
+		return rf(c, userID, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *orderRequest.Quote) *pricingService.Quote); ok {
+		r0 = rf(c, userID, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pricingService.Quote)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *orderRequest.Quote) error); ok {
+		r1 = rf(c, userID, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RejectOrder provides a mock function with given fields: c, orderID, userID, role, outletID
+func (_m *IOrderService) RejectOrder(c context.Context, orderID string, userID string, role string, outletID string) (*orderModel.Order, error) {
+	ret := _m.Called(c, orderID, userID, role, outletID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RejectOrder")
+	}
+
+	var r0 *orderModel.Order
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string) (*orderModel.Order, error)); ok {
+		return rf(c, orderID, userID, role, outletID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string) *orderModel.Order); ok {
+		r0 = rf(c, orderID, userID, role, outletID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*orderModel.Order)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, string) error); ok {
+		r1 = rf(c, orderID, userID, role, outletID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ScanTag provides a mock function with given fields: c, code, outletID
+func (_m *IOrderService) ScanTag(c context.Context, code string, outletID string) (*orderService.Scan, error) {
+	ret := _m.Called(c, code, outletID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ScanTag")
+	}
+
+	var r0 *orderService.Scan
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*orderService.Scan, error)); ok {
+		return rf(c, code, outletID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *orderService.Scan); ok {
+		r0 = rf(c, code, outletID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*orderService.Scan)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(c, code, outletID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateOrderItem provides a mock function with given fields: c, orderID, itemID, outletID, req
+func (_m *IOrderService) UpdateOrderItem(c context.Context, orderID string, itemID string, outletID string, req *orderRequest.OrderItem) (*orderModel.Order, error) {
+	ret := _m.Called(c, orderID, itemID, outletID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateOrderItem")
+	}
+
+	var r0 *orderModel.Order
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, *orderRequest.OrderItem) (*orderModel.Order, error)); ok {
+		return rf(c, orderID, itemID, outletID, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, *orderRequest.OrderItem) *orderModel.Order); ok {
+		r0 = rf(c, orderID, itemID, outletID, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*orderModel.Order)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, *orderRequest.OrderItem) error); ok {
+		r1 = rf(c, orderID, itemID, outletID, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateOrderStatus provides a mock function with given fields: c, orderID, userID, role, outletID, req
+func (_m *IOrderService) UpdateOrderStatus(c context.Context, orderID string, userID string, role string, outletID string, req *orderRequest.UpdateStatus) (*orderModel.Order, error) {
+	ret := _m.Called(c, orderID, userID, role, outletID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateOrderStatus")
+	}
+
+	var r0 *orderModel.Order
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string, *orderRequest.UpdateStatus) (*orderModel.Order, error)); ok {
+		return rf(c, orderID, userID, role, outletID, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string, *orderRequest.UpdateStatus) *orderModel.Order); ok {
+		r0 = rf(c, orderID, userID, role, outletID, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*orderModel.Order)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, string, *orderRequest.UpdateStatus) error); ok {
+		r1 = rf(c, orderID, userID, role, outletID, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateWeight provides a mock function with given fields: c, orderID, weight, outletID
+func (_m *IOrderService) UpdateWeight(c context.Context, orderID string, weight string, outletID string) (*orderModel.Order, error) {
+	ret := _m.Called(c, orderID, weight, outletID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateWeight")
+	}
+
+	var r0 *orderModel.Order
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (*orderModel.Order, error)); ok {
+		return rf(c, orderID, weight, outletID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) *orderModel.Order); ok {
+		r0 = rf(c, orderID, weight, outletID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*orderModel.Order)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(c, orderID, weight, outletID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewIOrderService creates a new instance of IOrderService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIOrderService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IOrderService {
+	mock := &IOrderService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
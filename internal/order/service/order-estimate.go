@@ -0,0 +1,145 @@
+package orderService
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+	"strconv"
+	"time"
+
+	orderModel "washit-api/internal/order/dto/model"
+	orderRequest "washit-api/internal/order/dto/request"
+	serviceModel "washit-api/internal/service/dto/model"
+	"washit-api/pkg/worktime"
+)
+
+// backlogStatuses are the statuses of orders the laundry is working on. They
+// are ahead of any order accepted now.
+var backlogStatuses = []orderModel.Status{
+	orderModel.StatusAccepted,
+	orderModel.StatusPickedUp,
+	orderModel.StatusWashing,
+}
+
+// Estimate is when an order is expected to be ready and how that was worked
+// out. All hours are working hours of the business calendar.
+type Estimate struct {
+	ServiceType     string
+	OrderType       string
+	Weight          float64
+	StartsAt        time.Time
+	TurnaroundHours int
+	LoadHours       int
+	BacklogHours    int
+	EstimateDate    time.Time
+}
+
+func (s *OrderService) EstimateOrder(c context.Context, req *orderRequest.Estimate) (*Estimate, error) {
+	if err := s.validator.Struct(req); err != nil {
+		log.Printf("Failed to validate estimate request: %v", err)
+		return nil, fmt.Errorf("validation error: %w", err)
+	}
+
+	var outletID string
+	if req.OutletID != 0 {
+		outletID = strconv.FormatInt(req.OutletID, 10)
+	}
+
+	return s.estimate(c, req.ServiceType, req.OrderType, req.Weight, req.CollectDate, outletID, 0)
+}
+
+// estimate works out when an order is ready. Work starts when the laundry is
+// collected, or now if that has passed, and takes the service type
+// turnaround, capped by the order type turnaround for express orders. Every
+// load beyond the first adds loadHours, and regular orders queue behind the
+// orders in progress at outletID, or at every outlet when it is empty, ahead
+// excluding the order itself when it is one of them.
+func (s *OrderService) estimate(c context.Context, serviceType string, orderType string, weight float64, collectDate time.Time, outletID string, ahead int64) (*Estimate, error) {
+	service, err := s.catalog.GetAvailableService(c, serviceModel.KindServiceType, serviceType)
+	if err != nil {
+		log.Printf("Invalid service type %s: %v", serviceType, err)
+		return nil, fmt.Errorf("validation error: %w", err)
+	}
+
+	kind, err := s.catalog.GetAvailableService(c, serviceModel.KindOrderType, orderType)
+	if err != nil {
+		log.Printf("Invalid order type %s: %v", orderType, err)
+		return nil, fmt.Errorf("validation error: %w", err)
+	}
+
+	estimate := &Estimate{
+		ServiceType:     serviceType,
+		OrderType:       orderType,
+		Weight:          weight,
+		StartsAt:        time.Now(),
+		TurnaroundHours: service.TurnaroundHours,
+	}
+	if collectDate.After(estimate.StartsAt) {
+		estimate.StartsAt = collectDate
+	}
+
+	express := kind.TurnaroundHours > 0
+	if express && kind.TurnaroundHours < estimate.TurnaroundHours {
+		estimate.TurnaroundHours = kind.TurnaroundHours
+	}
+
+	if s.loadKg > 0 && weight > s.loadKg {
+		estimate.LoadHours = (int(math.Ceil(weight/s.loadKg)) - 1) * s.loadHours
+	}
+
+	if !express && s.backlogPerHour > 0 {
+		backlog, err := s.repository.CountOrdersByStatus(c, backlogStatuses, outletID)
+		if err != nil {
+			log.Printf("Failed to count orders in progress: %v", err)
+			return nil, fmt.Errorf("failed to estimate order: %w", err)
+		}
+		if backlog -= ahead; backlog > 0 {
+			estimate.BacklogHours = int(backlog) / s.backlogPerHour
+		}
+	}
+
+	calendar, err := s.calendars.Load(c)
+	if err != nil {
+		return nil, fmt.Errorf("failed to estimate order: %w", err)
+	}
+
+	hours := estimate.TurnaroundHours + estimate.LoadHours + estimate.BacklogHours
+	estimate.EstimateDate, err = worktime.Add(calendar, estimate.StartsAt, time.Duration(hours)*time.Hour)
+	if err != nil {
+		log.Printf("Failed to add %d working hours to %s: %v", hours, estimate.StartsAt, err)
+		return nil, fmt.Errorf("failed to estimate order: %w", err)
+	}
+
+	return estimate, nil
+}
+
+// updateEstimate sets order.EstimateDate from its current service, order
+// type and weight.
+func (s *OrderService) updateEstimate(c context.Context, order *orderModel.Order) error {
+	var weight float64
+	if order.Weight != nil {
+		weight = *order.Weight
+	}
+
+	var outletID string
+	if order.OutletID != nil {
+		outletID = strconv.FormatInt(*order.OutletID, 10)
+	}
+
+	var ahead int64
+	for _, status := range backlogStatuses {
+		if order.Status == status {
+			ahead = 1
+		}
+	}
+
+	estimate, err := s.estimate(c, order.ServiceType, order.OrderType, weight, order.CollectDate, outletID, ahead)
+	if err != nil {
+		return err
+	}
+
+	order.EstimateDate = estimate.EstimateDate
+
+	return nil
+}
@@ -0,0 +1,268 @@
+package orderService
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	orderModel "washit-api/internal/order/dto/model"
+	orderRequest "washit-api/internal/order/dto/request"
+	generate "washit-api/pkg/generator"
+
+	"github.com/shopspring/decimal"
+)
+
+// maxItemPhotos caps the intake photos kept for one item.
+const maxItemPhotos = 5
+
+// photoExtensions maps the image types accepted for item photos to the
+// extension they are saved with.
+var photoExtensions = map[string]string{
+	"image/jpeg": "jpg",
+	"image/png":  "png",
+	"image/webp": "webp",
+}
+
+var (
+	ErrItemsLocked  = errors.New("order items can no longer be changed")
+	ErrItemNotFound = errors.New("order item not found")
+	ErrPhotoType    = errors.New("item photos must be jpg, png or webp")
+)
+
+// intakeStatuses are the statuses in which the garments and the weight of an
+// order may still be recorded: before pickup and during intake at the outlet.
+var intakeStatuses = map[orderModel.Status]bool{
+	orderModel.StatusCreated:  true,
+	orderModel.StatusAccepted: true,
+}
+
+// AddOrderItem records a garment of an order and reprices the order.
+func (s *OrderService) AddOrderItem(c context.Context, orderID string, outletID string, req *orderRequest.OrderItem) (*orderModel.Order, error) {
+	if err := s.validateItem(req); err != nil {
+		log.Printf("Failed to validate order item request: %v", err)
+		return nil, err
+	}
+
+	order, err := s.itemsOrder(c, orderID, outletID)
+	if err != nil {
+		return nil, err
+	}
+
+	tagCode, err := generate.TagCode(orderModel.TagPrefixItem)
+	if err != nil {
+		log.Printf("Failed to generate item tag code: %v", err)
+		return nil, fmt.Errorf("failed to generate item tag code: %w", err)
+	}
+
+	item := orderModel.OrderItem{OrderID: order.ID, TagCode: &tagCode}
+	applyItem(&item, req)
+	order.Items = append(order.Items, item)
+
+	if err := s.priceItems(c, order); err != nil {
+		return nil, err
+	}
+
+	if err := s.repository.CreateOrderItem(c, order, &order.Items[len(order.Items)-1]); err != nil {
+		log.Printf("Failed to create item of order %s: %v", orderID, err)
+		return nil, fmt.Errorf("failed to create order item: %w", err)
+	}
+
+	return order, nil
+}
+
+// UpdateOrderItem replaces the details of a garment and reprices the order.
+// Photos are kept.
+func (s *OrderService) UpdateOrderItem(c context.Context, orderID string, itemID string, outletID string, req *orderRequest.OrderItem) (*orderModel.Order, error) {
+	if err := s.validateItem(req); err != nil {
+		log.Printf("Failed to validate order item request: %v", err)
+		return nil, err
+	}
+
+	order, err := s.itemsOrder(c, orderID, outletID)
+	if err != nil {
+		return nil, err
+	}
+
+	index, err := findItem(order, itemID)
+	if err != nil {
+		return nil, err
+	}
+
+	item := order.Items[index]
+	applyItem(&item, req)
+	order.Items[index] = item
+
+	if err := s.priceItems(c, order); err != nil {
+		return nil, err
+	}
+
+	if err := s.repository.SaveOrderItem(c, order, &order.Items[index]); err != nil {
+		log.Printf("Failed to update item %s of order %s: %v", itemID, orderID, err)
+		return nil, fmt.Errorf("failed to update order item: %w", err)
+	}
+
+	return order, nil
+}
+
+// DeleteOrderItem removes a garment from an order and reprices the order.
+func (s *OrderService) DeleteOrderItem(c context.Context, orderID string, itemID string, outletID string) (*orderModel.Order, error) {
+	order, err := s.itemsOrder(c, orderID, outletID)
+	if err != nil {
+		return nil, err
+	}
+
+	index, err := findItem(order, itemID)
+	if err != nil {
+		return nil, err
+	}
+
+	item := order.Items[index]
+	order.Items = append(order.Items[:index], order.Items[index+1:]...)
+
+	if err := s.priceItems(c, order); err != nil {
+		return nil, err
+	}
+
+	if err := s.repository.DeleteOrderItem(c, order, &item); err != nil {
+		log.Printf("Failed to delete item %s of order %s: %v", itemID, orderID, err)
+		return nil, fmt.Errorf("failed to delete order item: %w", err)
+	}
+
+	return order, nil
+}
+
+// AddOrderItemPhoto stores an intake photo of a garment, typically of damage
+// found before washing, under ./public/garmentPhotos.
+func (s *OrderService) AddOrderItemPhoto(c context.Context, orderID string, itemID string, outletID string, req *orderRequest.ItemPhoto) (*orderModel.OrderItem, error) {
+	if err := s.validator.Struct(req); err != nil {
+		log.Printf("Failed to validate item photo request: %v", err)
+		return nil, fmt.Errorf("validation error: %w", err)
+	}
+
+	contentType := http.DetectContentType(req.Image)
+	ext, ok := photoExtensions[contentType]
+	if !ok {
+		log.Printf("Unsupported item photo type: %s", contentType)
+		return nil, fmt.Errorf("%w: got %s", ErrPhotoType, contentType)
+	}
+
+	order, err := s.itemsOrder(c, orderID, outletID)
+	if err != nil {
+		return nil, err
+	}
+
+	index, err := findItem(order, itemID)
+	if err != nil {
+		return nil, err
+	}
+
+	item := order.Items[index]
+	if len(item.Photos) >= maxItemPhotos {
+		return nil, fmt.Errorf("validation error: an item can have at most %d photos", maxItemPhotos)
+	}
+
+	mediaName := fmt.Sprintf("%s-%d-%d.%s", order.ID, item.ID, time.Now().UnixNano(), ext)
+	if err := generate.SaveMediaToFile(req.Image, "./public/garmentPhotos/"+mediaName); err != nil {
+		log.Printf("Failed to save photo of item %s: %v", itemID, err)
+		return nil, fmt.Errorf("failed to save item photo: %w", err)
+	}
+
+	item.Photos = append(item.Photos, mediaName)
+
+	if err := s.repository.UpdateOrderItem(c, &item); err != nil {
+		log.Printf("Failed to update item %s of order %s: %v", itemID, orderID, err)
+		return nil, fmt.Errorf("failed to update order item: %w", err)
+	}
+
+	return &item, nil
+}
+
+// itemsOrder returns the order whose items are to change, as long as they
+// still can and it is one of outletID when set.
+func (s *OrderService) itemsOrder(c context.Context, orderID string, outletID string) (*orderModel.Order, error) {
+	order, err := s.repository.GetOrderByID(c, orderID)
+	if err != nil {
+		log.Printf("Failed to get Order by id: %v", err)
+		return nil, fmt.Errorf("failed to get order by id: %w", err)
+	}
+
+	if err := checkOutlet(order.ID, order.OutletID, outletID); err != nil {
+		log.Printf("Order %s is outside outlet %s", orderID, outletID)
+		return nil, err
+	}
+
+	if !intakeStatuses[order.Status] {
+		log.Printf("Items of order %s cannot change in status %s", orderID, order.Status)
+		return nil, fmt.Errorf("%w: order is %s", ErrItemsLocked, order.Status)
+	}
+
+	return order, nil
+}
+
+// priceItems reprices order after its items changed. An order with neither a
+// weight nor items has no price yet. A paid order keeps the price it was paid
+// at, so its items can no longer change.
+func (s *OrderService) priceItems(c context.Context, order *orderModel.Order) error {
+	if order.TransactionID != "" {
+		log.Printf("Items of order %s cannot change once paid", order.ID)
+		return fmt.Errorf("%w: order is paid", ErrItemsLocked)
+	}
+
+	if order.Weight == nil && len(order.Items) == 0 {
+		order.Price = nil
+		return nil
+	}
+
+	return s.updatePrice(c, order)
+}
+
+func (s *OrderService) validateItem(req *orderRequest.OrderItem) error {
+	if err := s.validator.Struct(req); err != nil {
+		return fmt.Errorf("validation error: %w", err)
+	}
+
+	if req.UnitPrice.IsNegative() {
+		return fmt.Errorf("validation error: unit price cannot be negative")
+	}
+
+	return nil
+}
+
+func findItem(order *orderModel.Order, itemID string) (int, error) {
+	for i, item := range order.Items {
+		if strconv.FormatInt(item.ID, 10) == itemID {
+			return i, nil
+		}
+	}
+
+	return 0, fmt.Errorf("%w: %v", ErrItemNotFound, itemID)
+}
+
+func applyItem(item *orderModel.OrderItem, req *orderRequest.OrderItem) {
+	item.GarmentType = req.GarmentType
+	item.Quantity = req.Quantity
+	item.Colour = req.Colour
+	item.Brand = req.Brand
+	item.CareInstructions = req.CareInstructions
+	item.UnitPrice = req.UnitPrice
+	item.DamageNotes = req.DamageNotes
+}
+
+// itemLoad splits items into the quantity charged at the per-item rate of the
+// price list and the total of the individually priced ones.
+func itemLoad(items []orderModel.OrderItem) (int, decimal.Decimal) {
+	count, charges := 0, decimal.Zero
+	for _, item := range items {
+		if item.UnitPrice.IsPositive() {
+			charges = charges.Add(item.UnitPrice.Mul(decimal.NewFromInt(int64(item.Quantity))))
+		} else {
+			count += item.Quantity
+		}
+	}
+
+	return count, charges
+}
@@ -0,0 +1,203 @@
+package orderService
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	orderModel "washit-api/internal/order/dto/model"
+	generate "washit-api/pkg/generator"
+	"washit-api/pkg/label"
+)
+
+// Label sheet formats.
+const (
+	LabelPNG = "png"
+	LabelPDF = "pdf"
+)
+
+var (
+	ErrTagNotFound = errors.New("tag code not found")
+	ErrLabelFormat = errors.New("unknown label format")
+)
+
+// Scan is the order found by a tag code, with the garment when the code is on
+// an item rather than on the bag.
+type Scan struct {
+	Order *orderModel.Order
+	Item  *orderModel.OrderItem
+}
+
+// OrderLabels renders the bag label of an order followed by one label per
+// garment. Orders and items created before tagging get their codes here.
+func (s *OrderService) OrderLabels(c context.Context, orderID string, outletID string, format string, symbology label.Symbology) ([]byte, error) {
+	if format != LabelPNG && format != LabelPDF {
+		return nil, fmt.Errorf("%w: %s", ErrLabelFormat, format)
+	}
+
+	order, err := s.repository.GetOrderByID(c, orderID)
+	if err != nil {
+		log.Printf("Failed to get Order by id: %v", err)
+		return nil, fmt.Errorf("failed to get order by id: %w", err)
+	}
+
+	if err := checkOutlet(order.ID, order.OutletID, outletID); err != nil {
+		log.Printf("Order %s is outside outlet %s", orderID, outletID)
+		return nil, err
+	}
+
+	if err := s.ensureTags(c, order); err != nil {
+		return nil, err
+	}
+
+	labels := orderLabels(order)
+
+	var body []byte
+	if format == LabelPDF {
+		body, err = label.PDF(labels, symbology)
+	} else {
+		body, err = label.PNG(labels, symbology)
+	}
+	if err != nil {
+		log.Printf("Failed to render labels of order %s: %v", orderID, err)
+		return nil, fmt.Errorf("failed to render labels: %w", err)
+	}
+
+	return body, nil
+}
+
+// ScanTag looks up the order, and the garment if any, a tag code belongs to.
+// Codes are matched case-insensitively so hand-typed codes work too. Staff
+// bound to outletID only find the orders of their outlet.
+func (s *OrderService) ScanTag(c context.Context, code string, outletID string) (*Scan, error) {
+	code = strings.ToUpper(strings.TrimSpace(code))
+
+	switch {
+	case strings.HasPrefix(code, orderModel.TagPrefixOrder+"-"):
+		order, err := s.repository.GetOrderByTag(c, code)
+		if err != nil {
+			log.Printf("Failed to get Order by tag %s: %v", code, err)
+			return nil, fmt.Errorf("failed to get order by tag: %w", err)
+		}
+		if order == nil {
+			return nil, fmt.Errorf("%w: %s", ErrTagNotFound, code)
+		}
+
+		if err := checkOutlet(order.ID, order.OutletID, outletID); err != nil {
+			log.Printf("Tag %s is outside outlet %s", code, outletID)
+			return nil, err
+		}
+
+		return &Scan{Order: order}, nil
+	case strings.HasPrefix(code, orderModel.TagPrefixItem+"-"):
+		item, err := s.repository.GetOrderItemByTag(c, code)
+		if err != nil {
+			log.Printf("Failed to get order item by tag %s: %v", code, err)
+			return nil, fmt.Errorf("failed to get order item by tag: %w", err)
+		}
+		if item == nil {
+			return nil, fmt.Errorf("%w: %s", ErrTagNotFound, code)
+		}
+
+		order, err := s.repository.GetOrderByID(c, item.OrderID)
+		if err != nil {
+			log.Printf("Failed to get Order by id: %v", err)
+			return nil, fmt.Errorf("failed to get order by id: %w", err)
+		}
+
+		if err := checkOutlet(order.ID, order.OutletID, outletID); err != nil {
+			log.Printf("Tag %s is outside outlet %s", code, outletID)
+			return nil, err
+		}
+
+		return &Scan{Order: order, Item: item}, nil
+	}
+
+	return nil, fmt.Errorf("%w: %s", ErrTagNotFound, code)
+}
+
+// ensureTags assigns tag codes to an order and its items that have none yet.
+func (s *OrderService) ensureTags(c context.Context, order *orderModel.Order) error {
+	for i := range order.Items {
+		item := &order.Items[i]
+		if item.TagCode != nil {
+			continue
+		}
+
+		tagCode, err := generate.TagCode(orderModel.TagPrefixItem)
+		if err != nil {
+			log.Printf("Failed to generate item tag code: %v", err)
+			return fmt.Errorf("failed to generate item tag code: %w", err)
+		}
+		item.TagCode = &tagCode
+
+		if err := s.repository.UpdateOrderItem(c, item); err != nil {
+			log.Printf("Failed to tag item %d of order %s: %v", item.ID, order.ID, err)
+			return fmt.Errorf("failed to update order item: %w", err)
+		}
+	}
+
+	if order.TagCode != nil {
+		return nil
+	}
+
+	tagCode, err := generate.TagCode(orderModel.TagPrefixOrder)
+	if err != nil {
+		log.Printf("Failed to generate Order tag code: %v", err)
+		return fmt.Errorf("failed to generate order tag code: %w", err)
+	}
+	order.TagCode = &tagCode
+
+	if err := s.repository.UpdateOrder(c, order); err != nil {
+		log.Printf("Failed to tag order %s: %v", order.ID, err)
+		return fmt.Errorf("failed to update order: %w", err)
+	}
+
+	return nil
+}
+
+// orderLabels lays out the bag label and the garment labels of order.
+func orderLabels(order *orderModel.Order) []label.Label {
+	customer := strings.TrimSpace(order.User.FirstName + " " + order.User.LastName)
+	labels := []label.Label{{
+		Code:  *order.TagCode,
+		Title: order.ID,
+		Lines: []string{
+			customer,
+			order.ServiceType + " / " + order.OrderType,
+			fmt.Sprintf("%d items", len(order.Items)),
+			"Collect " + order.CollectDate.Format("02 Jan 2006 15:04"),
+		},
+	}}
+
+	for i, item := range order.Items {
+		lines := []string{fmt.Sprintf("%dx %s", item.Quantity, item.GarmentType)}
+		if details := joinNonEmpty(", ", item.Colour, item.Brand); details != "" {
+			lines = append(lines, details)
+		}
+		if item.CareInstructions != "" {
+			lines = append(lines, item.CareInstructions)
+		}
+
+		labels = append(labels, label.Label{
+			Code:  *item.TagCode,
+			Title: fmt.Sprintf("%s %d/%d", order.ID, i+1, len(order.Items)),
+			Lines: lines,
+		})
+	}
+
+	return labels
+}
+
+func joinNonEmpty(sep string, values ...string) string {
+	parts := make([]string, 0, len(values))
+	for _, value := range values {
+		if value != "" {
+			parts = append(parts, value)
+		}
+	}
+
+	return strings.Join(parts, sep)
+}
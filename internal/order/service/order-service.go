@@ -2,16 +2,41 @@ package orderService
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
+	"math"
 	"strconv"
 	"time"
 
+	addressModel "washit-api/internal/address/dto/model"
+	addressRepository "washit-api/internal/address/repository"
+	addressService "washit-api/internal/address/service"
+	calendarRepository "washit-api/internal/calendar/repository"
+	calendarService "washit-api/internal/calendar/service"
 	historyModel "washit-api/internal/history/dto/model"
 	orderModel "washit-api/internal/order/dto/model"
 	orderRequest "washit-api/internal/order/dto/request"
 	orderRepository "washit-api/internal/order/repository"
+	outletRepository "washit-api/internal/outlet/repository"
+	outletService "washit-api/internal/outlet/service"
+	pricingRequest "washit-api/internal/pricing/dto/request"
+	pricingRepository "washit-api/internal/pricing/repository"
+	pricingService "washit-api/internal/pricing/service"
+	serviceModel "washit-api/internal/service/dto/model"
+	serviceRepository "washit-api/internal/service/repository"
+	serviceService "washit-api/internal/service/service"
+	slotModel "washit-api/internal/slot/dto/model"
+	slotRepository "washit-api/internal/slot/repository"
+	slotService "washit-api/internal/slot/service"
+	transactionModel "washit-api/internal/transaction/dto/model"
+	transactionRepository "washit-api/internal/transaction/repository"
+	userService "washit-api/internal/user/service"
+	"washit-api/pkg/configs"
+	"washit-api/pkg/db/dbs"
 	generate "washit-api/pkg/generator"
+	"washit-api/pkg/geo"
+	"washit-api/pkg/label"
 	"washit-api/pkg/utils"
 
 	"github.com/go-playground/validator"
@@ -19,38 +44,108 @@ import (
 
 type IOrderService interface {
 	GetOrdersMe(c context.Context, userID string) ([]*orderModel.Order, error)
-	GetOrdersAll(c context.Context) ([]*orderModel.Order, error)
-	GetOrderByID(c context.Context, orderID string, userID string) (*orderModel.Order, error)
-	GetOrdersByUser(c context.Context, userID string) ([]*orderModel.Order, error)
+	GetOrdersAll(c context.Context, outletID string) ([]*orderModel.Order, error)
+	GetOrderByID(c context.Context, orderID string, userID string, outletID string) (*orderModel.Order, error)
+	GetOrdersByUser(c context.Context, userID string, outletID string) ([]*orderModel.Order, error)
+	GetOrdersByIDs(c context.Context, orderIDs []string) ([]*orderModel.Order, error)
 	CreateOrder(c context.Context, userID string, req *orderRequest.Order) (*orderModel.Order, error)
-	CancelOrder(c context.Context, orderID string, userID string) (*orderModel.Order, error)
-	UpdateWeight(c context.Context, orderID string, weight string) (*orderModel.Order, error)
-	AcceptOrder(c context.Context, orderID string) (*orderModel.Order, error)
-	CompleteOrder(c context.Context, orderID string, userID string) (*orderModel.Order, error)
-	PayOrder(c context.Context, orderID string, req *orderRequest.Payment) (*orderModel.Order, error)
-	RejectOrder(c context.Context, orderID string) (*orderModel.Order, error)
+	CancelOrder(c context.Context, orderID string, userID string, role string, outletID string) (*orderModel.Order, error)
+	UpdateWeight(c context.Context, orderID string, weight string, outletID string) (*orderModel.Order, error)
+	AcceptOrder(c context.Context, orderID string, userID string, role string, outletID string) (*orderModel.Order, error)
+	CompleteOrder(c context.Context, orderID string, userID string, role string, outletID string) (*orderModel.Order, error)
+	PayOrder(c context.Context, orderID string, userID string, req *orderRequest.Payment) (*orderModel.Order, error)
+	RejectOrder(c context.Context, orderID string, userID string, role string, outletID string) (*orderModel.Order, error)
 	EditOrder(c context.Context, orderID string, userID string, req *orderRequest.Order) (*orderModel.Order, error)
+	UpdateOrderStatus(c context.Context, orderID string, userID string, role string, outletID string, req *orderRequest.UpdateStatus) (*orderModel.Order, error)
+	MoveOrder(c context.Context, orderID string, userID string, role string, path []orderModel.Status, records ...any) (*orderModel.Order, error)
+	GetOrderTimeline(c context.Context, orderID string, userID string, outletID string) ([]*orderModel.OrderStatusEvent, error)
+	QuoteOrder(c context.Context, userID string, req *orderRequest.Quote) (*pricingService.Quote, error)
+	EstimateOrder(c context.Context, req *orderRequest.Estimate) (*Estimate, error)
+	AddOrderItem(c context.Context, orderID string, outletID string, req *orderRequest.OrderItem) (*orderModel.Order, error)
+	UpdateOrderItem(c context.Context, orderID string, itemID string, outletID string, req *orderRequest.OrderItem) (*orderModel.Order, error)
+	DeleteOrderItem(c context.Context, orderID string, itemID string, outletID string) (*orderModel.Order, error)
+	AddOrderItemPhoto(c context.Context, orderID string, itemID string, outletID string, req *orderRequest.ItemPhoto) (*orderModel.OrderItem, error)
+	OrderLabels(c context.Context, orderID string, outletID string, format string, symbology label.Symbology) ([]byte, error)
+	ScanTag(c context.Context, code string, outletID string) (*Scan, error)
 }
 
 type OrderService struct {
-	repository orderRepository.IOrderRepository
-	validator  *validator.Validate
+	repository   orderRepository.IOrderRepository
+	pricing      pricingService.IPricingService
+	catalog      serviceService.IServiceService
+	addresses    addressService.IAddressService
+	transactions transactionRepository.ITransactionRepository
+	users        userService.IUserService
+	slots        slotService.ISlotService
+	calendars    calendarService.ICalendarService
+	outlets      outletService.IOutletService
+	validator    *validator.Validate
+
+	loadKg         float64
+	loadHours      int
+	backlogPerHour int
 }
 
 func NewOrderService(
-	repository orderRepository.IOrderRepository, validator *validator.Validate) *OrderService {
+	repository orderRepository.IOrderRepository, pricing pricingService.IPricingService,
+	catalog serviceService.IServiceService, addresses addressService.IAddressService,
+	transactions transactionRepository.ITransactionRepository, users userService.IUserService,
+	slots slotService.ISlotService, calendars calendarService.ICalendarService,
+	outlets outletService.IOutletService, validator *validator.Validate) *OrderService {
 	return &OrderService{
-		repository: repository,
-		validator:  validator,
+		repository:   repository,
+		pricing:      pricing,
+		catalog:      catalog,
+		addresses:    addresses,
+		transactions: transactions,
+		users:        users,
+		slots:        slots,
+		calendars:    calendars,
+		outlets:      outlets,
+		validator:    validator,
+
+		loadKg:         configs.Envs.EstimateLoadKg,
+		loadHours:      configs.Envs.EstimateLoadHours,
+		backlogPerHour: configs.Envs.EstimateBacklogPerHour,
 	}
 }
 
+// NewOrderServiceFromDB builds the order service and the services it depends
+// on, for the order routes and any other routes that work on orders. users
+// is passed in so callers that need it as well can share one.
+func NewOrderServiceFromDB(db dbs.IDatabase, users userService.IUserService, validator *validator.Validate) *OrderService {
+	pricing := pricingService.NewPricingService(pricingRepository.NewPricingRepository(db), validator)
+	catalog := serviceService.NewServiceService(serviceRepository.NewServiceRepository(db), validator)
+	calendars := calendarService.NewCalendarService(calendarRepository.NewCalendarRepository(db), validator)
+	slots := slotService.NewSlotService(slotRepository.NewSlotRepository(db), calendars, validator)
+	outlets := outletService.NewOutletService(outletRepository.NewOutletRepository(db), users, validator)
+	addresses := addressService.NewAddressService(addressRepository.NewAddressRepository(db), outlets, validator)
+
+	return NewOrderService(
+		orderRepository.NewOrderRepository(db), pricing, catalog, addresses,
+		transactionRepository.NewTransactionRepository(db), users, slots, calendars, outlets, validator)
+}
+
 func (s *OrderService) CreateOrder(c context.Context, userID string, req *orderRequest.Order) (*orderModel.Order, error) {
 	if err := s.validator.Struct(req); err != nil {
 		log.Printf("Failed to validate Order request: %v", err)
 		return nil, fmt.Errorf("validation error: %w", err)
 	}
 
+	if err := s.users.EnsureEmailVerified(c, userID); err != nil {
+		log.Printf("User %s cannot create orders: %v", userID, err)
+		return nil, err
+	}
+
+	if err := s.checkCatalog(c, req); err != nil {
+		return nil, err
+	}
+
+	address, err := s.checkAddress(c, req, userID)
+	if err != nil {
+		return nil, err
+	}
+
 	order := &orderModel.Order{}
 	orderID, err := generate.AlphaNumericID("ORD")
 	if err != nil {
@@ -58,20 +153,55 @@ func (s *OrderService) CreateOrder(c context.Context, userID string, req *orderR
 		return nil, fmt.Errorf("failed to generate order ID: %w", err)
 	}
 
+	tagCode, err := generate.TagCode(orderModel.TagPrefixOrder)
+	if err != nil {
+		log.Printf("Failed to generate Order tag code: %v", err)
+		return nil, fmt.Errorf("failed to generate order tag code: %w", err)
+	}
+
 	orderUserID, err := strconv.ParseInt(userID, 10, 64)
 	if err != nil {
 		log.Printf("Failed to parse userID: %v", err)
 		return nil, fmt.Errorf("failed to parse userID: %w", err)
 	}
 
+	if err := s.calendars.CheckOpen(c, req.CollectDate); err != nil {
+		log.Printf("Collect date %s of user %s is outside opening hours: %v", req.CollectDate, userID, err)
+		return nil, err
+	}
+
+	if err := s.assignOutlet(c, order, address, req.CollectDate); err != nil {
+		log.Printf("Failed to route order of user %s to an outlet: %v", userID, err)
+		return nil, err
+	}
+
+	slot, err := s.slots.Reserve(c, req.CollectDate)
+	if err != nil {
+		log.Printf("Failed to reserve pickup slot for user %s: %v", userID, err)
+		return nil, err
+	}
+
 	utils.CopyTo(req, order)
 	order.ID = orderID
 	order.UserID = orderUserID
-	order.Status = "created"
+	order.Status = orderModel.StatusCreated
+	order.TagCode = &tagCode
+	if slot != nil {
+		order.PickupSlotID = &slot.ID
+		order.CollectDate = slot.StartsAt
+	}
+
+	event := &orderModel.OrderStatusEvent{
+		OrderID:   orderID,
+		ToStatus:  orderModel.StatusCreated,
+		ActorID:   orderUserID,
+		ActorRole: RoleCustomer,
+	}
 
-	createdOrder, err := s.repository.CreateOrder(c, order)
+	createdOrder, err := s.repository.CreateOrder(c, order, event)
 	if err != nil {
 		log.Printf("Failed to create Order: %v", err)
+		s.releaseSlot(c, slot)
 		return nil, fmt.Errorf("failed to create order: %w", err)
 	}
 
@@ -79,7 +209,7 @@ func (s *OrderService) CreateOrder(c context.Context, userID string, req *orderR
 }
 
 func (s *OrderService) GetOrdersMe(c context.Context, userID string) ([]*orderModel.Order, error) {
-	orders, err := s.repository.GetOrdersByUser(c, userID)
+	orders, err := s.repository.GetOrdersByUser(c, userID, "")
 	if err != nil {
 		log.Printf("Failed to get orders for user %s: %v", userID, err)
 		return nil, fmt.Errorf("failed to get orders for user %s: %w", userID, err)
@@ -88,8 +218,9 @@ func (s *OrderService) GetOrdersMe(c context.Context, userID string) ([]*orderMo
 	return orders, nil
 }
 
-func (s *OrderService) GetOrdersAll(c context.Context) ([]*orderModel.Order, error) {
-	orders, err := s.repository.GetAllOrders(c)
+// GetOrdersAll lists the latest orders, only those of outletID when it is set.
+func (s *OrderService) GetOrdersAll(c context.Context, outletID string) ([]*orderModel.Order, error) {
+	orders, err := s.repository.GetAllOrders(c, outletID)
 	if err != nil {
 		log.Printf("Failed to get all Orders: %v", err)
 		return nil, fmt.Errorf("failed to get all orders: %w", err)
@@ -98,8 +229,10 @@ func (s *OrderService) GetOrdersAll(c context.Context) ([]*orderModel.Order, err
 	return orders, nil
 }
 
-func (s *OrderService) GetOrdersByUser(c context.Context, userID string) ([]*orderModel.Order, error) {
-	orders, err := s.repository.GetOrdersByUser(c, userID)
+// GetOrdersByUser lists the latest orders of userID, only those of outletID
+// when it is set.
+func (s *OrderService) GetOrdersByUser(c context.Context, userID string, outletID string) ([]*orderModel.Order, error) {
+	orders, err := s.repository.GetOrdersByUser(c, userID, outletID)
 	if err != nil {
 		log.Printf("Failed to get Orders from userID: %v", err)
 		return nil, fmt.Errorf("failed to get orders from userID: %v", userID)
@@ -108,7 +241,20 @@ func (s *OrderService) GetOrdersByUser(c context.Context, userID string) ([]*ord
 	return orders, nil
 }
 
-func (s *OrderService) GetOrderByID(c context.Context, orderID string, userID string) (*orderModel.Order, error) {
+// GetOrdersByIDs returns the open orders among orderIDs in one query, for
+// callers working on many orders at once. Orders moved to history are left
+// out.
+func (s *OrderService) GetOrdersByIDs(c context.Context, orderIDs []string) ([]*orderModel.Order, error) {
+	orders, err := s.repository.GetOrdersByIDs(c, orderIDs)
+	if err != nil {
+		log.Printf("Failed to get orders by ids: %v", err)
+		return nil, fmt.Errorf("failed to get orders: %w", err)
+	}
+
+	return orders, nil
+}
+
+func (s *OrderService) GetOrderByID(c context.Context, orderID string, userID string, outletID string) (*orderModel.Order, error) {
 	order, err := s.repository.GetOrderByID(c, orderID)
 	if err != nil {
 		log.Printf("Failed to get Order by id: %v", err)
@@ -120,16 +266,40 @@ func (s *OrderService) GetOrderByID(c context.Context, orderID string, userID st
 		return nil, fmt.Errorf("user ID mismatch: %v", userID)
 	}
 
+	if err := checkOutlet(order.ID, order.OutletID, outletID); err != nil {
+		log.Printf("Order %s is outside outlet %s", orderID, outletID)
+		return nil, err
+	}
+
 	return order, nil
 }
 
-func (s *OrderService) UpdateWeight(c context.Context, orderID string, weight string) (*orderModel.Order, error) {
+var ErrWeightLocked = errors.New("order weight can no longer be changed")
+
+// UpdateWeight records the weight of an order at intake and reprices it. A
+// paid order keeps the price it was paid at, so its weight is locked too.
+func (s *OrderService) UpdateWeight(c context.Context, orderID string, weight string, outletID string) (*orderModel.Order, error) {
 	order, err := s.repository.GetOrderByID(c, orderID)
 	if err != nil {
 		log.Printf("Failed to get Order by id: %v", err)
 		return nil, fmt.Errorf("failed to get order by id: %v", err)
 	}
 
+	if err := checkOutlet(order.ID, order.OutletID, outletID); err != nil {
+		log.Printf("Order %s is outside outlet %s", orderID, outletID)
+		return nil, err
+	}
+
+	if !intakeStatuses[order.Status] {
+		log.Printf("Weight of order %s cannot change in status %s", orderID, order.Status)
+		return nil, fmt.Errorf("%w: order is %s", ErrWeightLocked, order.Status)
+	}
+
+	if order.TransactionID != "" {
+		log.Printf("Weight of order %s cannot change once paid", orderID)
+		return nil, fmt.Errorf("%w: order is paid", ErrWeightLocked)
+	}
+
 	weightFloat, err := strconv.ParseFloat(weight, 64)
 	if err != nil {
 		log.Printf("Failed to parse weight: %v", err)
@@ -138,6 +308,16 @@ func (s *OrderService) UpdateWeight(c context.Context, orderID string, weight st
 
 	order.Weight = &weightFloat
 
+	if err := s.updatePrice(c, order); err != nil {
+		return nil, err
+	}
+
+	if !order.EstimateDate.IsZero() {
+		if err := s.updateEstimate(c, order); err != nil {
+			log.Printf("Failed to recompute estimate of order %s: %v", orderID, err)
+		}
+	}
+
 	if err := s.repository.UpdateOrder(c, order); err != nil {
 		log.Printf("Failed to update order weight by ID: %v", err)
 		return nil, fmt.Errorf("failed to update order weight by ID: %v", orderID)
@@ -146,72 +326,221 @@ func (s *OrderService) UpdateWeight(c context.Context, orderID string, weight st
 	return order, nil
 }
 
-func (s *OrderService) AcceptOrder(c context.Context, orderID string) (*orderModel.Order, error) {
+func (s *OrderService) AcceptOrder(c context.Context, orderID string, userID string, role string, outletID string) (*orderModel.Order, error) {
 	order, err := s.repository.GetOrderByID(c, orderID)
 	if err != nil {
 		log.Printf("Failed to get Order by id: %v", err)
 		return nil, fmt.Errorf("failed to get order by id: %w", err)
 	}
 
-	if order.Status != "created" {
-		log.Printf("Order is already in a non-acceptable status: %v", order.Status)
-		return nil, fmt.Errorf("order is already in a non-acceptable status: %v", order.Status)
+	if err := checkOutlet(order.ID, order.OutletID, outletID); err != nil {
+		log.Printf("Order %s is outside outlet %s", orderID, outletID)
+		return nil, err
 	}
 
-	order.Status = "accepted"
+	return s.transition(c, order, orderModel.StatusAccepted, userID, role, "")
+}
+
+func (s *OrderService) CompleteOrder(c context.Context, orderID string, userID string, role string, outletID string) (*orderModel.Order, error) {
+	order, err := s.repository.GetOrderByID(c, orderID)
+	if err != nil {
+		log.Printf("Failed to get Order by id: %v", err)
+		return nil, fmt.Errorf("failed to get order by id: %w", err)
+	}
+
+	if err := checkOutlet(order.ID, order.OutletID, outletID); err != nil {
+		log.Printf("Order %s is outside outlet %s", orderID, outletID)
+		return nil, err
+	}
+
+	return s.transition(c, order, orderModel.StatusCompleted, userID, role, "")
+}
+
+// PayOrder links a paid transaction to an order. The transaction must belong
+// to the order and its owner and must cover the current order price.
+func (s *OrderService) PayOrder(c context.Context, orderID string, userID string, req *orderRequest.Payment) (*orderModel.Order, error) {
+	if err := s.validator.Struct(req); err != nil {
+		log.Printf("Failed to validate Order request: %v", err)
+		return nil, fmt.Errorf("validation error: %w", err)
+	}
+
+	order, err := s.GetOrderByID(c, orderID, userID, "")
+	if err != nil {
+		return nil, err
+	}
+
+	if order.Price == nil {
+		log.Printf("Payment is not allowed, invalid price: %v", order.Price)
+		return nil, fmt.Errorf("payment is not allowed, invalid price: %v", order.Price)
+	}
+
+	transaction, err := s.transactions.GetTransactionByID(c, req.TransactionID)
+	if err != nil {
+		log.Printf("Failed to get transaction by id: %v", err)
+		return nil, fmt.Errorf("transaction not found: %v", req.TransactionID)
+	}
+
+	if transaction.OrderID != order.ID || transaction.UserID != order.UserID {
+		log.Printf("Transaction %s does not belong to order %s", transaction.ID, order.ID)
+		return nil, fmt.Errorf("transaction %s does not belong to order %s", transaction.ID, order.ID)
+	}
+
+	if transaction.Status != transactionModel.StatusPaid {
+		log.Printf("Transaction %s is %s, not paid", transaction.ID, transaction.Status)
+		return nil, fmt.Errorf("transaction %s is not paid: %v", transaction.ID, transaction.Status)
+	}
+
+	if transaction.Amount == nil || !transaction.Amount.Equal(*order.Price) {
+		log.Printf("Transaction %s amount %v does not match order price %v", transaction.ID, transaction.Amount, order.Price)
+		return nil, fmt.Errorf("transaction amount does not match order price")
+	}
+
+	order.TransactionID = transaction.ID
 
 	if err := s.repository.UpdateOrder(c, order); err != nil {
-		log.Printf("Failed to update order status to 'accepted' for order ID %s: %v", orderID, err)
-		return nil, fmt.Errorf("failed to update order status: %w", err)
+		log.Printf("Failed to update transaction ID: %v", err)
+		return nil, fmt.Errorf("failed to update transaction by id: %v", err)
 	}
 
 	return order, nil
 }
 
-func (s *OrderService) CompleteOrder(c context.Context, orderID string, userID string) (*orderModel.Order, error) {
-	var history historyModel.History
+func (s *OrderService) RejectOrder(c context.Context, orderID string, userID string, role string, outletID string) (*orderModel.Order, error) {
+	order, err := s.repository.GetOrderByID(c, orderID)
+	if err != nil {
+		log.Printf("Failed to get Order by id: %v", err)
+		return nil, fmt.Errorf("failed to get order by id: %w", err)
+	}
+
+	if err := checkOutlet(order.ID, order.OutletID, outletID); err != nil {
+		log.Printf("Order %s is outside outlet %s", orderID, outletID)
+		return nil, err
+	}
 
+	return s.transition(c, order, orderModel.StatusRejected, userID, role, "")
+}
+
+func (s *OrderService) CancelOrder(c context.Context, orderID string, userID string, role string, outletID string) (*orderModel.Order, error) {
 	order, err := s.repository.GetOrderByID(c, orderID)
 	if err != nil {
 		log.Printf("Failed to get Order by id: %v", err)
 		return nil, fmt.Errorf("failed to get order by id: %w", err)
 	}
 
+	if err := checkOutlet(order.ID, order.OutletID, outletID); err != nil {
+		log.Printf("Order %s is outside outlet %s", orderID, outletID)
+		return nil, err
+	}
+
+	return s.transition(c, order, orderModel.StatusCancelled, userID, role, "")
+}
+
+func (s *OrderService) EditOrder(c context.Context, orderID string, userID string, req *orderRequest.Order) (*orderModel.Order, error) {
+	if err := s.validator.Struct(req); err != nil {
+		log.Printf("Validation failed for update profile request: %v", err)
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	order, err := s.repository.GetOrderByID(c, orderID)
+	if err != nil {
+		log.Printf("Failed to get Order by id: %v", err)
+		return nil, fmt.Errorf("failed to get order by id: %v", err)
+	}
+
 	if strconv.FormatInt(order.UserID, 10) != userID {
-		log.Printf("User ID mismatch: expected %v, got %v", userID, order.UserID)
+		log.Printf("User ID mismatch")
 		return nil, fmt.Errorf("user ID mismatch: %v", userID)
 	}
 
-	if order.Status != "delivered" {
-		log.Printf("Order cannot be completed due to its current status: %v", order.Status)
-		return nil, fmt.Errorf("order cannot be completed due to its current status: %v", order.Status)
+	if order.Status != orderModel.StatusCreated {
+		log.Printf("Editing is not allowed for orders with status: %v", order.Status)
+		return nil, fmt.Errorf("editing is not allowed for orders with status: %v", order.Status)
 	}
 
-	if order.TransactionID == "" {
-		log.Printf("Order cannot be completed due to missing transaction ID")
-		return nil, fmt.Errorf("order cannot be completed due to missing transaction ID")
+	if err := s.checkCatalog(c, req); err != nil {
+		return nil, err
 	}
 
-	utils.CopyTo(&order, &history)
-	history.Status = "completed"
-	history.DeletedAt = time.Now()
+	address, err := s.checkAddress(c, req, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// A new address or pickup time may fall to another outlet.
+	if req.AddressID != order.AddressID || !req.CollectDate.Equal(order.CollectDate) {
+		if err := s.assignOutlet(c, order, address, req.CollectDate); err != nil {
+			log.Printf("Failed to route order %s to an outlet: %v", orderID, err)
+			return nil, err
+		}
+	}
+
+	// The stored collect date is the start of the order's slot, so another
+	// time within the same slot keeps the place it already holds.
+	previousSlotID := order.PickupSlotID
+	var current, slot *slotModel.PickupSlot
+	moved := false
+	if !req.CollectDate.Equal(order.CollectDate) {
+		if err := s.calendars.CheckOpen(c, req.CollectDate); err != nil {
+			log.Printf("Collect date %s of order %s is outside opening hours: %v", req.CollectDate, orderID, err)
+			return nil, err
+		}
+
+		current, err = s.slots.Resolve(c, req.CollectDate)
+		if err != nil {
+			log.Printf("Failed to resolve pickup slot for order %s: %v", orderID, err)
+			return nil, err
+		}
+
+		moved = current == nil || previousSlotID == nil || current.ID != *previousSlotID
+		if moved {
+			slot, err = s.slots.Reserve(c, req.CollectDate)
+			if err != nil {
+				log.Printf("Failed to reserve pickup slot for order %s: %v", orderID, err)
+				return nil, err
+			}
+		}
+	}
+
+	utils.CopyTo(&req, order)
+	if moved {
+		order.PickupSlotID = nil
+	}
+	if slot != nil {
+		order.PickupSlotID = &slot.ID
+		order.CollectDate = slot.StartsAt
+	} else if current != nil {
+		order.CollectDate = current.StartsAt
+	}
+
+	if order.Weight != nil || len(order.Items) > 0 {
+		if err := s.updatePrice(c, order); err != nil {
+			s.releaseSlot(c, slot)
+			return nil, err
+		}
+	}
 
-	if err := s.repository.CreateHistory(c, &history); err != nil {
-		log.Printf("Failed to move order to history: %v", err)
-		return nil, fmt.Errorf("failed to move order to history: %v", err)
+	if !order.EstimateDate.IsZero() {
+		if err := s.updateEstimate(c, order); err != nil {
+			log.Printf("Failed to recompute estimate of order %s: %v", orderID, err)
+		}
 	}
 
-	if err := s.repository.DeleteOrder(c, order); err != nil {
-		log.Printf("Failed to delete order by ID %s: %v", orderID, err)
-		return nil, fmt.Errorf("failed to delete order by ID: %v", orderID)
+	if err := s.repository.UpdateOrder(c, order); err != nil {
+		log.Printf("Failed to update order with ID %s: %v", orderID, err)
+		s.releaseSlot(c, slot)
+		return nil, fmt.Errorf("failed to update order with ID %s: %w", orderID, err)
+	}
+
+	if moved && previousSlotID != nil {
+		_ = s.slots.Release(c, *previousSlotID)
 	}
 
 	return order, nil
 }
 
-func (s *OrderService) PayOrder(c context.Context, orderID string, req *orderRequest.Payment) (*orderModel.Order, error) {
+func (s *OrderService) UpdateOrderStatus(c context.Context, orderID string, userID string, role string, outletID string, req *orderRequest.UpdateStatus) (*orderModel.Order, error) {
 	if err := s.validator.Struct(req); err != nil {
-		log.Printf("Failed to validate Order request: %v", err)
+		log.Printf("Failed to validate status request: %v", err)
 		return nil, fmt.Errorf("validation error: %w", err)
 	}
 
@@ -221,116 +550,322 @@ func (s *OrderService) PayOrder(c context.Context, orderID string, req *orderReq
 		return nil, fmt.Errorf("failed to get order by id: %w", err)
 	}
 
-	if order.Price == nil {
-		log.Printf("Payment is not allowed, invalid price: %v", order.Price)
-		return nil, fmt.Errorf("payment is not allowed, invalid price: %v", order.Price)
+	if err := checkOutlet(order.ID, order.OutletID, outletID); err != nil {
+		log.Printf("Order %s is outside outlet %s", orderID, outletID)
+		return nil, err
 	}
 
-	order.TransactionID = req.TransactionID
+	return s.transition(c, order, orderModel.Status(req.Status), userID, role, req.Note)
+}
 
-	if err := s.repository.UpdateOrder(c, order); err != nil {
-		log.Printf("Failed to update transaction ID: %v", err)
-		return nil, fmt.Errorf("failed to update transaction by id: %v", err)
+func (s *OrderService) GetOrderTimeline(c context.Context, orderID string, userID string, outletID string) ([]*orderModel.OrderStatusEvent, error) {
+	var ownerID int64
+	var ownerOutletID *int64
+
+	if order, err := s.repository.GetOrderByID(c, orderID); err == nil {
+		ownerID, ownerOutletID = order.UserID, order.OutletID
+	} else {
+		history, err := s.repository.GetHistoryByID(c, orderID)
+		if err != nil {
+			log.Printf("Failed to get Order or History by id: %v", err)
+			return nil, fmt.Errorf("failed to get order by id: %w", err)
+		}
+		ownerID, ownerOutletID = history.UserID, history.OutletID
 	}
 
-	return order, nil
+	if userID != "" && strconv.FormatInt(ownerID, 10) != userID {
+		log.Printf("User ID mismatch: expected %v, got %v", userID, ownerID)
+		return nil, fmt.Errorf("user ID mismatch: %v", userID)
+	}
+
+	if err := checkOutlet(orderID, ownerOutletID, outletID); err != nil {
+		log.Printf("Order %s is outside outlet %s", orderID, outletID)
+		return nil, err
+	}
+
+	events, err := s.repository.GetStatusEvents(c, orderID)
+	if err != nil {
+		log.Printf("Failed to get status events for order %s: %v", orderID, err)
+		return nil, fmt.Errorf("failed to get order timeline: %w", err)
+	}
+
+	return events, nil
 }
 
-func (s *OrderService) RejectOrder(c context.Context, orderID string) (*orderModel.Order, error) {
-	var history historyModel.History
+// QuoteOrder prices a prospective order. With an address of userID the quote
+// is made at the outlet serving it and includes the delivery fee.
+func (s *OrderService) QuoteOrder(c context.Context, userID string, req *orderRequest.Quote) (*pricingService.Quote, error) {
+	if err := s.validator.Struct(req); err != nil {
+		log.Printf("Failed to validate quote request: %v", err)
+		return nil, fmt.Errorf("validation error: %w", err)
+	}
 
-	order, err := s.repository.GetOrderByID(c, orderID)
+	quoteReq := &pricingRequest.Quote{
+		ServiceType: req.ServiceType,
+		OrderType:   req.OrderType,
+		Weight:      req.Weight,
+		Items:       req.Items,
+		OutletID:    req.OutletID,
+	}
+
+	if req.AddressID != 0 {
+		addressID := strconv.FormatInt(req.AddressID, 10)
+		address, err := s.addresses.GetAddressByID(c, addressID, userID)
+		if err != nil {
+			log.Printf("Invalid address %s for user %s: %v", addressID, userID, err)
+			return nil, fmt.Errorf("validation error: %w", err)
+		}
+
+		outlet, err := s.outlets.Cover(c, location(address))
+		if err != nil {
+			log.Printf("Failed to find the outlet serving address %s: %v", addressID, err)
+			return nil, err
+		}
+		if outlet != nil {
+			distance := tripDistance(outlet.Location(), location(address))
+			quoteReq.OutletID = outlet.ID
+			quoteReq.DistanceKm = &distance
+		}
+	}
+
+	quote, err := s.pricing.Quote(c, quoteReq)
 	if err != nil {
-		log.Printf("Failed to get Order by id: %v", err)
-		return nil, fmt.Errorf("failed to get order by id: %w", err)
+		log.Printf("Failed to quote order: %v", err)
+		return nil, fmt.Errorf("failed to quote order: %w", err)
 	}
 
-	if order.Status != "created" {
-		log.Printf("Order cannot be rejected in its current status: %v", order.Status)
-		return nil, fmt.Errorf("order cannot be rejected in its current status: %v", order.Status)
+	return quote, nil
+}
+
+// checkCatalog ensures the service and order types of req are catalog
+// entries that can currently be ordered.
+func (s *OrderService) checkCatalog(c context.Context, req *orderRequest.Order) error {
+	if _, err := s.catalog.GetAvailableService(c, serviceModel.KindServiceType, req.ServiceType); err != nil {
+		log.Printf("Invalid service type %s: %v", req.ServiceType, err)
+		return fmt.Errorf("validation error: %w", err)
 	}
 
-	utils.CopyTo(&order, &history)
-	history.Reason = "rejected"
-	history.DeletedAt = time.Now()
+	if _, err := s.catalog.GetAvailableService(c, serviceModel.KindOrderType, req.OrderType); err != nil {
+		log.Printf("Invalid order type %s: %v", req.OrderType, err)
+		return fmt.Errorf("validation error: %w", err)
+	}
 
-	if err := s.repository.CreateHistory(c, &history); err != nil {
-		log.Printf("Failed to move order to history: %v", err)
-		return nil, fmt.Errorf("failed to move order to history: %w", err)
+	return nil
+}
+
+// fillPayment copies what was paid and refunded for order onto its history
+// record so finance can read net amounts without joining transactions.
+func (s *OrderService) fillPayment(c context.Context, order *orderModel.Order, history *historyModel.History) {
+	if order.TransactionID == "" {
+		return
 	}
 
-	if err := s.repository.DeleteOrder(c, order); err != nil {
-		log.Printf("Failed to delete order by ID %s: %v", orderID, err)
-		return nil, fmt.Errorf("failed to delete order by ID %s: %w", orderID, err)
+	transaction, err := s.transactions.GetTransactionByID(c, order.TransactionID)
+	if err != nil {
+		log.Printf("Failed to get transaction %s of order %s: %v", order.TransactionID, order.ID, err)
+		return
 	}
 
-	return order, nil
+	net := transaction.Refundable()
+	history.PaidAmount = transaction.Amount
+	history.RefundedAmount = transaction.RefundedAmount
+	history.NetAmount = &net
 }
 
-func (s *OrderService) CancelOrder(c context.Context, orderID string, userID string) (*orderModel.Order, error) {
-	var history historyModel.History
+// checkAddress ensures req points at one of userID's saved addresses and
+// returns it.
+func (s *OrderService) checkAddress(c context.Context, req *orderRequest.Order, userID string) (*addressModel.Address, error) {
+	addressID := strconv.FormatInt(req.AddressID, 10)
+	address, err := s.addresses.GetAddressByID(c, addressID, userID)
+	if err != nil {
+		log.Printf("Invalid address %s for user %s: %v", addressID, userID, err)
+		return nil, fmt.Errorf("validation error: %w", err)
+	}
 
-	order, err := s.repository.GetOrderByID(c, orderID)
+	return address, nil
+}
+
+// assignOutlet routes order to the outlet serving address at collectDate and
+// records the distance of the trip and its delivery fee. Without any outlets
+// the order stays unassigned and is delivered free.
+func (s *OrderService) assignOutlet(c context.Context, order *orderModel.Order, address *addressModel.Address, collectDate time.Time) error {
+	outlet, err := s.outlets.Route(c, location(address), collectDate)
 	if err != nil {
-		log.Printf("Failed to get Order by id: %v", err)
-		return nil, fmt.Errorf("failed to get order by id: %w", err)
+		return err
 	}
 
-	if strconv.FormatInt(order.UserID, 10) != userID {
-		log.Printf("User ID mismatch")
-		return nil, fmt.Errorf("user ID mismatch: %v", userID)
+	order.OutletID, order.DistanceKm, order.DeliveryFee = nil, nil, nil
+	if outlet == nil {
+		return nil
 	}
 
-	if order.Status != "created" {
-		log.Printf("Order cannot be cancelled in its current status: %v", order.Status)
-		return nil, fmt.Errorf("order cannot be cancelled in its current status: %v", order.Status)
+	distance := tripDistance(outlet.Location(), location(address))
+	fee, err := s.pricing.DeliveryFee(c, distance)
+	if err != nil {
+		return err
 	}
 
-	utils.CopyTo(&order, &history)
-	history.Reason = "cancelled"
-	history.DeletedAt = time.Now()
+	order.OutletID = &outlet.ID
+	order.DistanceKm = &distance
+	order.DeliveryFee = &fee
 
-	if err := s.repository.CreateHistory(c, &history); err != nil {
-		log.Printf("Failed to move order to history: %v", err)
-		return nil, fmt.Errorf("failed to move order to history: %w", err)
+	return nil
+}
+
+// updatePrice recalculates order.Price from the weight and items of the order,
+// the current price list, the overrides of the order's outlet and the
+// delivery fee of its distance.
+func (s *OrderService) updatePrice(c context.Context, order *orderModel.Order) error {
+	var weight float64
+	if order.Weight != nil {
+		weight = *order.Weight
+	}
+
+	var outletID int64
+	if order.OutletID != nil {
+		outletID = *order.OutletID
+	}
+
+	items, itemCharges := itemLoad(order.Items)
+	quote, err := s.pricing.Quote(c, &pricingRequest.Quote{
+		ServiceType: order.ServiceType,
+		OrderType:   order.OrderType,
+		Weight:      weight,
+		Items:       items,
+		ItemCharges: itemCharges,
+		OutletID:    outletID,
+		DistanceKm:  order.DistanceKm,
+	})
+	if err != nil {
+		log.Printf("Failed to calculate price for order %s: %v", order.ID, err)
+		return fmt.Errorf("failed to calculate order price: %w", err)
 	}
 
-	if err := s.repository.DeleteOrder(c, order); err != nil {
-		log.Printf("Failed to delete order by ID %s: %v", orderID, err)
-		return nil, fmt.Errorf("failed to delete order by ID %s: %w", orderID, err)
+	order.Price = &quote.Total
+
+	return nil
+}
+
+// transition moves order to the given status after checking the transition
+// table, and records who made the change. Terminal statuses archive the order.
+func (s *OrderService) transition(c context.Context, order *orderModel.Order, to orderModel.Status, userID string, role string, note string) (*orderModel.Order, error) {
+	event, history, err := s.step(c, order, to, userID, role, note)
+	if err != nil {
+		return nil, err
 	}
 
-	return order, nil
+	return s.commit(c, order, event, history)
 }
 
-func (s *OrderService) EditOrder(c context.Context, orderID string, userID string, req *orderRequest.Order) (*orderModel.Order, error) {
-	if err := s.validator.Struct(req); err != nil {
-		log.Printf("Validation failed for update profile request: %v", err)
-		return nil, fmt.Errorf("validation failed: %w", err)
+// MoveOrder moves an order through each status of path in turn, checking
+// every step as UpdateOrderStatus does, and saves records in the same
+// database transaction. Couriers use it to close a job together with the
+// order. Only the last status may archive the order.
+func (s *OrderService) MoveOrder(c context.Context, orderID string, userID string, role string, path []orderModel.Status, records ...any) (*orderModel.Order, error) {
+	if len(path) == 0 {
+		return nil, fmt.Errorf("validation error: no status to move order %s to", orderID)
 	}
 
 	order, err := s.repository.GetOrderByID(c, orderID)
 	if err != nil {
 		log.Printf("Failed to get Order by id: %v", err)
-		return nil, fmt.Errorf("failed to get order by id: %v", err)
+		return nil, fmt.Errorf("failed to get order by id: %w", err)
 	}
 
-	if strconv.FormatInt(order.UserID, 10) != userID {
-		log.Printf("User ID mismatch")
-		return nil, fmt.Errorf("user ID mismatch: %v", userID)
+	steps := make([]any, 0, len(path)-1+len(records))
+	for _, to := range path[:len(path)-1] {
+		if terminalStatuses[to] {
+			return nil, fmt.Errorf("%w: %s must be the last status", ErrInvalidTransition, to)
+		}
+
+		event, _, err := s.step(c, order, to, userID, role, "")
+		if err != nil {
+			return nil, err
+		}
+		steps = append(steps, event)
 	}
 
-	if order.Status != "created" {
-		log.Printf("Editing is not allowed for orders with status: %v", order.Status)
-		return nil, fmt.Errorf("editing is not allowed for orders with status: %v", order.Status)
+	event, history, err := s.step(c, order, path[len(path)-1], userID, role, "")
+	if err != nil {
+		return nil, err
 	}
 
-	utils.CopyTo(&req, order)
+	return s.commit(c, order, event, history, append(steps, records...)...)
+}
 
-	if err := s.repository.UpdateOrder(c, order); err != nil {
-		log.Printf("Failed to update order with ID %s: %v", orderID, err)
-		return nil, fmt.Errorf("failed to update order with ID %s: %w", orderID, err)
+// step checks that order may move to the given status and applies the change
+// in memory. It returns the audit event and, for terminal statuses, the
+// history record to write.
+func (s *OrderService) step(c context.Context, order *orderModel.Order, to orderModel.Status, userID string, role string, note string) (*orderModel.OrderStatusEvent, *historyModel.History, error) {
+	if err := checkTransition(order, to, userID, role); err != nil {
+		log.Printf("Order %s cannot move to %s: %v", order.ID, to, err)
+		return nil, nil, err
+	}
+
+	actorID, _ := strconv.ParseInt(userID, 10, 64)
+	event := &orderModel.OrderStatusEvent{
+		OrderID:    order.ID,
+		FromStatus: order.Status,
+		ToStatus:   to,
+		ActorID:    actorID,
+		ActorRole:  role,
+		Note:       note,
+	}
+
+	// Accepting commits the laundry to a ready date. A failed estimate must
+	// not block the order, it is only logged.
+	if to == orderModel.StatusAccepted {
+		if err := s.updateEstimate(c, order); err != nil {
+			log.Printf("Failed to estimate order %s: %v", order.ID, err)
+		}
+	}
+
+	order.Status = to
+
+	var history *historyModel.History
+	if terminalStatuses[to] {
+		history = &historyModel.History{}
+		utils.CopyTo(order, history)
+		history.DeletedAt = time.Now()
+		history.Reason = note
+		if history.Reason == "" && to != orderModel.StatusCompleted {
+			history.Reason = string(to)
+		}
+		s.fillPayment(c, order, history)
+	}
+
+	return event, history, nil
+}
+
+// commit writes a status change prepared by step together with records.
+func (s *OrderService) commit(c context.Context, order *orderModel.Order, event *orderModel.OrderStatusEvent, history *historyModel.History, records ...any) (*orderModel.Order, error) {
+	if err := s.repository.TransitionOrder(c, order, event, history, records...); err != nil {
+		log.Printf("Failed to move order %s to %s: %v", order.ID, event.ToStatus, err)
+		return nil, fmt.Errorf("failed to update order status: %w", err)
+	}
+
+	// A cancelled or rejected order no longer needs its pickup, so its place
+	// in the slot goes back to other customers.
+	if (order.Status == orderModel.StatusCancelled || order.Status == orderModel.StatusRejected) && order.PickupSlotID != nil {
+		_ = s.slots.Release(c, *order.PickupSlotID)
 	}
 
 	return order, nil
 }
+
+// releaseSlot gives back a slot reserved by a change that did not go through.
+func (s *OrderService) releaseSlot(c context.Context, slot *slotModel.PickupSlot) {
+	if slot != nil {
+		_ = s.slots.Release(c, slot.ID)
+	}
+}
+
+func location(address *addressModel.Address) geo.Point {
+	return geo.Point{Lat: address.Latitude, Lng: address.Longitude}
+}
+
+// tripDistance is the straight-line distance between an outlet and an
+// address in kilometres, to the metre precision fees are charged at.
+func tripDistance(from geo.Point, to geo.Point) float64 {
+	return math.Round(geo.Distance(from, to)*100) / 100
+}
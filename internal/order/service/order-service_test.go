@@ -0,0 +1,1101 @@
+package orderService
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+	addressModel "washit-api/internal/address/dto/model"
+	addressMocks "washit-api/internal/address/service/mock"
+	calendarService "washit-api/internal/calendar/service"
+	calendarMocks "washit-api/internal/calendar/service/mock"
+	historyModel "washit-api/internal/history/dto/model"
+	orderModel "washit-api/internal/order/dto/model"
+	orderRequest "washit-api/internal/order/dto/request"
+	mocks "washit-api/internal/order/repository/mock"
+	outletModel "washit-api/internal/outlet/dto/model"
+	outletService "washit-api/internal/outlet/service"
+	outletMocks "washit-api/internal/outlet/service/mock"
+	pricingRequest "washit-api/internal/pricing/dto/request"
+	pricingService "washit-api/internal/pricing/service"
+	pricingMocks "washit-api/internal/pricing/service/mock"
+	serviceModel "washit-api/internal/service/dto/model"
+	serviceMocks "washit-api/internal/service/service/mock"
+	slotModel "washit-api/internal/slot/dto/model"
+	slotService "washit-api/internal/slot/service"
+	slotMocks "washit-api/internal/slot/service/mock"
+	transactionModel "washit-api/internal/transaction/dto/model"
+	transactionMocks "washit-api/internal/transaction/repository/mock"
+	userService "washit-api/internal/user/service"
+	userMocks "washit-api/internal/user/service/mock"
+	"washit-api/pkg/geo"
+	"washit-api/pkg/label"
+	"washit-api/pkg/rbac"
+	"washit-api/pkg/worktime"
+
+	"github.com/go-playground/validator"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type OrderServiceTestSuite struct {
+	suite.Suite
+	mockRepo      *mocks.IOrderRepository
+	mockPricing   *pricingMocks.IPricingService
+	mockCatalog   *serviceMocks.IServiceService
+	mockAddress   *addressMocks.IAddressService
+	mockTrx       *transactionMocks.ITransactionRepository
+	mockUsers     *userMocks.IUserService
+	mockSlots     *slotMocks.ISlotService
+	mockCalendars *calendarMocks.ICalendarService
+	mockOutlets   *outletMocks.IOutletService
+	service       IOrderService
+}
+
+func (suite *OrderServiceTestSuite) SetupTest() {
+	validator := validator.New()
+	suite.mockRepo = new(mocks.IOrderRepository)
+	suite.mockPricing = new(pricingMocks.IPricingService)
+	suite.mockCatalog = new(serviceMocks.IServiceService)
+	suite.mockAddress = new(addressMocks.IAddressService)
+	suite.mockTrx = new(transactionMocks.ITransactionRepository)
+	suite.mockUsers = new(userMocks.IUserService)
+	suite.mockSlots = new(slotMocks.ISlotService)
+	suite.mockCalendars = new(calendarMocks.ICalendarService)
+	suite.mockCalendars.On("Load", mock.Anything).
+		Return(&worktime.Weekly{Open: 8 * time.Hour, Close: 18 * time.Hour, Zone: time.UTC}, nil).Maybe()
+	suite.mockOutlets = new(outletMocks.IOutletService)
+	service := NewOrderService(
+		suite.mockRepo, suite.mockPricing, suite.mockCatalog, suite.mockAddress, suite.mockTrx, suite.mockUsers,
+		suite.mockSlots, suite.mockCalendars, suite.mockOutlets, validator)
+	service.loadKg, service.loadHours, service.backlogPerHour = 8, 1, 10
+	suite.service = service
+}
+
+// mondayMorning is 09:00 on Monday 7 January 2030, far enough ahead that
+// estimates start at the collect date rather than now.
+var mondayMorning = time.Date(2030, time.January, 7, 9, 0, 0, 0, time.UTC)
+
+func TestOrderServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(OrderServiceTestSuite))
+}
+
+// CreateOrder
+// =================================================================
+
+func (suite *OrderServiceTestSuite) TestCreateOrderSuccess() {
+	req := &orderRequest.Order{
+		AddressID:   1,
+		ServiceType: "wash",
+		OrderType:   "regular",
+		CollectDate: time.Now().Add(24 * time.Hour),
+	}
+
+	suite.mockUsers.On("EnsureEmailVerified", mock.Anything, "1").
+		Return(nil).Times(1)
+	suite.mockCatalog.On("GetAvailableService", mock.Anything, serviceModel.KindServiceType, "wash").
+		Return(&serviceModel.Service{}, nil).Times(1)
+	suite.mockCatalog.On("GetAvailableService", mock.Anything, serviceModel.KindOrderType, "regular").
+		Return(&serviceModel.Service{}, nil).Times(1)
+	suite.mockAddress.On("GetAddressByID", mock.Anything, "1", "1").
+		Return(&addressModel.Address{ID: 1, UserID: 1, Latitude: -6.2, Longitude: 106.8}, nil).Times(1)
+	suite.mockCalendars.On("CheckOpen", mock.Anything, req.CollectDate).
+		Return(nil).Times(1)
+	suite.mockOutlets.On("Route", mock.Anything, geo.Point{Lat: -6.2, Lng: 106.8}, req.CollectDate).
+		Return(&outletModel.Outlet{ID: 5, Latitude: -6.2, Longitude: 106.82}, nil).Times(1)
+	suite.mockPricing.On("DeliveryFee", mock.Anything, 2.21).
+		Return(decimal.NewFromInt(5000), nil).Times(1)
+	startsAt := req.CollectDate.Truncate(time.Hour)
+	suite.mockSlots.On("Reserve", mock.Anything, req.CollectDate).
+		Return(&slotModel.PickupSlot{ID: 3, StartsAt: startsAt}, nil).Times(1)
+
+	suite.mockRepo.On("CreateOrder", mock.Anything, mock.Anything, mock.Anything).
+		Return(func(_ context.Context, order *orderModel.Order, _ *orderModel.OrderStatusEvent) (*orderModel.Order, error) {
+			return order, nil
+		}).Times(1)
+
+	order, err := suite.service.CreateOrder(context.Background(), "1", req)
+	suite.Nil(err)
+	suite.Equal(orderModel.StatusCreated, order.Status)
+	suite.Equal(int64(1), order.UserID)
+	suite.Equal(int64(3), *order.PickupSlotID)
+	suite.Equal(startsAt, order.CollectDate)
+	suite.Equal(int64(5), *order.OutletID)
+	suite.Equal(2.21, *order.DistanceKm)
+	suite.True(decimal.NewFromInt(5000).Equal(*order.DeliveryFee))
+	suite.Regexp(`^BAG-[2-9A-HJ-NP-Z]{8}$`, *order.TagCode)
+}
+
+func (suite *OrderServiceTestSuite) TestCreateOrderBeyondDeliveryRange() {
+	req := &orderRequest.Order{
+		AddressID:   1,
+		ServiceType: "wash",
+		OrderType:   "regular",
+		CollectDate: time.Now().Add(24 * time.Hour),
+	}
+
+	suite.mockUsers.On("EnsureEmailVerified", mock.Anything, "1").
+		Return(nil).Times(1)
+	suite.mockCatalog.On("GetAvailableService", mock.Anything, mock.Anything, mock.Anything).
+		Return(&serviceModel.Service{}, nil).Times(2)
+	suite.mockAddress.On("GetAddressByID", mock.Anything, "1", "1").
+		Return(&addressModel.Address{ID: 1, UserID: 1, Latitude: -6.2, Longitude: 106.8}, nil).Times(1)
+	suite.mockCalendars.On("CheckOpen", mock.Anything, req.CollectDate).
+		Return(nil).Times(1)
+	suite.mockOutlets.On("Route", mock.Anything, mock.Anything, req.CollectDate).
+		Return(&outletModel.Outlet{ID: 5, Latitude: -6.4, Longitude: 106.8}, nil).Times(1)
+	suite.mockPricing.On("DeliveryFee", mock.Anything, mock.Anything).
+		Return(decimal.Zero, pricingService.ErrOutOfDeliveryRange).Times(1)
+
+	order, err := suite.service.CreateOrder(context.Background(), "1", req)
+	suite.Nil(order)
+	suite.ErrorIs(err, pricingService.ErrOutOfDeliveryRange)
+	suite.mockSlots.AssertNotCalled(suite.T(), "Reserve", mock.Anything, mock.Anything)
+}
+
+func (suite *OrderServiceTestSuite) TestCreateOrderNoServingOutlet() {
+	req := &orderRequest.Order{
+		AddressID:   1,
+		ServiceType: "wash",
+		OrderType:   "regular",
+		CollectDate: time.Now().Add(24 * time.Hour),
+	}
+
+	suite.mockUsers.On("EnsureEmailVerified", mock.Anything, "1").
+		Return(nil).Times(1)
+	suite.mockCatalog.On("GetAvailableService", mock.Anything, mock.Anything, mock.Anything).
+		Return(&serviceModel.Service{}, nil).Times(2)
+	suite.mockAddress.On("GetAddressByID", mock.Anything, "1", "1").
+		Return(&addressModel.Address{ID: 1, UserID: 1}, nil).Times(1)
+	suite.mockCalendars.On("CheckOpen", mock.Anything, req.CollectDate).
+		Return(nil).Times(1)
+	suite.mockOutlets.On("Route", mock.Anything, mock.Anything, req.CollectDate).
+		Return(nil, outletService.ErrNoOutlet).Times(1)
+
+	order, err := suite.service.CreateOrder(context.Background(), "1", req)
+	suite.Nil(order)
+	suite.ErrorIs(err, outletService.ErrNoOutlet)
+	suite.mockSlots.AssertNotCalled(suite.T(), "Reserve", mock.Anything, mock.Anything)
+}
+
+func (suite *OrderServiceTestSuite) TestCreateOrderOutsideOpeningHours() {
+	req := &orderRequest.Order{
+		AddressID:   1,
+		ServiceType: "wash",
+		OrderType:   "regular",
+		CollectDate: mondayMorning.Add(-3 * time.Hour),
+	}
+
+	suite.mockUsers.On("EnsureEmailVerified", mock.Anything, "1").
+		Return(nil).Times(1)
+	suite.mockCatalog.On("GetAvailableService", mock.Anything, mock.Anything, mock.Anything).
+		Return(&serviceModel.Service{}, nil).Times(2)
+	suite.mockAddress.On("GetAddressByID", mock.Anything, "1", "1").
+		Return(&addressModel.Address{ID: 1, UserID: 1}, nil).Times(1)
+	suite.mockCalendars.On("CheckOpen", mock.Anything, req.CollectDate).
+		Return(calendarService.ErrClosed).Times(1)
+
+	order, err := suite.service.CreateOrder(context.Background(), "1", req)
+	suite.Nil(order)
+	suite.ErrorIs(err, calendarService.ErrClosed)
+	suite.mockSlots.AssertNotCalled(suite.T(), "Reserve", mock.Anything, mock.Anything)
+}
+
+func (suite *OrderServiceTestSuite) TestCreateOrderSlotFull() {
+	req := &orderRequest.Order{
+		AddressID:   1,
+		ServiceType: "wash",
+		OrderType:   "regular",
+		CollectDate: time.Now().Add(24 * time.Hour),
+	}
+
+	suite.mockUsers.On("EnsureEmailVerified", mock.Anything, "1").
+		Return(nil).Times(1)
+	suite.mockCatalog.On("GetAvailableService", mock.Anything, mock.Anything, mock.Anything).
+		Return(&serviceModel.Service{}, nil).Times(2)
+	suite.mockAddress.On("GetAddressByID", mock.Anything, "1", "1").
+		Return(&addressModel.Address{ID: 1, UserID: 1}, nil).Times(1)
+	suite.mockCalendars.On("CheckOpen", mock.Anything, req.CollectDate).
+		Return(nil).Times(1)
+	suite.mockOutlets.On("Route", mock.Anything, mock.Anything, req.CollectDate).
+		Return(nil, nil).Times(1)
+	suite.mockSlots.On("Reserve", mock.Anything, req.CollectDate).
+		Return(nil, slotService.ErrSlotFull).Times(1)
+
+	order, err := suite.service.CreateOrder(context.Background(), "1", req)
+	suite.Nil(order)
+	suite.ErrorIs(err, slotService.ErrSlotFull)
+	suite.mockRepo.AssertNotCalled(suite.T(), "CreateOrder", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *OrderServiceTestSuite) TestCreateOrderWithoutPickupWindows() {
+	req := &orderRequest.Order{
+		AddressID:   1,
+		ServiceType: "wash",
+		OrderType:   "regular",
+		CollectDate: time.Now().Add(24 * time.Hour),
+	}
+
+	suite.mockUsers.On("EnsureEmailVerified", mock.Anything, "1").
+		Return(nil).Times(1)
+	suite.mockCatalog.On("GetAvailableService", mock.Anything, mock.Anything, mock.Anything).
+		Return(&serviceModel.Service{}, nil).Times(2)
+	suite.mockAddress.On("GetAddressByID", mock.Anything, "1", "1").
+		Return(&addressModel.Address{ID: 1, UserID: 1}, nil).Times(1)
+	suite.mockCalendars.On("CheckOpen", mock.Anything, req.CollectDate).
+		Return(nil).Times(1)
+	suite.mockOutlets.On("Route", mock.Anything, mock.Anything, req.CollectDate).
+		Return(nil, nil).Times(1)
+	suite.mockSlots.On("Reserve", mock.Anything, req.CollectDate).
+		Return(nil, nil).Times(1)
+	suite.mockRepo.On("CreateOrder", mock.Anything, mock.Anything, mock.Anything).
+		Return(func(_ context.Context, order *orderModel.Order, _ *orderModel.OrderStatusEvent) (*orderModel.Order, error) {
+			return order, nil
+		}).Times(1)
+
+	order, err := suite.service.CreateOrder(context.Background(), "1", req)
+	suite.Nil(err)
+	suite.Nil(order.PickupSlotID)
+	suite.True(req.CollectDate.Equal(order.CollectDate))
+}
+
+func (suite *OrderServiceTestSuite) TestCreateOrderReleasesSlotOnFailure() {
+	req := &orderRequest.Order{
+		AddressID:   1,
+		ServiceType: "wash",
+		OrderType:   "regular",
+		CollectDate: time.Now().Add(24 * time.Hour),
+	}
+
+	suite.mockUsers.On("EnsureEmailVerified", mock.Anything, "1").
+		Return(nil).Times(1)
+	suite.mockCatalog.On("GetAvailableService", mock.Anything, mock.Anything, mock.Anything).
+		Return(&serviceModel.Service{}, nil).Times(2)
+	suite.mockAddress.On("GetAddressByID", mock.Anything, "1", "1").
+		Return(&addressModel.Address{ID: 1, UserID: 1}, nil).Times(1)
+	suite.mockCalendars.On("CheckOpen", mock.Anything, req.CollectDate).
+		Return(nil).Times(1)
+	suite.mockOutlets.On("Route", mock.Anything, mock.Anything, req.CollectDate).
+		Return(nil, nil).Times(1)
+	suite.mockSlots.On("Reserve", mock.Anything, req.CollectDate).
+		Return(&slotModel.PickupSlot{ID: 3}, nil).Times(1)
+	suite.mockRepo.On("CreateOrder", mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, errors.New("db down")).Times(1)
+	suite.mockSlots.On("Release", mock.Anything, int64(3)).
+		Return(nil).Times(1)
+
+	order, err := suite.service.CreateOrder(context.Background(), "1", req)
+	suite.Nil(order)
+	suite.NotNil(err)
+	suite.mockSlots.AssertExpectations(suite.T())
+}
+
+func (suite *OrderServiceTestSuite) TestCreateOrderUnavailableService() {
+	req := &orderRequest.Order{
+		AddressID:   1,
+		ServiceType: "wash",
+		OrderType:   "regular",
+		CollectDate: time.Now().Add(24 * time.Hour),
+	}
+
+	suite.mockUsers.On("EnsureEmailVerified", mock.Anything, "1").
+		Return(nil).Times(1)
+	suite.mockCatalog.On("GetAvailableService", mock.Anything, serviceModel.KindServiceType, "wash").
+		Return(nil, errors.New("service_type is not available")).Times(1)
+
+	order, err := suite.service.CreateOrder(context.Background(), "1", req)
+	suite.Nil(order)
+	suite.NotNil(err)
+}
+
+func (suite *OrderServiceTestSuite) TestCreateOrderUnverifiedEmail() {
+	req := &orderRequest.Order{
+		AddressID:   1,
+		ServiceType: "wash",
+		OrderType:   "regular",
+		CollectDate: time.Now().Add(24 * time.Hour),
+	}
+
+	suite.mockUsers.On("EnsureEmailVerified", mock.Anything, "1").
+		Return(userService.ErrEmailNotVerified).Times(1)
+
+	order, err := suite.service.CreateOrder(context.Background(), "1", req)
+	suite.Nil(order)
+	suite.ErrorIs(err, userService.ErrEmailNotVerified)
+	suite.mockRepo.AssertNotCalled(suite.T(), "CreateOrder", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *OrderServiceTestSuite) TestCreateOrderForeignAddress() {
+	req := &orderRequest.Order{
+		AddressID:   7,
+		ServiceType: "wash",
+		OrderType:   "regular",
+		CollectDate: time.Now().Add(24 * time.Hour),
+	}
+
+	suite.mockUsers.On("EnsureEmailVerified", mock.Anything, "1").
+		Return(nil).Times(1)
+	suite.mockCatalog.On("GetAvailableService", mock.Anything, mock.Anything, mock.Anything).
+		Return(&serviceModel.Service{}, nil).Times(2)
+	suite.mockAddress.On("GetAddressByID", mock.Anything, "7", "1").
+		Return(nil, errors.New("address not found: 7")).Times(1)
+
+	order, err := suite.service.CreateOrder(context.Background(), "1", req)
+	suite.Nil(order)
+	suite.NotNil(err)
+	suite.mockRepo.AssertNotCalled(suite.T(), "CreateOrder", mock.Anything, mock.Anything, mock.Anything)
+}
+
+// EditOrder
+// =================================================================
+
+func (suite *OrderServiceTestSuite) expectEdit(req *orderRequest.Order, slotID int64) {
+	suite.mockRepo.On("GetOrderByID", mock.Anything, "ORD-1").
+		Return(&orderModel.Order{
+			ID: "ORD-1", UserID: 1, AddressID: 1, Status: orderModel.StatusCreated,
+			ServiceType: "wash", OrderType: "regular", CollectDate: mondayMorning, PickupSlotID: &slotID,
+		}, nil).Times(1)
+	suite.mockCatalog.On("GetAvailableService", mock.Anything, mock.Anything, mock.Anything).
+		Return(&serviceModel.Service{}, nil).Times(2)
+	suite.mockAddress.On("GetAddressByID", mock.Anything, "1", "1").
+		Return(&addressModel.Address{ID: 1, UserID: 1}, nil).Times(1)
+	suite.mockOutlets.On("Route", mock.Anything, mock.Anything, req.CollectDate).
+		Return(nil, nil).Times(1)
+	suite.mockCalendars.On("CheckOpen", mock.Anything, req.CollectDate).
+		Return(nil).Times(1)
+	suite.mockRepo.On("UpdateOrder", mock.Anything, mock.Anything).
+		Return(nil).Times(1)
+}
+
+func (suite *OrderServiceTestSuite) TestEditOrderWithinSameSlot() {
+	req := &orderRequest.Order{
+		AddressID: 1, ServiceType: "wash", OrderType: "regular", CollectDate: mondayMorning.Add(30 * time.Minute),
+	}
+
+	suite.expectEdit(req, 3)
+	suite.mockSlots.On("Resolve", mock.Anything, req.CollectDate).
+		Return(&slotModel.PickupSlot{ID: 3, StartsAt: mondayMorning}, nil).Times(1)
+
+	order, err := suite.service.EditOrder(context.Background(), "ORD-1", "1", req)
+	suite.Nil(err)
+	suite.Equal(int64(3), *order.PickupSlotID)
+	suite.True(mondayMorning.Equal(order.CollectDate))
+	suite.mockSlots.AssertNotCalled(suite.T(), "Reserve", mock.Anything, mock.Anything)
+	suite.mockSlots.AssertNotCalled(suite.T(), "Release", mock.Anything, mock.Anything)
+}
+
+func (suite *OrderServiceTestSuite) TestEditOrderToAnotherSlot() {
+	req := &orderRequest.Order{
+		AddressID: 1, ServiceType: "wash", OrderType: "regular", CollectDate: mondayMorning.Add(2 * time.Hour),
+	}
+
+	suite.expectEdit(req, 3)
+	suite.mockSlots.On("Resolve", mock.Anything, req.CollectDate).
+		Return(&slotModel.PickupSlot{WindowID: 2, StartsAt: req.CollectDate}, nil).Times(1)
+	suite.mockSlots.On("Reserve", mock.Anything, req.CollectDate).
+		Return(&slotModel.PickupSlot{ID: 4, StartsAt: req.CollectDate}, nil).Times(1)
+	suite.mockSlots.On("Release", mock.Anything, int64(3)).
+		Return(nil).Times(1)
+
+	order, err := suite.service.EditOrder(context.Background(), "ORD-1", "1", req)
+	suite.Nil(err)
+	suite.Equal(int64(4), *order.PickupSlotID)
+	suite.mockSlots.AssertExpectations(suite.T())
+}
+
+// UpdateOrderStatus
+// =================================================================
+
+func (suite *OrderServiceTestSuite) TestUpdateOrderStatusSuccess() {
+	req := &orderRequest.UpdateStatus{Status: "picked_up", Note: "bag collected"}
+
+	suite.mockRepo.On("GetOrderByID", mock.Anything, "ORD-1").
+		Return(&orderModel.Order{ID: "ORD-1", UserID: 1, Status: orderModel.StatusAccepted}, nil).Times(1)
+
+	suite.mockRepo.On("TransitionOrder", mock.Anything, mock.Anything,
+		mock.MatchedBy(func(event *orderModel.OrderStatusEvent) bool {
+			return event.FromStatus == orderModel.StatusAccepted &&
+				event.ToStatus == orderModel.StatusPickedUp &&
+				event.ActorID == 2 && event.Note == req.Note
+		}), (*historyModel.History)(nil)).
+		Return(nil).Times(1)
+
+	order, err := suite.service.UpdateOrderStatus(context.Background(), "ORD-1", "2", RoleAdmin, "", req)
+	suite.Nil(err)
+	suite.Equal(orderModel.StatusPickedUp, order.Status)
+}
+
+func (suite *OrderServiceTestSuite) TestUpdateOrderStatusInvalidTransition() {
+	req := &orderRequest.UpdateStatus{Status: "delivered"}
+
+	suite.mockRepo.On("GetOrderByID", mock.Anything, "ORD-1").
+		Return(&orderModel.Order{ID: "ORD-1", UserID: 1, Status: orderModel.StatusCreated}, nil).Times(1)
+
+	order, err := suite.service.UpdateOrderStatus(context.Background(), "ORD-1", "2", RoleAdmin, "", req)
+	suite.Nil(order)
+	suite.True(errors.Is(err, ErrInvalidTransition))
+}
+
+func (suite *OrderServiceTestSuite) TestUpdateOrderStatusRoleNotAllowed() {
+	req := &orderRequest.UpdateStatus{Status: "accepted"}
+
+	suite.mockRepo.On("GetOrderByID", mock.Anything, "ORD-1").
+		Return(&orderModel.Order{ID: "ORD-1", UserID: 1, Status: orderModel.StatusCreated}, nil).Times(1)
+
+	order, err := suite.service.UpdateOrderStatus(context.Background(), "ORD-1", "1", RoleCustomer, "", req)
+	suite.Nil(order)
+	suite.True(errors.Is(err, ErrTransitionNotAllowed))
+}
+
+func (suite *OrderServiceTestSuite) TestUpdateOrderStatusByPermission() {
+	req := &orderRequest.UpdateStatus{Status: "washing"}
+
+	suite.mockRepo.On("GetOrderByID", mock.Anything, "ORD-1").
+		Return(&orderModel.Order{ID: "ORD-1", UserID: 1, Status: orderModel.StatusPickedUp}, nil).Times(1)
+	suite.mockRepo.On("TransitionOrder", mock.Anything, mock.Anything,
+		mock.MatchedBy(func(event *orderModel.OrderStatusEvent) bool {
+			return event.ToStatus == orderModel.StatusWashing && event.ActorRole == rbac.RoleOutletStaff
+		}), (*historyModel.History)(nil)).
+		Return(nil).Times(1)
+
+	order, err := suite.service.UpdateOrderStatus(context.Background(), "ORD-1", "3", rbac.RoleOutletStaff, "", req)
+	suite.Nil(err)
+	suite.Equal(orderModel.StatusWashing, order.Status)
+}
+
+func (suite *OrderServiceTestSuite) TestUpdateOrderStatusMissingPermission() {
+	req := &orderRequest.UpdateStatus{Status: "washing"}
+
+	suite.mockRepo.On("GetOrderByID", mock.Anything, "ORD-1").
+		Return(&orderModel.Order{ID: "ORD-1", UserID: 1, Status: orderModel.StatusPickedUp}, nil).Times(1)
+
+	order, err := suite.service.UpdateOrderStatus(context.Background(), "ORD-1", "4", rbac.RoleCourier, "", req)
+	suite.Nil(order)
+	suite.True(errors.Is(err, ErrTransitionNotAllowed))
+}
+
+// CancelOrder
+// =================================================================
+
+func (suite *OrderServiceTestSuite) TestMoveOrderSavesStepsAndRecords() {
+	job := &struct{ ID int64 }{ID: 9}
+	suite.mockRepo.On("GetOrderByID", mock.Anything, "ORD-1").
+		Return(&orderModel.Order{ID: "ORD-1", UserID: 1, Status: orderModel.StatusReady}, nil).Times(1)
+	suite.mockRepo.On("TransitionOrder", mock.Anything, mock.Anything,
+		mock.MatchedBy(func(event *orderModel.OrderStatusEvent) bool {
+			return event.FromStatus == orderModel.StatusOutForDelivery && event.ToStatus == orderModel.StatusDelivered
+		}), (*historyModel.History)(nil),
+		mock.MatchedBy(func(event *orderModel.OrderStatusEvent) bool {
+			return event.FromStatus == orderModel.StatusReady && event.ToStatus == orderModel.StatusOutForDelivery
+		}), job).
+		Return(nil).Times(1)
+
+	order, err := suite.service.MoveOrder(context.Background(), "ORD-1", "8", rbac.RoleCourier,
+		[]orderModel.Status{orderModel.StatusOutForDelivery, orderModel.StatusDelivered}, job)
+	suite.Nil(err)
+	suite.Equal(orderModel.StatusDelivered, order.Status)
+}
+
+func (suite *OrderServiceTestSuite) TestMoveOrderInvalidStep() {
+	suite.mockRepo.On("GetOrderByID", mock.Anything, "ORD-1").
+		Return(&orderModel.Order{ID: "ORD-1", UserID: 1, Status: orderModel.StatusWashing}, nil).Times(1)
+
+	order, err := suite.service.MoveOrder(context.Background(), "ORD-1", "8", rbac.RoleCourier,
+		[]orderModel.Status{orderModel.StatusOutForDelivery, orderModel.StatusDelivered})
+	suite.Nil(order)
+	suite.ErrorIs(err, ErrInvalidTransition)
+	suite.mockRepo.AssertNotCalled(suite.T(), "TransitionOrder", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *OrderServiceTestSuite) TestCancelOrderNotOwner() {
+	suite.mockRepo.On("GetOrderByID", mock.Anything, "ORD-1").
+		Return(&orderModel.Order{ID: "ORD-1", UserID: 1, Status: orderModel.StatusCreated}, nil).Times(1)
+
+	order, err := suite.service.CancelOrder(context.Background(), "ORD-1", "2", RoleCustomer, "")
+	suite.Nil(order)
+	suite.True(errors.Is(err, ErrTransitionNotAllowed))
+}
+
+func (suite *OrderServiceTestSuite) TestCancelOrderArchivesToHistory() {
+	suite.mockRepo.On("GetOrderByID", mock.Anything, "ORD-1").
+		Return(&orderModel.Order{ID: "ORD-1", UserID: 1, Status: orderModel.StatusCreated}, nil).Times(1)
+
+	suite.mockRepo.On("TransitionOrder", mock.Anything, mock.Anything, mock.Anything,
+		mock.MatchedBy(func(history *historyModel.History) bool {
+			return history != nil && history.ID == "ORD-1" &&
+				history.Status == "cancelled" && history.Reason == "cancelled"
+		})).
+		Return(nil).Times(1)
+
+	order, err := suite.service.CancelOrder(context.Background(), "ORD-1", "1", RoleCustomer, "")
+	suite.Nil(err)
+	suite.Equal(orderModel.StatusCancelled, order.Status)
+}
+
+func (suite *OrderServiceTestSuite) TestCancelOrderReleasesSlot() {
+	slotID := int64(3)
+	suite.mockRepo.On("GetOrderByID", mock.Anything, "ORD-1").
+		Return(&orderModel.Order{ID: "ORD-1", UserID: 1, Status: orderModel.StatusCreated, PickupSlotID: &slotID}, nil).Times(1)
+	suite.mockRepo.On("TransitionOrder", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil).Times(1)
+	suite.mockSlots.On("Release", mock.Anything, slotID).
+		Return(nil).Times(1)
+
+	order, err := suite.service.CancelOrder(context.Background(), "ORD-1", "1", RoleCustomer, "")
+	suite.Nil(err)
+	suite.Equal(orderModel.StatusCancelled, order.Status)
+	suite.mockSlots.AssertExpectations(suite.T())
+}
+
+func (suite *OrderServiceTestSuite) TestCancelOrderByStaffAfterAcceptance() {
+	suite.mockRepo.On("GetOrderByID", mock.Anything, "ORD-1").
+		Return(&orderModel.Order{ID: "ORD-1", UserID: 1, Status: orderModel.StatusAccepted}, nil).Times(1)
+	suite.mockRepo.On("TransitionOrder", mock.Anything, mock.Anything,
+		mock.MatchedBy(func(event *orderModel.OrderStatusEvent) bool {
+			return event.ActorRole == rbac.RoleOutletStaff
+		}), mock.Anything).
+		Return(nil).Times(1)
+
+	order, err := suite.service.CancelOrder(context.Background(), "ORD-1", "5", rbac.RoleOutletStaff, "")
+	suite.Nil(err)
+	suite.Equal(orderModel.StatusCancelled, order.Status)
+}
+
+func (suite *OrderServiceTestSuite) TestAcceptOrderByCustomer() {
+	suite.mockRepo.On("GetOrderByID", mock.Anything, "ORD-1").
+		Return(&orderModel.Order{ID: "ORD-1", UserID: 1, Status: orderModel.StatusCreated}, nil).Times(1)
+
+	order, err := suite.service.AcceptOrder(context.Background(), "ORD-1", "1", RoleCustomer, "")
+	suite.Nil(order)
+	suite.ErrorIs(err, ErrTransitionNotAllowed)
+	suite.mockRepo.AssertNotCalled(suite.T(), "TransitionOrder", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+// Outlet
+// =================================================================
+
+func (suite *OrderServiceTestSuite) TestAcceptOrderOfOtherOutlet() {
+	outletID := int64(2)
+	suite.mockRepo.On("GetOrderByID", mock.Anything, "ORD-1").
+		Return(&orderModel.Order{ID: "ORD-1", UserID: 1, Status: orderModel.StatusCreated, OutletID: &outletID}, nil).Times(1)
+
+	order, err := suite.service.AcceptOrder(context.Background(), "ORD-1", "5", rbac.RoleOutletStaff, "1")
+	suite.Nil(order)
+	suite.ErrorIs(err, ErrOtherOutlet)
+	suite.mockRepo.AssertNotCalled(suite.T(), "TransitionOrder", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *OrderServiceTestSuite) TestAddOrderItemOfOtherOutlet() {
+	outletID := int64(2)
+	suite.mockRepo.On("GetOrderByID", mock.Anything, "ORD-1").
+		Return(&orderModel.Order{ID: "ORD-1", Status: orderModel.StatusAccepted, OutletID: &outletID}, nil).Times(1)
+
+	order, err := suite.service.AddOrderItem(context.Background(), "ORD-1", "1", &orderRequest.OrderItem{
+		GarmentType: "shirt",
+		Quantity:    1,
+	})
+	suite.Nil(order)
+	suite.ErrorIs(err, ErrOtherOutlet)
+	suite.mockRepo.AssertNotCalled(suite.T(), "CreateOrderItem", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *OrderServiceTestSuite) TestScanTagOfOtherOutlet() {
+	code := "BAG-ABCDEFGH"
+	suite.mockRepo.On("GetOrderByTag", mock.Anything, code).
+		Return(&orderModel.Order{ID: "ORD-1", TagCode: &code}, nil).Times(1)
+
+	scan, err := suite.service.ScanTag(context.Background(), code, "1")
+	suite.Nil(scan)
+	suite.ErrorIs(err, ErrOtherOutlet)
+}
+
+func (suite *OrderServiceTestSuite) TestUpdateWeightOfOwnOutlet() {
+	outletID := int64(1)
+	suite.mockRepo.On("GetOrderByID", mock.Anything, "ORD-1").
+		Return(&orderModel.Order{ID: "ORD-1", Status: orderModel.StatusAccepted, ServiceType: "wash", OrderType: "regular", OutletID: &outletID}, nil).Times(1)
+	suite.mockPricing.On("Quote", mock.Anything, mock.Anything).
+		Return(&pricingService.Quote{Total: decimal.NewFromInt(10500)}, nil).Times(1)
+	suite.mockRepo.On("UpdateOrder", mock.Anything, mock.Anything).
+		Return(nil).Times(1)
+
+	order, err := suite.service.UpdateWeight(context.Background(), "ORD-1", "3", "1")
+	suite.Nil(err)
+	suite.Equal(3.0, *order.Weight)
+}
+
+// Estimate
+// =================================================================
+
+func (suite *OrderServiceTestSuite) TestAcceptOrderSetsEstimate() {
+	outletID := int64(5)
+	suite.mockRepo.On("GetOrderByID", mock.Anything, "ORD-1").
+		Return(&orderModel.Order{
+			ID: "ORD-1", UserID: 1, Status: orderModel.StatusCreated, OutletID: &outletID,
+			ServiceType: "wash", OrderType: "regular", CollectDate: mondayMorning,
+		}, nil).Times(1)
+	suite.mockCatalog.On("GetAvailableService", mock.Anything, serviceModel.KindServiceType, "wash").
+		Return(&serviceModel.Service{TurnaroundHours: 24}, nil).Times(1)
+	suite.mockCatalog.On("GetAvailableService", mock.Anything, serviceModel.KindOrderType, "regular").
+		Return(&serviceModel.Service{}, nil).Times(1)
+	// Only the backlog of the order's own outlet is ahead of it.
+	suite.mockRepo.On("CountOrdersByStatus", mock.Anything, backlogStatuses, "5").
+		Return(int64(25), nil).Times(1)
+	suite.mockRepo.On("TransitionOrder", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil).Times(1)
+
+	order, err := suite.service.AcceptOrder(context.Background(), "ORD-1", "2", RoleAdmin, "")
+	suite.Nil(err)
+	// 24 turnaround and 2 backlog hours from Monday 09:00 with 10 hour days.
+	suite.Equal(time.Date(2030, time.January, 9, 15, 0, 0, 0, time.UTC), order.EstimateDate)
+}
+
+func (suite *OrderServiceTestSuite) TestEstimateOrderExpressSkipsBacklog() {
+	suite.mockCatalog.On("GetAvailableService", mock.Anything, serviceModel.KindServiceType, "wash").
+		Return(&serviceModel.Service{TurnaroundHours: 24}, nil).Times(1)
+	suite.mockCatalog.On("GetAvailableService", mock.Anything, serviceModel.KindOrderType, "express").
+		Return(&serviceModel.Service{TurnaroundHours: 6}, nil).Times(1)
+
+	estimate, err := suite.service.EstimateOrder(context.Background(), &orderRequest.Estimate{
+		ServiceType: "wash",
+		OrderType:   "express",
+		Weight:      20,
+		CollectDate: mondayMorning,
+	})
+	suite.Nil(err)
+	suite.Equal(6, estimate.TurnaroundHours)
+	suite.Equal(2, estimate.LoadHours)
+	suite.Equal(0, estimate.BacklogHours)
+	suite.Equal(time.Date(2030, time.January, 7, 17, 0, 0, 0, time.UTC), estimate.EstimateDate)
+	suite.mockRepo.AssertNotCalled(suite.T(), "CountOrdersByStatus", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *OrderServiceTestSuite) TestUpdateWeightRecomputesEstimate() {
+	price := decimal.NewFromInt(30000)
+	suite.mockRepo.On("GetOrderByID", mock.Anything, "ORD-1").
+		Return(&orderModel.Order{
+			ID: "ORD-1", Status: orderModel.StatusAccepted, ServiceType: "wash", OrderType: "regular",
+			CollectDate: mondayMorning, EstimateDate: mondayMorning.Add(8 * time.Hour),
+		}, nil).Times(1)
+	suite.mockPricing.On("Quote", mock.Anything, mock.Anything).
+		Return(&pricingService.Quote{Total: price}, nil).Times(1)
+	suite.mockCatalog.On("GetAvailableService", mock.Anything, serviceModel.KindServiceType, "wash").
+		Return(&serviceModel.Service{TurnaroundHours: 8}, nil).Times(1)
+	suite.mockCatalog.On("GetAvailableService", mock.Anything, serviceModel.KindOrderType, "regular").
+		Return(&serviceModel.Service{}, nil).Times(1)
+	// The order itself is one of the 11 in progress and does not queue behind itself.
+	suite.mockRepo.On("CountOrdersByStatus", mock.Anything, backlogStatuses, "").
+		Return(int64(11), nil).Times(1)
+	suite.mockRepo.On("UpdateOrder", mock.Anything, mock.Anything).
+		Return(nil).Times(1)
+
+	order, err := suite.service.UpdateWeight(context.Background(), "ORD-1", "10", "")
+	suite.Nil(err)
+	// 8 turnaround, 1 extra load and 1 backlog hour.
+	suite.Equal(time.Date(2030, time.January, 8, 9, 0, 0, 0, time.UTC), order.EstimateDate)
+}
+
+// CompleteOrder
+// =================================================================
+
+func (suite *OrderServiceTestSuite) TestCompleteOrderMissingTransaction() {
+	suite.mockRepo.On("GetOrderByID", mock.Anything, "ORD-1").
+		Return(&orderModel.Order{ID: "ORD-1", UserID: 1, Status: orderModel.StatusDelivered}, nil).Times(1)
+
+	order, err := suite.service.CompleteOrder(context.Background(), "ORD-1", "1", RoleCustomer, "")
+	suite.Nil(order)
+	suite.NotNil(err)
+}
+
+func (suite *OrderServiceTestSuite) TestCompleteOrderNotDelivered() {
+	suite.mockRepo.On("GetOrderByID", mock.Anything, "ORD-1").
+		Return(&orderModel.Order{ID: "ORD-1", UserID: 1, Status: orderModel.StatusWashing, TransactionID: "TRX-1"}, nil).Times(1)
+
+	order, err := suite.service.CompleteOrder(context.Background(), "ORD-1", "1", RoleCustomer, "")
+	suite.Nil(order)
+	suite.True(errors.Is(err, ErrInvalidTransition))
+}
+
+func (suite *OrderServiceTestSuite) TestCompleteOrderArchivesPayment() {
+	paid := decimal.NewFromInt(21000)
+	refunded := decimal.NewFromInt(5000)
+
+	suite.mockRepo.On("GetOrderByID", mock.Anything, "ORD-1").
+		Return(&orderModel.Order{ID: "ORD-1", UserID: 1, Status: orderModel.StatusDelivered, TransactionID: "TRX-1"}, nil).Times(1)
+	suite.mockTrx.On("GetTransactionByID", mock.Anything, "TRX-1").
+		Return(&transactionModel.Transaction{ID: "TRX-1", Amount: &paid, RefundedAmount: &refunded}, nil).Times(1)
+	suite.mockRepo.On("TransitionOrder", mock.Anything, mock.Anything, mock.Anything,
+		mock.MatchedBy(func(history *historyModel.History) bool {
+			return history != nil && history.TransactionID == "TRX-1" &&
+				paid.Equal(*history.PaidAmount) && decimal.NewFromInt(16000).Equal(*history.NetAmount)
+		})).
+		Return(nil).Times(1)
+
+	order, err := suite.service.CompleteOrder(context.Background(), "ORD-1", "1", RoleCustomer, "")
+	suite.Nil(err)
+	suite.Equal(orderModel.StatusCompleted, order.Status)
+}
+
+// UpdateWeight
+// =================================================================
+
+func (suite *OrderServiceTestSuite) TestUpdateWeightAfterIntake() {
+	suite.mockRepo.On("GetOrderByID", mock.Anything, "ORD-1").
+		Return(&orderModel.Order{ID: "ORD-1", Status: orderModel.StatusWashing, ServiceType: "wash", OrderType: "regular"}, nil).Times(1)
+
+	order, err := suite.service.UpdateWeight(context.Background(), "ORD-1", "4", "")
+	suite.Nil(order)
+	suite.ErrorIs(err, ErrWeightLocked)
+	suite.mockRepo.AssertNotCalled(suite.T(), "UpdateOrder", mock.Anything, mock.Anything)
+}
+
+func (suite *OrderServiceTestSuite) TestUpdateWeightPaid() {
+	suite.mockRepo.On("GetOrderByID", mock.Anything, "ORD-1").
+		Return(&orderModel.Order{
+			ID: "ORD-1", Status: orderModel.StatusAccepted, ServiceType: "wash", OrderType: "regular", TransactionID: "TRX-1",
+		}, nil).Times(1)
+
+	order, err := suite.service.UpdateWeight(context.Background(), "ORD-1", "4", "")
+	suite.Nil(order)
+	suite.ErrorIs(err, ErrWeightLocked)
+	suite.mockPricing.AssertNotCalled(suite.T(), "Quote", mock.Anything, mock.Anything)
+	suite.mockRepo.AssertNotCalled(suite.T(), "UpdateOrder", mock.Anything, mock.Anything)
+}
+
+func (suite *OrderServiceTestSuite) TestUpdateWeightRecalculatesPrice() {
+	total := decimal.NewFromInt(21000)
+
+	suite.mockRepo.On("GetOrderByID", mock.Anything, "ORD-1").
+		Return(&orderModel.Order{ID: "ORD-1", Status: orderModel.StatusAccepted, ServiceType: "wash", OrderType: "regular"}, nil).Times(1)
+
+	suite.mockPricing.On("Quote", mock.Anything, mock.Anything).
+		Return(&pricingService.Quote{Total: total}, nil).Times(1)
+
+	suite.mockRepo.On("UpdateOrder", mock.Anything, mock.Anything).
+		Return(nil).Times(1)
+
+	order, err := suite.service.UpdateWeight(context.Background(), "ORD-1", "3.5", "")
+	suite.Nil(err)
+	suite.Equal(3.5, *order.Weight)
+	suite.True(total.Equal(*order.Price))
+}
+
+func (suite *OrderServiceTestSuite) TestUpdateWeightMissingPriceList() {
+	suite.mockRepo.On("GetOrderByID", mock.Anything, "ORD-1").
+		Return(&orderModel.Order{ID: "ORD-1", Status: orderModel.StatusAccepted, ServiceType: "unknown", OrderType: "regular"}, nil).Times(1)
+
+	suite.mockPricing.On("Quote", mock.Anything, mock.Anything).
+		Return(nil, errors.New("no price list")).Times(1)
+
+	order, err := suite.service.UpdateWeight(context.Background(), "ORD-1", "3.5", "")
+	suite.Nil(order)
+	suite.NotNil(err)
+}
+
+func (suite *OrderServiceTestSuite) TestUpdateWeightChargesDeliveryDistance() {
+	distance := 4.5
+
+	suite.mockRepo.On("GetOrderByID", mock.Anything, "ORD-1").
+		Return(&orderModel.Order{ID: "ORD-1", Status: orderModel.StatusAccepted, ServiceType: "wash", OrderType: "regular", DistanceKm: &distance}, nil).Times(1)
+
+	suite.mockPricing.On("Quote", mock.Anything, mock.MatchedBy(func(req *pricingRequest.Quote) bool {
+		return req.DistanceKm != nil && *req.DistanceKm == distance
+	})).Return(&pricingService.Quote{Total: decimal.NewFromInt(26000)}, nil).Times(1)
+
+	suite.mockRepo.On("UpdateOrder", mock.Anything, mock.Anything).
+		Return(nil).Times(1)
+
+	order, err := suite.service.UpdateWeight(context.Background(), "ORD-1", "3", "")
+	suite.Nil(err)
+	suite.True(decimal.NewFromInt(26000).Equal(*order.Price))
+}
+
+// QuoteOrder
+// =================================================================
+
+func (suite *OrderServiceTestSuite) TestQuoteOrderAtAddress() {
+	suite.mockAddress.On("GetAddressByID", mock.Anything, "1", "1").
+		Return(&addressModel.Address{ID: 1, UserID: 1, Latitude: -6.2, Longitude: 106.8}, nil).Times(1)
+	suite.mockOutlets.On("Cover", mock.Anything, geo.Point{Lat: -6.2, Lng: 106.8}).
+		Return(&outletModel.Outlet{ID: 5, Latitude: -6.2, Longitude: 106.82}, nil).Times(1)
+	suite.mockPricing.On("Quote", mock.Anything, mock.MatchedBy(func(req *pricingRequest.Quote) bool {
+		return req.OutletID == 5 && req.DistanceKm != nil && *req.DistanceKm == 2.21
+	})).Return(&pricingService.Quote{Total: decimal.NewFromInt(26000)}, nil).Times(1)
+
+	quote, err := suite.service.QuoteOrder(context.Background(), "1", &orderRequest.Quote{
+		ServiceType: "wash",
+		OrderType:   "regular",
+		Weight:      3,
+		AddressID:   1,
+	})
+	suite.Nil(err)
+	suite.True(decimal.NewFromInt(26000).Equal(quote.Total))
+}
+
+func (suite *OrderServiceTestSuite) TestQuoteOrderOutOfArea() {
+	suite.mockAddress.On("GetAddressByID", mock.Anything, "1", "1").
+		Return(&addressModel.Address{ID: 1, UserID: 1, Latitude: -6.6, Longitude: 106.8}, nil).Times(1)
+	suite.mockOutlets.On("Cover", mock.Anything, mock.Anything).
+		Return(nil, outletService.ErrOutOfArea).Times(1)
+
+	quote, err := suite.service.QuoteOrder(context.Background(), "1", &orderRequest.Quote{
+		ServiceType: "wash",
+		OrderType:   "regular",
+		AddressID:   1,
+	})
+	suite.Nil(quote)
+	suite.ErrorIs(err, outletService.ErrOutOfArea)
+	suite.mockPricing.AssertNotCalled(suite.T(), "Quote", mock.Anything, mock.Anything)
+}
+
+// Order Items
+// =================================================================
+
+func (suite *OrderServiceTestSuite) TestAddOrderItemReprices() {
+	weight := 2.0
+	suite.mockRepo.On("GetOrderByID", mock.Anything, "ORD-1").
+		Return(&orderModel.Order{
+			ID: "ORD-1", Status: orderModel.StatusAccepted, ServiceType: "dry_clean", OrderType: "regular", Weight: &weight,
+			Items: []orderModel.OrderItem{{ID: 1, OrderID: "ORD-1", GarmentType: "shirt", Quantity: 3}},
+		}, nil).Times(1)
+
+	suite.mockPricing.On("Quote", mock.Anything, mock.MatchedBy(func(req *pricingRequest.Quote) bool {
+		return req.Weight == 2 && req.Items == 3 && req.ItemCharges.Equal(decimal.NewFromInt(50000))
+	})).Return(&pricingService.Quote{Total: decimal.NewFromInt(71500)}, nil).Times(1)
+
+	suite.mockRepo.On("CreateOrderItem", mock.Anything, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			args.Get(2).(*orderModel.OrderItem).ID = 2
+		}).Return(nil).Times(1)
+
+	order, err := suite.service.AddOrderItem(context.Background(), "ORD-1", "", &orderRequest.OrderItem{
+		GarmentType: "suit",
+		Quantity:    2,
+		UnitPrice:   decimal.NewFromInt(25000),
+		DamageNotes: "missing button",
+	})
+	suite.Nil(err)
+	suite.Len(order.Items, 2)
+	suite.Equal(int64(2), order.Items[1].ID)
+	suite.Regexp(`^GMT-`, *order.Items[1].TagCode)
+	suite.True(decimal.NewFromInt(71500).Equal(*order.Price))
+}
+
+func (suite *OrderServiceTestSuite) TestAddOrderItemLocked() {
+	suite.mockRepo.On("GetOrderByID", mock.Anything, "ORD-1").
+		Return(&orderModel.Order{ID: "ORD-1", Status: orderModel.StatusWashing}, nil).Times(1)
+
+	order, err := suite.service.AddOrderItem(context.Background(), "ORD-1", "", &orderRequest.OrderItem{
+		GarmentType: "shirt",
+		Quantity:    1,
+	})
+	suite.Nil(order)
+	suite.ErrorIs(err, ErrItemsLocked)
+	suite.mockRepo.AssertNotCalled(suite.T(), "CreateOrderItem", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *OrderServiceTestSuite) TestAddOrderItemPaid() {
+	suite.mockRepo.On("GetOrderByID", mock.Anything, "ORD-1").
+		Return(&orderModel.Order{ID: "ORD-1", Status: orderModel.StatusAccepted, TransactionID: "TRX-1"}, nil).Times(1)
+
+	order, err := suite.service.AddOrderItem(context.Background(), "ORD-1", "", &orderRequest.OrderItem{
+		GarmentType: "shirt",
+		Quantity:    1,
+	})
+	suite.Nil(order)
+	suite.ErrorIs(err, ErrItemsLocked)
+	suite.mockRepo.AssertNotCalled(suite.T(), "CreateOrderItem", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *OrderServiceTestSuite) TestUpdateOrderItemSavesOrderWithItem() {
+	suite.mockRepo.On("GetOrderByID", mock.Anything, "ORD-1").
+		Return(&orderModel.Order{
+			ID: "ORD-1", Status: orderModel.StatusCreated, ServiceType: "dry_clean", OrderType: "regular",
+			Items: []orderModel.OrderItem{{ID: 4, OrderID: "ORD-1", GarmentType: "dress", Quantity: 1}},
+		}, nil).Times(1)
+	suite.mockPricing.On("Quote", mock.Anything, mock.Anything).
+		Return(&pricingService.Quote{Total: decimal.NewFromInt(30000)}, nil).Times(1)
+	suite.mockRepo.On("SaveOrderItem", mock.Anything,
+		mock.MatchedBy(func(order *orderModel.Order) bool {
+			return decimal.NewFromInt(30000).Equal(*order.Price)
+		}),
+		mock.MatchedBy(func(item *orderModel.OrderItem) bool {
+			return item.ID == 4 && item.Quantity == 2
+		})).
+		Return(nil).Times(1)
+
+	order, err := suite.service.UpdateOrderItem(context.Background(), "ORD-1", "4", "", &orderRequest.OrderItem{
+		GarmentType: "dress",
+		Quantity:    2,
+	})
+	suite.Nil(err)
+	suite.Equal(2, order.Items[0].Quantity)
+	suite.mockRepo.AssertNotCalled(suite.T(), "UpdateOrder", mock.Anything, mock.Anything)
+}
+
+func (suite *OrderServiceTestSuite) TestDeleteLastOrderItemClearsPrice() {
+	price := decimal.NewFromInt(15000)
+	suite.mockRepo.On("GetOrderByID", mock.Anything, "ORD-1").
+		Return(&orderModel.Order{
+			ID: "ORD-1", Status: orderModel.StatusCreated, Price: &price,
+			Items: []orderModel.OrderItem{{ID: 4, OrderID: "ORD-1", GarmentType: "dress", Quantity: 1}},
+		}, nil).Times(1)
+	suite.mockRepo.On("DeleteOrderItem", mock.Anything, mock.MatchedBy(func(order *orderModel.Order) bool {
+		return len(order.Items) == 0 && order.Price == nil
+	}), mock.Anything).
+		Return(nil).Times(1)
+
+	order, err := suite.service.DeleteOrderItem(context.Background(), "ORD-1", "4", "")
+	suite.Nil(err)
+	suite.Empty(order.Items)
+	suite.Nil(order.Price)
+	suite.mockPricing.AssertNotCalled(suite.T(), "Quote", mock.Anything, mock.Anything)
+}
+
+func (suite *OrderServiceTestSuite) TestUpdateOrderItemNotFound() {
+	suite.mockRepo.On("GetOrderByID", mock.Anything, "ORD-1").
+		Return(&orderModel.Order{ID: "ORD-1", Status: orderModel.StatusCreated}, nil).Times(1)
+
+	order, err := suite.service.UpdateOrderItem(context.Background(), "ORD-1", "9", "", &orderRequest.OrderItem{
+		GarmentType: "shirt",
+		Quantity:    1,
+	})
+	suite.Nil(order)
+	suite.ErrorIs(err, ErrItemNotFound)
+}
+
+func (suite *OrderServiceTestSuite) TestCancelOrderSnapshotsItems() {
+	suite.mockRepo.On("GetOrderByID", mock.Anything, "ORD-1").
+		Return(&orderModel.Order{
+			ID: "ORD-1", UserID: 1, Status: orderModel.StatusCreated,
+			Items: []orderModel.OrderItem{{ID: 4, OrderID: "ORD-1", GarmentType: "coat", Quantity: 1, Photos: []string{"ORD-1-4-1.jpg"}}},
+		}, nil).Times(1)
+	suite.mockRepo.On("TransitionOrder", mock.Anything, mock.Anything, mock.Anything,
+		mock.MatchedBy(func(history *historyModel.History) bool {
+			return len(history.Items) == 1 && history.Items[0].GarmentType == "coat" &&
+				history.Items[0].Photos[0] == "ORD-1-4-1.jpg"
+		})).Return(nil).Times(1)
+
+	_, err := suite.service.CancelOrder(context.Background(), "ORD-1", "1", RoleCustomer, "")
+	suite.Nil(err)
+}
+
+func (suite *OrderServiceTestSuite) TestAddOrderItemPhotoRejectsOtherTypes() {
+	item, err := suite.service.AddOrderItemPhoto(context.Background(), "ORD-1", "4", "",
+		&orderRequest.ItemPhoto{Image: []byte("<svg xmlns=\"http://www.w3.org/2000/svg\"></svg>")})
+	suite.Nil(item)
+	suite.ErrorIs(err, ErrPhotoType)
+	suite.mockRepo.AssertNotCalled(suite.T(), "GetOrderByID", mock.Anything, mock.Anything)
+}
+
+func (suite *OrderServiceTestSuite) TestAddOrderItemPhotoTooLarge() {
+	image := append([]byte("\x89PNG\r\n\x1a\n"), make([]byte, 2<<20)...)
+
+	item, err := suite.service.AddOrderItemPhoto(context.Background(), "ORD-1", "4", "",
+		&orderRequest.ItemPhoto{Image: image})
+	suite.Nil(item)
+	suite.NotNil(err)
+	suite.mockRepo.AssertNotCalled(suite.T(), "GetOrderByID", mock.Anything, mock.Anything)
+}
+
+// PayOrder
+// =================================================================
+
+func (suite *OrderServiceTestSuite) TestOrderLabelsTagsLegacyItems() {
+	bag := "BAG-23456789"
+	suite.mockRepo.On("GetOrderByID", mock.Anything, "ORD-1").
+		Return(&orderModel.Order{
+			ID: "ORD-1", Status: orderModel.StatusAccepted, TagCode: &bag,
+			Items: []orderModel.OrderItem{{ID: 1, OrderID: "ORD-1", GarmentType: "shirt", Quantity: 2}},
+		}, nil).Times(1)
+	suite.mockRepo.On("UpdateOrderItem", mock.Anything, mock.MatchedBy(func(item *orderModel.OrderItem) bool {
+		return item.ID == 1 && item.TagCode != nil
+	})).Return(nil).Times(1)
+
+	body, err := suite.service.OrderLabels(context.Background(), "ORD-1", "", LabelPDF, label.QR)
+	suite.Nil(err)
+	suite.True(bytes.HasPrefix(body, []byte("%PDF-")))
+	suite.mockRepo.AssertNotCalled(suite.T(), "UpdateOrder", mock.Anything, mock.Anything)
+}
+
+func (suite *OrderServiceTestSuite) TestOrderLabelsUnknownFormat() {
+	body, err := suite.service.OrderLabels(context.Background(), "ORD-1", "", "svg", label.Code128)
+	suite.Nil(body)
+	suite.ErrorIs(err, ErrLabelFormat)
+	suite.mockRepo.AssertNotCalled(suite.T(), "GetOrderByID", mock.Anything, mock.Anything)
+}
+
+func (suite *OrderServiceTestSuite) TestScanTagItem() {
+	code := "GMT-ABCDEFGH"
+	suite.mockRepo.On("GetOrderItemByTag", mock.Anything, code).
+		Return(&orderModel.OrderItem{ID: 7, OrderID: "ORD-1", TagCode: &code}, nil).Times(1)
+	suite.mockRepo.On("GetOrderByID", mock.Anything, "ORD-1").
+		Return(&orderModel.Order{ID: "ORD-1"}, nil).Times(1)
+
+	scan, err := suite.service.ScanTag(context.Background(), " gmt-abcdefgh ", "")
+	suite.Nil(err)
+	suite.Equal("ORD-1", scan.Order.ID)
+	suite.Equal(int64(7), scan.Item.ID)
+}
+
+func (suite *OrderServiceTestSuite) TestScanTagNotFound() {
+	suite.mockRepo.On("GetOrderByTag", mock.Anything, "BAG-ABCDEFGH").
+		Return(nil, nil).Times(1)
+
+	scan, err := suite.service.ScanTag(context.Background(), "BAG-ABCDEFGH", "")
+	suite.Nil(scan)
+	suite.ErrorIs(err, ErrTagNotFound)
+
+	scan, err = suite.service.ScanTag(context.Background(), "ORD-ABCDEFGH", "")
+	suite.Nil(scan)
+	suite.ErrorIs(err, ErrTagNotFound)
+}
+
+func (suite *OrderServiceTestSuite) TestPayOrderSuccess() {
+	price := decimal.NewFromInt(21000)
+
+	suite.mockRepo.On("GetOrderByID", mock.Anything, "ORD-1").
+		Return(&orderModel.Order{ID: "ORD-1", UserID: 1, Price: &price}, nil).Times(1)
+	suite.mockTrx.On("GetTransactionByID", mock.Anything, "TRX-1").
+		Return(&transactionModel.Transaction{
+			ID: "TRX-1", OrderID: "ORD-1", UserID: 1, Status: transactionModel.StatusPaid, Amount: &price,
+		}, nil).Times(1)
+	suite.mockRepo.On("UpdateOrder", mock.Anything, mock.Anything).
+		Return(nil).Times(1)
+
+	order, err := suite.service.PayOrder(context.Background(), "ORD-1", "1", &orderRequest.Payment{TransactionID: "TRX-1"})
+	suite.Nil(err)
+	suite.Equal("TRX-1", order.TransactionID)
+}
+
+func (suite *OrderServiceTestSuite) TestPayOrderPendingTransaction() {
+	price := decimal.NewFromInt(21000)
+
+	suite.mockRepo.On("GetOrderByID", mock.Anything, "ORD-1").
+		Return(&orderModel.Order{ID: "ORD-1", UserID: 1, Price: &price}, nil).Times(1)
+	suite.mockTrx.On("GetTransactionByID", mock.Anything, "TRX-1").
+		Return(&transactionModel.Transaction{
+			ID: "TRX-1", OrderID: "ORD-1", UserID: 1, Status: transactionModel.StatusPending, Amount: &price,
+		}, nil).Times(1)
+
+	order, err := suite.service.PayOrder(context.Background(), "ORD-1", "1", &orderRequest.Payment{TransactionID: "TRX-1"})
+	suite.Nil(order)
+	suite.NotNil(err)
+	suite.mockRepo.AssertNotCalled(suite.T(), "UpdateOrder", mock.Anything, mock.Anything)
+}
+
+func (suite *OrderServiceTestSuite) TestPayOrderForeignTransaction() {
+	price := decimal.NewFromInt(21000)
+
+	suite.mockRepo.On("GetOrderByID", mock.Anything, "ORD-1").
+		Return(&orderModel.Order{ID: "ORD-1", UserID: 1, Price: &price}, nil).Times(1)
+	suite.mockTrx.On("GetTransactionByID", mock.Anything, "TRX-2").
+		Return(&transactionModel.Transaction{
+			ID: "TRX-2", OrderID: "ORD-2", UserID: 1, Status: transactionModel.StatusPaid, Amount: &price,
+		}, nil).Times(1)
+
+	order, err := suite.service.PayOrder(context.Background(), "ORD-1", "1", &orderRequest.Payment{TransactionID: "TRX-2"})
+	suite.Nil(order)
+	suite.NotNil(err)
+}
@@ -0,0 +1,114 @@
+package orderService
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	orderModel "washit-api/internal/order/dto/model"
+	"washit-api/pkg/rbac"
+)
+
+const (
+	RoleAdmin    = rbac.RoleAdmin
+	RoleCustomer = rbac.RoleCustomer
+)
+
+var (
+	ErrInvalidTransition    = errors.New("invalid order status transition")
+	ErrTransitionNotAllowed = errors.New("order status transition not allowed")
+	ErrOtherOutlet          = errors.New("order belongs to another outlet")
+)
+
+// transition describes a single allowed edge of the order lifecycle. Staff
+// holding permission may take it on any order; when owner is set the
+// customer who placed the order may take it too. guard runs any extra check
+// the edge needs before the change is written.
+type transition struct {
+	permission rbac.Permission
+	owner      bool
+	guard      func(order *orderModel.Order) error
+}
+
+var transitions = map[orderModel.Status]map[orderModel.Status]transition{
+	orderModel.StatusCreated: {
+		orderModel.StatusAccepted:  {permission: rbac.OrderAccept},
+		orderModel.StatusRejected:  {permission: rbac.OrderAccept},
+		orderModel.StatusCancelled: {permission: rbac.OrderCancel, owner: true},
+	},
+	orderModel.StatusAccepted: {
+		orderModel.StatusPickedUp:  {permission: rbac.OrderDeliver},
+		orderModel.StatusCancelled: {permission: rbac.OrderCancel},
+	},
+	orderModel.StatusPickedUp: {
+		orderModel.StatusWashing: {permission: rbac.OrderProcess},
+	},
+	orderModel.StatusWashing: {
+		orderModel.StatusReady: {permission: rbac.OrderProcess},
+	},
+	orderModel.StatusReady: {
+		orderModel.StatusOutForDelivery: {permission: rbac.OrderDeliver},
+	},
+	orderModel.StatusOutForDelivery: {
+		orderModel.StatusDelivered: {permission: rbac.OrderDeliver},
+	},
+	orderModel.StatusDelivered: {
+		orderModel.StatusCompleted: {permission: rbac.OrderProcess, owner: true, guard: requirePayment},
+	},
+}
+
+// terminalStatuses end the lifecycle; reaching one archives the order to history.
+var terminalStatuses = map[orderModel.Status]bool{
+	orderModel.StatusCompleted: true,
+	orderModel.StatusRejected:  true,
+	orderModel.StatusCancelled: true,
+}
+
+func requirePayment(order *orderModel.Order) error {
+	if order.TransactionID == "" {
+		return fmt.Errorf("order cannot be completed due to missing transaction ID")
+	}
+
+	return nil
+}
+
+// checkTransition validates that role may move order to the given status.
+// Roles without the edge's permission are additionally required to own the
+// order.
+func checkTransition(order *orderModel.Order, to orderModel.Status, userID string, role string) error {
+	edge, ok := transitions[order.Status][to]
+	if !ok {
+		return fmt.Errorf("%w: %s -> %s", ErrInvalidTransition, order.Status, to)
+	}
+
+	if !rbac.Can(role, edge.permission) {
+		if !edge.owner {
+			return fmt.Errorf("%w: %s cannot move order from %s to %s", ErrTransitionNotAllowed, role, order.Status, to)
+		}
+
+		if strconv.FormatInt(order.UserID, 10) != userID {
+			return fmt.Errorf("%w: user ID mismatch: %v", ErrTransitionNotAllowed, userID)
+		}
+	}
+
+	if edge.guard != nil {
+		return edge.guard(order)
+	}
+
+	return nil
+}
+
+// checkOutlet refuses staff bound to outletID an order of any other outlet,
+// given the ID and outlet of the order. An empty outletID is not bound to any
+// outlet.
+func checkOutlet(orderID string, orderOutletID *int64, outletID string) error {
+	if outletID == "" {
+		return nil
+	}
+
+	if orderOutletID == nil || strconv.FormatInt(*orderOutletID, 10) != outletID {
+		return fmt.Errorf("%w: %s", ErrOtherOutlet, orderID)
+	}
+
+	return nil
+}
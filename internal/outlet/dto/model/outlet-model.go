@@ -0,0 +1,51 @@
+package outletModel
+
+import (
+	"time"
+
+	"washit-api/pkg/geo"
+)
+
+// Outlet is a branch of the laundry. It takes orders collected inside its
+// ServiceArea, a GeoJSON polygon, or without one within ServiceRadiusKm of
+// its location. OpenTime and CloseTime are HH:MM wall clock
+// times in the business time zone that narrow the business hours for pickups
+// at this outlet; when empty the outlet keeps the business hours.
+type Outlet struct {
+	ID              int64       `json:"id" gorm:"primaryKey"`
+	Code            string      `json:"code" gorm:"not null;uniqueIndex"`
+	Name            string      `json:"name" gorm:"not null"`
+	Phone           string      `json:"phone"`
+	Street          string      `json:"street"`
+	City            string      `json:"city"`
+	PostalCode      string      `json:"postalCode"`
+	Latitude        float64     `json:"latitude"`
+	Longitude       float64     `json:"longitude"`
+	ServiceRadiusKm float64     `json:"serviceRadiusKm" gorm:"not null;default:0"`
+	ServiceArea     geo.GeoJSON `json:"serviceArea" gorm:"type:text"`
+	OpenTime        string      `json:"openTime"`
+	CloseTime       string      `json:"closeTime"`
+	IsActive        bool        `json:"isActive" gorm:"not null"`
+	CreatedAt       time.Time   `json:"createdAt"`
+	UpdatedAt       time.Time   `json:"updatedAt"`
+}
+
+// Location is where the outlet is.
+func (o *Outlet) Location() geo.Point {
+	return geo.Point{Lat: o.Latitude, Lng: o.Longitude}
+}
+
+// Serves reports whether the outlet collects from p. An area that no longer
+// parses serves nothing.
+func (o *Outlet) Serves(p geo.Point) bool {
+	if len(o.ServiceArea) == 0 {
+		return geo.Distance(p, o.Location()) <= o.ServiceRadiusKm
+	}
+
+	area, err := geo.ParseArea(o.ServiceArea)
+	if err != nil {
+		return false
+	}
+
+	return area.Contains(p)
+}
@@ -0,0 +1,21 @@
+package outletRequest
+
+import "encoding/json"
+
+type Outlet struct {
+	Code            string  `json:"code" validate:"required,max=20"`
+	Name            string  `json:"name" validate:"required"`
+	Phone           string  `json:"phone"`
+	Street          string  `json:"street" validate:"required"`
+	City            string  `json:"city" validate:"required"`
+	PostalCode      string  `json:"postalCode"`
+	Latitude        float64 `json:"latitude" validate:"latitude"`
+	Longitude       float64 `json:"longitude" validate:"longitude"`
+	ServiceRadiusKm float64 `json:"serviceRadiusKm" validate:"gte=0"`
+	// ServiceArea is a GeoJSON Polygon, MultiPolygon, Feature or
+	// FeatureCollection. It takes precedence over ServiceRadiusKm.
+	ServiceArea json.RawMessage `json:"serviceArea" swaggertype:"object"`
+	OpenTime    string          `json:"openTime"`
+	CloseTime   string          `json:"closeTime"`
+	IsActive    *bool           `json:"isActive"`
+}
@@ -0,0 +1,35 @@
+package outletResource
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type Outlet struct {
+	ID              int64           `json:"id"`
+	Code            string          `json:"code"`
+	Name            string          `json:"name"`
+	Phone           string          `json:"phone"`
+	Street          string          `json:"street"`
+	City            string          `json:"city"`
+	PostalCode      string          `json:"postalCode"`
+	Latitude        float64         `json:"latitude"`
+	Longitude       float64         `json:"longitude"`
+	ServiceRadiusKm float64         `json:"serviceRadiusKm"`
+	ServiceArea     json.RawMessage `json:"serviceArea" swaggertype:"object"`
+	OpenTime        string          `json:"openTime"`
+	CloseTime       string          `json:"closeTime"`
+	IsActive        bool            `json:"isActive"`
+	CreatedAt       time.Time       `json:"createdAt"`
+	UpdatedAt       time.Time       `json:"updatedAt"`
+}
+
+type Staff struct {
+	ID        int64  `json:"id"`
+	FirstName string `json:"firstName"`
+	LastName  string `json:"lastName"`
+	Email     string `json:"email"`
+	Role      string `json:"role"`
+	Image     string `json:"image"`
+	OutletID  *int64 `json:"outletID"`
+}
@@ -0,0 +1,225 @@
+package outlet
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	outletRequest "washit-api/internal/outlet/dto/request"
+	outletResource "washit-api/internal/outlet/dto/resource"
+	outletService "washit-api/internal/outlet/service"
+	userService "washit-api/internal/user/service"
+	"washit-api/pkg/redis"
+	"washit-api/pkg/response"
+	"washit-api/pkg/utils"
+)
+
+type OutletHandler struct {
+	service outletService.IOutletService
+	cache   redis.IRedis
+}
+
+func NewOutletHandler(service outletService.IOutletService, cache redis.IRedis) *OutletHandler {
+	return &OutletHandler{
+		service: service,
+		cache:   cache,
+	}
+}
+
+// GetOutlets lists every outlet.
+//
+//	@Summary	Get outlets
+//	@Tags		Outlet
+//	@Accept		json
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Success	200	{object}	[]outletResource.Outlet
+//	@Router		/outlets [get]
+func (h *OutletHandler) GetOutlets(c *gin.Context) {
+	var res []outletResource.Outlet
+
+	outlets, err := h.service.GetOutlets(c)
+	if err != nil {
+		log.Println("Failed to get outlets ", err)
+		response.Error(c, http.StatusInternalServerError, "failed to get outlets", err)
+		return
+	}
+
+	utils.CopyTo(&outlets, &res)
+	response.Success(c, http.StatusOK, "outlets are collected successfully", &res, nil)
+}
+
+// GetOutletByID retrieves an outlet by its ID.
+//
+//	@Summary	Get an outlet
+//	@Tags		Outlet
+//	@Accept		json
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		id	path		string	true	"Outlet ID"
+//	@Success	200	{object}	outletResource.Outlet
+//	@Router		/outlet/{id} [get]
+func (h *OutletHandler) GetOutletByID(c *gin.Context) {
+	var res outletResource.Outlet
+
+	outlet, err := h.service.GetOutletByID(c, c.Param("id"))
+	if err != nil {
+		log.Println("Failed to get outlet ", err)
+		response.Error(c, statusCode(err), "failed to get outlet", err)
+		return
+	}
+
+	utils.CopyTo(&outlet, &res)
+	response.Success(c, http.StatusOK, "outlet is collected successfully", &res, nil)
+}
+
+// CreateOutlet adds an outlet.
+//
+//	@Summary	Create an outlet
+//	@Tags		Outlet
+//	@Accept		json
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		_	body		outletRequest.Outlet	true	"Outlet details"
+//	@Success	201	{object}	outletResource.Outlet
+//	@Router		/outlet [post]
+func (h *OutletHandler) CreateOutlet(c *gin.Context) {
+	var req outletRequest.Outlet
+	var res outletResource.Outlet
+
+	if err := utils.ParseJson(c, &req); err != nil {
+		log.Println("Failed to parse request body ", err)
+		response.Error(c, http.StatusBadRequest, "failed to parse request body", err)
+		return
+	}
+
+	outlet, err := h.service.CreateOutlet(c, &req)
+	if err != nil {
+		log.Println("Failed to create outlet ", err)
+		response.Error(c, http.StatusInternalServerError, "failed to create outlet", err)
+		return
+	}
+
+	utils.CopyTo(&outlet, &res)
+	response.Success(c, http.StatusCreated, "outlet is created successfully", &res, nil)
+}
+
+// UpdateOutlet changes an outlet.
+//
+//	@Summary	Update an outlet
+//	@Tags		Outlet
+//	@Accept		json
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		id	path		string					true	"Outlet ID"
+//	@Param		_	body		outletRequest.Outlet	true	"Outlet details"
+//	@Success	200	{object}	outletResource.Outlet
+//	@Router		/outlet/{id} [put]
+func (h *OutletHandler) UpdateOutlet(c *gin.Context) {
+	var req outletRequest.Outlet
+	var res outletResource.Outlet
+
+	if err := utils.ParseJson(c, &req); err != nil {
+		log.Println("Failed to parse request body ", err)
+		response.Error(c, http.StatusBadRequest, "failed to parse request body", err)
+		return
+	}
+
+	outlet, err := h.service.UpdateOutlet(c, c.Param("id"), &req)
+	if err != nil {
+		log.Println("Failed to update outlet ", err)
+		response.Error(c, statusCode(err), "failed to update outlet", err)
+		return
+	}
+
+	utils.CopyTo(&outlet, &res)
+	response.Success(c, http.StatusOK, "outlet is updated successfully", &res, nil)
+}
+
+// GetStaff lists the staff working at an outlet.
+//
+//	@Summary	Get the staff of an outlet
+//	@Tags		Outlet
+//	@Accept		json
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		id	path		string	true	"Outlet ID"
+//	@Success	200	{object}	[]outletResource.Staff
+//	@Router		/outlet/{id}/staff [get]
+func (h *OutletHandler) GetStaff(c *gin.Context) {
+	var res []outletResource.Staff
+
+	users, err := h.service.GetStaff(c, c.Param("id"))
+	if err != nil {
+		log.Println("Failed to get outlet staff ", err)
+		response.Error(c, statusCode(err), "failed to get outlet staff", err)
+		return
+	}
+
+	utils.CopyTo(&users, &res)
+	response.Success(c, http.StatusOK, "outlet staff are collected successfully", &res, nil)
+}
+
+// AssignStaff moves a staff member to an outlet. Their sessions are logged
+// out so the next login carries the new outlet.
+//
+//	@Summary	Assign a staff member to an outlet
+//	@Tags		Outlet
+//	@Accept		json
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		id		path		string	true	"Outlet ID"
+//	@Param		userID	path		string	true	"User ID"
+//	@Success	200		{object}	outletResource.Staff
+//	@Router		/outlet/{id}/staff/{userID} [put]
+func (h *OutletHandler) AssignStaff(c *gin.Context) {
+	var res outletResource.Staff
+
+	user, err := h.service.AssignStaff(c, c.Param("id"), c.Param("userID"))
+	if err != nil {
+		log.Println("Failed to assign outlet staff ", err)
+		response.Error(c, statusCode(err), "failed to assign outlet staff", err)
+		return
+	}
+
+	utils.CopyTo(&user, &res)
+	response.Success(c, http.StatusOK, "staff is assigned successfully", &res, nil)
+}
+
+// RemoveStaff takes a staff member off an outlet.
+//
+//	@Summary	Remove a staff member from an outlet
+//	@Tags		Outlet
+//	@Accept		json
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		id		path		string	true	"Outlet ID"
+//	@Param		userID	path		string	true	"User ID"
+//	@Success	200		{object}	outletResource.Staff
+//	@Router		/outlet/{id}/staff/{userID} [delete]
+func (h *OutletHandler) RemoveStaff(c *gin.Context) {
+	var res outletResource.Staff
+
+	user, err := h.service.RemoveStaff(c, c.Param("id"), c.Param("userID"))
+	if err != nil {
+		log.Println("Failed to remove outlet staff ", err)
+		response.Error(c, statusCode(err), "failed to remove outlet staff", err)
+		return
+	}
+
+	utils.CopyTo(&user, &res)
+	response.Success(c, http.StatusOK, "staff is removed successfully", &res, nil)
+}
+
+func statusCode(err error) int {
+	switch {
+	case errors.Is(err, outletService.ErrOutletNotFound), errors.Is(err, outletService.ErrStaffNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, userService.ErrNotStaff):
+		return http.StatusBadRequest
+	}
+
+	return http.StatusInternalServerError
+}
@@ -0,0 +1,155 @@
+// Code generated by mockery v2.50.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	outletModel "washit-api/internal/outlet/dto/model"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// IOutletRepository is an autogenerated mock type for the IOutletRepository type
+type IOutletRepository struct {
+	mock.Mock
+}
+
+// CreateOutlet provides a mock function with given fields: ctx, outlet
+func (_m *IOutletRepository) CreateOutlet(ctx context.Context, outlet *outletModel.Outlet) error {
+	ret := _m.Called(ctx, outlet)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateOutlet")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *outletModel.Outlet) error); ok {
+		r0 = rf(ctx, outlet)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetActiveOutlets provides a mock function with given fields: ctx
+func (_m *IOutletRepository) GetActiveOutlets(ctx context.Context) ([]*outletModel.Outlet, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetActiveOutlets")
+	}
+
+	var r0 []*outletModel.Outlet
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*outletModel.Outlet, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*outletModel.Outlet); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*outletModel.Outlet)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetOutletByID provides a mock function with given fields: ctx, outletID
+func (_m *IOutletRepository) GetOutletByID(ctx context.Context, outletID string) (*outletModel.Outlet, error) {
+	ret := _m.Called(ctx, outletID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOutletByID")
+	}
+
+	var r0 *outletModel.Outlet
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*outletModel.Outlet, error)); ok {
+		return rf(ctx, outletID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *outletModel.Outlet); ok {
+		r0 = rf(ctx, outletID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*outletModel.Outlet)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, outletID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetOutlets provides a mock function with given fields: ctx
+func (_m *IOutletRepository) GetOutlets(ctx context.Context) ([]*outletModel.Outlet, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOutlets")
+	}
+
+	var r0 []*outletModel.Outlet
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*outletModel.Outlet, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*outletModel.Outlet); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*outletModel.Outlet)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateOutlet provides a mock function with given fields: ctx, outlet
+func (_m *IOutletRepository) UpdateOutlet(ctx context.Context, outlet *outletModel.Outlet) error {
+	ret := _m.Called(ctx, outlet)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateOutlet")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *outletModel.Outlet) error); ok {
+		r0 = rf(ctx, outlet)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewIOutletRepository creates a new instance of IOutletRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIOutletRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IOutletRepository {
+	mock := &IOutletRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,60 @@
+package outletRepository
+
+import (
+	"context"
+
+	outletModel "washit-api/internal/outlet/dto/model"
+	"washit-api/pkg/db/dbs"
+)
+
+type IOutletRepository interface {
+	GetOutlets(ctx context.Context) ([]*outletModel.Outlet, error)
+	GetActiveOutlets(ctx context.Context) ([]*outletModel.Outlet, error)
+	GetOutletByID(ctx context.Context, outletID string) (*outletModel.Outlet, error)
+	CreateOutlet(ctx context.Context, outlet *outletModel.Outlet) error
+	UpdateOutlet(ctx context.Context, outlet *outletModel.Outlet) error
+}
+
+type OutletRepository struct {
+	db dbs.IDatabase
+}
+
+func NewOutletRepository(db dbs.IDatabase) *OutletRepository {
+	return &OutletRepository{db: db}
+}
+
+func (r *OutletRepository) GetOutlets(ctx context.Context) ([]*outletModel.Outlet, error) {
+	var outlets []*outletModel.Outlet
+	if err := r.db.Find(ctx, &outlets, dbs.WithOrder("code")); err != nil {
+		return nil, err
+	}
+
+	return outlets, nil
+}
+
+func (r *OutletRepository) GetActiveOutlets(ctx context.Context) ([]*outletModel.Outlet, error) {
+	var outlets []*outletModel.Outlet
+	query := dbs.NewQuery("is_active = ?", true)
+	if err := r.db.Find(ctx, &outlets, dbs.WithQuery(query), dbs.WithOrder("code")); err != nil {
+		return nil, err
+	}
+
+	return outlets, nil
+}
+
+func (r *OutletRepository) GetOutletByID(ctx context.Context, outletID string) (*outletModel.Outlet, error) {
+	var outlet outletModel.Outlet
+	if err := r.db.FindByID(ctx, outletID, &outlet); err != nil {
+		return nil, err
+	}
+
+	return &outlet, nil
+}
+
+func (r *OutletRepository) CreateOutlet(ctx context.Context, outlet *outletModel.Outlet) error {
+	return r.db.Create(ctx, outlet)
+}
+
+func (r *OutletRepository) UpdateOutlet(ctx context.Context, outlet *outletModel.Outlet) error {
+	return r.db.Update(ctx, outlet)
+}
@@ -0,0 +1,42 @@
+package outletRoutes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator"
+
+	outlet "washit-api/internal/outlet/handler"
+	outletRepository "washit-api/internal/outlet/repository"
+	outletService "washit-api/internal/outlet/service"
+	userRepository "washit-api/internal/user/repository"
+	userService "washit-api/internal/user/service"
+	"washit-api/pkg/db/dbs"
+	"washit-api/pkg/mailer"
+	"washit-api/pkg/middleware"
+	"washit-api/pkg/rbac"
+	"washit-api/pkg/redis"
+)
+
+func Main(r *gin.RouterGroup, db dbs.IDatabase, cache redis.IRedis, validator *validator.Validate) {
+	repository := outletRepository.NewOutletRepository(db)
+	users := userService.NewUserService(userRepository.NewUserRepository(db), cache, mailer.FromEnvs(), validator)
+	service := outletService.NewOutletService(repository, users, validator)
+	handler := outlet.NewOutletHandler(service, cache)
+
+	authMiddleware := middleware.JWTAuth(cache)
+	outletMiddleware := middleware.JWTPermission(cache, rbac.OutletManage)
+
+	// Outlet Get
+	r.GET("/outlets", authMiddleware, handler.GetOutlets)
+	r.GET("/outlet/:id", authMiddleware, handler.GetOutletByID)
+
+	// Staff Authority
+
+	// Outlet
+	r.POST("/outlet", outletMiddleware, handler.CreateOutlet)
+	r.PUT("/outlet/:id", outletMiddleware, handler.UpdateOutlet)
+
+	// Outlet Staff
+	r.GET("/outlet/:id/staff", outletMiddleware, handler.GetStaff)
+	r.PUT("/outlet/:id/staff/:userID", outletMiddleware, handler.AssignStaff)
+	r.DELETE("/outlet/:id/staff/:userID", outletMiddleware, handler.RemoveStaff)
+}
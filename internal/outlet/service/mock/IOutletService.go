@@ -0,0 +1,307 @@
+// Code generated by mockery v2.50.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	geo "washit-api/pkg/geo"
+
+	mock "github.com/stretchr/testify/mock"
+
+	outletModel "washit-api/internal/outlet/dto/model"
+
+	outletRequest "washit-api/internal/outlet/dto/request"
+
+	time "time"
+
+	userModel "washit-api/internal/user/dto/model"
+)
+
+// IOutletService is an autogenerated mock type for the IOutletService type
+type IOutletService struct {
+	mock.Mock
+}
+
+// AssignStaff provides a mock function with given fields: c, outletID, userID
+func (_m *IOutletService) AssignStaff(c context.Context, outletID string, userID string) (*userModel.User, error) {
+	ret := _m.Called(c, outletID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AssignStaff")
+	}
+
+	var r0 *userModel.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*userModel.User, error)); ok {
+		return rf(c, outletID, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *userModel.User); ok {
+		r0 = rf(c, outletID, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*userModel.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(c, outletID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Cover provides a mock function with given fields: c, location
+func (_m *IOutletService) Cover(c context.Context, location geo.Point) (*outletModel.Outlet, error) {
+	ret := _m.Called(c, location)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Cover")
+	}
+
+	var r0 *outletModel.Outlet
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, geo.Point) (*outletModel.Outlet, error)); ok {
+		return rf(c, location)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, geo.Point) *outletModel.Outlet); ok {
+		r0 = rf(c, location)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*outletModel.Outlet)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, geo.Point) error); ok {
+		r1 = rf(c, location)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateOutlet provides a mock function with given fields: c, req
+func (_m *IOutletService) CreateOutlet(c context.Context, req *outletRequest.Outlet) (*outletModel.Outlet, error) {
+	ret := _m.Called(c, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateOutlet")
+	}
+
+	var r0 *outletModel.Outlet
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *outletRequest.Outlet) (*outletModel.Outlet, error)); ok {
+		return rf(c, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *outletRequest.Outlet) *outletModel.Outlet); ok {
+		r0 = rf(c, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*outletModel.Outlet)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *outletRequest.Outlet) error); ok {
+		r1 = rf(c, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetOutletByID provides a mock function with given fields: c, outletID
+func (_m *IOutletService) GetOutletByID(c context.Context, outletID string) (*outletModel.Outlet, error) {
+	ret := _m.Called(c, outletID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOutletByID")
+	}
+
+	var r0 *outletModel.Outlet
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*outletModel.Outlet, error)); ok {
+		return rf(c, outletID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *outletModel.Outlet); ok {
+		r0 = rf(c, outletID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*outletModel.Outlet)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(c, outletID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetOutlets provides a mock function with given fields: c
+func (_m *IOutletService) GetOutlets(c context.Context) ([]*outletModel.Outlet, error) {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOutlets")
+	}
+
+	var r0 []*outletModel.Outlet
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*outletModel.Outlet, error)); ok {
+		return rf(c)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*outletModel.Outlet); ok {
+		r0 = rf(c)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*outletModel.Outlet)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(c)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStaff provides a mock function with given fields: c, outletID
+func (_m *IOutletService) GetStaff(c context.Context, outletID string) ([]*userModel.User, error) {
+	ret := _m.Called(c, outletID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStaff")
+	}
+
+	var r0 []*userModel.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*userModel.User, error)); ok {
+		return rf(c, outletID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*userModel.User); ok {
+		r0 = rf(c, outletID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*userModel.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(c, outletID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RemoveStaff provides a mock function with given fields: c, outletID, userID
+func (_m *IOutletService) RemoveStaff(c context.Context, outletID string, userID string) (*userModel.User, error) {
+	ret := _m.Called(c, outletID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveStaff")
+	}
+
+	var r0 *userModel.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*userModel.User, error)); ok {
+		return rf(c, outletID, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *userModel.User); ok {
+		r0 = rf(c, outletID, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*userModel.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(c, outletID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Route provides a mock function with given fields: c, location, collectDate
+func (_m *IOutletService) Route(c context.Context, location geo.Point, collectDate time.Time) (*outletModel.Outlet, error) {
+	ret := _m.Called(c, location, collectDate)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Route")
+	}
+
+	var r0 *outletModel.Outlet
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, geo.Point, time.Time) (*outletModel.Outlet, error)); ok {
+		return rf(c, location, collectDate)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, geo.Point, time.Time) *outletModel.Outlet); ok {
+		r0 = rf(c, location, collectDate)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*outletModel.Outlet)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, geo.Point, time.Time) error); ok {
+		r1 = rf(c, location, collectDate)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateOutlet provides a mock function with given fields: c, outletID, req
+func (_m *IOutletService) UpdateOutlet(c context.Context, outletID string, req *outletRequest.Outlet) (*outletModel.Outlet, error) {
+	ret := _m.Called(c, outletID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateOutlet")
+	}
+
+	var r0 *outletModel.Outlet
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *outletRequest.Outlet) (*outletModel.Outlet, error)); ok {
+		return rf(c, outletID, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *outletRequest.Outlet) *outletModel.Outlet); ok {
+		r0 = rf(c, outletID, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*outletModel.Outlet)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *outletRequest.Outlet) error); ok {
+		r1 = rf(c, outletID, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewIOutletService creates a new instance of IOutletService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIOutletService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IOutletService {
+	mock := &IOutletService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,316 @@
+package outletService
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	outletModel "washit-api/internal/outlet/dto/model"
+	outletRequest "washit-api/internal/outlet/dto/request"
+	outletRepository "washit-api/internal/outlet/repository"
+	userModel "washit-api/internal/user/dto/model"
+	userService "washit-api/internal/user/service"
+	"washit-api/pkg/geo"
+	"washit-api/pkg/worktime"
+
+	"github.com/go-playground/validator"
+)
+
+type IOutletService interface {
+	GetOutlets(c context.Context) ([]*outletModel.Outlet, error)
+	GetOutletByID(c context.Context, outletID string) (*outletModel.Outlet, error)
+	CreateOutlet(c context.Context, req *outletRequest.Outlet) (*outletModel.Outlet, error)
+	UpdateOutlet(c context.Context, outletID string, req *outletRequest.Outlet) (*outletModel.Outlet, error)
+	GetStaff(c context.Context, outletID string) ([]*userModel.User, error)
+	AssignStaff(c context.Context, outletID string, userID string) (*userModel.User, error)
+	RemoveStaff(c context.Context, outletID string, userID string) (*userModel.User, error)
+	Cover(c context.Context, location geo.Point) (*outletModel.Outlet, error)
+	Route(c context.Context, location geo.Point, collectDate time.Time) (*outletModel.Outlet, error)
+}
+
+var (
+	ErrOutletNotFound = errors.New("outlet not found")
+	ErrStaffNotFound  = errors.New("user is not staff of the outlet")
+	ErrOutOfArea      = errors.New("the address is outside every service area")
+	ErrNoOutlet       = errors.New("no outlet serves the address at the collect date")
+)
+
+type OutletService struct {
+	repository outletRepository.IOutletRepository
+	users      userService.IUserService
+	validator  *validator.Validate
+	location   *time.Location
+}
+
+func NewOutletService(
+	repository outletRepository.IOutletRepository, users userService.IUserService,
+	validator *validator.Validate) *OutletService {
+	return &OutletService{
+		repository: repository,
+		users:      users,
+		validator:  validator,
+		location:   worktime.Zone(),
+	}
+}
+
+func (s *OutletService) GetOutlets(c context.Context) ([]*outletModel.Outlet, error) {
+	outlets, err := s.repository.GetOutlets(c)
+	if err != nil {
+		log.Printf("Failed to get outlets: %v", err)
+		return nil, fmt.Errorf("failed to get outlets: %w", err)
+	}
+
+	return outlets, nil
+}
+
+func (s *OutletService) GetOutletByID(c context.Context, outletID string) (*outletModel.Outlet, error) {
+	outlet, err := s.repository.GetOutletByID(c, outletID)
+	if err != nil {
+		log.Printf("Failed to get outlet by id: %v", err)
+		return nil, fmt.Errorf("%w: %v", ErrOutletNotFound, outletID)
+	}
+
+	return outlet, nil
+}
+
+func (s *OutletService) CreateOutlet(c context.Context, req *outletRequest.Outlet) (*outletModel.Outlet, error) {
+	outlet := &outletModel.Outlet{IsActive: true}
+	if err := s.applyOutlet(c, outlet, req); err != nil {
+		log.Printf("Failed to validate outlet request: %v", err)
+		return nil, err
+	}
+
+	if err := s.repository.CreateOutlet(c, outlet); err != nil {
+		log.Printf("Failed to create outlet: %v", err)
+		return nil, fmt.Errorf("failed to create outlet: %w", err)
+	}
+
+	return outlet, nil
+}
+
+// UpdateOutlet changes an outlet. Outlets are deactivated rather than deleted
+// since orders, histories and staff keep pointing at them.
+func (s *OutletService) UpdateOutlet(c context.Context, outletID string, req *outletRequest.Outlet) (*outletModel.Outlet, error) {
+	outlet, err := s.GetOutletByID(c, outletID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.applyOutlet(c, outlet, req); err != nil {
+		log.Printf("Failed to validate outlet request: %v", err)
+		return nil, err
+	}
+
+	if err := s.repository.UpdateOutlet(c, outlet); err != nil {
+		log.Printf("Failed to update outlet %s: %v", outletID, err)
+		return nil, fmt.Errorf("failed to update outlet: %w", err)
+	}
+
+	return outlet, nil
+}
+
+func (s *OutletService) GetStaff(c context.Context, outletID string) ([]*userModel.User, error) {
+	if _, err := s.GetOutletByID(c, outletID); err != nil {
+		return nil, err
+	}
+
+	return s.users.GetUsersByOutlet(c, outletID)
+}
+
+// AssignStaff moves a staff member to an outlet. A member works at one outlet
+// at a time, so assigning them elsewhere takes them off their current one.
+func (s *OutletService) AssignStaff(c context.Context, outletID string, userID string) (*userModel.User, error) {
+	outlet, err := s.GetOutletByID(c, outletID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.users.AssignOutlet(c, userID, &outlet.ID)
+}
+
+func (s *OutletService) RemoveStaff(c context.Context, outletID string, userID string) (*userModel.User, error) {
+	outlet, err := s.GetOutletByID(c, outletID)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.users.GetUserByID(c, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.OutletID == nil || *user.OutletID != outlet.ID {
+		return nil, fmt.Errorf("%w: %s", ErrStaffNotFound, userID)
+	}
+
+	return s.users.AssignOutlet(c, userID, nil)
+}
+
+// Cover returns the nearest active outlet whose service area holds location.
+// Without any active outlet the laundry runs as a single branch and Cover
+// returns nil without an error.
+func (s *OutletService) Cover(c context.Context, location geo.Point) (*outletModel.Outlet, error) {
+	outlets, err := s.serving(c, location)
+	if err != nil || len(outlets) == 0 {
+		return nil, err
+	}
+
+	return outlets[0], nil
+}
+
+// Route picks the outlet that handles an order collected at location on
+// collectDate: the nearest active outlet that serves the location and is open
+// at that time. Like Cover it returns nil when there are no outlets.
+func (s *OutletService) Route(c context.Context, location geo.Point, collectDate time.Time) (*outletModel.Outlet, error) {
+	outlets, err := s.serving(c, location)
+	if err != nil || len(outlets) == 0 {
+		return nil, err
+	}
+
+	for _, outlet := range outlets {
+		if s.isOpen(outlet, collectDate) {
+			return outlet, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: %s", ErrNoOutlet, collectDate.In(s.location).Format(time.RFC3339))
+}
+
+// serving lists the active outlets that serve location, nearest first. It
+// fails with ErrOutOfArea when there are outlets but none serves location.
+func (s *OutletService) serving(c context.Context, location geo.Point) ([]*outletModel.Outlet, error) {
+	outlets, err := s.repository.GetActiveOutlets(c)
+	if err != nil {
+		log.Printf("Failed to get active outlets: %v", err)
+		return nil, fmt.Errorf("failed to get outlets: %w", err)
+	}
+
+	if len(outlets) == 0 {
+		return nil, nil
+	}
+
+	if location.IsZero() {
+		return nil, fmt.Errorf("%w: the address has no location", ErrOutOfArea)
+	}
+
+	serving := make([]*outletModel.Outlet, 0, len(outlets))
+	for _, outlet := range outlets {
+		if outlet.Serves(location) {
+			serving = append(serving, outlet)
+		}
+	}
+
+	if len(serving) == 0 {
+		return nil, fmt.Errorf("%w: %.5f,%.5f", ErrOutOfArea, location.Lat, location.Lng)
+	}
+
+	sort.SliceStable(serving, func(i, j int) bool {
+		return geo.Distance(location, serving[i].Location()) < geo.Distance(location, serving[j].Location())
+	})
+
+	return serving, nil
+}
+
+// isOpen reports whether t falls within the outlet's own hours. Outlets
+// without hours follow the business calendar, which is checked separately.
+func (s *OutletService) isOpen(outlet *outletModel.Outlet, t time.Time) bool {
+	if outlet.OpenTime == "" || outlet.CloseTime == "" {
+		return true
+	}
+
+	open, err := worktime.ParseClock(outlet.OpenTime)
+	if err != nil {
+		return false
+	}
+
+	close, err := worktime.ParseClock(outlet.CloseTime)
+	if err != nil {
+		return false
+	}
+
+	t = t.In(s.location)
+	midnight := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, s.location)
+	since := t.Sub(midnight)
+
+	return since >= open && since < close
+}
+
+// applyOutlet validates req and copies it onto outlet. Codes are unique, an
+// outlet needs a service area or radius, and hours are either both set or
+// both empty.
+func (s *OutletService) applyOutlet(c context.Context, outlet *outletModel.Outlet, req *outletRequest.Outlet) error {
+	if err := s.validator.Struct(req); err != nil {
+		return fmt.Errorf("validation error: %w", err)
+	}
+
+	if (geo.Point{Lat: req.Latitude, Lng: req.Longitude}).IsZero() {
+		return fmt.Errorf("validation error: latitude and longitude are required")
+	}
+
+	var area geo.GeoJSON
+	if len(req.ServiceArea) > 0 && string(req.ServiceArea) != "null" {
+		if _, err := geo.ParseArea(geo.GeoJSON(req.ServiceArea)); err != nil {
+			return fmt.Errorf("validation error: %w", err)
+		}
+		area = geo.GeoJSON(req.ServiceArea)
+	}
+
+	if area == nil && req.ServiceRadiusKm <= 0 {
+		return fmt.Errorf("validation error: serviceArea or serviceRadiusKm is required")
+	}
+
+	if (req.OpenTime == "") != (req.CloseTime == "") {
+		return fmt.Errorf("validation error: openTime and closeTime must be set together")
+	}
+
+	var openTime, closeTime string
+	if req.OpenTime != "" {
+		open, err := worktime.ParseClock(req.OpenTime)
+		if err != nil {
+			return fmt.Errorf("validation error: openTime must be HH:MM")
+		}
+
+		close, err := worktime.ParseClock(req.CloseTime)
+		if err != nil {
+			return fmt.Errorf("validation error: closeTime must be HH:MM")
+		}
+
+		if close <= open {
+			return fmt.Errorf("validation error: closeTime must be after openTime")
+		}
+
+		openTime, closeTime = worktime.FormatClock(open), worktime.FormatClock(close)
+	}
+
+	outlets, err := s.GetOutlets(c)
+	if err != nil {
+		return err
+	}
+
+	for _, existing := range outlets {
+		if existing.ID != outlet.ID && existing.Code == req.Code {
+			return fmt.Errorf("validation error: outlet code %s is taken", req.Code)
+		}
+	}
+
+	outlet.Code = req.Code
+	outlet.Name = req.Name
+	outlet.Phone = req.Phone
+	outlet.Street = req.Street
+	outlet.City = req.City
+	outlet.PostalCode = req.PostalCode
+	outlet.Latitude = req.Latitude
+	outlet.Longitude = req.Longitude
+	outlet.ServiceRadiusKm = req.ServiceRadiusKm
+	outlet.ServiceArea = area
+	outlet.OpenTime = openTime
+	outlet.CloseTime = closeTime
+	if req.IsActive != nil {
+		outlet.IsActive = *req.IsActive
+	}
+
+	return nil
+}
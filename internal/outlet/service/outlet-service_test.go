@@ -0,0 +1,191 @@
+package outletService
+
+import (
+	"context"
+	"testing"
+	"time"
+	outletModel "washit-api/internal/outlet/dto/model"
+	outletRequest "washit-api/internal/outlet/dto/request"
+	mocks "washit-api/internal/outlet/repository/mock"
+	userModel "washit-api/internal/user/dto/model"
+	userMocks "washit-api/internal/user/service/mock"
+	"washit-api/pkg/geo"
+
+	"github.com/go-playground/validator"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type OutletServiceTestSuite struct {
+	suite.Suite
+	mockRepo  *mocks.IOutletRepository
+	mockUsers *userMocks.IUserService
+	service   *OutletService
+}
+
+// morning is 09:00 on Monday 19 October 2026.
+var morning = time.Date(2026, time.October, 19, 9, 0, 0, 0, time.UTC)
+
+// customer lives in central Jakarta.
+var customer = geo.Point{Lat: -6.1754, Lng: 106.8272}
+
+func (suite *OutletServiceTestSuite) SetupTest() {
+	suite.mockRepo = new(mocks.IOutletRepository)
+	suite.mockUsers = new(userMocks.IUserService)
+	suite.service = NewOutletService(suite.mockRepo, suite.mockUsers, validator.New())
+	suite.service.location = time.UTC
+}
+
+func TestOutletServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(OutletServiceTestSuite))
+}
+
+// outlets are about 2 km (Menteng), 10 km (Kemang) and 20 km (Bekasi) away
+// from customer.
+func outlets() []*outletModel.Outlet {
+	return []*outletModel.Outlet{
+		{ID: 1, Code: "MTG", Latitude: -6.1944, Longitude: 106.8296, ServiceRadiusKm: 3, OpenTime: "10:00", CloseTime: "18:00", IsActive: true},
+		{ID: 2, Code: "KMG", Latitude: -6.2607, Longitude: 106.8137, ServiceRadiusKm: 12, IsActive: true},
+		{ID: 3, Code: "BKS", Latitude: -6.2383, Longitude: 106.9756, ServiceRadiusKm: 30, IsActive: true},
+	}
+}
+
+// Route
+// =================================================================
+
+func (suite *OutletServiceTestSuite) TestRouteNearestOpenOutlet() {
+	suite.mockRepo.On("GetActiveOutlets", mock.Anything).
+		Return(outlets(), nil)
+
+	outlet, err := suite.service.Route(context.Background(), customer, morning.Add(2*time.Hour))
+	suite.Nil(err)
+	suite.Equal(int64(1), outlet.ID)
+
+	// Menteng only opens at 10:00, so an earlier pickup goes to Kemang.
+	outlet, err = suite.service.Route(context.Background(), customer, morning)
+	suite.Nil(err)
+	suite.Equal(int64(2), outlet.ID)
+}
+
+func (suite *OutletServiceTestSuite) TestRouteOutsideServiceAreas() {
+	suite.mockRepo.On("GetActiveOutlets", mock.Anything).
+		Return(outlets(), nil).Times(1)
+
+	bogor := geo.Point{Lat: -6.5950, Lng: 106.8166}
+	outlet, err := suite.service.Route(context.Background(), bogor, morning)
+	suite.Nil(outlet)
+	suite.ErrorIs(err, ErrOutOfArea)
+}
+
+func (suite *OutletServiceTestSuite) TestRouteAddressWithoutLocation() {
+	suite.mockRepo.On("GetActiveOutlets", mock.Anything).
+		Return(outlets(), nil).Times(1)
+
+	outlet, err := suite.service.Route(context.Background(), geo.Point{}, morning)
+	suite.Nil(outlet)
+	suite.ErrorIs(err, ErrOutOfArea)
+}
+
+func (suite *OutletServiceTestSuite) TestRouteAllOutletsClosed() {
+	suite.mockRepo.On("GetActiveOutlets", mock.Anything).
+		Return(outlets()[:1], nil).Times(1)
+
+	outlet, err := suite.service.Route(context.Background(), customer, morning)
+	suite.Nil(outlet)
+	suite.ErrorIs(err, ErrNoOutlet)
+}
+
+func (suite *OutletServiceTestSuite) TestCoverServiceArea() {
+	// Kemang's area is a box around south Jakarta that stops short of the
+	// centre, although the centre is within its radius.
+	kemang := outlets()[1]
+	kemang.ServiceArea = geo.GeoJSON(`{"type": "Polygon", "coordinates": [[
+		[106.76, -6.22], [106.86, -6.22], [106.86, -6.30], [106.76, -6.30], [106.76, -6.22]
+	]]}`)
+	suite.mockRepo.On("GetActiveOutlets", mock.Anything).
+		Return([]*outletModel.Outlet{kemang}, nil)
+
+	outlet, err := suite.service.Cover(context.Background(), customer)
+	suite.Nil(outlet)
+	suite.ErrorIs(err, ErrOutOfArea)
+
+	outlet, err = suite.service.Cover(context.Background(), geo.Point{Lat: -6.27, Lng: 106.80})
+	suite.Nil(err)
+	suite.Equal(int64(2), outlet.ID)
+}
+
+func (suite *OutletServiceTestSuite) TestRouteSingleBranch() {
+	suite.mockRepo.On("GetActiveOutlets", mock.Anything).
+		Return([]*outletModel.Outlet{}, nil).Times(1)
+
+	outlet, err := suite.service.Route(context.Background(), customer, morning)
+	suite.Nil(err)
+	suite.Nil(outlet)
+}
+
+// CreateOutlet
+// =================================================================
+
+func (suite *OutletServiceTestSuite) TestCreateOutletSuccess() {
+	req := &outletRequest.Outlet{
+		Code: "TBT", Name: "Tebet", Street: "Jl. Tebet Raya 1", City: "Jakarta",
+		Latitude: -6.2262, Longitude: 106.8535, ServiceRadiusKm: 5,
+		OpenTime: "7:30", CloseTime: "21:00",
+	}
+
+	suite.mockRepo.On("GetOutlets", mock.Anything).
+		Return(outlets(), nil).Times(1)
+	suite.mockRepo.On("CreateOutlet", mock.Anything, mock.Anything).
+		Return(nil).Times(1)
+
+	outlet, err := suite.service.CreateOutlet(context.Background(), req)
+	suite.Nil(err)
+	suite.True(outlet.IsActive)
+	suite.Equal("07:30", outlet.OpenTime)
+}
+
+func (suite *OutletServiceTestSuite) TestCreateOutletInvalid() {
+	valid := outletRequest.Outlet{
+		Code: "TBT", Name: "Tebet", Street: "Jl. Tebet Raya 1", City: "Jakarta",
+		Latitude: -6.2262, Longitude: 106.8535, ServiceRadiusKm: 5,
+	}
+
+	suite.mockRepo.On("GetOutlets", mock.Anything).
+		Return(outlets(), nil)
+
+	taken := valid
+	taken.Code = "KMG"
+	noLocation := valid
+	noLocation.Latitude, noLocation.Longitude = 0, 0
+	halfHours := valid
+	halfHours.OpenTime = "08:00"
+	reversed := valid
+	reversed.OpenTime, reversed.CloseTime = "18:00", "08:00"
+	noArea := valid
+	noArea.ServiceRadiusKm = 0
+	badArea := valid
+	badArea.ServiceArea = []byte(`{"type": "Polygon", "coordinates": [[[106.8, -6.2], [106.9, -6.2]]]}`)
+
+	for _, req := range []outletRequest.Outlet{taken, noLocation, halfHours, reversed, noArea, badArea} {
+		outlet, err := suite.service.CreateOutlet(context.Background(), &req)
+		suite.Nil(outlet)
+		suite.ErrorContains(err, "validation error")
+	}
+	suite.mockRepo.AssertNotCalled(suite.T(), "CreateOutlet", mock.Anything, mock.Anything)
+}
+
+// RemoveStaff
+// =================================================================
+
+func (suite *OutletServiceTestSuite) TestRemoveStaffOfAnotherOutlet() {
+	other := int64(2)
+	suite.mockRepo.On("GetOutletByID", mock.Anything, "1").
+		Return(outlets()[0], nil).Times(1)
+	suite.mockUsers.On("GetUserByID", mock.Anything, "9").
+		Return(&userModel.User{ID: 9, Role: "outlet_staff", OutletID: &other}, nil).Times(1)
+
+	user, err := suite.service.RemoveStaff(context.Background(), "1", "9")
+	suite.Nil(user)
+	suite.ErrorIs(err, ErrStaffNotFound)
+	suite.mockUsers.AssertNotCalled(suite.T(), "AssignOutlet", mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,62 @@
+package pricingModel
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+const (
+	RoundingUp      = "up"
+	RoundingDown    = "down"
+	RoundingNearest = "nearest"
+)
+
+// PriceList holds the rates charged for one service type. RoundingUnit is the
+// step the final price is rounded to (e.g. 500), zero disables rounding.
+type PriceList struct {
+	ID            int64           `json:"id" gorm:"primaryKey"`
+	ServiceType   string          `json:"serviceType" gorm:"not null;uniqueIndex"`
+	PerKg         decimal.Decimal `json:"perKg" gorm:"type:numeric;default:0"`
+	PerItem       decimal.Decimal `json:"perItem" gorm:"type:numeric;default:0"`
+	MinimumCharge decimal.Decimal `json:"minimumCharge" gorm:"type:numeric;default:0"`
+	RoundingUnit  decimal.Decimal `json:"roundingUnit" gorm:"type:numeric;default:0"`
+	RoundingMode  string          `json:"roundingMode" gorm:"default:nearest"`
+	CreatedAt     time.Time       `json:"createdAt"`
+	UpdatedAt     time.Time       `json:"updatedAt"`
+}
+
+// Surcharge is added on top of the base price for an order type such as
+// "express". Order types without a surcharge are charged the base price.
+type Surcharge struct {
+	ID         int64           `json:"id" gorm:"primaryKey"`
+	OrderType  string          `json:"orderType" gorm:"not null;uniqueIndex"`
+	Percentage decimal.Decimal `json:"percentage" gorm:"type:numeric;default:0"`
+	FlatFee    decimal.Decimal `json:"flatFee" gorm:"type:numeric;default:0"`
+	CreatedAt  time.Time       `json:"createdAt"`
+	UpdatedAt  time.Time       `json:"updatedAt"`
+}
+
+// OutletPrice overrides the rates of a price list at one outlet. Rounding
+// still follows the price list of the service type.
+type OutletPrice struct {
+	ID            int64           `json:"id" gorm:"primaryKey"`
+	OutletID      int64           `json:"outletID" gorm:"not null;uniqueIndex:idx_outlet_price_service"`
+	ServiceType   string          `json:"serviceType" gorm:"not null;uniqueIndex:idx_outlet_price_service"`
+	PerKg         decimal.Decimal `json:"perKg" gorm:"type:numeric;default:0"`
+	PerItem       decimal.Decimal `json:"perItem" gorm:"type:numeric;default:0"`
+	MinimumCharge decimal.Decimal `json:"minimumCharge" gorm:"type:numeric;default:0"`
+	CreatedAt     time.Time       `json:"createdAt"`
+	UpdatedAt     time.Time       `json:"updatedAt"`
+}
+
+// DeliveryFee is one distance band of the delivery fee schedule. A trip is
+// charged the fee of the smallest band whose MaxKm covers its distance; trips
+// beyond the largest band are not delivered.
+type DeliveryFee struct {
+	ID        int64           `json:"id" gorm:"primaryKey"`
+	MaxKm     float64         `json:"maxKm" gorm:"not null;uniqueIndex"`
+	Fee       decimal.Decimal `json:"fee" gorm:"type:numeric;default:0"`
+	CreatedAt time.Time       `json:"createdAt"`
+	UpdatedAt time.Time       `json:"updatedAt"`
+}
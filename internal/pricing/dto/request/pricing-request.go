@@ -0,0 +1,43 @@
+package pricingRequest
+
+import "github.com/shopspring/decimal"
+
+type PriceList struct {
+	ServiceType   string          `json:"serviceType" validate:"required"`
+	PerKg         decimal.Decimal `json:"perKg"`
+	PerItem       decimal.Decimal `json:"perItem"`
+	MinimumCharge decimal.Decimal `json:"minimumCharge"`
+	RoundingUnit  decimal.Decimal `json:"roundingUnit"`
+	RoundingMode  string          `json:"roundingMode" validate:"omitempty,oneof=up down nearest"`
+}
+
+type Surcharge struct {
+	Percentage decimal.Decimal `json:"percentage"`
+	FlatFee    decimal.Decimal `json:"flatFee"`
+}
+
+type OutletPrice struct {
+	PerKg         decimal.Decimal `json:"perKg"`
+	PerItem       decimal.Decimal `json:"perItem"`
+	MinimumCharge decimal.Decimal `json:"minimumCharge"`
+}
+
+type DeliveryFee struct {
+	MaxKm float64         `json:"maxKm" validate:"gt=0"`
+	Fee   decimal.Decimal `json:"fee"`
+}
+
+type Quote struct {
+	ServiceType string  `json:"serviceType" validate:"required"`
+	OrderType   string  `json:"orderType" validate:"required"`
+	Weight      float64 `json:"weight" validate:"gte=0"`
+	Items       int     `json:"items" validate:"gte=0"`
+	// ItemCharges is the total of items priced individually; it is charged
+	// on top of Items at the per-item rate.
+	ItemCharges decimal.Decimal `json:"itemCharges"`
+	// OutletID prices the load with the overrides of that outlet, if any.
+	OutletID int64 `json:"outletID"`
+	// DistanceKm adds the delivery fee of that distance; nil quotes without
+	// delivery.
+	DistanceKm *float64 `json:"distanceKm" validate:"omitempty,gte=0"`
+}
@@ -0,0 +1,43 @@
+package pricingResource
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+type PriceList struct {
+	ID            int64           `json:"id"`
+	ServiceType   string          `json:"serviceType"`
+	PerKg         decimal.Decimal `json:"perKg"`
+	PerItem       decimal.Decimal `json:"perItem"`
+	MinimumCharge decimal.Decimal `json:"minimumCharge"`
+	RoundingUnit  decimal.Decimal `json:"roundingUnit"`
+	RoundingMode  string          `json:"roundingMode"`
+	UpdatedAt     time.Time       `json:"updatedAt"`
+}
+
+type Surcharge struct {
+	ID         int64           `json:"id"`
+	OrderType  string          `json:"orderType"`
+	Percentage decimal.Decimal `json:"percentage"`
+	FlatFee    decimal.Decimal `json:"flatFee"`
+	UpdatedAt  time.Time       `json:"updatedAt"`
+}
+
+type OutletPrice struct {
+	ID            int64           `json:"id"`
+	OutletID      int64           `json:"outletID"`
+	ServiceType   string          `json:"serviceType"`
+	PerKg         decimal.Decimal `json:"perKg"`
+	PerItem       decimal.Decimal `json:"perItem"`
+	MinimumCharge decimal.Decimal `json:"minimumCharge"`
+	UpdatedAt     time.Time       `json:"updatedAt"`
+}
+
+type DeliveryFee struct {
+	ID        int64           `json:"id"`
+	MaxKm     float64         `json:"maxKm"`
+	Fee       decimal.Decimal `json:"fee"`
+	UpdatedAt time.Time       `json:"updatedAt"`
+}
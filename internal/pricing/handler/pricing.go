@@ -0,0 +1,393 @@
+package pricing
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	pricingRequest "washit-api/internal/pricing/dto/request"
+	pricingResource "washit-api/internal/pricing/dto/resource"
+	pricingService "washit-api/internal/pricing/service"
+	"washit-api/pkg/redis"
+	"washit-api/pkg/response"
+	"washit-api/pkg/utils"
+)
+
+type PricingHandler struct {
+	service pricingService.IPricingService
+	cache   redis.IRedis
+}
+
+func NewPricingHandler(service pricingService.IPricingService, cache redis.IRedis) *PricingHandler {
+	return &PricingHandler{
+		service: service,
+		cache:   cache,
+	}
+}
+
+// GetPriceLists retrieves the price list of every service type.
+//
+//	@Summary	Get all price lists
+//	@Tags		Pricing
+//	@Accept		json
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Success	200	{object}	[]pricingResource.PriceList
+//	@Router		/pricing/price-lists [get]
+func (h *PricingHandler) GetPriceLists(c *gin.Context) {
+	var res []pricingResource.PriceList
+
+	priceLists, err := h.service.GetPriceLists(c)
+	if err != nil {
+		log.Println("Failed to get price lists ", err)
+		response.Error(c, http.StatusInternalServerError, "failed to get price lists", err)
+		return
+	}
+
+	utils.CopyTo(&priceLists, &res)
+	response.Success(c, http.StatusOK, "price lists are collected successfully", &res, nil)
+}
+
+// CreatePriceList creates the price list for a service type.
+//
+//	@Summary	Create a price list
+//	@Tags		Pricing
+//	@Accept		json
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		_	body		pricingRequest.PriceList	true	"Price list details"
+//	@Success	201	{object}	pricingResource.PriceList
+//	@Router		/pricing/price-list [post]
+func (h *PricingHandler) CreatePriceList(c *gin.Context) {
+	var req pricingRequest.PriceList
+	var res pricingResource.PriceList
+
+	if err := utils.ParseJson(c, &req); err != nil {
+		log.Println("Failed to parse request body ", err)
+		response.Error(c, http.StatusBadRequest, "failed to parse request body", err)
+		return
+	}
+
+	priceList, err := h.service.CreatePriceList(c, &req)
+	if err != nil {
+		log.Println("Failed to create price list ", err)
+		response.Error(c, http.StatusInternalServerError, "failed to create price list", err)
+		return
+	}
+
+	utils.CopyTo(&priceList, &res)
+	response.Success(c, http.StatusCreated, "price list is created successfully", &res, nil)
+}
+
+// UpdatePriceList updates an existing price list.
+//
+//	@Summary	Update a price list
+//	@Tags		Pricing
+//	@Accept		json
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		id	path		string						true	"Price list ID"
+//	@Param		_	body		pricingRequest.PriceList	true	"Price list details"
+//	@Success	200	{object}	pricingResource.PriceList
+//	@Router		/pricing/price-list/{id} [put]
+func (h *PricingHandler) UpdatePriceList(c *gin.Context) {
+	var req pricingRequest.PriceList
+	var res pricingResource.PriceList
+
+	if err := utils.ParseJson(c, &req); err != nil {
+		log.Println("Failed to parse request body ", err)
+		response.Error(c, http.StatusBadRequest, "failed to parse request body", err)
+		return
+	}
+
+	priceList, err := h.service.UpdatePriceList(c, c.Param("id"), &req)
+	if err != nil {
+		log.Println("Failed to update price list ", err)
+		response.Error(c, http.StatusInternalServerError, "failed to update price list", err)
+		return
+	}
+
+	utils.CopyTo(&priceList, &res)
+	response.Success(c, http.StatusOK, "price list is updated successfully", &res, nil)
+}
+
+// DeletePriceList deletes a price list.
+//
+//	@Summary	Delete a price list
+//	@Tags		Pricing
+//	@Accept		json
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		id	path	string	true	"Price list ID"
+//	@Success	200
+//	@Router		/pricing/price-list/{id} [delete]
+func (h *PricingHandler) DeletePriceList(c *gin.Context) {
+	if err := h.service.DeletePriceList(c, c.Param("id")); err != nil {
+		log.Println("Failed to delete price list ", err)
+		response.Error(c, http.StatusInternalServerError, "failed to delete price list", err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, "price list is deleted successfully", nil, nil)
+}
+
+// GetSurcharges retrieves the surcharge of every order type.
+//
+//	@Summary	Get all order type surcharges
+//	@Tags		Pricing
+//	@Accept		json
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Success	200	{object}	[]pricingResource.Surcharge
+//	@Router		/pricing/surcharges [get]
+func (h *PricingHandler) GetSurcharges(c *gin.Context) {
+	var res []pricingResource.Surcharge
+
+	surcharges, err := h.service.GetSurcharges(c)
+	if err != nil {
+		log.Println("Failed to get surcharges ", err)
+		response.Error(c, http.StatusInternalServerError, "failed to get surcharges", err)
+		return
+	}
+
+	utils.CopyTo(&surcharges, &res)
+	response.Success(c, http.StatusOK, "surcharges are collected successfully", &res, nil)
+}
+
+// SaveSurcharge creates or replaces the surcharge of an order type.
+//
+//	@Summary	Set the surcharge of an order type
+//	@Tags		Pricing
+//	@Accept		json
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		orderType	path		string						true	"Order type"
+//	@Param		_			body		pricingRequest.Surcharge	true	"Surcharge details"
+//	@Success	200			{object}	pricingResource.Surcharge
+//	@Router		/pricing/surcharge/{orderType} [put]
+func (h *PricingHandler) SaveSurcharge(c *gin.Context) {
+	var req pricingRequest.Surcharge
+	var res pricingResource.Surcharge
+
+	if err := utils.ParseJson(c, &req); err != nil {
+		log.Println("Failed to parse request body ", err)
+		response.Error(c, http.StatusBadRequest, "failed to parse request body", err)
+		return
+	}
+
+	surcharge, err := h.service.SaveSurcharge(c, c.Param("orderType"), &req)
+	if err != nil {
+		log.Println("Failed to save surcharge ", err)
+		response.Error(c, http.StatusInternalServerError, "failed to save surcharge", err)
+		return
+	}
+
+	utils.CopyTo(&surcharge, &res)
+	response.Success(c, http.StatusOK, "surcharge is saved successfully", &res, nil)
+}
+
+// DeleteSurcharge removes the surcharge of an order type.
+//
+//	@Summary	Delete the surcharge of an order type
+//	@Tags		Pricing
+//	@Accept		json
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		orderType	path	string	true	"Order type"
+//	@Success	200
+//	@Router		/pricing/surcharge/{orderType} [delete]
+func (h *PricingHandler) DeleteSurcharge(c *gin.Context) {
+	if err := h.service.DeleteSurcharge(c, c.Param("orderType")); err != nil {
+		log.Println("Failed to delete surcharge ", err)
+		response.Error(c, http.StatusInternalServerError, "failed to delete surcharge", err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, "surcharge is deleted successfully", nil, nil)
+}
+
+// GetOutletPrices retrieves the price overrides of an outlet.
+//
+//	@Summary	Get the price overrides of an outlet
+//	@Tags		Pricing
+//	@Accept		json
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		outletID	path		string	true	"Outlet ID"
+//	@Success	200			{object}	[]pricingResource.OutletPrice
+//	@Router		/pricing/outlet/{outletID}/prices [get]
+func (h *PricingHandler) GetOutletPrices(c *gin.Context) {
+	var res []pricingResource.OutletPrice
+
+	prices, err := h.service.GetOutletPrices(c, c.Param("outletID"))
+	if err != nil {
+		log.Println("Failed to get outlet prices ", err)
+		response.Error(c, http.StatusInternalServerError, "failed to get outlet prices", err)
+		return
+	}
+
+	utils.CopyTo(&prices, &res)
+	response.Success(c, http.StatusOK, "outlet prices are collected successfully", &res, nil)
+}
+
+// SaveOutletPrice creates or replaces the price override of a service type at
+// an outlet.
+//
+//	@Summary	Override the price of a service type at an outlet
+//	@Tags		Pricing
+//	@Accept		json
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		outletID	path		string						true	"Outlet ID"
+//	@Param		serviceType	path		string						true	"Service type"
+//	@Param		_			body		pricingRequest.OutletPrice	true	"Price details"
+//	@Success	200			{object}	pricingResource.OutletPrice
+//	@Router		/pricing/outlet/{outletID}/price/{serviceType} [put]
+func (h *PricingHandler) SaveOutletPrice(c *gin.Context) {
+	var req pricingRequest.OutletPrice
+	var res pricingResource.OutletPrice
+
+	if err := utils.ParseJson(c, &req); err != nil {
+		log.Println("Failed to parse request body ", err)
+		response.Error(c, http.StatusBadRequest, "failed to parse request body", err)
+		return
+	}
+
+	price, err := h.service.SaveOutletPrice(c, c.Param("outletID"), c.Param("serviceType"), &req)
+	if err != nil {
+		log.Println("Failed to save outlet price ", err)
+		response.Error(c, http.StatusInternalServerError, "failed to save outlet price", err)
+		return
+	}
+
+	utils.CopyTo(&price, &res)
+	response.Success(c, http.StatusOK, "outlet price is saved successfully", &res, nil)
+}
+
+// DeleteOutletPrice removes the price override of a service type at an outlet.
+//
+//	@Summary	Delete the price override of a service type at an outlet
+//	@Tags		Pricing
+//	@Accept		json
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		outletID	path	string	true	"Outlet ID"
+//	@Param		serviceType	path	string	true	"Service type"
+//	@Success	200
+//	@Router		/pricing/outlet/{outletID}/price/{serviceType} [delete]
+func (h *PricingHandler) DeleteOutletPrice(c *gin.Context) {
+	if err := h.service.DeleteOutletPrice(c, c.Param("outletID"), c.Param("serviceType")); err != nil {
+		log.Println("Failed to delete outlet price ", err)
+		response.Error(c, http.StatusInternalServerError, "failed to delete outlet price", err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, "outlet price is deleted successfully", nil, nil)
+}
+
+// GetDeliveryFees retrieves the delivery fee schedule.
+//
+//	@Summary	Get the delivery fee bands
+//	@Tags		Pricing
+//	@Accept		json
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Success	200	{object}	[]pricingResource.DeliveryFee
+//	@Router		/pricing/delivery-fees [get]
+func (h *PricingHandler) GetDeliveryFees(c *gin.Context) {
+	var res []pricingResource.DeliveryFee
+
+	deliveryFees, err := h.service.GetDeliveryFees(c)
+	if err != nil {
+		log.Println("Failed to get delivery fees ", err)
+		response.Error(c, http.StatusInternalServerError, "failed to get delivery fees", err)
+		return
+	}
+
+	utils.CopyTo(&deliveryFees, &res)
+	response.Success(c, http.StatusOK, "delivery fees are collected successfully", &res, nil)
+}
+
+// CreateDeliveryFee adds a distance band to the delivery fee schedule.
+//
+//	@Summary	Create a delivery fee band
+//	@Tags		Pricing
+//	@Accept		json
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		_	body		pricingRequest.DeliveryFee	true	"Delivery fee details"
+//	@Success	201	{object}	pricingResource.DeliveryFee
+//	@Router		/pricing/delivery-fee [post]
+func (h *PricingHandler) CreateDeliveryFee(c *gin.Context) {
+	var req pricingRequest.DeliveryFee
+	var res pricingResource.DeliveryFee
+
+	if err := utils.ParseJson(c, &req); err != nil {
+		log.Println("Failed to parse request body ", err)
+		response.Error(c, http.StatusBadRequest, "failed to parse request body", err)
+		return
+	}
+
+	deliveryFee, err := h.service.CreateDeliveryFee(c, &req)
+	if err != nil {
+		log.Println("Failed to create delivery fee ", err)
+		response.Error(c, http.StatusInternalServerError, "failed to create delivery fee", err)
+		return
+	}
+
+	utils.CopyTo(&deliveryFee, &res)
+	response.Success(c, http.StatusCreated, "delivery fee is created successfully", &res, nil)
+}
+
+// UpdateDeliveryFee updates a band of the delivery fee schedule.
+//
+//	@Summary	Update a delivery fee band
+//	@Tags		Pricing
+//	@Accept		json
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		id	path		string						true	"Delivery fee ID"
+//	@Param		_	body		pricingRequest.DeliveryFee	true	"Delivery fee details"
+//	@Success	200	{object}	pricingResource.DeliveryFee
+//	@Router		/pricing/delivery-fee/{id} [put]
+func (h *PricingHandler) UpdateDeliveryFee(c *gin.Context) {
+	var req pricingRequest.DeliveryFee
+	var res pricingResource.DeliveryFee
+
+	if err := utils.ParseJson(c, &req); err != nil {
+		log.Println("Failed to parse request body ", err)
+		response.Error(c, http.StatusBadRequest, "failed to parse request body", err)
+		return
+	}
+
+	deliveryFee, err := h.service.UpdateDeliveryFee(c, c.Param("id"), &req)
+	if err != nil {
+		log.Println("Failed to update delivery fee ", err)
+		response.Error(c, http.StatusInternalServerError, "failed to update delivery fee", err)
+		return
+	}
+
+	utils.CopyTo(&deliveryFee, &res)
+	response.Success(c, http.StatusOK, "delivery fee is updated successfully", &res, nil)
+}
+
+// DeleteDeliveryFee removes a band from the delivery fee schedule.
+//
+//	@Summary	Delete a delivery fee band
+//	@Tags		Pricing
+//	@Accept		json
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		id	path	string	true	"Delivery fee ID"
+//	@Success	200
+//	@Router		/pricing/delivery-fee/{id} [delete]
+func (h *PricingHandler) DeleteDeliveryFee(c *gin.Context) {
+	if err := h.service.DeleteDeliveryFee(c, c.Param("id")); err != nil {
+		log.Println("Failed to delete delivery fee ", err)
+		response.Error(c, http.StatusInternalServerError, "failed to delete delivery fee", err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, "delivery fee is deleted successfully", nil, nil)
+}
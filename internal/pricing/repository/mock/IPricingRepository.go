@@ -0,0 +1,509 @@
+// Code generated by mockery v2.50.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	pricingModel "washit-api/internal/pricing/dto/model"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// IPricingRepository is an autogenerated mock type for the IPricingRepository type
+type IPricingRepository struct {
+	mock.Mock
+}
+
+// CreateDeliveryFee provides a mock function with given fields: ctx, deliveryFee
+func (_m *IPricingRepository) CreateDeliveryFee(ctx context.Context, deliveryFee *pricingModel.DeliveryFee) error {
+	ret := _m.Called(ctx, deliveryFee)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateDeliveryFee")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *pricingModel.DeliveryFee) error); ok {
+		r0 = rf(ctx, deliveryFee)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreatePriceList provides a mock function with given fields: ctx, priceList
+func (_m *IPricingRepository) CreatePriceList(ctx context.Context, priceList *pricingModel.PriceList) error {
+	ret := _m.Called(ctx, priceList)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreatePriceList")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *pricingModel.PriceList) error); ok {
+		r0 = rf(ctx, priceList)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteDeliveryFee provides a mock function with given fields: ctx, deliveryFee
+func (_m *IPricingRepository) DeleteDeliveryFee(ctx context.Context, deliveryFee *pricingModel.DeliveryFee) error {
+	ret := _m.Called(ctx, deliveryFee)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteDeliveryFee")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *pricingModel.DeliveryFee) error); ok {
+		r0 = rf(ctx, deliveryFee)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteOutletPrice provides a mock function with given fields: ctx, price
+func (_m *IPricingRepository) DeleteOutletPrice(ctx context.Context, price *pricingModel.OutletPrice) error {
+	ret := _m.Called(ctx, price)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteOutletPrice")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *pricingModel.OutletPrice) error); ok {
+		r0 = rf(ctx, price)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeletePriceList provides a mock function with given fields: ctx, priceList
+func (_m *IPricingRepository) DeletePriceList(ctx context.Context, priceList *pricingModel.PriceList) error {
+	ret := _m.Called(ctx, priceList)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeletePriceList")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *pricingModel.PriceList) error); ok {
+		r0 = rf(ctx, priceList)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteSurcharge provides a mock function with given fields: ctx, surcharge
+func (_m *IPricingRepository) DeleteSurcharge(ctx context.Context, surcharge *pricingModel.Surcharge) error {
+	ret := _m.Called(ctx, surcharge)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSurcharge")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *pricingModel.Surcharge) error); ok {
+		r0 = rf(ctx, surcharge)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetDeliveryFeeByID provides a mock function with given fields: ctx, deliveryFeeID
+func (_m *IPricingRepository) GetDeliveryFeeByID(ctx context.Context, deliveryFeeID string) (*pricingModel.DeliveryFee, error) {
+	ret := _m.Called(ctx, deliveryFeeID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDeliveryFeeByID")
+	}
+
+	var r0 *pricingModel.DeliveryFee
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*pricingModel.DeliveryFee, error)); ok {
+		return rf(ctx, deliveryFeeID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *pricingModel.DeliveryFee); ok {
+		r0 = rf(ctx, deliveryFeeID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pricingModel.DeliveryFee)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, deliveryFeeID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetDeliveryFeeByMaxKm provides a mock function with given fields: ctx, maxKm
+func (_m *IPricingRepository) GetDeliveryFeeByMaxKm(ctx context.Context, maxKm float64) (*pricingModel.DeliveryFee, error) {
+	ret := _m.Called(ctx, maxKm)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDeliveryFeeByMaxKm")
+	}
+
+	var r0 *pricingModel.DeliveryFee
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, float64) (*pricingModel.DeliveryFee, error)); ok {
+		return rf(ctx, maxKm)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, float64) *pricingModel.DeliveryFee); ok {
+		r0 = rf(ctx, maxKm)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pricingModel.DeliveryFee)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, float64) error); ok {
+		r1 = rf(ctx, maxKm)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetDeliveryFees provides a mock function with given fields: ctx
+func (_m *IPricingRepository) GetDeliveryFees(ctx context.Context) ([]*pricingModel.DeliveryFee, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDeliveryFees")
+	}
+
+	var r0 []*pricingModel.DeliveryFee
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*pricingModel.DeliveryFee, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*pricingModel.DeliveryFee); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*pricingModel.DeliveryFee)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetOutletPrice provides a mock function with given fields: ctx, outletID, serviceType
+func (_m *IPricingRepository) GetOutletPrice(ctx context.Context, outletID string, serviceType string) (*pricingModel.OutletPrice, error) {
+	ret := _m.Called(ctx, outletID, serviceType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOutletPrice")
+	}
+
+	var r0 *pricingModel.OutletPrice
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*pricingModel.OutletPrice, error)); ok {
+		return rf(ctx, outletID, serviceType)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *pricingModel.OutletPrice); ok {
+		r0 = rf(ctx, outletID, serviceType)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pricingModel.OutletPrice)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, outletID, serviceType)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetOutletPrices provides a mock function with given fields: ctx, outletID
+func (_m *IPricingRepository) GetOutletPrices(ctx context.Context, outletID string) ([]*pricingModel.OutletPrice, error) {
+	ret := _m.Called(ctx, outletID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOutletPrices")
+	}
+
+	var r0 []*pricingModel.OutletPrice
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*pricingModel.OutletPrice, error)); ok {
+		return rf(ctx, outletID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*pricingModel.OutletPrice); ok {
+		r0 = rf(ctx, outletID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*pricingModel.OutletPrice)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, outletID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPriceListByID provides a mock function with given fields: ctx, priceListID
+func (_m *IPricingRepository) GetPriceListByID(ctx context.Context, priceListID string) (*pricingModel.PriceList, error) {
+	ret := _m.Called(ctx, priceListID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPriceListByID")
+	}
+
+	var r0 *pricingModel.PriceList
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*pricingModel.PriceList, error)); ok {
+		return rf(ctx, priceListID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *pricingModel.PriceList); ok {
+		r0 = rf(ctx, priceListID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pricingModel.PriceList)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, priceListID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPriceListByServiceType provides a mock function with given fields: ctx, serviceType
+func (_m *IPricingRepository) GetPriceListByServiceType(ctx context.Context, serviceType string) (*pricingModel.PriceList, error) {
+	ret := _m.Called(ctx, serviceType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPriceListByServiceType")
+	}
+
+	var r0 *pricingModel.PriceList
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*pricingModel.PriceList, error)); ok {
+		return rf(ctx, serviceType)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *pricingModel.PriceList); ok {
+		r0 = rf(ctx, serviceType)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pricingModel.PriceList)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, serviceType)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPriceLists provides a mock function with given fields: ctx
+func (_m *IPricingRepository) GetPriceLists(ctx context.Context) ([]*pricingModel.PriceList, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPriceLists")
+	}
+
+	var r0 []*pricingModel.PriceList
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*pricingModel.PriceList, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*pricingModel.PriceList); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*pricingModel.PriceList)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetSurchargeByOrderType provides a mock function with given fields: ctx, orderType
+func (_m *IPricingRepository) GetSurchargeByOrderType(ctx context.Context, orderType string) (*pricingModel.Surcharge, error) {
+	ret := _m.Called(ctx, orderType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSurchargeByOrderType")
+	}
+
+	var r0 *pricingModel.Surcharge
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*pricingModel.Surcharge, error)); ok {
+		return rf(ctx, orderType)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *pricingModel.Surcharge); ok {
+		r0 = rf(ctx, orderType)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pricingModel.Surcharge)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, orderType)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetSurcharges provides a mock function with given fields: ctx
+func (_m *IPricingRepository) GetSurcharges(ctx context.Context) ([]*pricingModel.Surcharge, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSurcharges")
+	}
+
+	var r0 []*pricingModel.Surcharge
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*pricingModel.Surcharge, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*pricingModel.Surcharge); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*pricingModel.Surcharge)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SaveOutletPrice provides a mock function with given fields: ctx, price
+func (_m *IPricingRepository) SaveOutletPrice(ctx context.Context, price *pricingModel.OutletPrice) error {
+	ret := _m.Called(ctx, price)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveOutletPrice")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *pricingModel.OutletPrice) error); ok {
+		r0 = rf(ctx, price)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SaveSurcharge provides a mock function with given fields: ctx, surcharge
+func (_m *IPricingRepository) SaveSurcharge(ctx context.Context, surcharge *pricingModel.Surcharge) error {
+	ret := _m.Called(ctx, surcharge)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveSurcharge")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *pricingModel.Surcharge) error); ok {
+		r0 = rf(ctx, surcharge)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateDeliveryFee provides a mock function with given fields: ctx, deliveryFee
+func (_m *IPricingRepository) UpdateDeliveryFee(ctx context.Context, deliveryFee *pricingModel.DeliveryFee) error {
+	ret := _m.Called(ctx, deliveryFee)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateDeliveryFee")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *pricingModel.DeliveryFee) error); ok {
+		r0 = rf(ctx, deliveryFee)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdatePriceList provides a mock function with given fields: ctx, priceList
+func (_m *IPricingRepository) UpdatePriceList(ctx context.Context, priceList *pricingModel.PriceList) error {
+	ret := _m.Called(ctx, priceList)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePriceList")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *pricingModel.PriceList) error); ok {
+		r0 = rf(ctx, priceList)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewIPricingRepository creates a new instance of IPricingRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIPricingRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IPricingRepository {
+	mock := &IPricingRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,192 @@
+package pricingRepository
+
+import (
+	"context"
+	"errors"
+
+	pricingModel "washit-api/internal/pricing/dto/model"
+	"washit-api/pkg/db/dbs"
+
+	"gorm.io/gorm"
+)
+
+type IPricingRepository interface {
+	GetPriceLists(ctx context.Context) ([]*pricingModel.PriceList, error)
+	GetPriceListByID(ctx context.Context, priceListID string) (*pricingModel.PriceList, error)
+	GetPriceListByServiceType(ctx context.Context, serviceType string) (*pricingModel.PriceList, error)
+	CreatePriceList(ctx context.Context, priceList *pricingModel.PriceList) error
+	UpdatePriceList(ctx context.Context, priceList *pricingModel.PriceList) error
+	DeletePriceList(ctx context.Context, priceList *pricingModel.PriceList) error
+	GetSurcharges(ctx context.Context) ([]*pricingModel.Surcharge, error)
+	GetSurchargeByOrderType(ctx context.Context, orderType string) (*pricingModel.Surcharge, error)
+	SaveSurcharge(ctx context.Context, surcharge *pricingModel.Surcharge) error
+	DeleteSurcharge(ctx context.Context, surcharge *pricingModel.Surcharge) error
+	GetOutletPrices(ctx context.Context, outletID string) ([]*pricingModel.OutletPrice, error)
+	GetOutletPrice(ctx context.Context, outletID string, serviceType string) (*pricingModel.OutletPrice, error)
+	SaveOutletPrice(ctx context.Context, price *pricingModel.OutletPrice) error
+	DeleteOutletPrice(ctx context.Context, price *pricingModel.OutletPrice) error
+	GetDeliveryFees(ctx context.Context) ([]*pricingModel.DeliveryFee, error)
+	GetDeliveryFeeByID(ctx context.Context, deliveryFeeID string) (*pricingModel.DeliveryFee, error)
+	GetDeliveryFeeByMaxKm(ctx context.Context, maxKm float64) (*pricingModel.DeliveryFee, error)
+	CreateDeliveryFee(ctx context.Context, deliveryFee *pricingModel.DeliveryFee) error
+	UpdateDeliveryFee(ctx context.Context, deliveryFee *pricingModel.DeliveryFee) error
+	DeleteDeliveryFee(ctx context.Context, deliveryFee *pricingModel.DeliveryFee) error
+}
+
+type PricingRepository struct {
+	db dbs.IDatabase
+}
+
+func NewPricingRepository(db dbs.IDatabase) *PricingRepository {
+	return &PricingRepository{db: db}
+}
+
+func (r *PricingRepository) GetPriceLists(ctx context.Context) ([]*pricingModel.PriceList, error) {
+	var priceLists []*pricingModel.PriceList
+	if err := r.db.Find(ctx, &priceLists, dbs.WithOrder("service_type")); err != nil {
+		return nil, err
+	}
+
+	return priceLists, nil
+}
+
+func (r *PricingRepository) GetPriceListByID(ctx context.Context, priceListID string) (*pricingModel.PriceList, error) {
+	var priceList pricingModel.PriceList
+	if err := r.db.FindByID(ctx, priceListID, &priceList); err != nil {
+		return nil, err
+	}
+
+	return &priceList, nil
+}
+
+func (r *PricingRepository) GetPriceListByServiceType(ctx context.Context, serviceType string) (*pricingModel.PriceList, error) {
+	var priceList pricingModel.PriceList
+	query := dbs.NewQuery("service_type = ?", serviceType)
+	if err := r.db.FindOne(ctx, &priceList, dbs.WithQuery(query)); err != nil {
+		return nil, err
+	}
+
+	return &priceList, nil
+}
+
+func (r *PricingRepository) CreatePriceList(ctx context.Context, priceList *pricingModel.PriceList) error {
+	return r.db.Create(ctx, priceList)
+}
+
+func (r *PricingRepository) UpdatePriceList(ctx context.Context, priceList *pricingModel.PriceList) error {
+	return r.db.Update(ctx, priceList)
+}
+
+func (r *PricingRepository) DeletePriceList(ctx context.Context, priceList *pricingModel.PriceList) error {
+	return r.db.Delete(ctx, priceList)
+}
+
+func (r *PricingRepository) GetSurcharges(ctx context.Context) ([]*pricingModel.Surcharge, error) {
+	var surcharges []*pricingModel.Surcharge
+	if err := r.db.Find(ctx, &surcharges, dbs.WithOrder("order_type")); err != nil {
+		return nil, err
+	}
+
+	return surcharges, nil
+}
+
+// GetSurchargeByOrderType returns nil without an error when the order type
+// has no surcharge configured.
+func (r *PricingRepository) GetSurchargeByOrderType(ctx context.Context, orderType string) (*pricingModel.Surcharge, error) {
+	var surcharge pricingModel.Surcharge
+	query := dbs.NewQuery("order_type = ?", orderType)
+	if err := r.db.FindOne(ctx, &surcharge, dbs.WithQuery(query)); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &surcharge, nil
+}
+
+func (r *PricingRepository) SaveSurcharge(ctx context.Context, surcharge *pricingModel.Surcharge) error {
+	return r.db.Update(ctx, surcharge)
+}
+
+func (r *PricingRepository) DeleteSurcharge(ctx context.Context, surcharge *pricingModel.Surcharge) error {
+	return r.db.Delete(ctx, surcharge)
+}
+
+func (r *PricingRepository) GetOutletPrices(ctx context.Context, outletID string) ([]*pricingModel.OutletPrice, error) {
+	var prices []*pricingModel.OutletPrice
+	query := dbs.NewQuery("outlet_id = ?", outletID)
+	if err := r.db.Find(ctx, &prices, dbs.WithQuery(query), dbs.WithOrder("service_type")); err != nil {
+		return nil, err
+	}
+
+	return prices, nil
+}
+
+// GetOutletPrice returns nil without an error when the outlet does not
+// override the service type.
+func (r *PricingRepository) GetOutletPrice(ctx context.Context, outletID string, serviceType string) (*pricingModel.OutletPrice, error) {
+	var price pricingModel.OutletPrice
+	query := []dbs.Query{
+		dbs.NewQuery("outlet_id = ?", outletID),
+		dbs.NewQuery("service_type = ?", serviceType),
+	}
+	if err := r.db.FindOne(ctx, &price, dbs.WithQuery(query...)); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &price, nil
+}
+
+func (r *PricingRepository) SaveOutletPrice(ctx context.Context, price *pricingModel.OutletPrice) error {
+	return r.db.Update(ctx, price)
+}
+
+func (r *PricingRepository) DeleteOutletPrice(ctx context.Context, price *pricingModel.OutletPrice) error {
+	return r.db.Delete(ctx, price)
+}
+
+// GetDeliveryFees returns the delivery fee bands from the shortest distance
+// to the longest.
+func (r *PricingRepository) GetDeliveryFees(ctx context.Context) ([]*pricingModel.DeliveryFee, error) {
+	var deliveryFees []*pricingModel.DeliveryFee
+	if err := r.db.Find(ctx, &deliveryFees, dbs.WithOrder("max_km")); err != nil {
+		return nil, err
+	}
+
+	return deliveryFees, nil
+}
+
+func (r *PricingRepository) GetDeliveryFeeByID(ctx context.Context, deliveryFeeID string) (*pricingModel.DeliveryFee, error) {
+	var deliveryFee pricingModel.DeliveryFee
+	if err := r.db.FindByID(ctx, deliveryFeeID, &deliveryFee); err != nil {
+		return nil, err
+	}
+
+	return &deliveryFee, nil
+}
+
+func (r *PricingRepository) GetDeliveryFeeByMaxKm(ctx context.Context, maxKm float64) (*pricingModel.DeliveryFee, error) {
+	var deliveryFee pricingModel.DeliveryFee
+	query := dbs.NewQuery("max_km = ?", maxKm)
+	if err := r.db.FindOne(ctx, &deliveryFee, dbs.WithQuery(query)); err != nil {
+		return nil, err
+	}
+
+	return &deliveryFee, nil
+}
+
+func (r *PricingRepository) CreateDeliveryFee(ctx context.Context, deliveryFee *pricingModel.DeliveryFee) error {
+	return r.db.Create(ctx, deliveryFee)
+}
+
+func (r *PricingRepository) UpdateDeliveryFee(ctx context.Context, deliveryFee *pricingModel.DeliveryFee) error {
+	return r.db.Update(ctx, deliveryFee)
+}
+
+func (r *PricingRepository) DeleteDeliveryFee(ctx context.Context, deliveryFee *pricingModel.DeliveryFee) error {
+	return r.db.Delete(ctx, deliveryFee)
+}
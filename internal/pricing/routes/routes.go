@@ -0,0 +1,49 @@
+package pricingRoutes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator"
+
+	pricing "washit-api/internal/pricing/handler"
+	pricingRepository "washit-api/internal/pricing/repository"
+	pricingService "washit-api/internal/pricing/service"
+	"washit-api/pkg/db/dbs"
+	"washit-api/pkg/middleware"
+	"washit-api/pkg/rbac"
+	"washit-api/pkg/redis"
+)
+
+func Main(r *gin.RouterGroup, db dbs.IDatabase, cache redis.IRedis, validator *validator.Validate) {
+	repository := pricingRepository.NewPricingRepository(db)
+	service := pricingService.NewPricingService(repository, validator)
+	handler := pricing.NewPricingHandler(service, cache)
+
+	authMiddleware := middleware.JWTAuth(cache)
+	pricingMiddleware := middleware.JWTPermission(cache, rbac.PricingManage)
+
+	// Pricing Get
+	r.GET("/pricing/price-lists", authMiddleware, handler.GetPriceLists)
+	r.GET("/pricing/surcharges", authMiddleware, handler.GetSurcharges)
+	r.GET("/pricing/outlet/:outletID/prices", authMiddleware, handler.GetOutletPrices)
+	r.GET("/pricing/delivery-fees", authMiddleware, handler.GetDeliveryFees)
+
+	// Staff Authority
+
+	// Price List
+	r.POST("/pricing/price-list", pricingMiddleware, handler.CreatePriceList)
+	r.PUT("/pricing/price-list/:id", pricingMiddleware, handler.UpdatePriceList)
+	r.DELETE("/pricing/price-list/:id", pricingMiddleware, handler.DeletePriceList)
+
+	// Surcharge
+	r.PUT("/pricing/surcharge/:orderType", pricingMiddleware, handler.SaveSurcharge)
+	r.DELETE("/pricing/surcharge/:orderType", pricingMiddleware, handler.DeleteSurcharge)
+
+	// Outlet Price
+	r.PUT("/pricing/outlet/:outletID/price/:serviceType", pricingMiddleware, handler.SaveOutletPrice)
+	r.DELETE("/pricing/outlet/:outletID/price/:serviceType", pricingMiddleware, handler.DeleteOutletPrice)
+
+	// Delivery Fee
+	r.POST("/pricing/delivery-fee", pricingMiddleware, handler.CreateDeliveryFee)
+	r.PUT("/pricing/delivery-fee/:id", pricingMiddleware, handler.UpdateDeliveryFee)
+	r.DELETE("/pricing/delivery-fee/:id", pricingMiddleware, handler.DeleteDeliveryFee)
+}
@@ -0,0 +1,465 @@
+// Code generated by mockery v2.50.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	decimal "github.com/shopspring/decimal"
+	mock "github.com/stretchr/testify/mock"
+
+	pricingModel "washit-api/internal/pricing/dto/model"
+
+	pricingRequest "washit-api/internal/pricing/dto/request"
+
+	pricingService "washit-api/internal/pricing/service"
+)
+
+// IPricingService is an autogenerated mock type for the IPricingService type
+type IPricingService struct {
+	mock.Mock
+}
+
+// CreateDeliveryFee provides a mock function with given fields: c, req
+func (_m *IPricingService) CreateDeliveryFee(c context.Context, req *pricingRequest.DeliveryFee) (*pricingModel.DeliveryFee, error) {
+	ret := _m.Called(c, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateDeliveryFee")
+	}
+
+	var r0 *pricingModel.DeliveryFee
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *pricingRequest.DeliveryFee) (*pricingModel.DeliveryFee, error)); ok {
+		return rf(c, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *pricingRequest.DeliveryFee) *pricingModel.DeliveryFee); ok {
+		r0 = rf(c, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pricingModel.DeliveryFee)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *pricingRequest.DeliveryFee) error); ok {
+		r1 = rf(c, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreatePriceList provides a mock function with given fields: c, req
+func (_m *IPricingService) CreatePriceList(c context.Context, req *pricingRequest.PriceList) (*pricingModel.PriceList, error) {
+	ret := _m.Called(c, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreatePriceList")
+	}
+
+	var r0 *pricingModel.PriceList
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *pricingRequest.PriceList) (*pricingModel.PriceList, error)); ok {
+		return rf(c, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *pricingRequest.PriceList) *pricingModel.PriceList); ok {
+		r0 = rf(c, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pricingModel.PriceList)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *pricingRequest.PriceList) error); ok {
+		r1 = rf(c, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteDeliveryFee provides a mock function with given fields: c, deliveryFeeID
+func (_m *IPricingService) DeleteDeliveryFee(c context.Context, deliveryFeeID string) error {
+	ret := _m.Called(c, deliveryFeeID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteDeliveryFee")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(c, deliveryFeeID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteOutletPrice provides a mock function with given fields: c, outletID, serviceType
+func (_m *IPricingService) DeleteOutletPrice(c context.Context, outletID string, serviceType string) error {
+	ret := _m.Called(c, outletID, serviceType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteOutletPrice")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(c, outletID, serviceType)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeletePriceList provides a mock function with given fields: c, priceListID
+func (_m *IPricingService) DeletePriceList(c context.Context, priceListID string) error {
+	ret := _m.Called(c, priceListID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeletePriceList")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(c, priceListID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteSurcharge provides a mock function with given fields: c, orderType
+func (_m *IPricingService) DeleteSurcharge(c context.Context, orderType string) error {
+	ret := _m.Called(c, orderType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSurcharge")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(c, orderType)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeliveryFee provides a mock function with given fields: c, distanceKm
+func (_m *IPricingService) DeliveryFee(c context.Context, distanceKm float64) (decimal.Decimal, error) {
+	ret := _m.Called(c, distanceKm)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeliveryFee")
+	}
+
+	var r0 decimal.Decimal
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, float64) (decimal.Decimal, error)); ok {
+		return rf(c, distanceKm)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, float64) decimal.Decimal); ok {
+		r0 = rf(c, distanceKm)
+	} else {
+		r0 = ret.Get(0).(decimal.Decimal)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, float64) error); ok {
+		r1 = rf(c, distanceKm)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetDeliveryFees provides a mock function with given fields: c
+func (_m *IPricingService) GetDeliveryFees(c context.Context) ([]*pricingModel.DeliveryFee, error) {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDeliveryFees")
+	}
+
+	var r0 []*pricingModel.DeliveryFee
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*pricingModel.DeliveryFee, error)); ok {
+		return rf(c)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*pricingModel.DeliveryFee); ok {
+		r0 = rf(c)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*pricingModel.DeliveryFee)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(c)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetOutletPrices provides a mock function with given fields: c, outletID
+func (_m *IPricingService) GetOutletPrices(c context.Context, outletID string) ([]*pricingModel.OutletPrice, error) {
+	ret := _m.Called(c, outletID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOutletPrices")
+	}
+
+	var r0 []*pricingModel.OutletPrice
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*pricingModel.OutletPrice, error)); ok {
+		return rf(c, outletID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*pricingModel.OutletPrice); ok {
+		r0 = rf(c, outletID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*pricingModel.OutletPrice)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(c, outletID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPriceLists provides a mock function with given fields: c
+func (_m *IPricingService) GetPriceLists(c context.Context) ([]*pricingModel.PriceList, error) {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPriceLists")
+	}
+
+	var r0 []*pricingModel.PriceList
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*pricingModel.PriceList, error)); ok {
+		return rf(c)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*pricingModel.PriceList); ok {
+		r0 = rf(c)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*pricingModel.PriceList)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(c)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetSurcharges provides a mock function with given fields: c
+func (_m *IPricingService) GetSurcharges(c context.Context) ([]*pricingModel.Surcharge, error) {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSurcharges")
+	}
+
+	var r0 []*pricingModel.Surcharge
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*pricingModel.Surcharge, error)); ok {
+		return rf(c)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*pricingModel.Surcharge); ok {
+		r0 = rf(c)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*pricingModel.Surcharge)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(c)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Quote provides a mock function with given fields: c, req
+func (_m *IPricingService) Quote(c context.Context, req *pricingRequest.Quote) (*pricingService.Quote, error) {
+	ret := _m.Called(c, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Quote")
+	}
+
+	var r0 *pricingService.Quote
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *pricingRequest.Quote) (*pricingService.Quote, error)); ok {
+		return rf(c, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *pricingRequest.Quote) *pricingService.Quote); ok {
+		r0 = rf(c, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pricingService.Quote)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *pricingRequest.Quote) error); ok {
+		r1 = rf(c, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SaveOutletPrice provides a mock function with given fields: c, outletID, serviceType, req
+func (_m *IPricingService) SaveOutletPrice(c context.Context, outletID string, serviceType string, req *pricingRequest.OutletPrice) (*pricingModel.OutletPrice, error) {
+	ret := _m.Called(c, outletID, serviceType, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveOutletPrice")
+	}
+
+	var r0 *pricingModel.OutletPrice
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *pricingRequest.OutletPrice) (*pricingModel.OutletPrice, error)); ok {
+		return rf(c, outletID, serviceType, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *pricingRequest.OutletPrice) *pricingModel.OutletPrice); ok {
+		r0 = rf(c, outletID, serviceType, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pricingModel.OutletPrice)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, *pricingRequest.OutletPrice) error); ok {
+		r1 = rf(c, outletID, serviceType, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SaveSurcharge provides a mock function with given fields: c, orderType, req
+func (_m *IPricingService) SaveSurcharge(c context.Context, orderType string, req *pricingRequest.Surcharge) (*pricingModel.Surcharge, error) {
+	ret := _m.Called(c, orderType, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveSurcharge")
+	}
+
+	var r0 *pricingModel.Surcharge
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *pricingRequest.Surcharge) (*pricingModel.Surcharge, error)); ok {
+		return rf(c, orderType, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *pricingRequest.Surcharge) *pricingModel.Surcharge); ok {
+		r0 = rf(c, orderType, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pricingModel.Surcharge)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *pricingRequest.Surcharge) error); ok {
+		r1 = rf(c, orderType, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateDeliveryFee provides a mock function with given fields: c, deliveryFeeID, req
+func (_m *IPricingService) UpdateDeliveryFee(c context.Context, deliveryFeeID string, req *pricingRequest.DeliveryFee) (*pricingModel.DeliveryFee, error) {
+	ret := _m.Called(c, deliveryFeeID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateDeliveryFee")
+	}
+
+	var r0 *pricingModel.DeliveryFee
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *pricingRequest.DeliveryFee) (*pricingModel.DeliveryFee, error)); ok {
+		return rf(c, deliveryFeeID, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *pricingRequest.DeliveryFee) *pricingModel.DeliveryFee); ok {
+		r0 = rf(c, deliveryFeeID, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pricingModel.DeliveryFee)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *pricingRequest.DeliveryFee) error); ok {
+		r1 = rf(c, deliveryFeeID, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdatePriceList provides a mock function with given fields: c, priceListID, req
+func (_m *IPricingService) UpdatePriceList(c context.Context, priceListID string, req *pricingRequest.PriceList) (*pricingModel.PriceList, error) {
+	ret := _m.Called(c, priceListID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePriceList")
+	}
+
+	var r0 *pricingModel.PriceList
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *pricingRequest.PriceList) (*pricingModel.PriceList, error)); ok {
+		return rf(c, priceListID, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *pricingRequest.PriceList) *pricingModel.PriceList); ok {
+		r0 = rf(c, priceListID, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pricingModel.PriceList)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *pricingRequest.PriceList) error); ok {
+		r1 = rf(c, priceListID, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewIPricingService creates a new instance of IPricingService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIPricingService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IPricingService {
+	mock := &IPricingService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,491 @@
+package pricingService
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+
+	pricingModel "washit-api/internal/pricing/dto/model"
+	pricingRequest "washit-api/internal/pricing/dto/request"
+	pricingRepository "washit-api/internal/pricing/repository"
+
+	"github.com/go-playground/validator"
+	"github.com/shopspring/decimal"
+)
+
+type IPricingService interface {
+	GetPriceLists(c context.Context) ([]*pricingModel.PriceList, error)
+	CreatePriceList(c context.Context, req *pricingRequest.PriceList) (*pricingModel.PriceList, error)
+	UpdatePriceList(c context.Context, priceListID string, req *pricingRequest.PriceList) (*pricingModel.PriceList, error)
+	DeletePriceList(c context.Context, priceListID string) error
+	GetSurcharges(c context.Context) ([]*pricingModel.Surcharge, error)
+	SaveSurcharge(c context.Context, orderType string, req *pricingRequest.Surcharge) (*pricingModel.Surcharge, error)
+	DeleteSurcharge(c context.Context, orderType string) error
+	GetOutletPrices(c context.Context, outletID string) ([]*pricingModel.OutletPrice, error)
+	SaveOutletPrice(c context.Context, outletID string, serviceType string, req *pricingRequest.OutletPrice) (*pricingModel.OutletPrice, error)
+	DeleteOutletPrice(c context.Context, outletID string, serviceType string) error
+	GetDeliveryFees(c context.Context) ([]*pricingModel.DeliveryFee, error)
+	CreateDeliveryFee(c context.Context, req *pricingRequest.DeliveryFee) (*pricingModel.DeliveryFee, error)
+	UpdateDeliveryFee(c context.Context, deliveryFeeID string, req *pricingRequest.DeliveryFee) (*pricingModel.DeliveryFee, error)
+	DeleteDeliveryFee(c context.Context, deliveryFeeID string) error
+	DeliveryFee(c context.Context, distanceKm float64) (decimal.Decimal, error)
+	Quote(c context.Context, req *pricingRequest.Quote) (*Quote, error)
+}
+
+// ErrOutOfDeliveryRange is returned for distances beyond the largest delivery
+// fee band.
+var ErrOutOfDeliveryRange = errors.New("distance is beyond the delivery range")
+
+// Quote is the price breakdown for a service type, order type and load.
+type Quote struct {
+	ServiceType    string          `json:"serviceType"`
+	OrderType      string          `json:"orderType"`
+	Weight         float64         `json:"weight"`
+	Items          int             `json:"items"`
+	ItemCharges    decimal.Decimal `json:"itemCharges"`
+	Base           decimal.Decimal `json:"base"`
+	Surcharge      decimal.Decimal `json:"surcharge"`
+	MinimumApplied bool            `json:"minimumApplied"`
+	DistanceKm     *float64        `json:"distanceKm,omitempty"`
+	DeliveryFee    decimal.Decimal `json:"deliveryFee"`
+	Total          decimal.Decimal `json:"total"`
+}
+
+type PricingService struct {
+	repository pricingRepository.IPricingRepository
+	validator  *validator.Validate
+}
+
+func NewPricingService(
+	repository pricingRepository.IPricingRepository, validator *validator.Validate) *PricingService {
+	return &PricingService{
+		repository: repository,
+		validator:  validator,
+	}
+}
+
+func (s *PricingService) GetPriceLists(c context.Context) ([]*pricingModel.PriceList, error) {
+	priceLists, err := s.repository.GetPriceLists(c)
+	if err != nil {
+		log.Printf("Failed to get price lists: %v", err)
+		return nil, fmt.Errorf("failed to get price lists: %w", err)
+	}
+
+	return priceLists, nil
+}
+
+func (s *PricingService) CreatePriceList(c context.Context, req *pricingRequest.PriceList) (*pricingModel.PriceList, error) {
+	if err := s.validatePriceList(req); err != nil {
+		log.Printf("Failed to validate price list request: %v", err)
+		return nil, err
+	}
+
+	if _, err := s.repository.GetPriceListByServiceType(c, req.ServiceType); err == nil {
+		return nil, fmt.Errorf("price list for service type %s already exists", req.ServiceType)
+	}
+
+	priceList := &pricingModel.PriceList{ServiceType: req.ServiceType}
+	applyPriceList(priceList, req)
+
+	if err := s.repository.CreatePriceList(c, priceList); err != nil {
+		log.Printf("Failed to create price list: %v", err)
+		return nil, fmt.Errorf("failed to create price list: %w", err)
+	}
+
+	return priceList, nil
+}
+
+func (s *PricingService) UpdatePriceList(c context.Context, priceListID string, req *pricingRequest.PriceList) (*pricingModel.PriceList, error) {
+	if err := s.validatePriceList(req); err != nil {
+		log.Printf("Failed to validate price list request: %v", err)
+		return nil, err
+	}
+
+	priceList, err := s.repository.GetPriceListByID(c, priceListID)
+	if err != nil {
+		log.Printf("Failed to get price list by id: %v", err)
+		return nil, fmt.Errorf("price list not found: %v", priceListID)
+	}
+
+	if existing, err := s.repository.GetPriceListByServiceType(c, req.ServiceType); err == nil && existing.ID != priceList.ID {
+		return nil, fmt.Errorf("price list for service type %s already exists", req.ServiceType)
+	}
+
+	priceList.ServiceType = req.ServiceType
+	applyPriceList(priceList, req)
+
+	if err := s.repository.UpdatePriceList(c, priceList); err != nil {
+		log.Printf("Failed to update price list %s: %v", priceListID, err)
+		return nil, fmt.Errorf("failed to update price list: %w", err)
+	}
+
+	return priceList, nil
+}
+
+func (s *PricingService) DeletePriceList(c context.Context, priceListID string) error {
+	priceList, err := s.repository.GetPriceListByID(c, priceListID)
+	if err != nil {
+		log.Printf("Failed to get price list by id: %v", err)
+		return fmt.Errorf("price list not found: %v", priceListID)
+	}
+
+	if err := s.repository.DeletePriceList(c, priceList); err != nil {
+		log.Printf("Failed to delete price list %s: %v", priceListID, err)
+		return fmt.Errorf("failed to delete price list: %w", err)
+	}
+
+	return nil
+}
+
+func (s *PricingService) GetSurcharges(c context.Context) ([]*pricingModel.Surcharge, error) {
+	surcharges, err := s.repository.GetSurcharges(c)
+	if err != nil {
+		log.Printf("Failed to get surcharges: %v", err)
+		return nil, fmt.Errorf("failed to get surcharges: %w", err)
+	}
+
+	return surcharges, nil
+}
+
+func (s *PricingService) SaveSurcharge(c context.Context, orderType string, req *pricingRequest.Surcharge) (*pricingModel.Surcharge, error) {
+	if req.Percentage.IsNegative() || req.FlatFee.IsNegative() {
+		return nil, fmt.Errorf("validation error: surcharge cannot be negative")
+	}
+
+	surcharge, err := s.repository.GetSurchargeByOrderType(c, orderType)
+	if err != nil {
+		log.Printf("Failed to get surcharge for order type %s: %v", orderType, err)
+		return nil, fmt.Errorf("failed to get surcharge: %w", err)
+	}
+	if surcharge == nil {
+		surcharge = &pricingModel.Surcharge{OrderType: orderType}
+	}
+
+	surcharge.Percentage = req.Percentage
+	surcharge.FlatFee = req.FlatFee
+
+	if err := s.repository.SaveSurcharge(c, surcharge); err != nil {
+		log.Printf("Failed to save surcharge for order type %s: %v", orderType, err)
+		return nil, fmt.Errorf("failed to save surcharge: %w", err)
+	}
+
+	return surcharge, nil
+}
+
+func (s *PricingService) DeleteSurcharge(c context.Context, orderType string) error {
+	surcharge, err := s.repository.GetSurchargeByOrderType(c, orderType)
+	if err != nil || surcharge == nil {
+		log.Printf("Failed to get surcharge for order type %s: %v", orderType, err)
+		return fmt.Errorf("surcharge not found: %v", orderType)
+	}
+
+	if err := s.repository.DeleteSurcharge(c, surcharge); err != nil {
+		log.Printf("Failed to delete surcharge for order type %s: %v", orderType, err)
+		return fmt.Errorf("failed to delete surcharge: %w", err)
+	}
+
+	return nil
+}
+
+func (s *PricingService) GetOutletPrices(c context.Context, outletID string) ([]*pricingModel.OutletPrice, error) {
+	prices, err := s.repository.GetOutletPrices(c, outletID)
+	if err != nil {
+		log.Printf("Failed to get prices of outlet %s: %v", outletID, err)
+		return nil, fmt.Errorf("failed to get outlet prices: %w", err)
+	}
+
+	return prices, nil
+}
+
+// SaveOutletPrice creates or replaces the override of a service type at an
+// outlet. The service type needs a price list to override.
+func (s *PricingService) SaveOutletPrice(c context.Context, outletID string, serviceType string, req *pricingRequest.OutletPrice) (*pricingModel.OutletPrice, error) {
+	if req.PerKg.IsNegative() || req.PerItem.IsNegative() || req.MinimumCharge.IsNegative() {
+		return nil, fmt.Errorf("validation error: prices cannot be negative")
+	}
+
+	id, err := strconv.ParseInt(outletID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("validation error: invalid outlet id: %v", outletID)
+	}
+
+	if _, err := s.repository.GetPriceListByServiceType(c, serviceType); err != nil {
+		log.Printf("Failed to get price list for service type %s: %v", serviceType, err)
+		return nil, fmt.Errorf("no price list for service type: %v", serviceType)
+	}
+
+	price, err := s.repository.GetOutletPrice(c, outletID, serviceType)
+	if err != nil {
+		log.Printf("Failed to get price of outlet %s for service type %s: %v", outletID, serviceType, err)
+		return nil, fmt.Errorf("failed to get outlet price: %w", err)
+	}
+	if price == nil {
+		price = &pricingModel.OutletPrice{OutletID: id, ServiceType: serviceType}
+	}
+
+	price.PerKg = req.PerKg
+	price.PerItem = req.PerItem
+	price.MinimumCharge = req.MinimumCharge
+
+	if err := s.repository.SaveOutletPrice(c, price); err != nil {
+		log.Printf("Failed to save price of outlet %s for service type %s: %v", outletID, serviceType, err)
+		return nil, fmt.Errorf("failed to save outlet price: %w", err)
+	}
+
+	return price, nil
+}
+
+func (s *PricingService) DeleteOutletPrice(c context.Context, outletID string, serviceType string) error {
+	price, err := s.repository.GetOutletPrice(c, outletID, serviceType)
+	if err != nil || price == nil {
+		log.Printf("Failed to get price of outlet %s for service type %s: %v", outletID, serviceType, err)
+		return fmt.Errorf("outlet price not found: %v", serviceType)
+	}
+
+	if err := s.repository.DeleteOutletPrice(c, price); err != nil {
+		log.Printf("Failed to delete price of outlet %s for service type %s: %v", outletID, serviceType, err)
+		return fmt.Errorf("failed to delete outlet price: %w", err)
+	}
+
+	return nil
+}
+
+func (s *PricingService) GetDeliveryFees(c context.Context) ([]*pricingModel.DeliveryFee, error) {
+	deliveryFees, err := s.repository.GetDeliveryFees(c)
+	if err != nil {
+		log.Printf("Failed to get delivery fees: %v", err)
+		return nil, fmt.Errorf("failed to get delivery fees: %w", err)
+	}
+
+	return deliveryFees, nil
+}
+
+func (s *PricingService) CreateDeliveryFee(c context.Context, req *pricingRequest.DeliveryFee) (*pricingModel.DeliveryFee, error) {
+	if err := s.validateDeliveryFee(req); err != nil {
+		log.Printf("Failed to validate delivery fee request: %v", err)
+		return nil, err
+	}
+
+	if _, err := s.repository.GetDeliveryFeeByMaxKm(c, req.MaxKm); err == nil {
+		return nil, fmt.Errorf("delivery fee band up to %v km already exists", req.MaxKm)
+	}
+
+	deliveryFee := &pricingModel.DeliveryFee{MaxKm: req.MaxKm, Fee: req.Fee}
+	if err := s.repository.CreateDeliveryFee(c, deliveryFee); err != nil {
+		log.Printf("Failed to create delivery fee: %v", err)
+		return nil, fmt.Errorf("failed to create delivery fee: %w", err)
+	}
+
+	return deliveryFee, nil
+}
+
+func (s *PricingService) UpdateDeliveryFee(c context.Context, deliveryFeeID string, req *pricingRequest.DeliveryFee) (*pricingModel.DeliveryFee, error) {
+	if err := s.validateDeliveryFee(req); err != nil {
+		log.Printf("Failed to validate delivery fee request: %v", err)
+		return nil, err
+	}
+
+	deliveryFee, err := s.repository.GetDeliveryFeeByID(c, deliveryFeeID)
+	if err != nil {
+		log.Printf("Failed to get delivery fee by id: %v", err)
+		return nil, fmt.Errorf("delivery fee not found: %v", deliveryFeeID)
+	}
+
+	if existing, err := s.repository.GetDeliveryFeeByMaxKm(c, req.MaxKm); err == nil && existing.ID != deliveryFee.ID {
+		return nil, fmt.Errorf("delivery fee band up to %v km already exists", req.MaxKm)
+	}
+
+	deliveryFee.MaxKm = req.MaxKm
+	deliveryFee.Fee = req.Fee
+
+	if err := s.repository.UpdateDeliveryFee(c, deliveryFee); err != nil {
+		log.Printf("Failed to update delivery fee %s: %v", deliveryFeeID, err)
+		return nil, fmt.Errorf("failed to update delivery fee: %w", err)
+	}
+
+	return deliveryFee, nil
+}
+
+func (s *PricingService) DeleteDeliveryFee(c context.Context, deliveryFeeID string) error {
+	deliveryFee, err := s.repository.GetDeliveryFeeByID(c, deliveryFeeID)
+	if err != nil {
+		log.Printf("Failed to get delivery fee by id: %v", err)
+		return fmt.Errorf("delivery fee not found: %v", deliveryFeeID)
+	}
+
+	if err := s.repository.DeleteDeliveryFee(c, deliveryFee); err != nil {
+		log.Printf("Failed to delete delivery fee %s: %v", deliveryFeeID, err)
+		return fmt.Errorf("failed to delete delivery fee: %w", err)
+	}
+
+	return nil
+}
+
+// DeliveryFee returns the fee of a trip of distanceKm. Without any bands
+// configured delivery is free.
+func (s *PricingService) DeliveryFee(c context.Context, distanceKm float64) (decimal.Decimal, error) {
+	deliveryFees, err := s.repository.GetDeliveryFees(c)
+	if err != nil {
+		log.Printf("Failed to get delivery fees: %v", err)
+		return decimal.Zero, fmt.Errorf("failed to get delivery fees: %w", err)
+	}
+
+	return FeeForDistance(deliveryFees, distanceKm)
+}
+
+// FeeForDistance picks the band covering distanceKm from bands sorted by
+// MaxKm. No bands means free delivery.
+func FeeForDistance(bands []*pricingModel.DeliveryFee, distanceKm float64) (decimal.Decimal, error) {
+	if len(bands) == 0 {
+		return decimal.Zero, nil
+	}
+
+	for _, band := range bands {
+		if distanceKm <= band.MaxKm {
+			return band.Fee, nil
+		}
+	}
+
+	return decimal.Zero, fmt.Errorf("%w: %v km", ErrOutOfDeliveryRange, distanceKm)
+}
+
+func (s *PricingService) Quote(c context.Context, req *pricingRequest.Quote) (*Quote, error) {
+	if err := s.validator.Struct(req); err != nil {
+		log.Printf("Failed to validate quote request: %v", err)
+		return nil, fmt.Errorf("validation error: %w", err)
+	}
+
+	if req.ItemCharges.IsNegative() {
+		return nil, fmt.Errorf("validation error: item charges cannot be negative")
+	}
+
+	priceList, err := s.repository.GetPriceListByServiceType(c, req.ServiceType)
+	if err != nil {
+		log.Printf("Failed to get price list for service type %s: %v", req.ServiceType, err)
+		return nil, fmt.Errorf("no price list for service type: %v", req.ServiceType)
+	}
+
+	if req.OutletID != 0 {
+		outletID := strconv.FormatInt(req.OutletID, 10)
+		override, err := s.repository.GetOutletPrice(c, outletID, req.ServiceType)
+		if err != nil {
+			log.Printf("Failed to get price of outlet %s for service type %s: %v", outletID, req.ServiceType, err)
+			return nil, fmt.Errorf("failed to get outlet price: %w", err)
+		}
+		if override != nil {
+			priceList.PerKg = override.PerKg
+			priceList.PerItem = override.PerItem
+			priceList.MinimumCharge = override.MinimumCharge
+		}
+	}
+
+	surcharge, err := s.repository.GetSurchargeByOrderType(c, req.OrderType)
+	if err != nil {
+		log.Printf("Failed to get surcharge for order type %s: %v", req.OrderType, err)
+		return nil, fmt.Errorf("failed to get surcharge: %w", err)
+	}
+
+	deliveryFee := decimal.Zero
+	if req.DistanceKm != nil {
+		if deliveryFee, err = s.DeliveryFee(c, *req.DistanceKm); err != nil {
+			return nil, err
+		}
+	}
+
+	quote := Calculate(priceList, surcharge, req.Weight, req.Items, req.ItemCharges, deliveryFee)
+	quote.OrderType = req.OrderType
+	quote.DistanceKm = req.DistanceKm
+
+	return quote, nil
+}
+
+// Calculate prices a load against a price list. Individually priced items
+// count towards the base amount. The surcharge, when present, applies to the
+// base amount; the minimum charge is enforced on the load alone, before the
+// delivery fee is added and the total rounded.
+func Calculate(priceList *pricingModel.PriceList, surcharge *pricingModel.Surcharge, weight float64, items int, itemCharges decimal.Decimal, deliveryFee decimal.Decimal) *Quote {
+	quote := &Quote{
+		ServiceType: priceList.ServiceType,
+		Weight:      weight,
+		Items:       items,
+		ItemCharges: itemCharges,
+		DeliveryFee: deliveryFee,
+	}
+
+	quote.Base = priceList.PerKg.Mul(decimal.NewFromFloat(weight)).
+		Add(priceList.PerItem.Mul(decimal.NewFromInt(int64(items)))).
+		Add(itemCharges)
+
+	if surcharge != nil {
+		quote.Surcharge = quote.Base.Mul(surcharge.Percentage).Div(decimal.NewFromInt(100)).
+			Add(surcharge.FlatFee)
+	}
+
+	total := quote.Base.Add(quote.Surcharge)
+	if total.LessThan(priceList.MinimumCharge) {
+		total = priceList.MinimumCharge
+		quote.MinimumApplied = true
+	}
+	total = total.Add(deliveryFee)
+
+	quote.Total = round(total, priceList.RoundingUnit, priceList.RoundingMode)
+
+	return quote
+}
+
+func round(amount decimal.Decimal, unit decimal.Decimal, mode string) decimal.Decimal {
+	if !unit.IsPositive() {
+		return amount.Round(2)
+	}
+
+	steps := amount.Div(unit)
+	switch mode {
+	case pricingModel.RoundingUp:
+		steps = steps.Ceil()
+	case pricingModel.RoundingDown:
+		steps = steps.Floor()
+	default:
+		steps = steps.Round(0)
+	}
+
+	return steps.Mul(unit)
+}
+
+func (s *PricingService) validatePriceList(req *pricingRequest.PriceList) error {
+	if err := s.validator.Struct(req); err != nil {
+		return fmt.Errorf("validation error: %w", err)
+	}
+
+	for _, amount := range []decimal.Decimal{req.PerKg, req.PerItem, req.MinimumCharge, req.RoundingUnit} {
+		if amount.IsNegative() {
+			return fmt.Errorf("validation error: rates cannot be negative")
+		}
+	}
+
+	return nil
+}
+
+func (s *PricingService) validateDeliveryFee(req *pricingRequest.DeliveryFee) error {
+	if err := s.validator.Struct(req); err != nil {
+		return fmt.Errorf("validation error: %w", err)
+	}
+
+	if req.Fee.IsNegative() {
+		return fmt.Errorf("validation error: fee cannot be negative")
+	}
+
+	return nil
+}
+
+func applyPriceList(priceList *pricingModel.PriceList, req *pricingRequest.PriceList) {
+	priceList.PerKg = req.PerKg
+	priceList.PerItem = req.PerItem
+	priceList.MinimumCharge = req.MinimumCharge
+	priceList.RoundingUnit = req.RoundingUnit
+	priceList.RoundingMode = req.RoundingMode
+	if priceList.RoundingMode == "" {
+		priceList.RoundingMode = pricingModel.RoundingNearest
+	}
+}
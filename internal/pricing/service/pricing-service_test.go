@@ -0,0 +1,207 @@
+package pricingService
+
+import (
+	"context"
+	"testing"
+	pricingModel "washit-api/internal/pricing/dto/model"
+	pricingRequest "washit-api/internal/pricing/dto/request"
+	mocks "washit-api/internal/pricing/repository/mock"
+
+	"github.com/go-playground/validator"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type PricingServiceTestSuite struct {
+	suite.Suite
+	priceList *pricingModel.PriceList
+	mockRepo  *mocks.IPricingRepository
+	service   IPricingService
+}
+
+func (suite *PricingServiceTestSuite) SetupTest() {
+	suite.priceList = &pricingModel.PriceList{
+		ServiceType:   "wash",
+		PerKg:         decimal.NewFromInt(7000),
+		PerItem:       decimal.NewFromInt(2500),
+		MinimumCharge: decimal.NewFromInt(15000),
+		RoundingUnit:  decimal.NewFromInt(500),
+		RoundingMode:  pricingModel.RoundingUp,
+	}
+	suite.mockRepo = new(mocks.IPricingRepository)
+	suite.service = NewPricingService(suite.mockRepo, validator.New())
+}
+
+func TestPricingServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(PricingServiceTestSuite))
+}
+
+// Calculate
+// =================================================================
+
+func (suite *PricingServiceTestSuite) TestCalculateWeightAndItems() {
+	quote := Calculate(suite.priceList, nil, 3.2, 2, decimal.Zero, decimal.Zero)
+
+	suite.True(decimal.NewFromInt(27400).Equal(quote.Base))
+	suite.True(quote.Surcharge.IsZero())
+	suite.False(quote.MinimumApplied)
+	suite.True(decimal.NewFromInt(27500).Equal(quote.Total))
+}
+
+func (suite *PricingServiceTestSuite) TestCalculateSurcharge() {
+	surcharge := &pricingModel.Surcharge{
+		OrderType:  "express",
+		Percentage: decimal.NewFromInt(50),
+		FlatFee:    decimal.NewFromInt(1000),
+	}
+
+	quote := Calculate(suite.priceList, surcharge, 4, 0, decimal.Zero, decimal.Zero)
+
+	suite.True(decimal.NewFromInt(28000).Equal(quote.Base))
+	suite.True(decimal.NewFromInt(15000).Equal(quote.Surcharge))
+	suite.True(decimal.NewFromInt(43000).Equal(quote.Total))
+}
+
+func (suite *PricingServiceTestSuite) TestCalculateMinimumCharge() {
+	quote := Calculate(suite.priceList, nil, 1, 0, decimal.Zero, decimal.Zero)
+
+	suite.True(quote.MinimumApplied)
+	suite.True(decimal.NewFromInt(15000).Equal(quote.Total))
+}
+
+func (suite *PricingServiceTestSuite) TestCalculateRoundingModes() {
+	suite.priceList.MinimumCharge = decimal.Zero
+
+	suite.priceList.RoundingMode = pricingModel.RoundingDown
+	suite.True(decimal.NewFromInt(21500).Equal(Calculate(suite.priceList, nil, 3.1, 0, decimal.Zero, decimal.Zero).Total))
+
+	suite.priceList.RoundingMode = pricingModel.RoundingNearest
+	suite.True(decimal.NewFromInt(21500).Equal(Calculate(suite.priceList, nil, 3.1, 0, decimal.Zero, decimal.Zero).Total))
+
+	suite.priceList.RoundingUnit = decimal.Zero
+	suite.True(decimal.NewFromInt(21700).Equal(Calculate(suite.priceList, nil, 3.1, 0, decimal.Zero, decimal.Zero).Total))
+}
+
+// Quote
+// =================================================================
+
+func (suite *PricingServiceTestSuite) TestQuoteOutletOverride() {
+	suite.mockRepo.On("GetPriceListByServiceType", mock.Anything, "wash").
+		Return(suite.priceList, nil).Times(1)
+	suite.mockRepo.On("GetOutletPrice", mock.Anything, "2", "wash").
+		Return(&pricingModel.OutletPrice{
+			OutletID:      2,
+			ServiceType:   "wash",
+			PerKg:         decimal.NewFromInt(9000),
+			MinimumCharge: decimal.NewFromInt(20000),
+		}, nil).Times(1)
+	suite.mockRepo.On("GetSurchargeByOrderType", mock.Anything, "regular").
+		Return(nil, nil).Times(1)
+
+	quote, err := suite.service.Quote(context.Background(), &pricingRequest.Quote{
+		ServiceType: "wash",
+		OrderType:   "regular",
+		Weight:      3,
+		OutletID:    2,
+	})
+	suite.Nil(err)
+	suite.True(decimal.NewFromInt(27000).Equal(quote.Base))
+	suite.True(decimal.NewFromInt(27000).Equal(quote.Total))
+}
+
+func (suite *PricingServiceTestSuite) TestQuoteOutletWithoutOverride() {
+	suite.mockRepo.On("GetPriceListByServiceType", mock.Anything, "wash").
+		Return(suite.priceList, nil).Times(1)
+	suite.mockRepo.On("GetOutletPrice", mock.Anything, "2", "wash").
+		Return(nil, nil).Times(1)
+	suite.mockRepo.On("GetSurchargeByOrderType", mock.Anything, "regular").
+		Return(nil, nil).Times(1)
+
+	quote, err := suite.service.Quote(context.Background(), &pricingRequest.Quote{
+		ServiceType: "wash",
+		OrderType:   "regular",
+		Weight:      3,
+		OutletID:    2,
+	})
+	suite.Nil(err)
+	suite.True(decimal.NewFromInt(21000).Equal(quote.Total))
+}
+
+// Delivery Fee
+// =================================================================
+
+func deliveryFees() []*pricingModel.DeliveryFee {
+	return []*pricingModel.DeliveryFee{
+		{ID: 1, MaxKm: 3, Fee: decimal.Zero},
+		{ID: 2, MaxKm: 7, Fee: decimal.NewFromInt(5000)},
+		{ID: 3, MaxKm: 15, Fee: decimal.NewFromInt(12000)},
+	}
+}
+
+func (suite *PricingServiceTestSuite) TestFeeForDistanceBands() {
+	for km, fee := range map[float64]int64{0: 0, 3: 0, 3.01: 5000, 7: 5000, 14.9: 12000} {
+		got, err := FeeForDistance(deliveryFees(), km)
+		suite.Nil(err)
+		suite.True(decimal.NewFromInt(fee).Equal(got), "distance %v km", km)
+	}
+}
+
+func (suite *PricingServiceTestSuite) TestFeeForDistanceOutOfRange() {
+	_, err := FeeForDistance(deliveryFees(), 15.5)
+	suite.ErrorIs(err, ErrOutOfDeliveryRange)
+}
+
+func (suite *PricingServiceTestSuite) TestFeeForDistanceWithoutBands() {
+	fee, err := FeeForDistance(nil, 40)
+	suite.Nil(err)
+	suite.True(fee.IsZero())
+}
+
+func (suite *PricingServiceTestSuite) TestCalculateItemCharges() {
+	quote := Calculate(suite.priceList, nil, 2, 1, decimal.NewFromInt(25000), decimal.Zero)
+
+	suite.True(decimal.NewFromInt(41500).Equal(quote.Base))
+	suite.True(decimal.NewFromInt(41500).Equal(quote.Total))
+}
+
+func (suite *PricingServiceTestSuite) TestCalculateDeliveryFeeAfterMinimum() {
+	quote := Calculate(suite.priceList, nil, 1, 0, decimal.Zero, decimal.NewFromInt(5000))
+
+	suite.True(quote.MinimumApplied)
+	suite.True(decimal.NewFromInt(5000).Equal(quote.DeliveryFee))
+	suite.True(decimal.NewFromInt(20000).Equal(quote.Total))
+}
+
+func (suite *PricingServiceTestSuite) TestQuoteWithDistance() {
+	suite.mockRepo.On("GetPriceListByServiceType", mock.Anything, "wash").
+		Return(suite.priceList, nil).Times(1)
+	suite.mockRepo.On("GetSurchargeByOrderType", mock.Anything, "regular").
+		Return(nil, nil).Times(1)
+	suite.mockRepo.On("GetDeliveryFees", mock.Anything).
+		Return(deliveryFees(), nil).Times(1)
+
+	distance := 5.2
+	quote, err := suite.service.Quote(context.Background(), &pricingRequest.Quote{
+		ServiceType: "wash",
+		OrderType:   "regular",
+		Weight:      3,
+		DistanceKm:  &distance,
+	})
+	suite.Nil(err)
+	suite.True(decimal.NewFromInt(5000).Equal(quote.DeliveryFee))
+	suite.True(decimal.NewFromInt(26000).Equal(quote.Total))
+}
+
+func (suite *PricingServiceTestSuite) TestCreateDeliveryFeeDuplicateBand() {
+	suite.mockRepo.On("GetDeliveryFeeByMaxKm", mock.Anything, 7.0).
+		Return(deliveryFees()[1], nil).Times(1)
+
+	deliveryFee, err := suite.service.CreateDeliveryFee(context.Background(), &pricingRequest.DeliveryFee{
+		MaxKm: 7,
+		Fee:   decimal.NewFromInt(6000),
+	})
+	suite.Nil(deliveryFee)
+	suite.NotNil(err)
+	suite.mockRepo.AssertNotCalled(suite.T(), "CreateDeliveryFee", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,43 @@
+package serviceModel
+
+import "time"
+
+const (
+	KindServiceType = "service_type"
+	KindOrderType   = "order_type"
+)
+
+// Service is a catalog entry. Entries of kind service_type are what the
+// laundry does (wash, dry-clean, iron), entries of kind order_type are how
+// fast it is done (regular, express). Orders reference entries by Code.
+type Service struct {
+	ID          int64  `json:"id" gorm:"primaryKey"`
+	Kind        string `json:"kind" gorm:"not null;uniqueIndex:idx_service_kind_code"`
+	Code        string `json:"code" gorm:"not null;uniqueIndex:idx_service_kind_code"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Image       string `json:"image"`
+	// TurnaroundHours is the processing time of a service type. On an order
+	// type a non-zero value caps the service turnaround, e.g. for express.
+	TurnaroundHours int        `json:"turnaroundHours"`
+	IsActive        bool       `json:"isActive" gorm:"not null"`
+	ActiveFrom      *time.Time `json:"activeFrom"`
+	ActiveUntil     *time.Time `json:"activeUntil"`
+	CreatedAt       time.Time  `json:"createdAt"`
+	UpdatedAt       time.Time  `json:"updatedAt"`
+}
+
+// IsAvailable reports whether the entry is active and inside its activation window at t.
+func (s *Service) IsAvailable(t time.Time) bool {
+	if !s.IsActive {
+		return false
+	}
+	if s.ActiveFrom != nil && t.Before(*s.ActiveFrom) {
+		return false
+	}
+	if s.ActiveUntil != nil && !t.Before(*s.ActiveUntil) {
+		return false
+	}
+
+	return true
+}
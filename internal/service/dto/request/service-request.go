@@ -0,0 +1,15 @@
+package serviceRequest
+
+import "time"
+
+type Service struct {
+	Kind            string     `json:"kind" validate:"required,oneof=service_type order_type"`
+	Code            string     `json:"code" validate:"required,max=50"`
+	Name            string     `json:"name" validate:"required"`
+	Description     string     `json:"description"`
+	Image           string     `json:"image"`
+	TurnaroundHours int        `json:"turnaroundHours" validate:"gte=0"`
+	IsActive        *bool      `json:"isActive"`
+	ActiveFrom      *time.Time `json:"activeFrom"`
+	ActiveUntil     *time.Time `json:"activeUntil"`
+}
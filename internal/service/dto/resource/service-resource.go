@@ -0,0 +1,16 @@
+package serviceResource
+
+import "time"
+
+type Service struct {
+	ID              int64      `json:"id"`
+	Kind            string     `json:"kind"`
+	Code            string     `json:"code"`
+	Name            string     `json:"name"`
+	Description     string     `json:"description"`
+	Image           string     `json:"image"`
+	TurnaroundHours int        `json:"turnaroundHours"`
+	IsActive        bool       `json:"isActive"`
+	ActiveFrom      *time.Time `json:"activeFrom"`
+	ActiveUntil     *time.Time `json:"activeUntil"`
+}
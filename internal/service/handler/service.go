@@ -0,0 +1,197 @@
+package service
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	serviceRequest "washit-api/internal/service/dto/request"
+	serviceResource "washit-api/internal/service/dto/resource"
+	serviceService "washit-api/internal/service/service"
+	"washit-api/pkg/configs"
+	"washit-api/pkg/redis"
+	"washit-api/pkg/response"
+	"washit-api/pkg/utils"
+)
+
+type ServiceHandler struct {
+	service serviceService.IServiceService
+	cache   redis.IRedis
+}
+
+func NewServiceHandler(service serviceService.IServiceService, cache redis.IRedis) *ServiceHandler {
+	return &ServiceHandler{
+		service: service,
+		cache:   cache,
+	}
+}
+
+var servicesCacheKey = "/api/v1/services"
+
+// GetServices lists the catalog entries that can currently be ordered.
+//
+//	@Summary	Get available services
+//	@Tags		Service
+//	@Accept		json
+//	@Produce	json
+//	@Param		kind	query		string	false	"service_type or order_type"
+//	@Success	200		{object}	[]serviceResource.Service
+//	@Router		/services [get]
+func (h *ServiceHandler) GetServices(c *gin.Context) {
+	var res []serviceResource.Service
+	cacheKey := servicesCacheKey + "?kind=" + c.Query("kind")
+
+	if err := h.cache.Get(cacheKey, &res); err == nil {
+		response.Success(c, http.StatusOK, "services are collected successfully", &res, nil)
+		return
+	}
+
+	services, err := h.service.GetAvailableServices(c, c.Query("kind"))
+	if err != nil {
+		log.Println("Failed to get services ", err)
+		response.Error(c, http.StatusInternalServerError, "failed to get services", err)
+		return
+	}
+
+	utils.CopyTo(&services, &res)
+	response.Success(c, http.StatusOK, "services are collected successfully", &res, nil)
+
+	_ = h.cache.SetWithExpiration(cacheKey, &res, configs.ProductCachingTime)
+}
+
+// GetAllServices lists every catalog entry including inactive ones.
+//
+//	@Summary	Get all services
+//	@Tags		Service
+//	@Accept		json
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		kind	query		string	false	"service_type or order_type"
+//	@Success	200		{object}	[]serviceResource.Service
+//	@Router		/services/all [get]
+func (h *ServiceHandler) GetAllServices(c *gin.Context) {
+	var res []serviceResource.Service
+
+	services, err := h.service.GetServices(c, c.Query("kind"))
+	if err != nil {
+		log.Println("Failed to get services ", err)
+		response.Error(c, http.StatusInternalServerError, "failed to get services", err)
+		return
+	}
+
+	utils.CopyTo(&services, &res)
+	response.Success(c, http.StatusOK, "services are collected successfully", &res, nil)
+}
+
+// GetServiceByID retrieves a catalog entry by its ID.
+//
+//	@Summary	Get service details by ID
+//	@Tags		Service
+//	@Accept		json
+//	@Produce	json
+//	@Param		id	path		string	true	"Service ID"
+//	@Success	200	{object}	serviceResource.Service
+//	@Router		/service/{id} [get]
+func (h *ServiceHandler) GetServiceByID(c *gin.Context) {
+	var res serviceResource.Service
+
+	service, err := h.service.GetServiceByID(c, c.Param("id"))
+	if err != nil {
+		log.Println("Failed to get service ", err)
+		response.Error(c, http.StatusNotFound, "service not found", err)
+		return
+	}
+
+	utils.CopyTo(&service, &res)
+	response.Success(c, http.StatusOK, "service is collected successfully", &res, nil)
+}
+
+// CreateService adds a catalog entry.
+//
+//	@Summary	Create a service
+//	@Tags		Service
+//	@Accept		json
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		_	body		serviceRequest.Service	true	"Service details"
+//	@Success	201	{object}	serviceResource.Service
+//	@Router		/service [post]
+func (h *ServiceHandler) CreateService(c *gin.Context) {
+	var req serviceRequest.Service
+	var res serviceResource.Service
+
+	if err := utils.ParseJson(c, &req); err != nil {
+		log.Println("Failed to parse request body ", err)
+		response.Error(c, http.StatusBadRequest, "failed to parse request body", err)
+		return
+	}
+
+	service, err := h.service.CreateService(c, &req)
+	if err != nil {
+		log.Println("Failed to create service ", err)
+		response.Error(c, http.StatusInternalServerError, "failed to create service", err)
+		return
+	}
+
+	utils.CopyTo(&service, &res)
+	response.Success(c, http.StatusCreated, "service is created successfully", &res, nil)
+
+	_ = h.cache.RemovePattern(servicesCacheKey + "*")
+}
+
+// UpdateService updates a catalog entry.
+//
+//	@Summary	Update a service
+//	@Tags		Service
+//	@Accept		json
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		id	path		string					true	"Service ID"
+//	@Param		_	body		serviceRequest.Service	true	"Service details"
+//	@Success	200	{object}	serviceResource.Service
+//	@Router		/service/{id} [put]
+func (h *ServiceHandler) UpdateService(c *gin.Context) {
+	var req serviceRequest.Service
+	var res serviceResource.Service
+
+	if err := utils.ParseJson(c, &req); err != nil {
+		log.Println("Failed to parse request body ", err)
+		response.Error(c, http.StatusBadRequest, "failed to parse request body", err)
+		return
+	}
+
+	service, err := h.service.UpdateService(c, c.Param("id"), &req)
+	if err != nil {
+		log.Println("Failed to update service ", err)
+		response.Error(c, http.StatusInternalServerError, "failed to update service", err)
+		return
+	}
+
+	utils.CopyTo(&service, &res)
+	response.Success(c, http.StatusOK, "service is updated successfully", &res, nil)
+
+	_ = h.cache.RemovePattern(servicesCacheKey + "*")
+}
+
+// DeleteService removes a catalog entry.
+//
+//	@Summary	Delete a service
+//	@Tags		Service
+//	@Accept		json
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		id	path	string	true	"Service ID"
+//	@Success	200
+//	@Router		/service/{id} [delete]
+func (h *ServiceHandler) DeleteService(c *gin.Context) {
+	if err := h.service.DeleteService(c, c.Param("id")); err != nil {
+		log.Println("Failed to delete service ", err)
+		response.Error(c, http.StatusInternalServerError, "failed to delete service", err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, "service is deleted successfully", nil, nil)
+
+	_ = h.cache.RemovePattern(servicesCacheKey + "*")
+}
@@ -0,0 +1,77 @@
+package serviceRepository
+
+import (
+	"context"
+
+	serviceModel "washit-api/internal/service/dto/model"
+	"washit-api/pkg/db/dbs"
+)
+
+type IServiceRepository interface {
+	GetServices(ctx context.Context, kind string) ([]*serviceModel.Service, error)
+	GetServiceByID(ctx context.Context, serviceID string) (*serviceModel.Service, error)
+	GetServiceByCode(ctx context.Context, kind string, code string) (*serviceModel.Service, error)
+	CreateService(ctx context.Context, service *serviceModel.Service) error
+	UpdateService(ctx context.Context, service *serviceModel.Service) error
+	DeleteService(ctx context.Context, service *serviceModel.Service) error
+}
+
+type ServiceRepository struct {
+	db dbs.IDatabase
+}
+
+func NewServiceRepository(db dbs.IDatabase) *ServiceRepository {
+	return &ServiceRepository{db: db}
+}
+
+func (r *ServiceRepository) GetServices(ctx context.Context, kind string) ([]*serviceModel.Service, error) {
+	var services []*serviceModel.Service
+	query := []dbs.FindOption{
+		dbs.WithOrder("kind, name"),
+	}
+
+	if kind != "" {
+		query = append(query, dbs.WithQuery(dbs.NewQuery("kind = ?", kind)))
+	}
+
+	if err := r.db.Find(ctx, &services, query...); err != nil {
+		return nil, err
+	}
+
+	return services, nil
+}
+
+func (r *ServiceRepository) GetServiceByID(ctx context.Context, serviceID string) (*serviceModel.Service, error) {
+	var service serviceModel.Service
+	if err := r.db.FindByID(ctx, serviceID, &service); err != nil {
+		return nil, err
+	}
+
+	return &service, nil
+}
+
+func (r *ServiceRepository) GetServiceByCode(ctx context.Context, kind string, code string) (*serviceModel.Service, error) {
+	var service serviceModel.Service
+	query := []dbs.Query{
+		dbs.NewQuery("kind = ?", kind),
+		dbs.NewQuery("code = ?", code),
+	}
+
+	if err := r.db.FindOne(ctx, &service, dbs.WithQuery(query...)); err != nil {
+		return nil, err
+	}
+
+	return &service, nil
+}
+
+func (r *ServiceRepository) CreateService(ctx context.Context, service *serviceModel.Service) error {
+	return r.db.Create(ctx, service)
+}
+
+func (r *ServiceRepository) UpdateService(ctx context.Context, service *serviceModel.Service) error {
+	return r.db.Update(ctx, service)
+}
+
+func (r *ServiceRepository) DeleteService(ctx context.Context, service *serviceModel.Service) error {
+	return r.db.Delete(ctx, service)
+}
@@ -0,0 +1,38 @@
+package serviceRoutes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator"
+
+	service "washit-api/internal/service/handler"
+	serviceRepository "washit-api/internal/service/repository"
+	serviceService "washit-api/internal/service/service"
+	"washit-api/pkg/db/dbs"
+	"washit-api/pkg/middleware"
+	"washit-api/pkg/rbac"
+	"washit-api/pkg/redis"
+)
+
+func Main(r *gin.RouterGroup, db dbs.IDatabase, cache redis.IRedis, validator *validator.Validate) {
+	repository := serviceRepository.NewServiceRepository(db)
+	catalog := serviceService.NewServiceService(repository, validator)
+	handler := service.NewServiceHandler(catalog, cache)
+
+	catalogMiddleware := middleware.JWTPermission(cache, rbac.CatalogManage)
+
+	// Service Get
+	r.GET("/services", handler.GetServices)
+	r.GET("/service/:id", handler.GetServiceByID)
+
+	// Staff Authority
+
+	// Service Get
+	r.GET("/services/all", catalogMiddleware, handler.GetAllServices)
+
+	// Service Post
+	r.POST("/service", catalogMiddleware, handler.CreateService)
+
+	// Service Update
+	r.PUT("/service/:id", catalogMiddleware, handler.UpdateService)
+	r.DELETE("/service/:id", catalogMiddleware, handler.DeleteService)
+}
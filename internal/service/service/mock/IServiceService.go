@@ -0,0 +1,229 @@
+// Code generated by mockery v2.50.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	serviceModel "washit-api/internal/service/dto/model"
+
+	mock "github.com/stretchr/testify/mock"
+
+	serviceRequest "washit-api/internal/service/dto/request"
+)
+
+// IServiceService is an autogenerated mock type for the IServiceService type
+type IServiceService struct {
+	mock.Mock
+}
+
+// CreateService provides a mock function with given fields: c, req
+func (_m *IServiceService) CreateService(c context.Context, req *serviceRequest.Service) (*serviceModel.Service, error) {
+	ret := _m.Called(c, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateService")
+	}
+
+	var r0 *serviceModel.Service
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *serviceRequest.Service) (*serviceModel.Service, error)); ok {
+		return rf(c, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *serviceRequest.Service) *serviceModel.Service); ok {
+		r0 = rf(c, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*serviceModel.Service)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *serviceRequest.Service) error); ok {
+		r1 = rf(c, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteService provides a mock function with given fields: c, serviceID
+func (_m *IServiceService) DeleteService(c context.Context, serviceID string) error {
+	ret := _m.Called(c, serviceID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteService")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(c, serviceID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetAvailableService provides a mock function with given fields: c, kind, code
+func (_m *IServiceService) GetAvailableService(c context.Context, kind string, code string) (*serviceModel.Service, error) {
+	ret := _m.Called(c, kind, code)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAvailableService")
+	}
+
+	var r0 *serviceModel.Service
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*serviceModel.Service, error)); ok {
+		return rf(c, kind, code)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *serviceModel.Service); ok {
+		r0 = rf(c, kind, code)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*serviceModel.Service)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(c, kind, code)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAvailableServices provides a mock function with given fields: c, kind
+func (_m *IServiceService) GetAvailableServices(c context.Context, kind string) ([]*serviceModel.Service, error) {
+	ret := _m.Called(c, kind)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAvailableServices")
+	}
+
+	var r0 []*serviceModel.Service
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*serviceModel.Service, error)); ok {
+		return rf(c, kind)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*serviceModel.Service); ok {
+		r0 = rf(c, kind)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*serviceModel.Service)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(c, kind)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetServiceByID provides a mock function with given fields: c, serviceID
+func (_m *IServiceService) GetServiceByID(c context.Context, serviceID string) (*serviceModel.Service, error) {
+	ret := _m.Called(c, serviceID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetServiceByID")
+	}
+
+	var r0 *serviceModel.Service
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*serviceModel.Service, error)); ok {
+		return rf(c, serviceID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *serviceModel.Service); ok {
+		r0 = rf(c, serviceID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*serviceModel.Service)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(c, serviceID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetServices provides a mock function with given fields: c, kind
+func (_m *IServiceService) GetServices(c context.Context, kind string) ([]*serviceModel.Service, error) {
+	ret := _m.Called(c, kind)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetServices")
+	}
+
+	var r0 []*serviceModel.Service
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*serviceModel.Service, error)); ok {
+		return rf(c, kind)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*serviceModel.Service); ok {
+		r0 = rf(c, kind)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*serviceModel.Service)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(c, kind)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateService provides a mock function with given fields: c, serviceID, req
+func (_m *IServiceService) UpdateService(c context.Context, serviceID string, req *serviceRequest.Service) (*serviceModel.Service, error) {
+	ret := _m.Called(c, serviceID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateService")
+	}
+
+	var r0 *serviceModel.Service
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *serviceRequest.Service) (*serviceModel.Service, error)); ok {
+		return rf(c, serviceID, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *serviceRequest.Service) *serviceModel.Service); ok {
+		r0 = rf(c, serviceID, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*serviceModel.Service)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *serviceRequest.Service) error); ok {
+		r1 = rf(c, serviceID, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewIServiceService creates a new instance of IServiceService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIServiceService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IServiceService {
+	mock := &IServiceService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
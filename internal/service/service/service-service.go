@@ -0,0 +1,179 @@
+package serviceService
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	serviceModel "washit-api/internal/service/dto/model"
+	serviceRequest "washit-api/internal/service/dto/request"
+	serviceRepository "washit-api/internal/service/repository"
+
+	"github.com/go-playground/validator"
+)
+
+type IServiceService interface {
+	GetServices(c context.Context, kind string) ([]*serviceModel.Service, error)
+	GetAvailableServices(c context.Context, kind string) ([]*serviceModel.Service, error)
+	GetServiceByID(c context.Context, serviceID string) (*serviceModel.Service, error)
+	GetAvailableService(c context.Context, kind string, code string) (*serviceModel.Service, error)
+	CreateService(c context.Context, req *serviceRequest.Service) (*serviceModel.Service, error)
+	UpdateService(c context.Context, serviceID string, req *serviceRequest.Service) (*serviceModel.Service, error)
+	DeleteService(c context.Context, serviceID string) error
+}
+
+type ServiceService struct {
+	repository serviceRepository.IServiceRepository
+	validator  *validator.Validate
+}
+
+func NewServiceService(
+	repository serviceRepository.IServiceRepository, validator *validator.Validate) *ServiceService {
+	return &ServiceService{
+		repository: repository,
+		validator:  validator,
+	}
+}
+
+func (s *ServiceService) GetServices(c context.Context, kind string) ([]*serviceModel.Service, error) {
+	services, err := s.repository.GetServices(c, kind)
+	if err != nil {
+		log.Printf("Failed to get services: %v", err)
+		return nil, fmt.Errorf("failed to get services: %w", err)
+	}
+
+	return services, nil
+}
+
+func (s *ServiceService) GetAvailableServices(c context.Context, kind string) ([]*serviceModel.Service, error) {
+	services, err := s.GetServices(c, kind)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	available := make([]*serviceModel.Service, 0, len(services))
+	for _, service := range services {
+		if service.IsAvailable(now) {
+			available = append(available, service)
+		}
+	}
+
+	return available, nil
+}
+
+func (s *ServiceService) GetServiceByID(c context.Context, serviceID string) (*serviceModel.Service, error) {
+	service, err := s.repository.GetServiceByID(c, serviceID)
+	if err != nil {
+		log.Printf("Failed to get service by id: %v", err)
+		return nil, fmt.Errorf("service not found: %v", serviceID)
+	}
+
+	return service, nil
+}
+
+// GetAvailableService looks up a catalog entry by code and fails unless it
+// can currently be ordered.
+func (s *ServiceService) GetAvailableService(c context.Context, kind string, code string) (*serviceModel.Service, error) {
+	service, err := s.repository.GetServiceByCode(c, kind, code)
+	if err != nil {
+		log.Printf("Failed to get %s %s: %v", kind, code, err)
+		return nil, fmt.Errorf("unknown %s: %v", kind, code)
+	}
+
+	if !service.IsAvailable(time.Now()) {
+		log.Printf("Catalog entry %s %s is not available", kind, code)
+		return nil, fmt.Errorf("%s is not available: %v", kind, code)
+	}
+
+	return service, nil
+}
+
+func (s *ServiceService) CreateService(c context.Context, req *serviceRequest.Service) (*serviceModel.Service, error) {
+	if err := s.validateService(req); err != nil {
+		log.Printf("Failed to validate service request: %v", err)
+		return nil, err
+	}
+
+	if _, err := s.repository.GetServiceByCode(c, req.Kind, req.Code); err == nil {
+		return nil, fmt.Errorf("%s with code %s already exists", req.Kind, req.Code)
+	}
+
+	service := &serviceModel.Service{IsActive: true}
+	applyService(service, req)
+
+	if err := s.repository.CreateService(c, service); err != nil {
+		log.Printf("Failed to create service: %v", err)
+		return nil, fmt.Errorf("failed to create service: %w", err)
+	}
+
+	return service, nil
+}
+
+func (s *ServiceService) UpdateService(c context.Context, serviceID string, req *serviceRequest.Service) (*serviceModel.Service, error) {
+	if err := s.validateService(req); err != nil {
+		log.Printf("Failed to validate service request: %v", err)
+		return nil, err
+	}
+
+	service, err := s.repository.GetServiceByID(c, serviceID)
+	if err != nil {
+		log.Printf("Failed to get service by id: %v", err)
+		return nil, fmt.Errorf("service not found: %v", serviceID)
+	}
+
+	if existing, err := s.repository.GetServiceByCode(c, req.Kind, req.Code); err == nil && existing.ID != service.ID {
+		return nil, fmt.Errorf("%s with code %s already exists", req.Kind, req.Code)
+	}
+
+	applyService(service, req)
+
+	if err := s.repository.UpdateService(c, service); err != nil {
+		log.Printf("Failed to update service %s: %v", serviceID, err)
+		return nil, fmt.Errorf("failed to update service: %w", err)
+	}
+
+	return service, nil
+}
+
+func (s *ServiceService) DeleteService(c context.Context, serviceID string) error {
+	service, err := s.repository.GetServiceByID(c, serviceID)
+	if err != nil {
+		log.Printf("Failed to get service by id: %v", err)
+		return fmt.Errorf("service not found: %v", serviceID)
+	}
+
+	if err := s.repository.DeleteService(c, service); err != nil {
+		log.Printf("Failed to delete service %s: %v", serviceID, err)
+		return fmt.Errorf("failed to delete service: %w", err)
+	}
+
+	return nil
+}
+
+func (s *ServiceService) validateService(req *serviceRequest.Service) error {
+	if err := s.validator.Struct(req); err != nil {
+		return fmt.Errorf("validation error: %w", err)
+	}
+
+	if req.ActiveFrom != nil && req.ActiveUntil != nil && !req.ActiveUntil.After(*req.ActiveFrom) {
+		return fmt.Errorf("validation error: activeUntil must be after activeFrom")
+	}
+
+	return nil
+}
+
+func applyService(service *serviceModel.Service, req *serviceRequest.Service) {
+	service.Kind = req.Kind
+	service.Code = req.Code
+	service.Name = req.Name
+	service.Description = req.Description
+	service.Image = req.Image
+	service.TurnaroundHours = req.TurnaroundHours
+	service.ActiveFrom = req.ActiveFrom
+	service.ActiveUntil = req.ActiveUntil
+	if req.IsActive != nil {
+		service.IsActive = *req.IsActive
+	}
+}
@@ -0,0 +1,29 @@
+package slotModel
+
+import "time"
+
+// PickupWindow is a recurring pickup time range on one day of the week, such
+// as Monday 09:00-11:00. StartTime and EndTime are HH:MM wall clock times and
+// Capacity caps how many orders may be collected in each occurrence.
+type PickupWindow struct {
+	ID        int64        `json:"id" gorm:"primaryKey"`
+	Weekday   time.Weekday `json:"weekday" gorm:"not null;index"`
+	StartTime string       `json:"startTime" gorm:"not null"`
+	EndTime   string       `json:"endTime" gorm:"not null"`
+	Capacity  int          `json:"capacity" gorm:"not null"`
+	IsActive  bool         `json:"isActive" gorm:"not null"`
+	CreatedAt time.Time    `json:"createdAt"`
+	UpdatedAt time.Time    `json:"updatedAt"`
+}
+
+// PickupSlot is one dated occurrence of a window. Rows are created by the
+// first reservation and Reserved counts the orders holding the slot.
+type PickupSlot struct {
+	ID        int64     `json:"id" gorm:"primaryKey"`
+	WindowID  int64     `json:"windowID" gorm:"not null;uniqueIndex:idx_pickup_slot_window_start"`
+	StartsAt  time.Time `json:"startsAt" gorm:"not null;uniqueIndex:idx_pickup_slot_window_start"`
+	EndsAt    time.Time `json:"endsAt" gorm:"not null"`
+	Reserved  int       `json:"reserved" gorm:"not null;default:0"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
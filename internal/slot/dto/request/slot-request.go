@@ -0,0 +1,9 @@
+package slotRequest
+
+type Window struct {
+	Weekday   int    `json:"weekday" validate:"gte=0,lte=6"`
+	StartTime string `json:"startTime" validate:"required"`
+	EndTime   string `json:"endTime" validate:"required"`
+	Capacity  int    `json:"capacity" validate:"required,gt=0"`
+	IsActive  *bool  `json:"isActive"`
+}
@@ -0,0 +1,22 @@
+package slotResource
+
+import "time"
+
+type Window struct {
+	ID        int64     `json:"id"`
+	Weekday   int       `json:"weekday"`
+	StartTime string    `json:"startTime"`
+	EndTime   string    `json:"endTime"`
+	Capacity  int       `json:"capacity"`
+	IsActive  bool      `json:"isActive"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+type Slot struct {
+	WindowID  int64     `json:"windowID"`
+	StartsAt  time.Time `json:"startsAt"`
+	EndsAt    time.Time `json:"endsAt"`
+	Capacity  int       `json:"capacity"`
+	Available int       `json:"available"`
+}
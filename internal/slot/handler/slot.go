@@ -0,0 +1,180 @@
+package slot
+
+import (
+	"errors"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	slotRequest "washit-api/internal/slot/dto/request"
+	slotResource "washit-api/internal/slot/dto/resource"
+	slotService "washit-api/internal/slot/service"
+	"washit-api/pkg/redis"
+	"washit-api/pkg/response"
+	"washit-api/pkg/utils"
+)
+
+const defaultDays = 7
+
+type SlotHandler struct {
+	service slotService.ISlotService
+	cache   redis.IRedis
+}
+
+func NewSlotHandler(service slotService.ISlotService, cache redis.IRedis) *SlotHandler {
+	return &SlotHandler{
+		service: service,
+		cache:   cache,
+	}
+}
+
+// GetAvailableSlots lists the pickup slots that can still be booked.
+//
+//	@Summary	Get available pickup slots
+//	@Tags		Pickup Slot
+//	@Accept		json
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		days	query		int	false	"Number of days starting today, defaults to 7, at most 30"
+//	@Success	200		{object}	[]slotResource.Slot
+//	@Router		/pickup-slots [get]
+func (h *SlotHandler) GetAvailableSlots(c *gin.Context) {
+	var res []slotResource.Slot
+
+	days := defaultDays
+	if value := c.Query("days"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 1 || parsed > slotService.MaxDays {
+			log.Println("Failed to parse days ", value)
+			response.Error(c, http.StatusBadRequest, "invalid days, expected 1 to 30", err)
+			return
+		}
+		days = parsed
+	}
+
+	slots, err := h.service.GetAvailableSlots(c, days)
+	if err != nil {
+		log.Println("Failed to get pickup slots ", err)
+		response.Error(c, http.StatusInternalServerError, "failed to get pickup slots", err)
+		return
+	}
+
+	utils.CopyTo(&slots, &res)
+	response.Success(c, http.StatusOK, "pickup slots are collected successfully", &res, nil)
+}
+
+// GetWindows lists the configured weekly pickup windows.
+//
+//	@Summary	Get pickup windows
+//	@Tags		Pickup Slot
+//	@Accept		json
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Success	200	{object}	[]slotResource.Window
+//	@Router		/pickup-windows [get]
+func (h *SlotHandler) GetWindows(c *gin.Context) {
+	var res []slotResource.Window
+
+	windows, err := h.service.GetWindows(c)
+	if err != nil {
+		log.Println("Failed to get pickup windows ", err)
+		response.Error(c, http.StatusInternalServerError, "failed to get pickup windows", err)
+		return
+	}
+
+	utils.CopyTo(&windows, &res)
+	response.Success(c, http.StatusOK, "pickup windows are collected successfully", &res, nil)
+}
+
+// CreateWindow adds a weekly pickup window.
+//
+//	@Summary	Create a pickup window
+//	@Tags		Pickup Slot
+//	@Accept		json
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		_	body		slotRequest.Window	true	"Window details"
+//	@Success	201	{object}	slotResource.Window
+//	@Router		/pickup-window [post]
+func (h *SlotHandler) CreateWindow(c *gin.Context) {
+	var req slotRequest.Window
+	var res slotResource.Window
+
+	if err := utils.ParseJson(c, &req); err != nil {
+		log.Println("Failed to parse request body ", err)
+		response.Error(c, http.StatusBadRequest, "failed to parse request body", err)
+		return
+	}
+
+	window, err := h.service.CreateWindow(c, &req)
+	if err != nil {
+		log.Println("Failed to create pickup window ", err)
+		response.Error(c, statusCode(err), "failed to create pickup window", err)
+		return
+	}
+
+	utils.CopyTo(&window, &res)
+	response.Success(c, http.StatusCreated, "pickup window is created successfully", &res, nil)
+}
+
+// UpdateWindow changes a weekly pickup window. Existing reservations are kept.
+//
+//	@Summary	Update a pickup window
+//	@Tags		Pickup Slot
+//	@Accept		json
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		id	path		string				true	"Window ID"
+//	@Param		_	body		slotRequest.Window	true	"Window details"
+//	@Success	200	{object}	slotResource.Window
+//	@Router		/pickup-window/{id} [put]
+func (h *SlotHandler) UpdateWindow(c *gin.Context) {
+	var req slotRequest.Window
+	var res slotResource.Window
+
+	if err := utils.ParseJson(c, &req); err != nil {
+		log.Println("Failed to parse request body ", err)
+		response.Error(c, http.StatusBadRequest, "failed to parse request body", err)
+		return
+	}
+
+	window, err := h.service.UpdateWindow(c, c.Param("id"), &req)
+	if err != nil {
+		log.Println("Failed to update pickup window ", err)
+		response.Error(c, statusCode(err), "failed to update pickup window", err)
+		return
+	}
+
+	utils.CopyTo(&window, &res)
+	response.Success(c, http.StatusOK, "pickup window is updated successfully", &res, nil)
+}
+
+// DeleteWindow removes a weekly pickup window.
+//
+//	@Summary	Delete a pickup window
+//	@Tags		Pickup Slot
+//	@Accept		json
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		id	path	string	true	"Window ID"
+//	@Success	200
+//	@Router		/pickup-window/{id} [delete]
+func (h *SlotHandler) DeleteWindow(c *gin.Context) {
+	if err := h.service.DeleteWindow(c, c.Param("id")); err != nil {
+		log.Println("Failed to delete pickup window ", err)
+		response.Error(c, statusCode(err), "failed to delete pickup window", err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, "pickup window is deleted successfully", nil, nil)
+}
+
+func statusCode(err error) int {
+	if errors.Is(err, slotService.ErrWindowNotFound) {
+		return http.StatusNotFound
+	}
+
+	return http.StatusInternalServerError
+}
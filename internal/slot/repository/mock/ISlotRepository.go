@@ -0,0 +1,253 @@
+// Code generated by mockery v2.50.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	slotModel "washit-api/internal/slot/dto/model"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// ISlotRepository is an autogenerated mock type for the ISlotRepository type
+type ISlotRepository struct {
+	mock.Mock
+}
+
+// CreateWindow provides a mock function with given fields: ctx, window
+func (_m *ISlotRepository) CreateWindow(ctx context.Context, window *slotModel.PickupWindow) error {
+	ret := _m.Called(ctx, window)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateWindow")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *slotModel.PickupWindow) error); ok {
+		r0 = rf(ctx, window)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteWindow provides a mock function with given fields: ctx, window
+func (_m *ISlotRepository) DeleteWindow(ctx context.Context, window *slotModel.PickupWindow) error {
+	ret := _m.Called(ctx, window)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteWindow")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *slotModel.PickupWindow) error); ok {
+		r0 = rf(ctx, window)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetSlot provides a mock function with given fields: ctx, windowID, startsAt
+func (_m *ISlotRepository) GetSlot(ctx context.Context, windowID int64, startsAt time.Time) (*slotModel.PickupSlot, error) {
+	ret := _m.Called(ctx, windowID, startsAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSlot")
+	}
+
+	var r0 *slotModel.PickupSlot
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, time.Time) (*slotModel.PickupSlot, error)); ok {
+		return rf(ctx, windowID, startsAt)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, time.Time) *slotModel.PickupSlot); ok {
+		r0 = rf(ctx, windowID, startsAt)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*slotModel.PickupSlot)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, time.Time) error); ok {
+		r1 = rf(ctx, windowID, startsAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetSlots provides a mock function with given fields: ctx, from, to
+func (_m *ISlotRepository) GetSlots(ctx context.Context, from time.Time, to time.Time) ([]*slotModel.PickupSlot, error) {
+	ret := _m.Called(ctx, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSlots")
+	}
+
+	var r0 []*slotModel.PickupSlot
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time) ([]*slotModel.PickupSlot, error)); ok {
+		return rf(ctx, from, to)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time) []*slotModel.PickupSlot); ok {
+		r0 = rf(ctx, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*slotModel.PickupSlot)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetWindowByID provides a mock function with given fields: ctx, windowID
+func (_m *ISlotRepository) GetWindowByID(ctx context.Context, windowID string) (*slotModel.PickupWindow, error) {
+	ret := _m.Called(ctx, windowID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWindowByID")
+	}
+
+	var r0 *slotModel.PickupWindow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*slotModel.PickupWindow, error)); ok {
+		return rf(ctx, windowID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *slotModel.PickupWindow); ok {
+		r0 = rf(ctx, windowID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*slotModel.PickupWindow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, windowID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetWindows provides a mock function with given fields: ctx
+func (_m *ISlotRepository) GetWindows(ctx context.Context) ([]*slotModel.PickupWindow, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWindows")
+	}
+
+	var r0 []*slotModel.PickupWindow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*slotModel.PickupWindow, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*slotModel.PickupWindow); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*slotModel.PickupWindow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Release provides a mock function with given fields: ctx, slotID
+func (_m *ISlotRepository) Release(ctx context.Context, slotID int64) error {
+	ret := _m.Called(ctx, slotID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Release")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, slotID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Reserve provides a mock function with given fields: ctx, window, startsAt, endsAt
+func (_m *ISlotRepository) Reserve(ctx context.Context, window *slotModel.PickupWindow, startsAt time.Time, endsAt time.Time) (*slotModel.PickupSlot, error) {
+	ret := _m.Called(ctx, window, startsAt, endsAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Reserve")
+	}
+
+	var r0 *slotModel.PickupSlot
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *slotModel.PickupWindow, time.Time, time.Time) (*slotModel.PickupSlot, error)); ok {
+		return rf(ctx, window, startsAt, endsAt)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *slotModel.PickupWindow, time.Time, time.Time) *slotModel.PickupSlot); ok {
+		r0 = rf(ctx, window, startsAt, endsAt)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*slotModel.PickupSlot)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *slotModel.PickupWindow, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, window, startsAt, endsAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateWindow provides a mock function with given fields: ctx, window
+func (_m *ISlotRepository) UpdateWindow(ctx context.Context, window *slotModel.PickupWindow) error {
+	ret := _m.Called(ctx, window)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateWindow")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *slotModel.PickupWindow) error); ok {
+		r0 = rf(ctx, window)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewISlotRepository creates a new instance of ISlotRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewISlotRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ISlotRepository {
+	mock := &ISlotRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,148 @@
+package slotRepository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	slotModel "washit-api/internal/slot/dto/model"
+	"washit-api/pkg/db/dbs"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var ErrSlotFull = errors.New("pickup slot is full")
+
+type ISlotRepository interface {
+	GetWindows(ctx context.Context) ([]*slotModel.PickupWindow, error)
+	GetWindowByID(ctx context.Context, windowID string) (*slotModel.PickupWindow, error)
+	CreateWindow(ctx context.Context, window *slotModel.PickupWindow) error
+	UpdateWindow(ctx context.Context, window *slotModel.PickupWindow) error
+	DeleteWindow(ctx context.Context, window *slotModel.PickupWindow) error
+	GetSlots(ctx context.Context, from time.Time, to time.Time) ([]*slotModel.PickupSlot, error)
+	GetSlot(ctx context.Context, windowID int64, startsAt time.Time) (*slotModel.PickupSlot, error)
+	Reserve(ctx context.Context, window *slotModel.PickupWindow, startsAt time.Time, endsAt time.Time) (*slotModel.PickupSlot, error)
+	Release(ctx context.Context, slotID int64) error
+}
+
+type SlotRepository struct {
+	db dbs.IDatabase
+}
+
+func NewSlotRepository(db dbs.IDatabase) *SlotRepository {
+	return &SlotRepository{db: db}
+}
+
+func (r *SlotRepository) GetWindows(ctx context.Context) ([]*slotModel.PickupWindow, error) {
+	var windows []*slotModel.PickupWindow
+	query := []dbs.FindOption{
+		dbs.WithOrder("weekday ASC, start_time ASC"),
+	}
+
+	if err := r.db.Find(ctx, &windows, query...); err != nil {
+		return nil, err
+	}
+
+	return windows, nil
+}
+
+func (r *SlotRepository) GetWindowByID(ctx context.Context, windowID string) (*slotModel.PickupWindow, error) {
+	var window slotModel.PickupWindow
+	if err := r.db.FindByID(ctx, windowID, &window); err != nil {
+		return nil, err
+	}
+
+	return &window, nil
+}
+
+func (r *SlotRepository) CreateWindow(ctx context.Context, window *slotModel.PickupWindow) error {
+	return r.db.Create(ctx, window)
+}
+
+func (r *SlotRepository) UpdateWindow(ctx context.Context, window *slotModel.PickupWindow) error {
+	return r.db.Update(ctx, window)
+}
+
+func (r *SlotRepository) DeleteWindow(ctx context.Context, window *slotModel.PickupWindow) error {
+	return r.db.Delete(ctx, window)
+}
+
+// GetSlots returns the slots that have been reserved at least once and start
+// in [from, to).
+func (r *SlotRepository) GetSlots(ctx context.Context, from time.Time, to time.Time) ([]*slotModel.PickupSlot, error) {
+	var slots []*slotModel.PickupSlot
+	query := []dbs.FindOption{
+		dbs.WithQuery(
+			dbs.NewQuery("starts_at >= ?", from),
+			dbs.NewQuery("starts_at < ?", to),
+		),
+	}
+
+	if err := r.db.Find(ctx, &slots, query...); err != nil {
+		return nil, err
+	}
+
+	return slots, nil
+}
+
+// GetSlot returns the occurrence of a window starting at startsAt, or nil
+// without an error until the slot has been reserved once.
+func (r *SlotRepository) GetSlot(ctx context.Context, windowID int64, startsAt time.Time) (*slotModel.PickupSlot, error) {
+	var slot slotModel.PickupSlot
+	query := []dbs.FindOption{
+		dbs.WithQuery(
+			dbs.NewQuery("window_id = ?", windowID),
+			dbs.NewQuery("starts_at = ?", startsAt),
+		),
+	}
+
+	if err := r.db.FindOne(ctx, &slot, query...); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &slot, nil
+}
+
+// Reserve takes one place in the occurrence of window starting at startsAt,
+// creating the slot row on first use. The conditional increment keeps
+// concurrent reservations from exceeding the window's capacity; a full slot
+// fails with ErrSlotFull.
+func (r *SlotRepository) Reserve(ctx context.Context, window *slotModel.PickupWindow, startsAt time.Time, endsAt time.Time) (*slotModel.PickupSlot, error) {
+	slot := &slotModel.PickupSlot{WindowID: window.ID, StartsAt: startsAt, EndsAt: endsAt}
+
+	err := r.db.WithTransaction(func(tx dbs.IDatabase) error {
+		db := tx.GetDB().WithContext(ctx)
+		if err := db.Clauses(clause.OnConflict{DoNothing: true}).Create(slot).Error; err != nil {
+			return err
+		}
+
+		result := db.Model(&slotModel.PickupSlot{}).
+			Where("window_id = ? AND starts_at = ? AND reserved < ?", window.ID, startsAt, window.Capacity).
+			Update("reserved", gorm.Expr("reserved + 1"))
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return ErrSlotFull
+		}
+
+		return db.Where("window_id = ? AND starts_at = ?", window.ID, startsAt).First(slot).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return slot, nil
+}
+
+// Release gives back a place taken by Reserve.
+func (r *SlotRepository) Release(ctx context.Context, slotID int64) error {
+	return r.db.GetDB().WithContext(ctx).Model(&slotModel.PickupSlot{}).
+		Where("id = ? AND reserved > 0", slotID).
+		Update("reserved", gorm.Expr("reserved - 1")).Error
+}
@@ -0,0 +1,35 @@
+package slotRoutes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator"
+
+	calendarRepository "washit-api/internal/calendar/repository"
+	calendarService "washit-api/internal/calendar/service"
+	slot "washit-api/internal/slot/handler"
+	slotRepository "washit-api/internal/slot/repository"
+	slotService "washit-api/internal/slot/service"
+	"washit-api/pkg/db/dbs"
+	"washit-api/pkg/middleware"
+	"washit-api/pkg/rbac"
+	"washit-api/pkg/redis"
+)
+
+func Main(r *gin.RouterGroup, db dbs.IDatabase, cache redis.IRedis, validator *validator.Validate) {
+	repository := slotRepository.NewSlotRepository(db)
+	calendars := calendarService.NewCalendarService(calendarRepository.NewCalendarRepository(db), validator)
+	service := slotService.NewSlotService(repository, calendars, validator)
+	handler := slot.NewSlotHandler(service, cache)
+
+	authMiddleware := middleware.JWTAuth(cache)
+	slotMiddleware := middleware.JWTPermission(cache, rbac.SlotManage)
+
+	// Pickup Slot Get
+	r.GET("/pickup-slots", authMiddleware, handler.GetAvailableSlots)
+
+	// Staff Authority
+	r.GET("/pickup-windows", slotMiddleware, handler.GetWindows)
+	r.POST("/pickup-window", slotMiddleware, handler.CreateWindow)
+	r.PUT("/pickup-window/:id", slotMiddleware, handler.UpdateWindow)
+	r.DELETE("/pickup-window/:id", slotMiddleware, handler.DeleteWindow)
+}
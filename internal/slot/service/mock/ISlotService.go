@@ -0,0 +1,251 @@
+// Code generated by mockery v2.50.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	slotModel "washit-api/internal/slot/dto/model"
+
+	mock "github.com/stretchr/testify/mock"
+
+	slotRequest "washit-api/internal/slot/dto/request"
+
+	slotService "washit-api/internal/slot/service"
+
+	time "time"
+)
+
+// ISlotService is an autogenerated mock type for the ISlotService type
+type ISlotService struct {
+	mock.Mock
+}
+
+// CreateWindow provides a mock function with given fields: c, req
+func (_m *ISlotService) CreateWindow(c context.Context, req *slotRequest.Window) (*slotModel.PickupWindow, error) {
+	ret := _m.Called(c, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateWindow")
+	}
+
+	var r0 *slotModel.PickupWindow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *slotRequest.Window) (*slotModel.PickupWindow, error)); ok {
+		return rf(c, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *slotRequest.Window) *slotModel.PickupWindow); ok {
+		r0 = rf(c, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*slotModel.PickupWindow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *slotRequest.Window) error); ok {
+		r1 = rf(c, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteWindow provides a mock function with given fields: c, windowID
+func (_m *ISlotService) DeleteWindow(c context.Context, windowID string) error {
+	ret := _m.Called(c, windowID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteWindow")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(c, windowID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetAvailableSlots provides a mock function with given fields: c, days
+func (_m *ISlotService) GetAvailableSlots(c context.Context, days int) ([]*slotService.Slot, error) {
+	ret := _m.Called(c, days)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAvailableSlots")
+	}
+
+	var r0 []*slotService.Slot
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]*slotService.Slot, error)); ok {
+		return rf(c, days)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []*slotService.Slot); ok {
+		r0 = rf(c, days)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*slotService.Slot)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(c, days)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetWindows provides a mock function with given fields: c
+func (_m *ISlotService) GetWindows(c context.Context) ([]*slotModel.PickupWindow, error) {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWindows")
+	}
+
+	var r0 []*slotModel.PickupWindow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*slotModel.PickupWindow, error)); ok {
+		return rf(c)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*slotModel.PickupWindow); ok {
+		r0 = rf(c)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*slotModel.PickupWindow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(c)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Release provides a mock function with given fields: c, slotID
+func (_m *ISlotService) Release(c context.Context, slotID int64) error {
+	ret := _m.Called(c, slotID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Release")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(c, slotID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Reserve provides a mock function with given fields: c, collectDate
+func (_m *ISlotService) Reserve(c context.Context, collectDate time.Time) (*slotModel.PickupSlot, error) {
+	ret := _m.Called(c, collectDate)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Reserve")
+	}
+
+	var r0 *slotModel.PickupSlot
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) (*slotModel.PickupSlot, error)); ok {
+		return rf(c, collectDate)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) *slotModel.PickupSlot); ok {
+		r0 = rf(c, collectDate)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*slotModel.PickupSlot)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(c, collectDate)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Resolve provides a mock function with given fields: c, collectDate
+func (_m *ISlotService) Resolve(c context.Context, collectDate time.Time) (*slotModel.PickupSlot, error) {
+	ret := _m.Called(c, collectDate)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Resolve")
+	}
+
+	var r0 *slotModel.PickupSlot
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) (*slotModel.PickupSlot, error)); ok {
+		return rf(c, collectDate)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) *slotModel.PickupSlot); ok {
+		r0 = rf(c, collectDate)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*slotModel.PickupSlot)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(c, collectDate)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateWindow provides a mock function with given fields: c, windowID, req
+func (_m *ISlotService) UpdateWindow(c context.Context, windowID string, req *slotRequest.Window) (*slotModel.PickupWindow, error) {
+	ret := _m.Called(c, windowID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateWindow")
+	}
+
+	var r0 *slotModel.PickupWindow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *slotRequest.Window) (*slotModel.PickupWindow, error)); ok {
+		return rf(c, windowID, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *slotRequest.Window) *slotModel.PickupWindow); ok {
+		r0 = rf(c, windowID, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*slotModel.PickupWindow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *slotRequest.Window) error); ok {
+		r1 = rf(c, windowID, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewISlotService creates a new instance of ISlotService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewISlotService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ISlotService {
+	mock := &ISlotService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,366 @@
+package slotService
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	calendarService "washit-api/internal/calendar/service"
+	slotModel "washit-api/internal/slot/dto/model"
+	slotRequest "washit-api/internal/slot/dto/request"
+	slotRepository "washit-api/internal/slot/repository"
+	"washit-api/pkg/worktime"
+
+	"github.com/go-playground/validator"
+)
+
+type ISlotService interface {
+	GetWindows(c context.Context) ([]*slotModel.PickupWindow, error)
+	CreateWindow(c context.Context, req *slotRequest.Window) (*slotModel.PickupWindow, error)
+	UpdateWindow(c context.Context, windowID string, req *slotRequest.Window) (*slotModel.PickupWindow, error)
+	DeleteWindow(c context.Context, windowID string) error
+	GetAvailableSlots(c context.Context, days int) ([]*Slot, error)
+	Reserve(c context.Context, collectDate time.Time) (*slotModel.PickupSlot, error)
+	Resolve(c context.Context, collectDate time.Time) (*slotModel.PickupSlot, error)
+	Release(c context.Context, slotID int64) error
+}
+
+const (
+	clockLayout = "15:04"
+	MaxDays     = 30
+)
+
+var (
+	ErrWindowNotFound = errors.New("pickup window not found")
+	ErrNoSlot         = errors.New("collect date is not within a pickup window")
+	ErrSlotPassed     = errors.New("pickup slot has already started")
+	ErrSlotFull       = errors.New("pickup slot is full")
+)
+
+// Slot is one bookable occurrence of a pickup window.
+type Slot struct {
+	WindowID  int64
+	StartsAt  time.Time
+	EndsAt    time.Time
+	Capacity  int
+	Available int
+}
+
+type SlotService struct {
+	repository slotRepository.ISlotRepository
+	calendars  calendarService.ICalendarService
+	validator  *validator.Validate
+	location   *time.Location
+	now        func() time.Time
+}
+
+func NewSlotService(
+	repository slotRepository.ISlotRepository, calendars calendarService.ICalendarService,
+	validator *validator.Validate) *SlotService {
+	return &SlotService{
+		repository: repository,
+		calendars:  calendars,
+		validator:  validator,
+		location:   worktime.Zone(),
+		now:        time.Now,
+	}
+}
+
+func (s *SlotService) GetWindows(c context.Context) ([]*slotModel.PickupWindow, error) {
+	windows, err := s.repository.GetWindows(c)
+	if err != nil {
+		log.Printf("Failed to get pickup windows: %v", err)
+		return nil, fmt.Errorf("failed to get pickup windows: %w", err)
+	}
+
+	return windows, nil
+}
+
+func (s *SlotService) CreateWindow(c context.Context, req *slotRequest.Window) (*slotModel.PickupWindow, error) {
+	window := &slotModel.PickupWindow{IsActive: true}
+	if err := s.applyWindow(c, window, req); err != nil {
+		log.Printf("Failed to validate pickup window request: %v", err)
+		return nil, err
+	}
+
+	if err := s.repository.CreateWindow(c, window); err != nil {
+		log.Printf("Failed to create pickup window: %v", err)
+		return nil, fmt.Errorf("failed to create pickup window: %w", err)
+	}
+
+	return window, nil
+}
+
+func (s *SlotService) UpdateWindow(c context.Context, windowID string, req *slotRequest.Window) (*slotModel.PickupWindow, error) {
+	window, err := s.repository.GetWindowByID(c, windowID)
+	if err != nil {
+		log.Printf("Failed to get pickup window by id: %v", err)
+		return nil, fmt.Errorf("%w: %v", ErrWindowNotFound, windowID)
+	}
+
+	if err := s.applyWindow(c, window, req); err != nil {
+		log.Printf("Failed to validate pickup window request: %v", err)
+		return nil, err
+	}
+
+	if err := s.repository.UpdateWindow(c, window); err != nil {
+		log.Printf("Failed to update pickup window %s: %v", windowID, err)
+		return nil, fmt.Errorf("failed to update pickup window: %w", err)
+	}
+
+	return window, nil
+}
+
+// DeleteWindow removes a window from future booking. Orders already holding
+// one of its slots keep their reservation.
+func (s *SlotService) DeleteWindow(c context.Context, windowID string) error {
+	window, err := s.repository.GetWindowByID(c, windowID)
+	if err != nil {
+		log.Printf("Failed to get pickup window by id: %v", err)
+		return fmt.Errorf("%w: %v", ErrWindowNotFound, windowID)
+	}
+
+	if err := s.repository.DeleteWindow(c, window); err != nil {
+		log.Printf("Failed to delete pickup window %s: %v", windowID, err)
+		return fmt.Errorf("failed to delete pickup window: %w", err)
+	}
+
+	return nil
+}
+
+// GetAvailableSlots lists the slots of the active windows over the next days
+// calendar days, starting today, that have not started, fall within opening
+// hours and still have room.
+func (s *SlotService) GetAvailableSlots(c context.Context, days int) ([]*Slot, error) {
+	if days < 1 || days > MaxDays {
+		return nil, fmt.Errorf("validation error: days must be between 1 and %d", MaxDays)
+	}
+
+	windows, err := s.GetWindows(c)
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.now().In(s.location)
+	from := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, s.location)
+	to := from.AddDate(0, 0, days)
+
+	calendar, err := s.calendars.Load(c)
+	if err != nil {
+		return nil, err
+	}
+
+	reserved, err := s.repository.GetSlots(c, from, to)
+	if err != nil {
+		log.Printf("Failed to get pickup slots: %v", err)
+		return nil, fmt.Errorf("failed to get pickup slots: %w", err)
+	}
+
+	taken := make(map[string]int, len(reserved))
+	for _, slot := range reserved {
+		taken[slotKey(slot.WindowID, slot.StartsAt)] = slot.Reserved
+	}
+
+	slots := make([]*Slot, 0)
+	for day := from; day.Before(to); day = day.AddDate(0, 0, 1) {
+		open, close, ok := calendar.Hours(day)
+		if !ok {
+			continue
+		}
+
+		for _, window := range windows {
+			if !window.IsActive || window.Weekday != day.Weekday() {
+				continue
+			}
+
+			startsAt, endsAt, err := s.occurrence(window, day)
+			if err != nil || !startsAt.After(now) || startsAt.Before(open) || !startsAt.Before(close) {
+				continue
+			}
+
+			available := window.Capacity - taken[slotKey(window.ID, startsAt)]
+			if available <= 0 {
+				continue
+			}
+
+			slots = append(slots, &Slot{
+				WindowID:  window.ID,
+				StartsAt:  startsAt,
+				EndsAt:    endsAt,
+				Capacity:  window.Capacity,
+				Available: available,
+			})
+		}
+	}
+
+	return slots, nil
+}
+
+// Reserve takes a place in the slot of the active window that collectDate
+// falls within, from its start up to its end. The slot is returned so the
+// order can be collected from its start; it must not have started yet.
+// Without any active window pickups are not slotted and Reserve returns no
+// slot and no error.
+func (s *SlotService) Reserve(c context.Context, collectDate time.Time) (*slotModel.PickupSlot, error) {
+	window, startsAt, endsAt, err := s.find(c, collectDate)
+	if err != nil || window == nil {
+		return nil, err
+	}
+
+	slot, err := s.repository.Reserve(c, window, startsAt, endsAt)
+	if errors.Is(err, slotRepository.ErrSlotFull) {
+		log.Printf("Pickup slot %s of window %d is full", startsAt.Format(time.RFC3339), window.ID)
+		return nil, fmt.Errorf("%w: %s", ErrSlotFull, startsAt.Format(time.RFC3339))
+	}
+	if err != nil {
+		log.Printf("Failed to reserve pickup slot: %v", err)
+		return nil, fmt.Errorf("failed to reserve pickup slot: %w", err)
+	}
+
+	return slot, nil
+}
+
+// Resolve returns the slot collectDate falls within like Reserve does, but
+// without taking a place in it. A slot nobody has reserved yet is returned
+// unsaved, with no ID.
+func (s *SlotService) Resolve(c context.Context, collectDate time.Time) (*slotModel.PickupSlot, error) {
+	window, startsAt, endsAt, err := s.find(c, collectDate)
+	if err != nil || window == nil {
+		return nil, err
+	}
+
+	slot, err := s.repository.GetSlot(c, window.ID, startsAt)
+	if err != nil {
+		log.Printf("Failed to get pickup slot: %v", err)
+		return nil, fmt.Errorf("failed to get pickup slot: %w", err)
+	}
+
+	if slot == nil {
+		return &slotModel.PickupSlot{WindowID: window.ID, StartsAt: startsAt, EndsAt: endsAt}, nil
+	}
+
+	return slot, nil
+}
+
+// find returns the active window collectDate falls within and the start and
+// end of that occurrence. Without any active window it returns no window and
+// no error.
+func (s *SlotService) find(c context.Context, collectDate time.Time) (*slotModel.PickupWindow, time.Time, time.Time, error) {
+	collectDate = collectDate.In(s.location)
+	if !collectDate.After(s.now()) {
+		return nil, time.Time{}, time.Time{}, fmt.Errorf("%w: %s", ErrSlotPassed, collectDate.Format(time.RFC3339))
+	}
+
+	windows, err := s.GetWindows(c)
+	if err != nil {
+		return nil, time.Time{}, time.Time{}, err
+	}
+
+	slotted := false
+	for _, window := range windows {
+		if !window.IsActive {
+			continue
+		}
+		slotted = true
+
+		if window.Weekday != collectDate.Weekday() {
+			continue
+		}
+
+		startsAt, endsAt, err := s.occurrence(window, collectDate)
+		if err != nil || collectDate.Before(startsAt) || !collectDate.Before(endsAt) {
+			continue
+		}
+
+		if !startsAt.After(s.now()) {
+			return nil, time.Time{}, time.Time{}, fmt.Errorf("%w: %s", ErrSlotPassed, startsAt.Format(time.RFC3339))
+		}
+
+		return window, startsAt, endsAt, nil
+	}
+
+	if !slotted {
+		return nil, time.Time{}, time.Time{}, nil
+	}
+
+	return nil, time.Time{}, time.Time{}, fmt.Errorf("%w: %s", ErrNoSlot, collectDate.Format(time.RFC3339))
+}
+
+func (s *SlotService) Release(c context.Context, slotID int64) error {
+	if err := s.repository.Release(c, slotID); err != nil {
+		log.Printf("Failed to release pickup slot %d: %v", slotID, err)
+		return fmt.Errorf("failed to release pickup slot: %w", err)
+	}
+
+	return nil
+}
+
+// applyWindow validates req and copies it onto window. Two active windows may
+// not start at the same time on the same weekday, since customers would see
+// the same slot twice.
+func (s *SlotService) applyWindow(c context.Context, window *slotModel.PickupWindow, req *slotRequest.Window) error {
+	if err := s.validator.Struct(req); err != nil {
+		return fmt.Errorf("validation error: %w", err)
+	}
+
+	start, err := time.Parse(clockLayout, req.StartTime)
+	if err != nil {
+		return fmt.Errorf("validation error: startTime must be HH:MM")
+	}
+
+	end, err := time.Parse(clockLayout, req.EndTime)
+	if err != nil {
+		return fmt.Errorf("validation error: endTime must be HH:MM")
+	}
+
+	if !end.After(start) {
+		return fmt.Errorf("validation error: endTime must be after startTime")
+	}
+
+	windows, err := s.GetWindows(c)
+	if err != nil {
+		return err
+	}
+
+	for _, existing := range windows {
+		if existing.ID != window.ID && existing.IsActive &&
+			int(existing.Weekday) == req.Weekday && existing.StartTime == start.Format(clockLayout) {
+			return fmt.Errorf("validation error: a window already starts at %s on that day", req.StartTime)
+		}
+	}
+
+	window.Weekday = time.Weekday(req.Weekday)
+	window.StartTime = start.Format(clockLayout)
+	window.EndTime = end.Format(clockLayout)
+	window.Capacity = req.Capacity
+	if req.IsActive != nil {
+		window.IsActive = *req.IsActive
+	}
+
+	return nil
+}
+
+// occurrence returns when window starts and ends on the calendar day of day.
+func (s *SlotService) occurrence(window *slotModel.PickupWindow, day time.Time) (time.Time, time.Time, error) {
+	start, err := time.Parse(clockLayout, window.StartTime)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	end, err := time.Parse(clockLayout, window.EndTime)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	day = day.In(s.location)
+	startsAt := time.Date(day.Year(), day.Month(), day.Day(), start.Hour(), start.Minute(), 0, 0, s.location)
+	endsAt := time.Date(day.Year(), day.Month(), day.Day(), end.Hour(), end.Minute(), 0, 0, s.location)
+
+	return startsAt, endsAt, nil
+}
+
+func slotKey(windowID int64, startsAt time.Time) string {
+	return fmt.Sprintf("%d@%d", windowID, startsAt.Unix())
+}
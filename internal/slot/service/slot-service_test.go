@@ -0,0 +1,282 @@
+package slotService
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+	calendarMocks "washit-api/internal/calendar/service/mock"
+	slotModel "washit-api/internal/slot/dto/model"
+	slotRequest "washit-api/internal/slot/dto/request"
+	slotRepository "washit-api/internal/slot/repository"
+	mocks "washit-api/internal/slot/repository/mock"
+	"washit-api/pkg/worktime"
+
+	"github.com/go-playground/validator"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type SlotServiceTestSuite struct {
+	suite.Suite
+	mockRepo      *mocks.ISlotRepository
+	mockCalendars *calendarMocks.ICalendarService
+	service       *SlotService
+}
+
+// monday is 09:30 on Monday 19 October 2026.
+var monday = time.Date(2026, time.October, 19, 9, 30, 0, 0, time.UTC)
+
+func (suite *SlotServiceTestSuite) SetupTest() {
+	suite.mockRepo = new(mocks.ISlotRepository)
+	suite.mockCalendars = new(calendarMocks.ICalendarService)
+	suite.service = NewSlotService(suite.mockRepo, suite.mockCalendars, validator.New())
+	suite.service.location = time.UTC
+	suite.service.now = func() time.Time { return monday }
+}
+
+func TestSlotServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(SlotServiceTestSuite))
+}
+
+func windows() []*slotModel.PickupWindow {
+	return []*slotModel.PickupWindow{
+		{ID: 1, Weekday: time.Monday, StartTime: "08:00", EndTime: "10:00", Capacity: 2, IsActive: true},
+		{ID: 2, Weekday: time.Monday, StartTime: "13:00", EndTime: "15:00", Capacity: 2, IsActive: true},
+		{ID: 3, Weekday: time.Tuesday, StartTime: "08:00", EndTime: "10:00", Capacity: 1, IsActive: true},
+		{ID: 4, Weekday: time.Tuesday, StartTime: "13:00", EndTime: "15:00", Capacity: 1, IsActive: false},
+	}
+}
+
+// GetAvailableSlots
+// =================================================================
+
+func (suite *SlotServiceTestSuite) TestGetAvailableSlotsSkipsStartedFullAndInactive() {
+	suite.mockCalendars.On("Load", mock.Anything).
+		Return(&worktime.Weekly{Open: 8 * time.Hour, Close: 18 * time.Hour, Zone: time.UTC}, nil).Times(1)
+	tuesdayMorning := time.Date(2026, time.October, 20, 8, 0, 0, 0, time.UTC)
+	suite.mockRepo.On("GetWindows", mock.Anything).
+		Return(windows(), nil).Times(1)
+	suite.mockRepo.On("GetSlots", mock.Anything,
+		time.Date(2026, time.October, 19, 0, 0, 0, 0, time.UTC),
+		time.Date(2026, time.October, 21, 0, 0, 0, 0, time.UTC)).
+		Return([]*slotModel.PickupSlot{
+			{WindowID: 2, StartsAt: time.Date(2026, time.October, 19, 13, 0, 0, 0, time.UTC), Reserved: 1},
+			{WindowID: 3, StartsAt: tuesdayMorning, Reserved: 1},
+		}, nil).Times(1)
+
+	slots, err := suite.service.GetAvailableSlots(context.Background(), 2)
+	suite.Nil(err)
+	suite.Len(slots, 1)
+	suite.Equal(int64(2), slots[0].WindowID)
+	suite.Equal(1, slots[0].Available)
+	suite.Equal(time.Date(2026, time.October, 19, 15, 0, 0, 0, time.UTC), slots[0].EndsAt)
+}
+
+func (suite *SlotServiceTestSuite) TestGetAvailableSlotsSkipsClosedHours() {
+	// Closed on Tuesdays and open from 10:00, which leaves Monday 13:00 only.
+	suite.mockCalendars.On("Load", mock.Anything).
+		Return(&worktime.Weekly{
+			Open: 10 * time.Hour, Close: 18 * time.Hour, Closed: map[time.Weekday]bool{time.Tuesday: true}, Zone: time.UTC,
+		}, nil).Times(1)
+	suite.service.now = func() time.Time { return monday.Add(-2 * time.Hour) }
+	suite.mockRepo.On("GetWindows", mock.Anything).
+		Return(windows(), nil).Times(1)
+	suite.mockRepo.On("GetSlots", mock.Anything, mock.Anything, mock.Anything).
+		Return([]*slotModel.PickupSlot{}, nil).Times(1)
+
+	slots, err := suite.service.GetAvailableSlots(context.Background(), 2)
+	suite.Nil(err)
+	suite.Len(slots, 1)
+	suite.Equal(time.Date(2026, time.October, 19, 13, 0, 0, 0, time.UTC), slots[0].StartsAt)
+}
+
+func (suite *SlotServiceTestSuite) TestGetAvailableSlotsInvalidDays() {
+	slots, err := suite.service.GetAvailableSlots(context.Background(), MaxDays+1)
+	suite.Nil(slots)
+	suite.NotNil(err)
+	suite.mockRepo.AssertNotCalled(suite.T(), "GetWindows", mock.Anything)
+}
+
+// Reserve
+// =================================================================
+
+func (suite *SlotServiceTestSuite) TestReserveSuccess() {
+	startsAt := time.Date(2026, time.October, 20, 8, 0, 0, 0, time.UTC)
+	endsAt := time.Date(2026, time.October, 20, 10, 0, 0, 0, time.UTC)
+	suite.mockRepo.On("GetWindows", mock.Anything).
+		Return(windows(), nil).Times(1)
+	suite.mockRepo.On("Reserve", mock.Anything, mock.MatchedBy(func(window *slotModel.PickupWindow) bool {
+		return window.ID == 3
+	}), startsAt, endsAt).
+		Return(&slotModel.PickupSlot{ID: 9, WindowID: 3, StartsAt: startsAt, EndsAt: endsAt, Reserved: 1}, nil).Times(1)
+
+	slot, err := suite.service.Reserve(context.Background(), startsAt)
+	suite.Nil(err)
+	suite.Equal(int64(9), slot.ID)
+}
+
+func (suite *SlotServiceTestSuite) TestReserveWithinWindow() {
+	startsAt := time.Date(2026, time.October, 20, 8, 0, 0, 0, time.UTC)
+	endsAt := time.Date(2026, time.October, 20, 10, 0, 0, 0, time.UTC)
+	suite.mockRepo.On("GetWindows", mock.Anything).
+		Return(windows(), nil).Times(1)
+	suite.mockRepo.On("Reserve", mock.Anything, mock.MatchedBy(func(window *slotModel.PickupWindow) bool {
+		return window.ID == 3
+	}), startsAt, endsAt).
+		Return(&slotModel.PickupSlot{ID: 9, WindowID: 3, StartsAt: startsAt, EndsAt: endsAt, Reserved: 1}, nil).Times(1)
+
+	slot, err := suite.service.Reserve(context.Background(), time.Date(2026, time.October, 20, 9, 15, 0, 0, time.UTC))
+	suite.Nil(err)
+	suite.Equal(startsAt, slot.StartsAt)
+}
+
+func (suite *SlotServiceTestSuite) TestReserveWindowEnd() {
+	suite.mockRepo.On("GetWindows", mock.Anything).
+		Return(windows(), nil).Times(1)
+
+	slot, err := suite.service.Reserve(context.Background(), time.Date(2026, time.October, 20, 10, 0, 0, 0, time.UTC))
+	suite.Nil(slot)
+	suite.ErrorIs(err, ErrNoSlot)
+}
+
+func (suite *SlotServiceTestSuite) TestReserveWithoutWindows() {
+	inactive := windows()
+	for _, window := range inactive {
+		window.IsActive = false
+	}
+	suite.mockRepo.On("GetWindows", mock.Anything).
+		Return(inactive, nil).Times(1)
+
+	slot, err := suite.service.Reserve(context.Background(), time.Date(2026, time.October, 20, 11, 20, 0, 0, time.UTC))
+	suite.Nil(err)
+	suite.Nil(slot)
+	suite.mockRepo.AssertNotCalled(suite.T(), "Reserve", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *SlotServiceTestSuite) TestReserveFull() {
+	suite.mockRepo.On("GetWindows", mock.Anything).
+		Return(windows(), nil).Times(1)
+	suite.mockRepo.On("Reserve", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, slotRepository.ErrSlotFull).Times(1)
+
+	slot, err := suite.service.Reserve(context.Background(), time.Date(2026, time.October, 20, 8, 0, 0, 0, time.UTC))
+	suite.Nil(slot)
+	suite.ErrorIs(err, ErrSlotFull)
+}
+
+func (suite *SlotServiceTestSuite) TestReserveNoMatchingWindow() {
+	suite.mockRepo.On("GetWindows", mock.Anything).
+		Return(windows(), nil).Times(1)
+
+	slot, err := suite.service.Reserve(context.Background(), time.Date(2026, time.October, 20, 13, 0, 0, 0, time.UTC))
+	suite.Nil(slot)
+	suite.ErrorIs(err, ErrNoSlot)
+	suite.mockRepo.AssertNotCalled(suite.T(), "Reserve", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *SlotServiceTestSuite) TestReserveStartedSlot() {
+	slot, err := suite.service.Reserve(context.Background(), time.Date(2026, time.October, 19, 8, 0, 0, 0, time.UTC))
+	suite.Nil(slot)
+	suite.ErrorIs(err, ErrSlotPassed)
+}
+
+func (suite *SlotServiceTestSuite) TestReserveWithinStartedWindow() {
+	suite.mockRepo.On("GetWindows", mock.Anything).
+		Return(windows(), nil).Times(1)
+
+	slot, err := suite.service.Reserve(context.Background(), time.Date(2026, time.October, 19, 9, 45, 0, 0, time.UTC))
+	suite.Nil(slot)
+	suite.ErrorIs(err, ErrSlotPassed)
+}
+
+// Resolve
+// =================================================================
+
+func (suite *SlotServiceTestSuite) TestResolveReservedSlot() {
+	startsAt := time.Date(2026, time.October, 20, 8, 0, 0, 0, time.UTC)
+	suite.mockRepo.On("GetWindows", mock.Anything).
+		Return(windows(), nil).Times(1)
+	suite.mockRepo.On("GetSlot", mock.Anything, int64(3), startsAt).
+		Return(&slotModel.PickupSlot{ID: 9, WindowID: 3, StartsAt: startsAt, Reserved: 1}, nil).Times(1)
+
+	slot, err := suite.service.Resolve(context.Background(), time.Date(2026, time.October, 20, 9, 15, 0, 0, time.UTC))
+	suite.Nil(err)
+	suite.Equal(int64(9), slot.ID)
+	suite.mockRepo.AssertNotCalled(suite.T(), "Reserve", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *SlotServiceTestSuite) TestResolveUnreservedSlot() {
+	startsAt := time.Date(2026, time.October, 20, 8, 0, 0, 0, time.UTC)
+	suite.mockRepo.On("GetWindows", mock.Anything).
+		Return(windows(), nil).Times(1)
+	suite.mockRepo.On("GetSlot", mock.Anything, int64(3), startsAt).
+		Return(nil, nil).Times(1)
+
+	slot, err := suite.service.Resolve(context.Background(), time.Date(2026, time.October, 20, 9, 15, 0, 0, time.UTC))
+	suite.Nil(err)
+	suite.Zero(slot.ID)
+	suite.Equal(startsAt, slot.StartsAt)
+}
+
+// CreateWindow
+// =================================================================
+
+func (suite *SlotServiceTestSuite) TestCreateWindowSuccess() {
+	suite.mockRepo.On("GetWindows", mock.Anything).
+		Return(windows(), nil).Times(1)
+	suite.mockRepo.On("CreateWindow", mock.Anything, mock.Anything).
+		Return(nil).Times(1)
+
+	window, err := suite.service.CreateWindow(context.Background(), &slotRequest.Window{
+		Weekday:   int(time.Wednesday),
+		StartTime: "8:00",
+		EndTime:   "10:30",
+		Capacity:  5,
+	})
+	suite.Nil(err)
+	suite.Equal("08:00", window.StartTime)
+	suite.True(window.IsActive)
+}
+
+func (suite *SlotServiceTestSuite) TestCreateWindowDuplicateStart() {
+	suite.mockRepo.On("GetWindows", mock.Anything).
+		Return(windows(), nil).Times(1)
+
+	window, err := suite.service.CreateWindow(context.Background(), &slotRequest.Window{
+		Weekday:   int(time.Monday),
+		StartTime: "08:00",
+		EndTime:   "09:00",
+		Capacity:  5,
+	})
+	suite.Nil(window)
+	suite.NotNil(err)
+	suite.mockRepo.AssertNotCalled(suite.T(), "CreateWindow", mock.Anything, mock.Anything)
+}
+
+func (suite *SlotServiceTestSuite) TestCreateWindowEndBeforeStart() {
+	window, err := suite.service.CreateWindow(context.Background(), &slotRequest.Window{
+		Weekday:   int(time.Monday),
+		StartTime: "10:00",
+		EndTime:   "09:00",
+		Capacity:  5,
+	})
+	suite.Nil(window)
+	suite.NotNil(err)
+}
+
+// UpdateWindow
+// =================================================================
+
+func (suite *SlotServiceTestSuite) TestUpdateWindowNotFound() {
+	suite.mockRepo.On("GetWindowByID", mock.Anything, "7").
+		Return(nil, errors.New("record not found")).Times(1)
+
+	window, err := suite.service.UpdateWindow(context.Background(), "7", &slotRequest.Window{
+		StartTime: "08:00",
+		EndTime:   "09:00",
+		Capacity:  5,
+	})
+	suite.Nil(window)
+	suite.ErrorIs(err, ErrWindowNotFound)
+}
@@ -0,0 +1,39 @@
+package transactionModel
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+type RefundStatus string
+
+const (
+	RefundRequested RefundStatus = "requested"
+	// RefundProcessing refunds are approved and being sent to the provider.
+	RefundProcessing RefundStatus = "processing"
+	RefundRejected   RefundStatus = "rejected"
+	RefundCompleted  RefundStatus = "completed"
+	RefundFailed     RefundStatus = "failed"
+)
+
+// Refund is money given back on a paid transaction. Refunds are requested by
+// the customer or an admin and only reach the provider once an admin
+// approves them.
+type Refund struct {
+	ID            string           `json:"id" gorm:"primaryKey unique"`
+	TransactionID string           `json:"transactionID" gorm:"not null;index"`
+	OrderID       string           `json:"orderID" gorm:"not null;index"`
+	UserID        int64            `json:"userID" gorm:"not null;index"`
+	Amount        *decimal.Decimal `json:"amount" gorm:"type:numeric;not null"`
+	Reason        string           `json:"reason"`
+	Status        RefundStatus     `json:"status" gorm:"not null;index"`
+	RequestedBy   int64            `json:"requestedBy"`
+	ReviewedBy    int64            `json:"reviewedBy"`
+	ReviewNote    string           `json:"reviewNote"`
+	ExternalID    string           `json:"externalID"`
+	FailureReason string           `json:"failureReason"`
+	ProcessedAt   *time.Time       `json:"processedAt"`
+	CreatedAt     time.Time        `json:"createdAt"`
+	UpdatedAt     time.Time        `json:"updatedAt"`
+}
@@ -6,15 +6,49 @@ import (
 	"github.com/shopspring/decimal"
 )
 
+type Status string
+
+const (
+	StatusPending  Status = "pending"
+	StatusPaid     Status = "paid"
+	StatusFailed   Status = "failed"
+	StatusExpired  Status = "expired"
+	StatusRefunded Status = "refunded"
+)
+
 type Transaction struct {
 	ID             string           `json:"id" gorm:"primaryKey unique"`
-	OrderID        string           `json:"orderID"`
+	OrderID        string           `json:"orderID" gorm:"not null;index"`
 	UserID         int64            `json:"userID" gorm:"not null;index"`
-	ExternalID     string           `json:"externalID"`
+	Provider       string           `json:"provider"`
+	ExternalID     string           `json:"externalID" gorm:"index"`
 	PaymentMethod  string           `json:"paymentMethod"`
-	Status         string           `json:"status"`
+	Status         Status           `json:"status" gorm:"not null;index"`
 	Amount         *decimal.Decimal `json:"amount" gorm:"type:numeric"`
+	RefundedAmount *decimal.Decimal `json:"refundedAmount" gorm:"type:numeric"`
 	PaymentChannel string           `json:"paymentChannel"`
 	Description    string           `json:"description"`
-	PaidAt         time.Time        `json:"paidAt"`
+	PaymentURL     string           `json:"paymentURL"`
+	PaidAt         *time.Time       `json:"paidAt"`
+	ExpiresAt      time.Time        `json:"expiresAt"`
+	CreatedAt      time.Time        `json:"createdAt"`
+	UpdatedAt      time.Time        `json:"updatedAt"`
+}
+
+// IsExpired reports whether a pending transaction can no longer be paid.
+func (t *Transaction) IsExpired(now time.Time) bool {
+	return t.Status == StatusPending && !t.ExpiresAt.IsZero() && now.After(t.ExpiresAt)
+}
+
+// Refundable returns how much of a paid transaction has not been refunded.
+func (t *Transaction) Refundable() decimal.Decimal {
+	if t.Amount == nil {
+		return decimal.Zero
+	}
+
+	if t.RefundedAmount == nil {
+		return *t.Amount
+	}
+
+	return t.Amount.Sub(*t.RefundedAmount)
 }
@@ -0,0 +1,45 @@
+package transactionModel
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+type WebhookState string
+
+const (
+	// WebhookProcessed events changed their transaction.
+	WebhookProcessed WebhookState = "processed"
+	// WebhookIgnored events repeated a status the transaction already had.
+	WebhookIgnored WebhookState = "ignored"
+	// WebhookUnmatched events reference a charge no transaction knows about.
+	WebhookUnmatched WebhookState = "unmatched"
+	// WebhookOutOfOrder events report a status the transaction cannot move
+	// to yet, e.g. a refund that arrives before the payment.
+	WebhookOutOfOrder WebhookState = "out_of_order"
+	// WebhookRejected events disagree with the transaction, e.g. on amount.
+	WebhookRejected WebhookState = "rejected"
+)
+
+// WebhookEvent is a verified provider notification. Every delivery that
+// passes signature verification is kept, whether or not it could be applied,
+// and (Provider, EventID) is unique so replays are detected.
+type WebhookEvent struct {
+	ID            int64            `json:"id" gorm:"primaryKey"`
+	Provider      string           `json:"provider" gorm:"not null;uniqueIndex:idx_webhook_provider_event"`
+	EventID       string           `json:"eventID" gorm:"not null;uniqueIndex:idx_webhook_provider_event"`
+	Type          string           `json:"type"`
+	ExternalID    string           `json:"externalID" gorm:"index"`
+	TransactionID string           `json:"transactionID" gorm:"index"`
+	Status        Status           `json:"status"`
+	Amount        *decimal.Decimal `json:"amount" gorm:"type:numeric"`
+	Fee           *decimal.Decimal `json:"fee" gorm:"type:numeric"`
+	State         WebhookState     `json:"state" gorm:"not null;index"`
+	Error         string           `json:"error"`
+	Payload       string           `json:"payload" gorm:"type:text"`
+	OccurredAt    time.Time        `json:"occurredAt"`
+	ProcessedAt   *time.Time       `json:"processedAt"`
+	CreatedAt     time.Time        `json:"createdAt"`
+	UpdatedAt     time.Time        `json:"updatedAt"`
+}
@@ -1,3 +1,59 @@
 package transactionRequest
 
-type Transaction struct{}
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// Transaction opens a payment for an order. PaymentMethod is only a hint for
+// the provider; the method and channel stored are the ones it reports back.
+type Transaction struct {
+	OrderID       string `json:"orderID" validate:"required"`
+	PaymentMethod string `json:"paymentMethod"`
+	Description   string `json:"description"`
+}
+
+type UpdateStatus struct {
+	Status string `json:"status" validate:"required"`
+}
+
+type ListTransaction struct {
+	UserID        int64     `json:"-" form:"user_id"`
+	OrderID       string    `json:"orderID,omitempty" form:"order_id"`
+	Status        string    `json:"status,omitempty" form:"status"`
+	PaymentMethod string    `json:"paymentMethod,omitempty" form:"payment_method"`
+	From          time.Time `json:"-" form:"from" time_format:"2006-01-02"`
+	To            time.Time `json:"-" form:"to" time_format:"2006-01-02"`
+	Page          int64     `json:"-" form:"page"`
+	Limit         int64     `json:"-" form:"limit"`
+	OrderBy       string    `json:"-" form:"order_by"`
+	OrderDesc     bool      `json:"-" form:"order_desc"`
+}
+
+type ListWebhookEvent struct {
+	Provider      string `json:"provider,omitempty" form:"provider"`
+	State         string `json:"state,omitempty" form:"state"`
+	TransactionID string `json:"transactionID,omitempty" form:"transaction_id"`
+	Page          int64  `json:"-" form:"page"`
+	Limit         int64  `json:"-" form:"limit"`
+}
+
+// Refund asks for money back on a paid transaction. A missing amount refunds
+// everything that has not been refunded yet.
+type Refund struct {
+	Amount *decimal.Decimal `json:"amount"`
+	Reason string           `json:"reason" validate:"required,max=500"`
+}
+
+type ReviewRefund struct {
+	Note string `json:"note" validate:"max=500"`
+}
+
+type ListRefund struct {
+	TransactionID string `json:"transactionID,omitempty" form:"transaction_id"`
+	OrderID       string `json:"orderID,omitempty" form:"order_id"`
+	Status        string `json:"status,omitempty" form:"status"`
+	Page          int64  `json:"-" form:"page"`
+	Limit         int64  `json:"-" form:"limit"`
+}
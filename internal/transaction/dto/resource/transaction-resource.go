@@ -1,3 +1,78 @@
 package transactionResource
 
-type Transaction struct{}
+import (
+	"time"
+	"washit-api/pkg/paging"
+
+	"github.com/shopspring/decimal"
+)
+
+type ListTransaction struct {
+	Transactions []*Transaction     `json:"transactions,omitempty"`
+	Pagination   *paging.Pagination `json:"pagination,omitempty"`
+}
+
+type ListWebhookEvent struct {
+	Events     []*WebhookEvent    `json:"events,omitempty"`
+	Pagination *paging.Pagination `json:"pagination,omitempty"`
+}
+
+type Transaction struct {
+	ID             string           `json:"id"`
+	OrderID        string           `json:"orderID"`
+	UserID         int64            `json:"userID"`
+	Provider       string           `json:"provider"`
+	ExternalID     string           `json:"externalID"`
+	PaymentMethod  string           `json:"paymentMethod"`
+	Status         string           `json:"status"`
+	Amount         *decimal.Decimal `json:"amount"`
+	RefundedAmount *decimal.Decimal `json:"refundedAmount"`
+	PaymentChannel string           `json:"paymentChannel"`
+	Description    string           `json:"description"`
+	PaymentURL     string           `json:"paymentURL"`
+	PaidAt         *time.Time       `json:"paidAt"`
+	ExpiresAt      time.Time        `json:"expiresAt"`
+	CreatedAt      time.Time        `json:"createdAt"`
+	UpdatedAt      time.Time        `json:"updatedAt"`
+}
+
+type WebhookEvent struct {
+	ID            int64            `json:"id"`
+	Provider      string           `json:"provider"`
+	EventID       string           `json:"eventID"`
+	Type          string           `json:"type"`
+	ExternalID    string           `json:"externalID"`
+	TransactionID string           `json:"transactionID"`
+	Status        string           `json:"status"`
+	Amount        *decimal.Decimal `json:"amount"`
+	Fee           *decimal.Decimal `json:"fee"`
+	State         string           `json:"state"`
+	Error         string           `json:"error"`
+	Payload       string           `json:"payload"`
+	OccurredAt    time.Time        `json:"occurredAt"`
+	ProcessedAt   *time.Time       `json:"processedAt"`
+	CreatedAt     time.Time        `json:"createdAt"`
+}
+
+type Refund struct {
+	ID            string           `json:"id"`
+	TransactionID string           `json:"transactionID"`
+	OrderID       string           `json:"orderID"`
+	UserID        int64            `json:"userID"`
+	Amount        *decimal.Decimal `json:"amount"`
+	Reason        string           `json:"reason"`
+	Status        string           `json:"status"`
+	RequestedBy   int64            `json:"requestedBy"`
+	ReviewedBy    int64            `json:"reviewedBy"`
+	ReviewNote    string           `json:"reviewNote"`
+	ExternalID    string           `json:"externalID"`
+	FailureReason string           `json:"failureReason"`
+	ProcessedAt   *time.Time       `json:"processedAt"`
+	CreatedAt     time.Time        `json:"createdAt"`
+	UpdatedAt     time.Time        `json:"updatedAt"`
+}
+
+type ListRefund struct {
+	Refunds    []*Refund          `json:"refunds,omitempty"`
+	Pagination *paging.Pagination `json:"pagination,omitempty"`
+}
@@ -0,0 +1,159 @@
+package transactionGateway
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+
+	transactionModel "washit-api/internal/transaction/dto/model"
+	generate "washit-api/pkg/generator"
+
+	"github.com/shopspring/decimal"
+)
+
+const (
+	FakeProvider        = "fake"
+	FakeSignatureHeader = "X-Fake-Signature"
+)
+
+type fakeCharge struct {
+	amount   decimal.Decimal
+	refunded decimal.Decimal
+	status   transactionModel.Status
+}
+
+// FakeGateway is an in-memory provider for development and tests. Charges
+// stay pending until SetStatus is called or a signed webhook reports them.
+type FakeGateway struct {
+	secret  string
+	mu      sync.Mutex
+	charges map[string]*fakeCharge
+}
+
+func NewFakeGateway(secret string) *FakeGateway {
+	return &FakeGateway{
+		secret:  secret,
+		charges: make(map[string]*fakeCharge),
+	}
+}
+
+func (g *FakeGateway) Name() string {
+	return FakeProvider
+}
+
+func (g *FakeGateway) CreateCharge(_ context.Context, charge *Charge) (*ChargeResult, error) {
+	externalID, err := generate.AlphaNumericID("FAKE")
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate charge ID: %w", err)
+	}
+
+	method := charge.Method
+	if method == "" {
+		method = "bank_transfer"
+	}
+
+	g.mu.Lock()
+	g.charges[externalID] = &fakeCharge{amount: charge.Amount, status: transactionModel.StatusPending}
+	g.mu.Unlock()
+
+	return &ChargeResult{
+		ExternalID:     externalID,
+		PaymentMethod:  method,
+		PaymentChannel: FakeProvider,
+		PaymentURL:     "https://pay.fake.local/" + externalID,
+		Status:         transactionModel.StatusPending,
+	}, nil
+}
+
+func (g *FakeGateway) GetChargeStatus(_ context.Context, externalID string) (transactionModel.Status, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	charge, ok := g.charges[externalID]
+	if !ok {
+		return "", fmt.Errorf("%w: %s", ErrUnknownCharge, externalID)
+	}
+
+	return charge.status, nil
+}
+
+func (g *FakeGateway) Refund(_ context.Context, externalID string, amount decimal.Decimal, _ string) (*RefundResult, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	charge, ok := g.charges[externalID]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownCharge, externalID)
+	}
+
+	if charge.status != transactionModel.StatusPaid && charge.status != transactionModel.StatusRefunded {
+		return nil, fmt.Errorf("charge %s is %s and cannot be refunded", externalID, charge.status)
+	}
+
+	if charge.refunded.Add(amount).GreaterThan(charge.amount) {
+		return nil, fmt.Errorf("refund of %s exceeds the remaining amount of charge %s", amount, externalID)
+	}
+
+	charge.refunded = charge.refunded.Add(amount)
+	if charge.refunded.Equal(charge.amount) {
+		charge.status = transactionModel.StatusRefunded
+	}
+
+	refundID, err := generate.AlphaNumericID("FAKERF")
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate refund ID: %w", err)
+	}
+
+	return &RefundResult{ExternalID: refundID, Amount: amount}, nil
+}
+
+// VerifyWebhook checks the hex HMAC-SHA256 of body in FakeSignatureHeader and
+// decodes the body as an Event.
+func (g *FakeGateway) VerifyWebhook(_ context.Context, header http.Header, body []byte) (*Event, error) {
+	signature, err := hex.DecodeString(header.Get(FakeSignatureHeader))
+	if err != nil || !hmac.Equal(signature, g.sign(body)) {
+		return nil, ErrInvalidSignature
+	}
+
+	var event Event
+	if err := json.Unmarshal(body, &event); err != nil {
+		return nil, fmt.Errorf("failed to decode webhook: %w", err)
+	}
+
+	if event.ID == "" || event.ExternalID == "" {
+		return nil, fmt.Errorf("webhook is missing an event or charge ID")
+	}
+
+	return &event, nil
+}
+
+// SetStatus simulates the payer completing or abandoning a charge.
+func (g *FakeGateway) SetStatus(externalID string, status transactionModel.Status) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	charge, ok := g.charges[externalID]
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrUnknownCharge, externalID)
+	}
+
+	charge.status = status
+	return nil
+}
+
+// Sign returns the signature header value the fake provider would send with
+// body.
+func (g *FakeGateway) Sign(body []byte) string {
+	return hex.EncodeToString(g.sign(body))
+}
+
+func (g *FakeGateway) sign(body []byte) []byte {
+	mac := hmac.New(sha256.New, []byte(g.secret))
+	mac.Write(body)
+	return mac.Sum(nil)
+}
@@ -0,0 +1,67 @@
+package transactionGateway
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	transactionModel "washit-api/internal/transaction/dto/model"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+)
+
+type FakeGatewayTestSuite struct {
+	suite.Suite
+	gateway *FakeGateway
+}
+
+func (suite *FakeGatewayTestSuite) SetupTest() {
+	suite.gateway = NewFakeGateway("secret")
+}
+
+func TestFakeGatewayTestSuite(t *testing.T) {
+	suite.Run(t, new(FakeGatewayTestSuite))
+}
+
+func (suite *FakeGatewayTestSuite) TestChargeLifecycle() {
+	ctx := context.Background()
+
+	charge, err := suite.gateway.CreateCharge(ctx, &Charge{TransactionID: "TRX-1", Amount: decimal.NewFromInt(100)})
+	suite.Nil(err)
+	suite.Equal(transactionModel.StatusPending, charge.Status)
+
+	_, err = suite.gateway.Refund(ctx, charge.ExternalID, decimal.NewFromInt(10), "")
+	suite.NotNil(err)
+
+	suite.Nil(suite.gateway.SetStatus(charge.ExternalID, transactionModel.StatusPaid))
+
+	_, err = suite.gateway.Refund(ctx, charge.ExternalID, decimal.NewFromInt(40), "")
+	suite.Nil(err)
+	status, _ := suite.gateway.GetChargeStatus(ctx, charge.ExternalID)
+	suite.Equal(transactionModel.StatusPaid, status)
+
+	_, err = suite.gateway.Refund(ctx, charge.ExternalID, decimal.NewFromInt(61), "")
+	suite.NotNil(err)
+
+	_, err = suite.gateway.Refund(ctx, charge.ExternalID, decimal.NewFromInt(60), "")
+	suite.Nil(err)
+	status, _ = suite.gateway.GetChargeStatus(ctx, charge.ExternalID)
+	suite.Equal(transactionModel.StatusRefunded, status)
+}
+
+func (suite *FakeGatewayTestSuite) TestVerifyWebhook() {
+	body := []byte(`{"id":"EVT-1","type":"charge.paid","externalID":"FAKE-1","status":"paid"}`)
+
+	header := http.Header{}
+	header.Set(FakeSignatureHeader, suite.gateway.Sign(body))
+
+	event, err := suite.gateway.VerifyWebhook(context.Background(), header, body)
+	suite.Nil(err)
+	suite.Equal("EVT-1", event.ID)
+	suite.Equal(transactionModel.StatusPaid, event.Status)
+
+	header.Set(FakeSignatureHeader, NewFakeGateway("other").Sign(body))
+	_, err = suite.gateway.VerifyWebhook(context.Background(), header, body)
+	suite.True(errors.Is(err, ErrInvalidSignature))
+}
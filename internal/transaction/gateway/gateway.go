@@ -0,0 +1,96 @@
+package transactionGateway
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	transactionModel "washit-api/internal/transaction/dto/model"
+
+	"github.com/shopspring/decimal"
+)
+
+var (
+	ErrUnknownProvider  = errors.New("unknown payment provider")
+	ErrUnknownCharge    = errors.New("unknown charge")
+	ErrInvalidSignature = errors.New("invalid webhook signature")
+)
+
+// Charge is what a provider needs to open a payment for a transaction.
+type Charge struct {
+	TransactionID string
+	Amount        decimal.Decimal
+	Method        string
+	Description   string
+	ExpiresAt     time.Time
+}
+
+// ChargeResult is the provider's view of a charge. ExternalID, PaymentMethod
+// and PaymentChannel are copied onto the transaction as reported here.
+type ChargeResult struct {
+	ExternalID     string
+	PaymentMethod  string
+	PaymentChannel string
+	PaymentURL     string
+	Status         transactionModel.Status
+}
+
+type RefundResult struct {
+	ExternalID string
+	Amount     decimal.Decimal
+}
+
+// Event is a verified webhook notification.
+type Event struct {
+	ID            string                  `json:"id"`
+	Type          string                  `json:"type"`
+	ExternalID    string                  `json:"externalID"`
+	TransactionID string                  `json:"transactionID"`
+	Status        transactionModel.Status `json:"status"`
+	Amount        *decimal.Decimal        `json:"amount"`
+	Fee           *decimal.Decimal        `json:"fee"`
+	OccurredAt    time.Time               `json:"occurredAt"`
+}
+
+type IPaymentGateway interface {
+	Name() string
+	CreateCharge(ctx context.Context, charge *Charge) (*ChargeResult, error)
+	GetChargeStatus(ctx context.Context, externalID string) (transactionModel.Status, error)
+	Refund(ctx context.Context, externalID string, amount decimal.Decimal, reason string) (*RefundResult, error)
+	VerifyWebhook(ctx context.Context, header http.Header, body []byte) (*Event, error)
+}
+
+// Registry looks payment gateways up by provider name. New charges go to the
+// default provider; existing transactions keep talking to the provider that
+// created them.
+type Registry struct {
+	gateways map[string]IPaymentGateway
+	fallback string
+}
+
+func NewRegistry(fallback string, gateways ...IPaymentGateway) *Registry {
+	registry := &Registry{
+		gateways: make(map[string]IPaymentGateway, len(gateways)),
+		fallback: fallback,
+	}
+	for _, gateway := range gateways {
+		registry.gateways[gateway.Name()] = gateway
+	}
+
+	return registry
+}
+
+func (r *Registry) Get(provider string) (IPaymentGateway, error) {
+	gateway, ok := r.gateways[provider]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownProvider, provider)
+	}
+
+	return gateway, nil
+}
+
+func (r *Registry) Default() (IPaymentGateway, error) {
+	return r.Get(r.fallback)
+}
@@ -1,26 +1,483 @@
 package transaction
 
 import (
+	"errors"
+	"log"
 	"net/http"
+	"strconv"
+
+	transactionRequest "washit-api/internal/transaction/dto/request"
+	transactionResource "washit-api/internal/transaction/dto/resource"
+	transactionGateway "washit-api/internal/transaction/gateway"
 	transactionService "washit-api/internal/transaction/service"
+	"washit-api/pkg/rbac"
 	"washit-api/pkg/redis"
 	"washit-api/pkg/response"
+	"washit-api/pkg/utils"
 
 	"github.com/gin-gonic/gin"
 )
 
 type TransactionHandler struct {
-	service transactionService.TransactionServiceInterface
+	service transactionService.ITransactionService
 	cache   redis.IRedis
 }
 
-func NewTransactionHandler(service transactionService.TransactionServiceInterface, cache redis.IRedis) *TransactionHandler {
+func NewTransactionHandler(service transactionService.ITransactionService, cache redis.IRedis) *TransactionHandler {
 	return &TransactionHandler{
 		service: service,
 		cache:   cache,
 	}
 }
 
-func (h *TransactionHandler) GetTransactions(c *gin.Context) {
-	response.Success(c, http.StatusOK, "Test", nil, nil)
+// GetTransactionsMe retrieves the transactions of the logged in user.
+//
+//	@Summary	Get my transactions
+//	@Tags		Transaction
+//	@Accept		json
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		_	query		transactionRequest.ListTransaction	false	"Filters"
+//	@Success	200	{object}	transactionResource.ListTransaction
+//	@Router		/transactions [get]
+func (h *TransactionHandler) GetTransactionsMe(c *gin.Context) {
+	var res transactionResource.ListTransaction
+	var req transactionRequest.ListTransaction
+
+	if err := c.ShouldBindQuery(&req); err != nil {
+		log.Println("Failed to parse query ", err)
+		response.Error(c, http.StatusBadRequest, "failed to parse query", err)
+		return
+	}
+
+	userID, err := strconv.ParseInt(c.GetString("userID"), 10, 64)
+	if err != nil {
+		log.Printf("Invalid user ID: %v", err)
+		response.Error(c, http.StatusBadRequest, "invalid user ID", err)
+		return
+	}
+
+	req.UserID = userID
+
+	transactions, pagination, err := h.service.GetTransactions(c, &req)
+	if err != nil {
+		log.Println("Failed to get transactions ", err)
+		response.Error(c, http.StatusInternalServerError, "failed to get transactions", err)
+		return
+	}
+
+	utils.CopyTo(&transactions, &res.Transactions)
+	res.Pagination = pagination
+	response.Success(c, http.StatusOK, "transactions are collected successfully", &res, nil)
+}
+
+// GetAllTransactions retrieves every transaction, optionally filtered.
+//
+//	@Summary	Get all transactions
+//	@Tags		Transaction
+//	@Accept		json
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		_	query		transactionRequest.ListTransaction	false	"Filters"
+//	@Success	200	{object}	transactionResource.ListTransaction
+//	@Router		/transactions/all [get]
+func (h *TransactionHandler) GetAllTransactions(c *gin.Context) {
+	var res transactionResource.ListTransaction
+	var req transactionRequest.ListTransaction
+
+	if err := c.ShouldBindQuery(&req); err != nil {
+		log.Println("Failed to parse query ", err)
+		response.Error(c, http.StatusBadRequest, "failed to parse query", err)
+		return
+	}
+
+	transactions, pagination, err := h.service.GetTransactions(c, &req)
+	if err != nil {
+		log.Println("Failed to get transactions ", err)
+		response.Error(c, http.StatusInternalServerError, "failed to get transactions", err)
+		return
+	}
+
+	utils.CopyTo(&transactions, &res.Transactions)
+	res.Pagination = pagination
+	response.Success(c, http.StatusOK, "transactions are collected successfully", &res, nil)
+}
+
+// GetTransactionByID retrieves a transaction by its ID.
+//
+//	@Summary	Get a transaction by ID
+//	@Tags		Transaction
+//	@Accept		json
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		id	path		string	true	"Transaction ID"
+//	@Success	200	{object}	transactionResource.Transaction
+//	@Router		/transaction/{id} [get]
+func (h *TransactionHandler) GetTransactionByID(c *gin.Context) {
+	var res transactionResource.Transaction
+	var userID string
+
+	if !rbac.Can(c.GetString("userRole"), rbac.TransactionReadAll) {
+		userID = c.GetString("userID")
+	}
+
+	transaction, err := h.service.GetTransactionByID(c, c.Param("id"), userID)
+	if err != nil {
+		log.Println("Failed to get transaction ", err)
+		response.Error(c, http.StatusNotFound, "failed to get transaction", err)
+		return
+	}
+
+	utils.CopyTo(&transaction, &res)
+	response.Success(c, http.StatusOK, "transaction is collected successfully", &res, nil)
+}
+
+// CreateTransaction opens a payment for one of the user's orders.
+//
+//	@Summary	Create a transaction for an order
+//	@Tags		Transaction
+//	@Accept		json
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		_	body		transactionRequest.Transaction	true	"Transaction details"
+//	@Success	201	{object}	transactionResource.Transaction
+//	@Router		/transaction [post]
+func (h *TransactionHandler) CreateTransaction(c *gin.Context) {
+	var req transactionRequest.Transaction
+	var res transactionResource.Transaction
+
+	if err := utils.ParseJson(c, &req); err != nil {
+		log.Println("Failed to parse request body ", err)
+		response.Error(c, http.StatusBadRequest, "failed to parse request body", err)
+		return
+	}
+
+	transaction, err := h.service.CreateTransaction(c, c.GetString("userID"), &req)
+	if err != nil {
+		log.Println("Failed to create transaction ", err)
+		response.Error(c, http.StatusInternalServerError, "failed to create transaction", err)
+		return
+	}
+
+	utils.CopyTo(&transaction, &res)
+	response.Success(c, http.StatusCreated, "transaction is created successfully", &res, nil)
+}
+
+// UpdateTransactionStatus moves a transaction to a new status.
+//
+//	@Summary	Update the status of a transaction
+//	@Tags		Transaction
+//	@Accept		json
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		id	path		string							true	"Transaction ID"
+//	@Param		_	body		transactionRequest.UpdateStatus	true	"Status details"
+//	@Success	200	{object}	transactionResource.Transaction
+//	@Router		/transaction/{id}/status [put]
+func (h *TransactionHandler) UpdateTransactionStatus(c *gin.Context) {
+	var req transactionRequest.UpdateStatus
+	var res transactionResource.Transaction
+
+	if err := utils.ParseJson(c, &req); err != nil {
+		log.Println("Failed to parse request body ", err)
+		response.Error(c, http.StatusBadRequest, "failed to parse request body", err)
+		return
+	}
+
+	transaction, err := h.service.UpdateTransactionStatus(c, c.Param("id"), &req)
+	if err != nil {
+		log.Println("Failed to update transaction status ", err)
+		code := http.StatusInternalServerError
+		if errors.Is(err, transactionService.ErrInvalidTransition) {
+			code = http.StatusConflict
+		}
+		response.Error(c, code, "failed to update transaction status", err)
+		return
+	}
+
+	utils.CopyTo(&transaction, &res)
+	response.Success(c, http.StatusOK, "transaction status is updated successfully", &res, nil)
+}
+
+// SyncTransaction refreshes a pending transaction from its payment provider.
+//
+//	@Summary	Sync a transaction with its payment provider
+//	@Tags		Transaction
+//	@Accept		json
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		id	path		string	true	"Transaction ID"
+//	@Success	200	{object}	transactionResource.Transaction
+//	@Router		/transaction/{id}/sync [put]
+func (h *TransactionHandler) SyncTransaction(c *gin.Context) {
+	var res transactionResource.Transaction
+	var userID string
+
+	if !rbac.Can(c.GetString("userRole"), rbac.TransactionReadAll) {
+		userID = c.GetString("userID")
+	}
+
+	transaction, err := h.service.SyncTransaction(c, c.Param("id"), userID)
+	if err != nil {
+		log.Println("Failed to sync transaction ", err)
+		response.Error(c, http.StatusInternalServerError, "failed to sync transaction", err)
+		return
+	}
+
+	utils.CopyTo(&transaction, &res)
+	response.Success(c, http.StatusOK, "transaction is synced successfully", &res, nil)
+}
+
+// HandlePaymentWebhook receives status notifications from a payment provider.
+//
+//	@Summary	Receive a payment provider webhook
+//	@Tags		Transaction
+//	@Accept		json
+//	@Produce	json
+//	@Param		provider	path	string	true	"Payment provider"
+//	@Success	200
+//	@Router		/webhooks/payments/{provider} [post]
+func (h *TransactionHandler) HandlePaymentWebhook(c *gin.Context) {
+	body, err := c.GetRawData()
+	if err != nil {
+		log.Println("Failed to read webhook body ", err)
+		response.Error(c, http.StatusBadRequest, "failed to read request body", err)
+		return
+	}
+
+	if _, err := h.service.HandleWebhook(c, c.Param("provider"), c.Request.Header, body); err != nil {
+		log.Println("Failed to handle payment webhook ", err)
+		code := http.StatusInternalServerError
+		switch {
+		case errors.Is(err, transactionGateway.ErrUnknownProvider):
+			code = http.StatusNotFound
+		case errors.Is(err, transactionGateway.ErrInvalidSignature):
+			code = http.StatusUnauthorized
+		}
+		response.Error(c, code, "failed to handle payment webhook", err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, "webhook is received successfully", nil, nil)
+}
+
+// GetWebhookEvents retrieves stored payment webhook events.
+//
+//	@Summary	Get payment webhook events
+//	@Tags		Transaction
+//	@Accept		json
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		_	query		transactionRequest.ListWebhookEvent	false	"Filters"
+//	@Success	200	{object}	transactionResource.ListWebhookEvent
+//	@Router		/webhook-events [get]
+func (h *TransactionHandler) GetWebhookEvents(c *gin.Context) {
+	var res transactionResource.ListWebhookEvent
+	var req transactionRequest.ListWebhookEvent
+
+	if err := c.ShouldBindQuery(&req); err != nil {
+		log.Println("Failed to parse query ", err)
+		response.Error(c, http.StatusBadRequest, "failed to parse query", err)
+		return
+	}
+
+	events, pagination, err := h.service.GetWebhookEvents(c, &req)
+	if err != nil {
+		log.Println("Failed to get webhook events ", err)
+		response.Error(c, http.StatusInternalServerError, "failed to get webhook events", err)
+		return
+	}
+
+	utils.CopyTo(&events, &res.Events)
+	res.Pagination = pagination
+	response.Success(c, http.StatusOK, "webhook events are collected successfully", &res, nil)
+}
+
+// ReplayWebhookEvent applies a stored webhook event again.
+//
+//	@Summary	Replay a payment webhook event
+//	@Tags		Transaction
+//	@Accept		json
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		id	path		string	true	"Webhook event ID"
+//	@Success	200	{object}	transactionResource.WebhookEvent
+//	@Router		/webhook-event/{id}/replay [post]
+func (h *TransactionHandler) ReplayWebhookEvent(c *gin.Context) {
+	var res transactionResource.WebhookEvent
+
+	event, err := h.service.ReplayWebhookEvent(c, c.Param("id"))
+	if err != nil {
+		log.Println("Failed to replay webhook event ", err)
+		response.Error(c, http.StatusInternalServerError, "failed to replay webhook event", err)
+		return
+	}
+
+	utils.CopyTo(&event, &res)
+	response.Success(c, http.StatusOK, "webhook event is replayed successfully", &res, nil)
+}
+
+// RequestRefund asks for a full or partial refund of a paid transaction.
+//
+//	@Summary	Request a refund
+//	@Tags		Refund
+//	@Accept		json
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		id	path		string						true	"Transaction ID"
+//	@Param		_	body		transactionRequest.Refund	true	"Refund details"
+//	@Success	201	{object}	transactionResource.Refund
+//	@Router		/transaction/{id}/refund [post]
+func (h *TransactionHandler) RequestRefund(c *gin.Context) {
+	var req transactionRequest.Refund
+	var res transactionResource.Refund
+
+	if err := utils.ParseJson(c, &req); err != nil {
+		log.Println("Failed to parse request body ", err)
+		response.Error(c, http.StatusBadRequest, "failed to parse request body", err)
+		return
+	}
+
+	refund, err := h.service.RequestRefund(c, c.Param("id"), c.GetString("userID"), c.GetString("userRole"), &req)
+	if err != nil {
+		log.Println("Failed to request refund ", err)
+		response.Error(c, refundStatusCode(err), "failed to request refund", err)
+		return
+	}
+
+	utils.CopyTo(&refund, &res)
+	response.Success(c, http.StatusCreated, "refund is requested successfully", &res, nil)
+}
+
+// GetRefundsByTransaction retrieves the refunds of a transaction.
+//
+//	@Summary	Get the refunds of a transaction
+//	@Tags		Refund
+//	@Accept		json
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		id	path		string	true	"Transaction ID"
+//	@Success	200	{object}	[]transactionResource.Refund
+//	@Router		/transaction/{id}/refunds [get]
+func (h *TransactionHandler) GetRefundsByTransaction(c *gin.Context) {
+	var res []transactionResource.Refund
+	var userID string
+
+	if !rbac.Can(c.GetString("userRole"), rbac.TransactionReadAll) {
+		userID = c.GetString("userID")
+	}
+
+	refunds, err := h.service.GetRefundsByTransaction(c, c.Param("id"), userID)
+	if err != nil {
+		log.Println("Failed to get refunds ", err)
+		response.Error(c, http.StatusInternalServerError, "failed to get refunds", err)
+		return
+	}
+
+	utils.CopyTo(&refunds, &res)
+	response.Success(c, http.StatusOK, "refunds are collected successfully", &res, nil)
+}
+
+// GetRefunds retrieves every refund, optionally filtered.
+//
+//	@Summary	Get all refunds
+//	@Tags		Refund
+//	@Accept		json
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		_	query		transactionRequest.ListRefund	false	"Filters"
+//	@Success	200	{object}	transactionResource.ListRefund
+//	@Router		/refunds [get]
+func (h *TransactionHandler) GetRefunds(c *gin.Context) {
+	var res transactionResource.ListRefund
+	var req transactionRequest.ListRefund
+
+	if err := c.ShouldBindQuery(&req); err != nil {
+		log.Println("Failed to parse query ", err)
+		response.Error(c, http.StatusBadRequest, "failed to parse query", err)
+		return
+	}
+
+	refunds, pagination, err := h.service.GetRefunds(c, &req)
+	if err != nil {
+		log.Println("Failed to get refunds ", err)
+		response.Error(c, http.StatusInternalServerError, "failed to get refunds", err)
+		return
+	}
+
+	utils.CopyTo(&refunds, &res.Refunds)
+	res.Pagination = pagination
+	response.Success(c, http.StatusOK, "refunds are collected successfully", &res, nil)
+}
+
+// ApproveRefund approves a requested refund and sends it to the provider.
+//
+//	@Summary	Approve a refund
+//	@Tags		Refund
+//	@Accept		json
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		id	path		string							true	"Refund ID"
+//	@Param		_	body		transactionRequest.ReviewRefund	true	"Review details"
+//	@Success	200	{object}	transactionResource.Refund
+//	@Router		/refund/{id}/approve [put]
+func (h *TransactionHandler) ApproveRefund(c *gin.Context) {
+	var req transactionRequest.ReviewRefund
+	var res transactionResource.Refund
+
+	if err := utils.ParseJson(c, &req); err != nil {
+		log.Println("Failed to parse request body ", err)
+		response.Error(c, http.StatusBadRequest, "failed to parse request body", err)
+		return
+	}
+
+	refund, err := h.service.ApproveRefund(c, c.Param("id"), c.GetString("userID"), &req)
+	if err != nil {
+		log.Println("Failed to approve refund ", err)
+		response.Error(c, refundStatusCode(err), "failed to approve refund", err)
+		return
+	}
+
+	utils.CopyTo(&refund, &res)
+	response.Success(c, http.StatusOK, "refund is approved successfully", &res, nil)
+}
+
+// RejectRefund rejects a requested refund.
+//
+//	@Summary	Reject a refund
+//	@Tags		Refund
+//	@Accept		json
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		id	path		string							true	"Refund ID"
+//	@Param		_	body		transactionRequest.ReviewRefund	true	"Review details"
+//	@Success	200	{object}	transactionResource.Refund
+//	@Router		/refund/{id}/reject [put]
+func (h *TransactionHandler) RejectRefund(c *gin.Context) {
+	var req transactionRequest.ReviewRefund
+	var res transactionResource.Refund
+
+	if err := utils.ParseJson(c, &req); err != nil {
+		log.Println("Failed to parse request body ", err)
+		response.Error(c, http.StatusBadRequest, "failed to parse request body", err)
+		return
+	}
+
+	refund, err := h.service.RejectRefund(c, c.Param("id"), c.GetString("userID"), &req)
+	if err != nil {
+		log.Println("Failed to reject refund ", err)
+		response.Error(c, refundStatusCode(err), "failed to reject refund", err)
+		return
+	}
+
+	utils.CopyTo(&refund, &res)
+	response.Success(c, http.StatusOK, "refund is rejected successfully", &res, nil)
+}
+
+func refundStatusCode(err error) int {
+	if errors.Is(err, transactionService.ErrRefundNotAllowed) {
+		return http.StatusConflict
+	}
+
+	return http.StatusInternalServerError
 }
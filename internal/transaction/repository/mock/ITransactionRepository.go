@@ -0,0 +1,522 @@
+// Code generated by mockery v2.50.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	paging "washit-api/pkg/paging"
+
+	mock "github.com/stretchr/testify/mock"
+
+	transactionModel "washit-api/internal/transaction/dto/model"
+
+	transactionRequest "washit-api/internal/transaction/dto/request"
+)
+
+// ITransactionRepository is an autogenerated mock type for the ITransactionRepository type
+type ITransactionRepository struct {
+	mock.Mock
+}
+
+// ClaimRefund provides a mock function with given fields: ctx, refund, status
+func (_m *ITransactionRepository) ClaimRefund(ctx context.Context, refund *transactionModel.Refund, status transactionModel.RefundStatus) error {
+	ret := _m.Called(ctx, refund, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimRefund")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *transactionModel.Refund, transactionModel.RefundStatus) error); ok {
+		r0 = rf(ctx, refund, status)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CompleteRefund provides a mock function with given fields: ctx, refund
+func (_m *ITransactionRepository) CompleteRefund(ctx context.Context, refund *transactionModel.Refund) (*transactionModel.Transaction, error) {
+	ret := _m.Called(ctx, refund)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CompleteRefund")
+	}
+
+	var r0 *transactionModel.Transaction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *transactionModel.Refund) (*transactionModel.Transaction, error)); ok {
+		return rf(ctx, refund)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *transactionModel.Refund) *transactionModel.Transaction); ok {
+		r0 = rf(ctx, refund)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*transactionModel.Transaction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *transactionModel.Refund) error); ok {
+		r1 = rf(ctx, refund)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateRefund provides a mock function with given fields: ctx, refund
+func (_m *ITransactionRepository) CreateRefund(ctx context.Context, refund *transactionModel.Refund) error {
+	ret := _m.Called(ctx, refund)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateRefund")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *transactionModel.Refund) error); ok {
+		r0 = rf(ctx, refund)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateTransaction provides a mock function with given fields: ctx, transaction
+func (_m *ITransactionRepository) CreateTransaction(ctx context.Context, transaction *transactionModel.Transaction) error {
+	ret := _m.Called(ctx, transaction)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateTransaction")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *transactionModel.Transaction) error); ok {
+		r0 = rf(ctx, transaction)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetRefundByID provides a mock function with given fields: ctx, refundID
+func (_m *ITransactionRepository) GetRefundByID(ctx context.Context, refundID string) (*transactionModel.Refund, error) {
+	ret := _m.Called(ctx, refundID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRefundByID")
+	}
+
+	var r0 *transactionModel.Refund
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*transactionModel.Refund, error)); ok {
+		return rf(ctx, refundID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *transactionModel.Refund); ok {
+		r0 = rf(ctx, refundID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*transactionModel.Refund)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, refundID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRefunds provides a mock function with given fields: ctx, req
+func (_m *ITransactionRepository) GetRefunds(ctx context.Context, req *transactionRequest.ListRefund) ([]*transactionModel.Refund, *paging.Pagination, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRefunds")
+	}
+
+	var r0 []*transactionModel.Refund
+	var r1 *paging.Pagination
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *transactionRequest.ListRefund) ([]*transactionModel.Refund, *paging.Pagination, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *transactionRequest.ListRefund) []*transactionModel.Refund); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*transactionModel.Refund)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *transactionRequest.ListRefund) *paging.Pagination); ok {
+		r1 = rf(ctx, req)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*paging.Pagination)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, *transactionRequest.ListRefund) error); ok {
+		r2 = rf(ctx, req)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetRefundsByTransaction provides a mock function with given fields: ctx, transactionID
+func (_m *ITransactionRepository) GetRefundsByTransaction(ctx context.Context, transactionID string) ([]*transactionModel.Refund, error) {
+	ret := _m.Called(ctx, transactionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRefundsByTransaction")
+	}
+
+	var r0 []*transactionModel.Refund
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*transactionModel.Refund, error)); ok {
+		return rf(ctx, transactionID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*transactionModel.Refund); ok {
+		r0 = rf(ctx, transactionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*transactionModel.Refund)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, transactionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTransactionByExternalID provides a mock function with given fields: ctx, provider, externalID
+func (_m *ITransactionRepository) GetTransactionByExternalID(ctx context.Context, provider string, externalID string) (*transactionModel.Transaction, error) {
+	ret := _m.Called(ctx, provider, externalID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTransactionByExternalID")
+	}
+
+	var r0 *transactionModel.Transaction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*transactionModel.Transaction, error)); ok {
+		return rf(ctx, provider, externalID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *transactionModel.Transaction); ok {
+		r0 = rf(ctx, provider, externalID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*transactionModel.Transaction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, provider, externalID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTransactionByID provides a mock function with given fields: ctx, transactionID
+func (_m *ITransactionRepository) GetTransactionByID(ctx context.Context, transactionID string) (*transactionModel.Transaction, error) {
+	ret := _m.Called(ctx, transactionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTransactionByID")
+	}
+
+	var r0 *transactionModel.Transaction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*transactionModel.Transaction, error)); ok {
+		return rf(ctx, transactionID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *transactionModel.Transaction); ok {
+		r0 = rf(ctx, transactionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*transactionModel.Transaction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, transactionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTransactions provides a mock function with given fields: ctx, req
+func (_m *ITransactionRepository) GetTransactions(ctx context.Context, req *transactionRequest.ListTransaction) ([]*transactionModel.Transaction, *paging.Pagination, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTransactions")
+	}
+
+	var r0 []*transactionModel.Transaction
+	var r1 *paging.Pagination
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *transactionRequest.ListTransaction) ([]*transactionModel.Transaction, *paging.Pagination, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *transactionRequest.ListTransaction) []*transactionModel.Transaction); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*transactionModel.Transaction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *transactionRequest.ListTransaction) *paging.Pagination); ok {
+		r1 = rf(ctx, req)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*paging.Pagination)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, *transactionRequest.ListTransaction) error); ok {
+		r2 = rf(ctx, req)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetTransactionsByOrder provides a mock function with given fields: ctx, orderID
+func (_m *ITransactionRepository) GetTransactionsByOrder(ctx context.Context, orderID string) ([]*transactionModel.Transaction, error) {
+	ret := _m.Called(ctx, orderID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTransactionsByOrder")
+	}
+
+	var r0 []*transactionModel.Transaction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*transactionModel.Transaction, error)); ok {
+		return rf(ctx, orderID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*transactionModel.Transaction); ok {
+		r0 = rf(ctx, orderID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*transactionModel.Transaction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, orderID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetWebhookEvent provides a mock function with given fields: ctx, provider, eventID
+func (_m *ITransactionRepository) GetWebhookEvent(ctx context.Context, provider string, eventID string) (*transactionModel.WebhookEvent, error) {
+	ret := _m.Called(ctx, provider, eventID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWebhookEvent")
+	}
+
+	var r0 *transactionModel.WebhookEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*transactionModel.WebhookEvent, error)); ok {
+		return rf(ctx, provider, eventID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *transactionModel.WebhookEvent); ok {
+		r0 = rf(ctx, provider, eventID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*transactionModel.WebhookEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, provider, eventID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetWebhookEventByID provides a mock function with given fields: ctx, eventID
+func (_m *ITransactionRepository) GetWebhookEventByID(ctx context.Context, eventID string) (*transactionModel.WebhookEvent, error) {
+	ret := _m.Called(ctx, eventID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWebhookEventByID")
+	}
+
+	var r0 *transactionModel.WebhookEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*transactionModel.WebhookEvent, error)); ok {
+		return rf(ctx, eventID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *transactionModel.WebhookEvent); ok {
+		r0 = rf(ctx, eventID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*transactionModel.WebhookEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, eventID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetWebhookEvents provides a mock function with given fields: ctx, req
+func (_m *ITransactionRepository) GetWebhookEvents(ctx context.Context, req *transactionRequest.ListWebhookEvent) ([]*transactionModel.WebhookEvent, *paging.Pagination, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWebhookEvents")
+	}
+
+	var r0 []*transactionModel.WebhookEvent
+	var r1 *paging.Pagination
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *transactionRequest.ListWebhookEvent) ([]*transactionModel.WebhookEvent, *paging.Pagination, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *transactionRequest.ListWebhookEvent) []*transactionModel.WebhookEvent); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*transactionModel.WebhookEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *transactionRequest.ListWebhookEvent) *paging.Pagination); ok {
+		r1 = rf(ctx, req)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*paging.Pagination)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, *transactionRequest.ListWebhookEvent) error); ok {
+		r2 = rf(ctx, req)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// SaveTransactionStatus provides a mock function with given fields: ctx, transaction, records
+func (_m *ITransactionRepository) SaveTransactionStatus(ctx context.Context, transaction *transactionModel.Transaction, records ...interface{}) error {
+	var _ca []interface{}
+	_ca = append(_ca, ctx, transaction)
+	_ca = append(_ca, records...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveTransactionStatus")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *transactionModel.Transaction, ...interface{}) error); ok {
+		r0 = rf(ctx, transaction, records...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SaveWebhookEvent provides a mock function with given fields: ctx, event, transaction, records
+func (_m *ITransactionRepository) SaveWebhookEvent(ctx context.Context, event *transactionModel.WebhookEvent, transaction *transactionModel.Transaction, records ...interface{}) error {
+	var _ca []interface{}
+	_ca = append(_ca, ctx, event, transaction)
+	_ca = append(_ca, records...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveWebhookEvent")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *transactionModel.WebhookEvent, *transactionModel.Transaction, ...interface{}) error); ok {
+		r0 = rf(ctx, event, transaction, records...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateRefund provides a mock function with given fields: ctx, refund
+func (_m *ITransactionRepository) UpdateRefund(ctx context.Context, refund *transactionModel.Refund) error {
+	ret := _m.Called(ctx, refund)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateRefund")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *transactionModel.Refund) error); ok {
+		r0 = rf(ctx, refund)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateTransaction provides a mock function with given fields: ctx, transaction
+func (_m *ITransactionRepository) UpdateTransaction(ctx context.Context, transaction *transactionModel.Transaction) error {
+	ret := _m.Called(ctx, transaction)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateTransaction")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *transactionModel.Transaction) error); ok {
+		r0 = rf(ctx, transaction)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewITransactionRepository creates a new instance of ITransactionRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewITransactionRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ITransactionRepository {
+	mock := &ITransactionRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -1,8 +1,58 @@
 package transactionRepository
 
-import "washit-api/pkg/db/dbs"
+import (
+	"context"
+	"errors"
 
-type TransactionRepositoryInterface interface{}
+	historyModel "washit-api/internal/history/dto/model"
+	orderModel "washit-api/internal/order/dto/model"
+	transactionModel "washit-api/internal/transaction/dto/model"
+	transactionRequest "washit-api/internal/transaction/dto/request"
+	"washit-api/pkg/db/dbs"
+	"washit-api/pkg/paging"
+
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	// ErrRefundReviewed is returned when a refund was approved or rejected
+	// by someone else in the meantime.
+	ErrRefundReviewed = errors.New("refund was already reviewed")
+	// ErrRefundExceeded is returned when approving a refund would give back
+	// more than is left to refund of its transaction.
+	ErrRefundExceeded = errors.New("refund exceeds what is left to refund")
+)
+
+// sortableColumns whitelists the columns a transaction listing may be
+// ordered by.
+var sortableColumns = map[string]bool{
+	"created_at": true,
+	"paid_at":    true,
+	"amount":     true,
+	"status":     true,
+}
+
+type ITransactionRepository interface {
+	GetTransactions(ctx context.Context, req *transactionRequest.ListTransaction) ([]*transactionModel.Transaction, *paging.Pagination, error)
+	GetTransactionByID(ctx context.Context, transactionID string) (*transactionModel.Transaction, error)
+	GetTransactionsByOrder(ctx context.Context, orderID string) ([]*transactionModel.Transaction, error)
+	CreateTransaction(ctx context.Context, transaction *transactionModel.Transaction) error
+	UpdateTransaction(ctx context.Context, transaction *transactionModel.Transaction) error
+	SaveTransactionStatus(ctx context.Context, transaction *transactionModel.Transaction, records ...any) error
+	GetTransactionByExternalID(ctx context.Context, provider string, externalID string) (*transactionModel.Transaction, error)
+	GetWebhookEvents(ctx context.Context, req *transactionRequest.ListWebhookEvent) ([]*transactionModel.WebhookEvent, *paging.Pagination, error)
+	GetWebhookEventByID(ctx context.Context, eventID string) (*transactionModel.WebhookEvent, error)
+	GetWebhookEvent(ctx context.Context, provider string, eventID string) (*transactionModel.WebhookEvent, error)
+	SaveWebhookEvent(ctx context.Context, event *transactionModel.WebhookEvent, transaction *transactionModel.Transaction, records ...any) error
+	GetRefunds(ctx context.Context, req *transactionRequest.ListRefund) ([]*transactionModel.Refund, *paging.Pagination, error)
+	GetRefundByID(ctx context.Context, refundID string) (*transactionModel.Refund, error)
+	GetRefundsByTransaction(ctx context.Context, transactionID string) ([]*transactionModel.Refund, error)
+	CreateRefund(ctx context.Context, refund *transactionModel.Refund) error
+	UpdateRefund(ctx context.Context, refund *transactionModel.Refund) error
+	ClaimRefund(ctx context.Context, refund *transactionModel.Refund, status transactionModel.RefundStatus) error
+	CompleteRefund(ctx context.Context, refund *transactionModel.Refund) (*transactionModel.Transaction, error)
+}
 
 type TransactionRepository struct {
 	db dbs.IDatabase
@@ -13,3 +63,365 @@ func NewTransactionRepository(db dbs.IDatabase) *TransactionRepository {
 		db: db,
 	}
 }
+
+func (r *TransactionRepository) GetTransactions(ctx context.Context, req *transactionRequest.ListTransaction) ([]*transactionModel.Transaction, *paging.Pagination, error) {
+	var query []dbs.Query
+	if req.UserID != 0 {
+		query = append(query, dbs.NewQuery("user_id = ?", req.UserID))
+	}
+	if req.OrderID != "" {
+		query = append(query, dbs.NewQuery("order_id = ?", req.OrderID))
+	}
+	if req.Status != "" {
+		query = append(query, dbs.NewQuery("status = ?", req.Status))
+	}
+	if req.PaymentMethod != "" {
+		query = append(query, dbs.NewQuery("payment_method = ?", req.PaymentMethod))
+	}
+	if !req.From.IsZero() {
+		query = append(query, dbs.NewQuery("created_at >= ?", req.From))
+	}
+	if !req.To.IsZero() {
+		query = append(query, dbs.NewQuery("created_at < ?", req.To.AddDate(0, 0, 1)))
+	}
+
+	order := "created_at DESC"
+	if sortableColumns[req.OrderBy] {
+		order = req.OrderBy
+		if req.OrderDesc {
+			order += " DESC"
+		}
+	}
+
+	var total int64
+	if err := r.db.Count(ctx, &transactionModel.Transaction{}, &total, dbs.WithQuery(query...)); err != nil {
+		return nil, nil, err
+	}
+
+	pagination := paging.New(req.Page, req.Limit, total)
+
+	var transactions []*transactionModel.Transaction
+	if err := r.db.Find(
+		ctx,
+		&transactions,
+		dbs.WithQuery(query...),
+		dbs.WithLimit(int(pagination.Limit)),
+		dbs.WithOffset(int(pagination.Skip)),
+		dbs.WithOrder(order),
+	); err != nil {
+		return nil, nil, err
+	}
+
+	return transactions, pagination, nil
+}
+
+func (r *TransactionRepository) GetTransactionByID(ctx context.Context, transactionID string) (*transactionModel.Transaction, error) {
+	var transaction transactionModel.Transaction
+	if err := r.db.FindByID(ctx, transactionID, &transaction); err != nil {
+		return nil, err
+	}
+
+	return &transaction, nil
+}
+
+func (r *TransactionRepository) GetTransactionsByOrder(ctx context.Context, orderID string) ([]*transactionModel.Transaction, error) {
+	var transactions []*transactionModel.Transaction
+	query := []dbs.FindOption{
+		dbs.WithQuery(dbs.NewQuery("order_id = ?", orderID)),
+		dbs.WithOrder("created_at DESC"),
+	}
+
+	if err := r.db.Find(ctx, &transactions, query...); err != nil {
+		return nil, err
+	}
+
+	return transactions, nil
+}
+
+func (r *TransactionRepository) CreateTransaction(ctx context.Context, transaction *transactionModel.Transaction) error {
+	return r.db.Create(ctx, transaction)
+}
+
+func (r *TransactionRepository) UpdateTransaction(ctx context.Context, transaction *transactionModel.Transaction) error {
+	return r.db.Update(ctx, transaction)
+}
+
+func (r *TransactionRepository) GetTransactionByExternalID(ctx context.Context, provider string, externalID string) (*transactionModel.Transaction, error) {
+	var transaction transactionModel.Transaction
+	query := dbs.WithQuery(
+		dbs.NewQuery("provider = ?", provider),
+		dbs.NewQuery("external_id = ?", externalID),
+	)
+
+	if err := r.db.FindOne(ctx, &transaction, query); err != nil {
+		return nil, err
+	}
+
+	return &transaction, nil
+}
+
+func (r *TransactionRepository) GetWebhookEvents(ctx context.Context, req *transactionRequest.ListWebhookEvent) ([]*transactionModel.WebhookEvent, *paging.Pagination, error) {
+	var query []dbs.Query
+	if req.Provider != "" {
+		query = append(query, dbs.NewQuery("provider = ?", req.Provider))
+	}
+	if req.State != "" {
+		query = append(query, dbs.NewQuery("state = ?", req.State))
+	}
+	if req.TransactionID != "" {
+		query = append(query, dbs.NewQuery("transaction_id = ?", req.TransactionID))
+	}
+
+	var total int64
+	if err := r.db.Count(ctx, &transactionModel.WebhookEvent{}, &total, dbs.WithQuery(query...)); err != nil {
+		return nil, nil, err
+	}
+
+	pagination := paging.New(req.Page, req.Limit, total)
+
+	var events []*transactionModel.WebhookEvent
+	if err := r.db.Find(
+		ctx,
+		&events,
+		dbs.WithQuery(query...),
+		dbs.WithLimit(int(pagination.Limit)),
+		dbs.WithOffset(int(pagination.Skip)),
+		dbs.WithOrder("created_at DESC"),
+	); err != nil {
+		return nil, nil, err
+	}
+
+	return events, pagination, nil
+}
+
+func (r *TransactionRepository) GetWebhookEventByID(ctx context.Context, eventID string) (*transactionModel.WebhookEvent, error) {
+	var event transactionModel.WebhookEvent
+	if err := r.db.FindByID(ctx, eventID, &event); err != nil {
+		return nil, err
+	}
+
+	return &event, nil
+}
+
+func (r *TransactionRepository) GetWebhookEvent(ctx context.Context, provider string, eventID string) (*transactionModel.WebhookEvent, error) {
+	var event transactionModel.WebhookEvent
+	query := dbs.WithQuery(
+		dbs.NewQuery("provider = ?", provider),
+		dbs.NewQuery("event_id = ?", eventID),
+	)
+
+	if err := r.db.FindOne(ctx, &event, query); err != nil {
+		return nil, err
+	}
+
+	return &event, nil
+}
+
+// SaveWebhookEvent stores a webhook event and, when transaction is given,
+// the status change it caused and its records in one database transaction,
+// as SaveTransactionStatus does.
+func (r *TransactionRepository) SaveWebhookEvent(ctx context.Context, event *transactionModel.WebhookEvent, transaction *transactionModel.Transaction, records ...any) error {
+	return r.db.WithTransaction(func(tx dbs.IDatabase) error {
+		if err := tx.Update(ctx, event); err != nil {
+			return err
+		}
+
+		if transaction == nil {
+			return nil
+		}
+
+		return saveStatus(ctx, tx, transaction, records...)
+	})
+}
+
+// SaveTransactionStatus stores the status change of transaction in one
+// database transaction. A transaction that became paid is linked to its order
+// unless the order already has one or was repriced since the charge was made.
+// records, such as the ledger entries of a payment, are created with it.
+func (r *TransactionRepository) SaveTransactionStatus(ctx context.Context, transaction *transactionModel.Transaction, records ...any) error {
+	return r.db.WithTransaction(func(tx dbs.IDatabase) error {
+		return saveStatus(ctx, tx, transaction, records...)
+	})
+}
+
+func saveStatus(ctx context.Context, tx dbs.IDatabase, transaction *transactionModel.Transaction, records ...any) error {
+	if err := tx.Update(ctx, transaction); err != nil {
+		return err
+	}
+
+	if transaction.Status == transactionModel.StatusPaid {
+		if err := tx.GetDB().WithContext(ctx).Model(&orderModel.Order{}).
+			Where("id = ? AND price = ? AND (transaction_id = '' OR transaction_id IS NULL)", transaction.OrderID, transaction.Amount).
+			Update("transaction_id", transaction.ID).Error; err != nil {
+			return err
+		}
+	}
+
+	for _, record := range records {
+		if err := tx.Create(ctx, record); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *TransactionRepository) GetRefunds(ctx context.Context, req *transactionRequest.ListRefund) ([]*transactionModel.Refund, *paging.Pagination, error) {
+	var query []dbs.Query
+	if req.TransactionID != "" {
+		query = append(query, dbs.NewQuery("transaction_id = ?", req.TransactionID))
+	}
+	if req.OrderID != "" {
+		query = append(query, dbs.NewQuery("order_id = ?", req.OrderID))
+	}
+	if req.Status != "" {
+		query = append(query, dbs.NewQuery("status = ?", req.Status))
+	}
+
+	var total int64
+	if err := r.db.Count(ctx, &transactionModel.Refund{}, &total, dbs.WithQuery(query...)); err != nil {
+		return nil, nil, err
+	}
+
+	pagination := paging.New(req.Page, req.Limit, total)
+
+	var refunds []*transactionModel.Refund
+	if err := r.db.Find(
+		ctx,
+		&refunds,
+		dbs.WithQuery(query...),
+		dbs.WithLimit(int(pagination.Limit)),
+		dbs.WithOffset(int(pagination.Skip)),
+		dbs.WithOrder("created_at DESC"),
+	); err != nil {
+		return nil, nil, err
+	}
+
+	return refunds, pagination, nil
+}
+
+func (r *TransactionRepository) GetRefundByID(ctx context.Context, refundID string) (*transactionModel.Refund, error) {
+	var refund transactionModel.Refund
+	if err := r.db.FindByID(ctx, refundID, &refund); err != nil {
+		return nil, err
+	}
+
+	return &refund, nil
+}
+
+func (r *TransactionRepository) GetRefundsByTransaction(ctx context.Context, transactionID string) ([]*transactionModel.Refund, error) {
+	var refunds []*transactionModel.Refund
+	query := []dbs.FindOption{
+		dbs.WithQuery(dbs.NewQuery("transaction_id = ?", transactionID)),
+		dbs.WithOrder("created_at DESC"),
+	}
+
+	if err := r.db.Find(ctx, &refunds, query...); err != nil {
+		return nil, err
+	}
+
+	return refunds, nil
+}
+
+func (r *TransactionRepository) CreateRefund(ctx context.Context, refund *transactionModel.Refund) error {
+	return r.db.Create(ctx, refund)
+}
+
+func (r *TransactionRepository) UpdateRefund(ctx context.Context, refund *transactionModel.Refund) error {
+	return r.db.Update(ctx, refund)
+}
+
+// ClaimRefund moves a requested refund to status together with its review,
+// unless it was reviewed in the meantime. A refund claimed for processing
+// must fit into what is left to refund of its transaction alongside the
+// refunds already processing; the transaction is locked while this is
+// checked, so concurrent approvals cannot add up to more.
+func (r *TransactionRepository) ClaimRefund(ctx context.Context, refund *transactionModel.Refund, status transactionModel.RefundStatus) error {
+	return r.db.WithTransaction(func(tx dbs.IDatabase) error {
+		db := tx.GetDB().WithContext(ctx)
+
+		var transaction transactionModel.Transaction
+		if err := db.Clauses(clause.Locking{Strength: "UPDATE"}).
+			First(&transaction, "id = ?", refund.TransactionID).Error; err != nil {
+			return err
+		}
+
+		result := db.Model(&transactionModel.Refund{}).
+			Where("id = ? AND status = ?", refund.ID, transactionModel.RefundRequested).
+			Updates(map[string]any{
+				"status":      status,
+				"reviewed_by": refund.ReviewedBy,
+				"review_note": refund.ReviewNote,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ErrRefundReviewed
+		}
+
+		if status != transactionModel.RefundProcessing {
+			return nil
+		}
+
+		var processing decimal.NullDecimal
+		if err := db.Model(&transactionModel.Refund{}).
+			Select("SUM(amount)").
+			Where("transaction_id = ? AND status = ?", transaction.ID, transactionModel.RefundProcessing).
+			Scan(&processing).Error; err != nil {
+			return err
+		}
+
+		if transaction.Status != transactionModel.StatusPaid || processing.Decimal.GreaterThan(transaction.Refundable()) {
+			return ErrRefundExceeded
+		}
+
+		return nil
+	})
+}
+
+// CompleteRefund stores a refund the provider accepted and adds it to the
+// refunded total of its transaction, which is read under lock so concurrent
+// refunds add up. A transaction with nothing left to refund becomes
+// refunded. The archived order is updated so its net amount stays correct.
+func (r *TransactionRepository) CompleteRefund(ctx context.Context, refund *transactionModel.Refund) (*transactionModel.Transaction, error) {
+	var transaction transactionModel.Transaction
+	err := r.db.WithTransaction(func(tx dbs.IDatabase) error {
+		if err := tx.GetDB().WithContext(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).
+			First(&transaction, "id = ?", refund.TransactionID).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Update(ctx, refund); err != nil {
+			return err
+		}
+
+		refunded := decimal.Zero
+		if transaction.RefundedAmount != nil {
+			refunded = *transaction.RefundedAmount
+		}
+		refunded = refunded.Add(*refund.Amount)
+		transaction.RefundedAmount = &refunded
+		if !transaction.Refundable().IsPositive() {
+			transaction.Status = transactionModel.StatusRefunded
+		}
+
+		if err := tx.Update(ctx, &transaction); err != nil {
+			return err
+		}
+
+		net := transaction.Refundable()
+		return tx.GetDB().Model(&historyModel.History{}).
+			Where("id = ? AND transaction_id = ?", transaction.OrderID, transaction.ID).
+			Updates(map[string]any{
+				"paid_amount":     transaction.Amount,
+				"refunded_amount": transaction.RefundedAmount,
+				"net_amount":      &net,
+			}).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &transaction, nil
+}
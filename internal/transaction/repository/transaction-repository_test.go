@@ -0,0 +1,70 @@
+package transactionRepository
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	transactionModel "washit-api/internal/transaction/dto/model"
+	"washit-api/pkg/db/dbs"
+
+	"github.com/shopspring/decimal"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	gormLogger "gorm.io/gorm/logger"
+)
+
+// sqlRecorder keeps the SQL gorm builds, with its arguments inlined.
+type sqlRecorder struct {
+	statements []string
+}
+
+func (r *sqlRecorder) LogMode(gormLogger.LogLevel) gormLogger.Interface { return r }
+func (r *sqlRecorder) Info(context.Context, string, ...interface{})     {}
+func (r *sqlRecorder) Warn(context.Context, string, ...interface{})     {}
+func (r *sqlRecorder) Error(context.Context, string, ...interface{})    {}
+
+func (r *sqlRecorder) Trace(_ context.Context, _ time.Time, fc func() (string, int64), _ error) {
+	sql, _ := fc()
+	r.statements = append(r.statements, sql)
+}
+
+// dryRun returns a database whose queries are built for postgres but never
+// sent, so the generated SQL can be checked without a database. Saves skip
+// their implicit transaction, which would need a connection.
+func dryRun(t *testing.T) (dbs.IDatabase, *sqlRecorder) {
+	t.Helper()
+
+	recorder := &sqlRecorder{}
+	db, err := gorm.Open(postgres.Open("host=localhost"), &gorm.Config{
+		DryRun:                 true,
+		DisableAutomaticPing:   true,
+		SkipDefaultTransaction: true,
+		Logger:                 recorder,
+	})
+	if err != nil {
+		t.Fatalf("open: %v", err)
+	}
+
+	return dbs.Wrap(db), recorder
+}
+
+func TestSaveStatusLinksOrderAtItsPrice(t *testing.T) {
+	db, recorder := dryRun(t)
+
+	amount := decimal.NewFromInt(21000)
+	transaction := &transactionModel.Transaction{
+		ID: "TRX-1", OrderID: "ORD-1", Status: transactionModel.StatusPaid, Amount: &amount,
+	}
+	if err := saveStatus(context.Background(), db, transaction); err != nil {
+		t.Fatalf("saveStatus: %v", err)
+	}
+
+	want := `UPDATE "orders" SET "transaction_id"='TRX-1',"updated_at"=`
+	where := `WHERE id = 'ORD-1' AND price = '21000' AND (transaction_id = '' OR transaction_id IS NULL)`
+	if len(recorder.statements) != 2 ||
+		!strings.HasPrefix(recorder.statements[1], want) || !strings.Contains(recorder.statements[1], where) {
+		t.Errorf("got %q, want %q ... %q", recorder.statements, want, where)
+	}
+}
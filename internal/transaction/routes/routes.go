@@ -1,23 +1,58 @@
 package transactionRoutes
 
 import (
+	ledgerRepository "washit-api/internal/ledger/repository"
+	ledgerService "washit-api/internal/ledger/service"
+	orderRepository "washit-api/internal/order/repository"
+	transactionGateway "washit-api/internal/transaction/gateway"
 	transaction "washit-api/internal/transaction/handler"
 	transactionRepository "washit-api/internal/transaction/repository"
 	transactionService "washit-api/internal/transaction/service"
+	"washit-api/pkg/configs"
 	"washit-api/pkg/db/dbs"
 	"washit-api/pkg/middleware"
+	"washit-api/pkg/rbac"
 	"washit-api/pkg/redis"
 
 	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator"
+	"github.com/shopspring/decimal"
 )
 
-func Main(r *gin.RouterGroup, db dbs.IDatabase, cache redis.IRedis) {
+func Main(r *gin.RouterGroup, db dbs.IDatabase, cache redis.IRedis, validator *validator.Validate) {
 	repository := transactionRepository.NewTransactionRepository(db)
-	service := transactionService.NewTransactionService(repository)
+	gateways := transactionGateway.NewRegistry(
+		configs.Envs.PaymentProvider,
+		transactionGateway.NewFakeGateway(configs.Envs.FakePaymentSecret),
+	)
+	ledger := ledgerService.NewLedgerService(ledgerRepository.NewLedgerRepository(db), decimal.NewFromFloat(configs.Envs.TaxRate))
+	service := transactionService.NewTransactionService(
+		repository, orderRepository.NewOrderRepository(db), gateways, ledger, validator)
 	handler := transaction.NewTransactionHandler(service, cache)
 
-	authMiddleware := middleware.JWTAuth()
-	// adminAuthMiddleware := middleware.JTWAuthAdmin()
+	authMiddleware := middleware.JWTAuth(cache)
 
-	r.GET("/transactions", authMiddleware, handler.GetTransactions)
+	// Transaction Get
+	r.GET("/transactions", authMiddleware, handler.GetTransactionsMe)
+	r.GET("/transaction/:id", authMiddleware, handler.GetTransactionByID)
+
+	// Transaction Post
+	r.POST("/transaction", authMiddleware, handler.CreateTransaction)
+	r.PUT("/transaction/:id/sync", authMiddleware, handler.SyncTransaction)
+
+	// Refund
+	r.GET("/transaction/:id/refunds", authMiddleware, handler.GetRefundsByTransaction)
+	r.POST("/transaction/:id/refund", authMiddleware, handler.RequestRefund)
+
+	// Provider Webhook
+	r.POST("/webhooks/payments/:provider", handler.HandlePaymentWebhook)
+
+	// Staff Authority
+	r.GET("/transactions/all", middleware.JWTPermission(cache, rbac.TransactionReadAll), handler.GetAllTransactions)
+	r.PUT("/transaction/:id/status", middleware.JWTPermission(cache, rbac.TransactionManage), handler.UpdateTransactionStatus)
+	r.GET("/webhook-events", middleware.JWTPermission(cache, rbac.TransactionManage), handler.GetWebhookEvents)
+	r.POST("/webhook-event/:id/replay", middleware.JWTPermission(cache, rbac.TransactionManage), handler.ReplayWebhookEvent)
+	r.GET("/refunds", middleware.JWTPermission(cache, rbac.TransactionReadAll), handler.GetRefunds)
+	r.PUT("/refund/:id/approve", middleware.JWTPermission(cache, rbac.RefundApprove), handler.ApproveRefund)
+	r.PUT("/refund/:id/reject", middleware.JWTPermission(cache, rbac.RefundApprove), handler.RejectRefund)
 }
@@ -0,0 +1,230 @@
+package transactionService
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	transactionModel "washit-api/internal/transaction/dto/model"
+	transactionRequest "washit-api/internal/transaction/dto/request"
+	transactionRepository "washit-api/internal/transaction/repository"
+	generate "washit-api/pkg/generator"
+	"washit-api/pkg/paging"
+	"washit-api/pkg/rbac"
+)
+
+var ErrRefundNotAllowed = errors.New("refund not allowed")
+
+func (s *TransactionService) GetRefunds(c context.Context, req *transactionRequest.ListRefund) ([]*transactionModel.Refund, *paging.Pagination, error) {
+	refunds, pagination, err := s.repository.GetRefunds(c, req)
+	if err != nil {
+		log.Printf("Failed to get refunds: %v", err)
+		return nil, nil, fmt.Errorf("failed to get refunds: %w", err)
+	}
+
+	return refunds, pagination, nil
+}
+
+// GetRefundsByTransaction lists the refunds of a transaction. An empty
+// userID skips the ownership check and is used for admins.
+func (s *TransactionService) GetRefundsByTransaction(c context.Context, transactionID string, userID string) ([]*transactionModel.Refund, error) {
+	if _, err := s.GetTransactionByID(c, transactionID, userID); err != nil {
+		return nil, err
+	}
+
+	refunds, err := s.repository.GetRefundsByTransaction(c, transactionID)
+	if err != nil {
+		log.Printf("Failed to get refunds of transaction %s: %v", transactionID, err)
+		return nil, fmt.Errorf("failed to get refunds: %w", err)
+	}
+
+	return refunds, nil
+}
+
+// RequestRefund records a refund waiting for admin approval. Users who cannot
+// approve refunds may only ask for refunds of their own transactions, and the amounts of all
+// open and processing requests together may not exceed what is left to refund.
+func (s *TransactionService) RequestRefund(c context.Context, transactionID string, userID string, role string, req *transactionRequest.Refund) (*transactionModel.Refund, error) {
+	if err := s.validator.Struct(req); err != nil {
+		log.Printf("Failed to validate refund request: %v", err)
+		return nil, fmt.Errorf("validation error: %w", err)
+	}
+
+	owner := userID
+	if rbac.Can(role, rbac.RefundApprove) {
+		owner = ""
+	}
+
+	transaction, err := s.GetTransactionByID(c, transactionID, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	if transaction.Status != transactionModel.StatusPaid {
+		log.Printf("Transaction %s is %s and cannot be refunded", transaction.ID, transaction.Status)
+		return nil, fmt.Errorf("%w: transaction is %s", ErrRefundNotAllowed, transaction.Status)
+	}
+
+	refunds, err := s.repository.GetRefundsByTransaction(c, transaction.ID)
+	if err != nil {
+		log.Printf("Failed to get refunds of transaction %s: %v", transaction.ID, err)
+		return nil, fmt.Errorf("failed to request refund: %w", err)
+	}
+
+	available := transaction.Refundable()
+	for _, refund := range refunds {
+		if refund.Status == transactionModel.RefundRequested || refund.Status == transactionModel.RefundProcessing {
+			available = available.Sub(*refund.Amount)
+		}
+	}
+
+	amount := available
+	if req.Amount != nil {
+		amount = *req.Amount
+	}
+
+	if !amount.IsPositive() {
+		return nil, fmt.Errorf("%w: nothing left to refund", ErrRefundNotAllowed)
+	}
+
+	if amount.GreaterThan(available) {
+		return nil, fmt.Errorf("%w: at most %s can still be refunded", ErrRefundNotAllowed, available)
+	}
+
+	refundID, err := generate.AlphaNumericID("RFD")
+	if err != nil {
+		log.Printf("Failed to generate Refund ID: %v", err)
+		return nil, fmt.Errorf("failed to generate refund ID: %w", err)
+	}
+
+	requestedBy, _ := strconv.ParseInt(userID, 10, 64)
+	refund := &transactionModel.Refund{
+		ID:            refundID,
+		TransactionID: transaction.ID,
+		OrderID:       transaction.OrderID,
+		UserID:        transaction.UserID,
+		Amount:        &amount,
+		Reason:        req.Reason,
+		Status:        transactionModel.RefundRequested,
+		RequestedBy:   requestedBy,
+	}
+
+	if err := s.repository.CreateRefund(c, refund); err != nil {
+		log.Printf("Failed to create refund: %v", err)
+		return nil, fmt.Errorf("failed to request refund: %w", err)
+	}
+
+	return refund, nil
+}
+
+// ApproveRefund sends a requested refund to the transaction's provider. The
+// refund is claimed for processing first, so that it is paid out once however
+// many admins approve it, and only while it fits into what is left to refund.
+// The refund is kept as failed when the provider declines it.
+func (s *TransactionService) ApproveRefund(c context.Context, refundID string, adminID string, req *transactionRequest.ReviewRefund) (*transactionModel.Refund, error) {
+	refund, transaction, err := s.reviewRefund(c, refundID, adminID, req)
+	if err != nil {
+		return nil, err
+	}
+
+	gateway, err := s.gateways.Get(transaction.Provider)
+	if err != nil {
+		log.Printf("Failed to get payment gateway: %v", err)
+		return nil, fmt.Errorf("failed to approve refund: %w", err)
+	}
+
+	if err := s.claimRefund(c, refund, transactionModel.RefundProcessing); err != nil {
+		return nil, err
+	}
+
+	result, err := gateway.Refund(c, transaction.ExternalID, *refund.Amount, refund.Reason)
+	if err != nil {
+		log.Printf("Provider declined refund %s: %v", refund.ID, err)
+		refund.Status = transactionModel.RefundFailed
+		refund.FailureReason = err.Error()
+		if err := s.repository.UpdateRefund(c, refund); err != nil {
+			log.Printf("Failed to update refund %s: %v", refund.ID, err)
+		}
+
+		return nil, fmt.Errorf("failed to refund with %s: %w", transaction.Provider, err)
+	}
+
+	now := time.Now()
+	refund.Status = transactionModel.RefundCompleted
+	refund.ExternalID = result.ExternalID
+	refund.ProcessedAt = &now
+
+	if _, err := s.repository.CompleteRefund(c, refund); err != nil {
+		log.Printf("Failed to complete refund %s: %v", refund.ID, err)
+		return nil, fmt.Errorf("failed to complete refund: %w", err)
+	}
+
+	if err := s.ledger.RecordRefund(c, refund); err != nil {
+		log.Printf("Failed to record refund %s in the ledger: %v", refund.ID, err)
+	}
+
+	return refund, nil
+}
+
+func (s *TransactionService) RejectRefund(c context.Context, refundID string, adminID string, req *transactionRequest.ReviewRefund) (*transactionModel.Refund, error) {
+	refund, _, err := s.reviewRefund(c, refundID, adminID, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.claimRefund(c, refund, transactionModel.RefundRejected); err != nil {
+		return nil, err
+	}
+
+	return refund, nil
+}
+
+// claimRefund moves a requested refund to status, refusing it when it was
+// reviewed concurrently or no longer fits into its transaction.
+func (s *TransactionService) claimRefund(c context.Context, refund *transactionModel.Refund, status transactionModel.RefundStatus) error {
+	err := s.repository.ClaimRefund(c, refund, status)
+	if errors.Is(err, transactionRepository.ErrRefundReviewed) || errors.Is(err, transactionRepository.ErrRefundExceeded) {
+		log.Printf("Refund %s cannot become %s: %v", refund.ID, status, err)
+		return fmt.Errorf("%w: %v", ErrRefundNotAllowed, err)
+	}
+	if err != nil {
+		log.Printf("Failed to claim refund %s: %v", refund.ID, err)
+		return fmt.Errorf("failed to review refund: %w", err)
+	}
+
+	refund.Status = status
+	return nil
+}
+
+// reviewRefund loads a refund that is still waiting for review together with
+// its transaction and records who reviewed it.
+func (s *TransactionService) reviewRefund(c context.Context, refundID string, adminID string, req *transactionRequest.ReviewRefund) (*transactionModel.Refund, *transactionModel.Transaction, error) {
+	if err := s.validator.Struct(req); err != nil {
+		log.Printf("Failed to validate refund review: %v", err)
+		return nil, nil, fmt.Errorf("validation error: %w", err)
+	}
+
+	refund, err := s.repository.GetRefundByID(c, refundID)
+	if err != nil {
+		log.Printf("Failed to get refund by id: %v", err)
+		return nil, nil, fmt.Errorf("refund not found: %v", refundID)
+	}
+
+	if refund.Status != transactionModel.RefundRequested {
+		return nil, nil, fmt.Errorf("%w: refund is already %s", ErrRefundNotAllowed, refund.Status)
+	}
+
+	transaction, err := s.repository.GetTransactionByID(c, refund.TransactionID)
+	if err != nil {
+		log.Printf("Failed to get transaction by id: %v", err)
+		return nil, nil, fmt.Errorf("transaction not found: %v", refund.TransactionID)
+	}
+
+	refund.ReviewedBy, _ = strconv.ParseInt(adminID, 10, 64)
+	refund.ReviewNote = req.Note
+
+	return refund, transaction, nil
+}
@@ -0,0 +1,155 @@
+package transactionService
+
+import (
+	"context"
+	"errors"
+	transactionModel "washit-api/internal/transaction/dto/model"
+	transactionRequest "washit-api/internal/transaction/dto/request"
+	transactionGateway "washit-api/internal/transaction/gateway"
+	transactionRepository "washit-api/internal/transaction/repository"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/mock"
+)
+
+// paidCharge opens a charge on the fake provider and marks it paid.
+func (suite *TransactionServiceTestSuite) paidCharge(amount decimal.Decimal) *transactionModel.Transaction {
+	charge, _ := suite.gateway.CreateCharge(context.Background(), &transactionGateway.Charge{Amount: amount})
+	suite.Nil(suite.gateway.SetStatus(charge.ExternalID, transactionModel.StatusPaid))
+
+	return &transactionModel.Transaction{
+		ID: "TRX-1", OrderID: "ORD-1", UserID: 1, Provider: transactionGateway.FakeProvider,
+		ExternalID: charge.ExternalID, Status: transactionModel.StatusPaid, Amount: &amount,
+	}
+}
+
+// RequestRefund
+// =================================================================
+
+func (suite *TransactionServiceTestSuite) TestRequestRefundDefaultsToRemainingAmount() {
+	transaction := suite.paidCharge(decimal.NewFromInt(100))
+	pending := decimal.NewFromInt(30)
+
+	suite.mockRepo.On("GetTransactionByID", mock.Anything, "TRX-1").
+		Return(transaction, nil).Times(1)
+	suite.mockRepo.On("GetRefundsByTransaction", mock.Anything, "TRX-1").
+		Return([]*transactionModel.Refund{{Status: transactionModel.RefundRequested, Amount: &pending}}, nil).Times(1)
+	suite.mockRepo.On("CreateRefund", mock.Anything, mock.Anything).
+		Return(nil).Times(1)
+
+	refund, err := suite.service.RequestRefund(context.Background(), "TRX-1", "1", "customer",
+		&transactionRequest.Refund{Reason: "shirt was damaged"})
+	suite.Nil(err)
+	suite.True(decimal.NewFromInt(70).Equal(*refund.Amount))
+	suite.Equal(transactionModel.RefundRequested, refund.Status)
+}
+
+func (suite *TransactionServiceTestSuite) TestRequestRefundExceedsRemainingAmount() {
+	transaction := suite.paidCharge(decimal.NewFromInt(100))
+	refunded := decimal.NewFromInt(80)
+	transaction.RefundedAmount = &refunded
+	amount := decimal.NewFromInt(30)
+
+	suite.mockRepo.On("GetTransactionByID", mock.Anything, "TRX-1").
+		Return(transaction, nil).Times(1)
+	suite.mockRepo.On("GetRefundsByTransaction", mock.Anything, "TRX-1").
+		Return([]*transactionModel.Refund{}, nil).Times(1)
+
+	refund, err := suite.service.RequestRefund(context.Background(), "TRX-1", "1", "customer",
+		&transactionRequest.Refund{Amount: &amount, Reason: "late delivery"})
+	suite.Nil(refund)
+	suite.True(errors.Is(err, ErrRefundNotAllowed))
+}
+
+func (suite *TransactionServiceTestSuite) TestRequestRefundNotOwner() {
+	transaction := suite.paidCharge(decimal.NewFromInt(100))
+
+	suite.mockRepo.On("GetTransactionByID", mock.Anything, "TRX-1").
+		Return(transaction, nil).Times(1)
+
+	refund, err := suite.service.RequestRefund(context.Background(), "TRX-1", "2", "customer",
+		&transactionRequest.Refund{Reason: "not mine"})
+	suite.Nil(refund)
+	suite.NotNil(err)
+}
+
+// ApproveRefund
+// =================================================================
+
+func (suite *TransactionServiceTestSuite) TestApprovePartialRefund() {
+	transaction := suite.paidCharge(decimal.NewFromInt(100))
+	amount := decimal.NewFromInt(40)
+
+	suite.mockRepo.On("GetRefundByID", mock.Anything, "RFD-1").
+		Return(&transactionModel.Refund{
+			ID: "RFD-1", TransactionID: "TRX-1", Amount: &amount, Status: transactionModel.RefundRequested,
+		}, nil).Times(1)
+	suite.mockRepo.On("GetTransactionByID", mock.Anything, "TRX-1").
+		Return(transaction, nil).Times(1)
+	suite.mockRepo.On("ClaimRefund", mock.Anything,
+		mock.MatchedBy(func(refund *transactionModel.Refund) bool { return refund.ReviewedBy == 9 }),
+		transactionModel.RefundProcessing).
+		Return(nil).Times(1)
+	suite.mockRepo.On("CompleteRefund", mock.Anything,
+		mock.MatchedBy(func(refund *transactionModel.Refund) bool {
+			return refund.Status == transactionModel.RefundCompleted && refund.ExternalID != "" && refund.ReviewedBy == 9
+		})).
+		Return(transaction, nil).Times(1)
+	suite.mockLedger.On("RecordRefund", mock.Anything, mock.Anything).
+		Return(nil).Times(1)
+
+	refund, err := suite.service.ApproveRefund(context.Background(), "RFD-1", "9", &transactionRequest.ReviewRefund{})
+	suite.Nil(err)
+	suite.Equal(transactionModel.RefundCompleted, refund.Status)
+}
+
+func (suite *TransactionServiceTestSuite) TestApproveRefundReviewedConcurrently() {
+	transaction := suite.paidCharge(decimal.NewFromInt(100))
+	amount := decimal.NewFromInt(100)
+
+	suite.mockRepo.On("GetRefundByID", mock.Anything, "RFD-1").
+		Return(&transactionModel.Refund{
+			ID: "RFD-1", TransactionID: "TRX-1", Amount: &amount, Status: transactionModel.RefundRequested,
+		}, nil).Times(1)
+	suite.mockRepo.On("GetTransactionByID", mock.Anything, "TRX-1").
+		Return(transaction, nil).Times(1)
+	suite.mockRepo.On("ClaimRefund", mock.Anything, mock.Anything, transactionModel.RefundProcessing).
+		Return(transactionRepository.ErrRefundReviewed).Times(1)
+
+	refund, err := suite.service.ApproveRefund(context.Background(), "RFD-1", "9", &transactionRequest.ReviewRefund{})
+	suite.Nil(refund)
+	suite.True(errors.Is(err, ErrRefundNotAllowed))
+	suite.mockRepo.AssertNotCalled(suite.T(), "CompleteRefund", mock.Anything, mock.Anything)
+}
+
+func (suite *TransactionServiceTestSuite) TestApproveRefundExceedsRefundable() {
+	transaction := suite.paidCharge(decimal.NewFromInt(100))
+	amount := decimal.NewFromInt(60)
+
+	suite.mockRepo.On("GetRefundByID", mock.Anything, "RFD-2").
+		Return(&transactionModel.Refund{
+			ID: "RFD-2", TransactionID: "TRX-1", Amount: &amount, Status: transactionModel.RefundRequested,
+		}, nil).Times(1)
+	suite.mockRepo.On("GetTransactionByID", mock.Anything, "TRX-1").
+		Return(transaction, nil).Times(1)
+	suite.mockRepo.On("ClaimRefund", mock.Anything, mock.Anything, transactionModel.RefundProcessing).
+		Return(transactionRepository.ErrRefundExceeded).Times(1)
+
+	refund, err := suite.service.ApproveRefund(context.Background(), "RFD-2", "9", &transactionRequest.ReviewRefund{})
+	suite.Nil(refund)
+	suite.True(errors.Is(err, ErrRefundNotAllowed))
+	suite.mockRepo.AssertNotCalled(suite.T(), "CompleteRefund", mock.Anything, mock.Anything)
+}
+
+func (suite *TransactionServiceTestSuite) TestApproveRefundAlreadyReviewed() {
+	amount := decimal.NewFromInt(100)
+
+	suite.mockRepo.On("GetRefundByID", mock.Anything, "RFD-1").
+		Return(&transactionModel.Refund{
+			ID: "RFD-1", TransactionID: "TRX-1", Amount: &amount, Status: transactionModel.RefundRejected,
+		}, nil).Times(1)
+
+	refund, err := suite.service.ApproveRefund(context.Background(), "RFD-1", "9", &transactionRequest.ReviewRefund{})
+	suite.Nil(refund)
+	suite.True(errors.Is(err, ErrRefundNotAllowed))
+}
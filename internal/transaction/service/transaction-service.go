@@ -1,16 +1,290 @@
 package transactionService
 
-import transactionRepository "washit-api/internal/transaction/repository"
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
 
-type TransactionServiceInterface interface {
+	ledgerModel "washit-api/internal/ledger/dto/model"
+	ledgerService "washit-api/internal/ledger/service"
+	orderRepository "washit-api/internal/order/repository"
+	transactionModel "washit-api/internal/transaction/dto/model"
+	transactionRequest "washit-api/internal/transaction/dto/request"
+	transactionGateway "washit-api/internal/transaction/gateway"
+	transactionRepository "washit-api/internal/transaction/repository"
+	"washit-api/pkg/configs"
+	generate "washit-api/pkg/generator"
+	"washit-api/pkg/paging"
+
+	"github.com/go-playground/validator"
+)
+
+type ITransactionService interface {
+	GetTransactions(c context.Context, req *transactionRequest.ListTransaction) ([]*transactionModel.Transaction, *paging.Pagination, error)
+	GetTransactionByID(c context.Context, transactionID string, userID string) (*transactionModel.Transaction, error)
+	CreateTransaction(c context.Context, userID string, req *transactionRequest.Transaction) (*transactionModel.Transaction, error)
+	UpdateTransactionStatus(c context.Context, transactionID string, req *transactionRequest.UpdateStatus) (*transactionModel.Transaction, error)
+	SyncTransaction(c context.Context, transactionID string, userID string) (*transactionModel.Transaction, error)
+	HandleWebhook(c context.Context, provider string, header http.Header, body []byte) (*transactionModel.WebhookEvent, error)
+	GetWebhookEvents(c context.Context, req *transactionRequest.ListWebhookEvent) ([]*transactionModel.WebhookEvent, *paging.Pagination, error)
+	ReplayWebhookEvent(c context.Context, eventID string) (*transactionModel.WebhookEvent, error)
+	GetRefunds(c context.Context, req *transactionRequest.ListRefund) ([]*transactionModel.Refund, *paging.Pagination, error)
+	GetRefundsByTransaction(c context.Context, transactionID string, userID string) ([]*transactionModel.Refund, error)
+	RequestRefund(c context.Context, transactionID string, userID string, role string, req *transactionRequest.Refund) (*transactionModel.Refund, error)
+	ApproveRefund(c context.Context, refundID string, adminID string, req *transactionRequest.ReviewRefund) (*transactionModel.Refund, error)
+	RejectRefund(c context.Context, refundID string, adminID string, req *transactionRequest.ReviewRefund) (*transactionModel.Refund, error)
 }
 
-type transactionService struct {
-	repository transactionRepository.TransactionRepositoryInterface
+type TransactionService struct {
+	repository transactionRepository.ITransactionRepository
+	orders     orderRepository.IOrderRepository
+	gateways   *transactionGateway.Registry
+	ledger     ledgerService.ILedgerService
+	validator  *validator.Validate
 }
 
-func NewTransactionService(repository transactionRepository.TransactionRepositoryInterface) *transactionService {
-	return &transactionService{
+func NewTransactionService(
+	repository transactionRepository.ITransactionRepository, orders orderRepository.IOrderRepository,
+	gateways *transactionGateway.Registry, ledger ledgerService.ILedgerService,
+	validator *validator.Validate) *TransactionService {
+	return &TransactionService{
 		repository: repository,
+		orders:     orders,
+		gateways:   gateways,
+		ledger:     ledger,
+		validator:  validator,
+	}
+}
+
+func (s *TransactionService) GetTransactions(c context.Context, req *transactionRequest.ListTransaction) ([]*transactionModel.Transaction, *paging.Pagination, error) {
+	transactions, pagination, err := s.repository.GetTransactions(c, req)
+	if err != nil {
+		log.Printf("Failed to get transactions: %v", err)
+		return nil, nil, fmt.Errorf("failed to get transactions: %w", err)
+	}
+
+	return transactions, pagination, nil
+}
+
+// GetTransactionByID returns a transaction. An empty userID skips the
+// ownership check and is used for admins.
+func (s *TransactionService) GetTransactionByID(c context.Context, transactionID string, userID string) (*transactionModel.Transaction, error) {
+	transaction, err := s.repository.GetTransactionByID(c, transactionID)
+	if err != nil {
+		log.Printf("Failed to get transaction by id: %v", err)
+		return nil, fmt.Errorf("transaction not found: %v", transactionID)
+	}
+
+	if userID != "" && strconv.FormatInt(transaction.UserID, 10) != userID {
+		log.Printf("User ID mismatch: expected %v, got %v", userID, transaction.UserID)
+		return nil, fmt.Errorf("transaction not found: %v", transactionID)
+	}
+
+	return transaction, nil
+}
+
+// CreateTransaction opens a charge with the default payment provider for the
+// current price of an order. A pending transaction for the same amount is
+// reused so retrying checkout does not pile up payment attempts.
+func (s *TransactionService) CreateTransaction(c context.Context, userID string, req *transactionRequest.Transaction) (*transactionModel.Transaction, error) {
+	if err := s.validator.Struct(req); err != nil {
+		log.Printf("Failed to validate transaction request: %v", err)
+		return nil, fmt.Errorf("validation error: %w", err)
+	}
+
+	order, err := s.orders.GetOrderByID(c, req.OrderID)
+	if err != nil {
+		log.Printf("Failed to get order by id: %v", err)
+		return nil, fmt.Errorf("order not found: %v", req.OrderID)
+	}
+
+	if strconv.FormatInt(order.UserID, 10) != userID {
+		log.Printf("User ID mismatch: expected %v, got %v", userID, order.UserID)
+		return nil, fmt.Errorf("order not found: %v", req.OrderID)
+	}
+
+	if order.Price == nil {
+		log.Printf("Order %s has no price yet", order.ID)
+		return nil, fmt.Errorf("order %s has not been priced yet", order.ID)
+	}
+
+	existing, err := s.repository.GetTransactionsByOrder(c, order.ID)
+	if err != nil {
+		log.Printf("Failed to get transactions of order %s: %v", order.ID, err)
+		return nil, fmt.Errorf("failed to create transaction: %w", err)
+	}
+
+	now := time.Now()
+	for _, transaction := range existing {
+		switch {
+		case transaction.Status == transactionModel.StatusPaid:
+			return nil, fmt.Errorf("order %s is already paid", order.ID)
+		case transaction.Status != transactionModel.StatusPending:
+			continue
+		case !transaction.IsExpired(now) && transaction.Amount != nil && transaction.Amount.Equal(*order.Price):
+			return transaction, nil
+		}
+
+		// The order was repriced or the payment window closed.
+		transaction.Status = transactionModel.StatusExpired
+		if err := s.repository.UpdateTransaction(c, transaction); err != nil {
+			log.Printf("Failed to expire transaction %s: %v", transaction.ID, err)
+			return nil, fmt.Errorf("failed to create transaction: %w", err)
+		}
+	}
+
+	transactionID, err := generate.AlphaNumericID("TRX")
+	if err != nil {
+		log.Printf("Failed to generate Transaction ID: %v", err)
+		return nil, fmt.Errorf("failed to generate transaction ID: %w", err)
+	}
+
+	gateway, err := s.gateways.Default()
+	if err != nil {
+		log.Printf("Failed to get payment gateway: %v", err)
+		return nil, fmt.Errorf("failed to create transaction: %w", err)
+	}
+
+	amount := *order.Price
+	expiresAt := now.Add(configs.TransactionExpiry)
+	charge, err := gateway.CreateCharge(c, &transactionGateway.Charge{
+		TransactionID: transactionID,
+		Amount:        amount,
+		Method:        req.PaymentMethod,
+		Description:   req.Description,
+		ExpiresAt:     expiresAt,
+	})
+	if err != nil {
+		log.Printf("Failed to create %s charge for order %s: %v", gateway.Name(), order.ID, err)
+		return nil, fmt.Errorf("failed to create charge: %w", err)
+	}
+
+	transaction := &transactionModel.Transaction{
+		ID:             transactionID,
+		OrderID:        order.ID,
+		UserID:         order.UserID,
+		Provider:       gateway.Name(),
+		ExternalID:     charge.ExternalID,
+		PaymentMethod:  charge.PaymentMethod,
+		PaymentChannel: charge.PaymentChannel,
+		PaymentURL:     charge.PaymentURL,
+		Description:    req.Description,
+		Status:         transactionModel.StatusPending,
+		Amount:         &amount,
+		ExpiresAt:      expiresAt,
+	}
+
+	if err := s.repository.CreateTransaction(c, transaction); err != nil {
+		log.Printf("Failed to create transaction: %v", err)
+		return nil, fmt.Errorf("failed to create transaction: %w", err)
+	}
+
+	return transaction, nil
+}
+
+// UpdateTransactionStatus lets an admin settle a transaction by hand.
+func (s *TransactionService) UpdateTransactionStatus(c context.Context, transactionID string, req *transactionRequest.UpdateStatus) (*transactionModel.Transaction, error) {
+	if err := s.validator.Struct(req); err != nil {
+		log.Printf("Failed to validate transaction status request: %v", err)
+		return nil, fmt.Errorf("validation error: %w", err)
+	}
+
+	transaction, err := s.repository.GetTransactionByID(c, transactionID)
+	if err != nil {
+		log.Printf("Failed to get transaction by id: %v", err)
+		return nil, fmt.Errorf("transaction not found: %v", transactionID)
+	}
+
+	to := transactionModel.Status(req.Status)
+	if to == transactionModel.StatusRefunded {
+		return nil, fmt.Errorf("%w: refunds must go through the refund flow", ErrInvalidTransition)
+	}
+
+	return s.setStatus(c, transaction, to)
+}
+
+// SyncTransaction asks the provider for the current status of a pending
+// transaction and applies it. An empty userID skips the ownership check.
+func (s *TransactionService) SyncTransaction(c context.Context, transactionID string, userID string) (*transactionModel.Transaction, error) {
+	transaction, err := s.GetTransactionByID(c, transactionID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if transaction.Status != transactionModel.StatusPending {
+		return transaction, nil
+	}
+
+	gateway, err := s.gateways.Get(transaction.Provider)
+	if err != nil {
+		log.Printf("Failed to get payment gateway: %v", err)
+		return nil, fmt.Errorf("failed to sync transaction: %w", err)
+	}
+
+	status, err := gateway.GetChargeStatus(c, transaction.ExternalID)
+	if err != nil {
+		log.Printf("Failed to get status of charge %s: %v", transaction.ExternalID, err)
+		return nil, fmt.Errorf("failed to sync transaction: %w", err)
+	}
+
+	if status == transaction.Status {
+		return transaction, nil
 	}
+
+	return s.setStatus(c, transaction, status)
+}
+
+// setStatus moves a transaction to a new status. A transaction that becomes
+// paid is linked to its order and posted to the ledger in the same database
+// transaction; one paid after its window closed expires instead.
+func (s *TransactionService) setStatus(c context.Context, transaction *transactionModel.Transaction, to transactionModel.Status) (*transactionModel.Transaction, error) {
+	now := time.Now()
+	if to == transactionModel.StatusPaid && transaction.IsExpired(now) {
+		transaction.Status = transactionModel.StatusExpired
+		if err := s.repository.UpdateTransaction(c, transaction); err != nil {
+			log.Printf("Failed to expire transaction %s: %v", transaction.ID, err)
+		}
+
+		return nil, fmt.Errorf("transaction %s has expired", transaction.ID)
+	}
+
+	if !canTransition(transaction.Status, to) {
+		log.Printf("Transaction %s cannot move from %s to %s", transaction.ID, transaction.Status, to)
+		return nil, fmt.Errorf("%w: %s to %s", ErrInvalidTransition, transaction.Status, to)
+	}
+
+	transaction.Status = to
+
+	var records []any
+	if to == transactionModel.StatusPaid {
+		transaction.PaidAt = &now
+
+		entries, err := s.ledger.PaymentEntries(c, transaction)
+		if err != nil {
+			log.Printf("Failed to prepare ledger entries of payment %s: %v", transaction.ID, err)
+			return nil, fmt.Errorf("failed to prepare ledger entries: %w", err)
+		}
+		records = journalRecords(entries)
+	}
+
+	if err := s.repository.SaveTransactionStatus(c, transaction, records...); err != nil {
+		log.Printf("Failed to update transaction %s: %v", transaction.ID, err)
+		return nil, fmt.Errorf("failed to update transaction: %w", err)
+	}
+
+	return transaction, nil
+}
+
+// journalRecords turns prepared ledger entries into records the repository
+// creates along with a status change.
+func journalRecords(entries []*ledgerModel.JournalEntry) []any {
+	records := make([]any, 0, len(entries))
+	for _, entry := range entries {
+		records = append(records, entry)
+	}
+
+	return records
 }
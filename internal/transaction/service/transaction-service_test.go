@@ -0,0 +1,195 @@
+package transactionService
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+	ledgerModel "washit-api/internal/ledger/dto/model"
+	ledgerMocks "washit-api/internal/ledger/service/mock"
+	orderModel "washit-api/internal/order/dto/model"
+	orderMocks "washit-api/internal/order/repository/mock"
+	transactionModel "washit-api/internal/transaction/dto/model"
+	transactionRequest "washit-api/internal/transaction/dto/request"
+	transactionGateway "washit-api/internal/transaction/gateway"
+	mocks "washit-api/internal/transaction/repository/mock"
+
+	"github.com/go-playground/validator"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type TransactionServiceTestSuite struct {
+	suite.Suite
+	mockRepo   *mocks.ITransactionRepository
+	mockOrders *orderMocks.IOrderRepository
+	mockLedger *ledgerMocks.ILedgerService
+	gateway    *transactionGateway.FakeGateway
+	service    ITransactionService
+}
+
+func (suite *TransactionServiceTestSuite) SetupTest() {
+	validator := validator.New()
+	suite.mockRepo = new(mocks.ITransactionRepository)
+	suite.mockOrders = new(orderMocks.IOrderRepository)
+	suite.gateway = transactionGateway.NewFakeGateway("secret")
+	gateways := transactionGateway.NewRegistry(transactionGateway.FakeProvider, suite.gateway)
+	suite.mockLedger = new(ledgerMocks.ILedgerService)
+	suite.service = NewTransactionService(suite.mockRepo, suite.mockOrders, gateways, suite.mockLedger, validator)
+}
+
+func TestTransactionServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(TransactionServiceTestSuite))
+}
+
+// CreateTransaction
+// =================================================================
+
+func (suite *TransactionServiceTestSuite) TestCreateTransactionSuccess() {
+	price := decimal.NewFromInt(21000)
+	req := &transactionRequest.Transaction{OrderID: "ORD-1", PaymentMethod: "bank_transfer"}
+
+	suite.mockOrders.On("GetOrderByID", mock.Anything, "ORD-1").
+		Return(&orderModel.Order{ID: "ORD-1", UserID: 1, Price: &price}, nil).Times(1)
+	suite.mockRepo.On("GetTransactionsByOrder", mock.Anything, "ORD-1").
+		Return([]*transactionModel.Transaction{}, nil).Times(1)
+	suite.mockRepo.On("CreateTransaction", mock.Anything, mock.Anything).
+		Return(nil).Times(1)
+
+	transaction, err := suite.service.CreateTransaction(context.Background(), "1", req)
+	suite.Nil(err)
+	suite.Equal(transactionModel.StatusPending, transaction.Status)
+	suite.True(price.Equal(*transaction.Amount))
+	suite.Equal(int64(1), transaction.UserID)
+	suite.Equal(transactionGateway.FakeProvider, transaction.Provider)
+	suite.NotEmpty(transaction.ExternalID)
+	suite.Equal("bank_transfer", transaction.PaymentMethod)
+}
+
+func (suite *TransactionServiceTestSuite) TestCreateTransactionReusesPending() {
+	price := decimal.NewFromInt(21000)
+	pending := &transactionModel.Transaction{
+		ID: "TRX-1", Status: transactionModel.StatusPending, Amount: &price, ExpiresAt: time.Now().Add(time.Hour),
+	}
+
+	suite.mockOrders.On("GetOrderByID", mock.Anything, "ORD-1").
+		Return(&orderModel.Order{ID: "ORD-1", UserID: 1, Price: &price}, nil).Times(1)
+	suite.mockRepo.On("GetTransactionsByOrder", mock.Anything, "ORD-1").
+		Return([]*transactionModel.Transaction{pending}, nil).Times(1)
+
+	transaction, err := suite.service.CreateTransaction(context.Background(), "1",
+		&transactionRequest.Transaction{OrderID: "ORD-1", PaymentMethod: "bank_transfer"})
+	suite.Nil(err)
+	suite.Equal("TRX-1", transaction.ID)
+	suite.mockRepo.AssertNotCalled(suite.T(), "CreateTransaction", mock.Anything, mock.Anything)
+}
+
+func (suite *TransactionServiceTestSuite) TestCreateTransactionAlreadyPaid() {
+	price := decimal.NewFromInt(21000)
+
+	suite.mockOrders.On("GetOrderByID", mock.Anything, "ORD-1").
+		Return(&orderModel.Order{ID: "ORD-1", UserID: 1, Price: &price}, nil).Times(1)
+	suite.mockRepo.On("GetTransactionsByOrder", mock.Anything, "ORD-1").
+		Return([]*transactionModel.Transaction{{ID: "TRX-1", Status: transactionModel.StatusPaid}}, nil).Times(1)
+
+	transaction, err := suite.service.CreateTransaction(context.Background(), "1",
+		&transactionRequest.Transaction{OrderID: "ORD-1", PaymentMethod: "bank_transfer"})
+	suite.Nil(transaction)
+	suite.NotNil(err)
+}
+
+func (suite *TransactionServiceTestSuite) TestCreateTransactionNotOwner() {
+	price := decimal.NewFromInt(21000)
+
+	suite.mockOrders.On("GetOrderByID", mock.Anything, "ORD-1").
+		Return(&orderModel.Order{ID: "ORD-1", UserID: 1, Price: &price}, nil).Times(1)
+
+	transaction, err := suite.service.CreateTransaction(context.Background(), "2",
+		&transactionRequest.Transaction{OrderID: "ORD-1", PaymentMethod: "bank_transfer"})
+	suite.Nil(transaction)
+	suite.NotNil(err)
+}
+
+// UpdateTransactionStatus
+// =================================================================
+
+func (suite *TransactionServiceTestSuite) TestUpdateTransactionStatusPaidSavesEntries() {
+	payment := &ledgerModel.JournalEntry{ID: "JNL-1", Kind: ledgerModel.EntryPayment, Reference: "TRX-1"}
+	sale := &ledgerModel.JournalEntry{ID: "JNL-2", Kind: ledgerModel.EntrySale, Reference: "TRX-1"}
+
+	suite.mockRepo.On("GetTransactionByID", mock.Anything, "TRX-1").
+		Return(&transactionModel.Transaction{ID: "TRX-1", OrderID: "ORD-1", Status: transactionModel.StatusPending}, nil).Times(1)
+	suite.mockLedger.On("PaymentEntries", mock.Anything, mock.Anything).
+		Return([]*ledgerModel.JournalEntry{payment, sale}, nil).Times(1)
+	suite.mockRepo.On("SaveTransactionStatus", mock.Anything, mock.MatchedBy(func(transaction *transactionModel.Transaction) bool {
+		return transaction.Status == transactionModel.StatusPaid
+	}), payment, sale).Return(nil).Times(1)
+
+	transaction, err := suite.service.UpdateTransactionStatus(context.Background(), "TRX-1",
+		&transactionRequest.UpdateStatus{Status: "paid"})
+	suite.Nil(err)
+	suite.Equal(transactionModel.StatusPaid, transaction.Status)
+	suite.NotNil(transaction.PaidAt)
+	suite.mockLedger.AssertNotCalled(suite.T(), "RecordPayment", mock.Anything, mock.Anything)
+}
+
+func (suite *TransactionServiceTestSuite) TestUpdateTransactionStatusPaidWithoutEntries() {
+	suite.mockRepo.On("GetTransactionByID", mock.Anything, "TRX-1").
+		Return(&transactionModel.Transaction{ID: "TRX-1", OrderID: "ORD-1", Status: transactionModel.StatusPending}, nil).Times(1)
+	suite.mockLedger.On("PaymentEntries", mock.Anything, mock.Anything).
+		Return(nil, errors.New("transaction TRX-1 has no amount")).Times(1)
+
+	transaction, err := suite.service.UpdateTransactionStatus(context.Background(), "TRX-1",
+		&transactionRequest.UpdateStatus{Status: "paid"})
+	suite.Nil(transaction)
+	suite.NotNil(err)
+	suite.mockRepo.AssertNotCalled(suite.T(), "SaveTransactionStatus", mock.Anything, mock.Anything)
+}
+
+func (suite *TransactionServiceTestSuite) TestUpdateTransactionStatusInvalidTransition() {
+	suite.mockRepo.On("GetTransactionByID", mock.Anything, "TRX-1").
+		Return(&transactionModel.Transaction{ID: "TRX-1", Status: transactionModel.StatusFailed}, nil).Times(1)
+
+	transaction, err := suite.service.UpdateTransactionStatus(context.Background(), "TRX-1",
+		&transactionRequest.UpdateStatus{Status: "paid"})
+	suite.Nil(transaction)
+	suite.True(errors.Is(err, ErrInvalidTransition))
+}
+
+func (suite *TransactionServiceTestSuite) TestUpdateTransactionStatusExpired() {
+	suite.mockRepo.On("GetTransactionByID", mock.Anything, "TRX-1").
+		Return(&transactionModel.Transaction{
+			ID: "TRX-1", Status: transactionModel.StatusPending, ExpiresAt: time.Now().Add(-time.Minute),
+		}, nil).Times(1)
+	suite.mockRepo.On("UpdateTransaction", mock.Anything, mock.MatchedBy(func(transaction *transactionModel.Transaction) bool {
+		return transaction.Status == transactionModel.StatusExpired
+	})).Return(nil).Times(1)
+	suite.mockLedger.On("RecordPayment", mock.Anything, mock.Anything).
+		Return(nil).Times(1)
+
+	transaction, err := suite.service.UpdateTransactionStatus(context.Background(), "TRX-1",
+		&transactionRequest.UpdateStatus{Status: "paid"})
+	suite.Nil(transaction)
+	suite.NotNil(err)
+}
+
+// SyncTransaction
+// =================================================================
+
+func (suite *TransactionServiceTestSuite) TestSyncTransactionAppliesProviderStatus() {
+	charge, _ := suite.gateway.CreateCharge(context.Background(), &transactionGateway.Charge{Amount: decimal.NewFromInt(21000)})
+	suite.Nil(suite.gateway.SetStatus(charge.ExternalID, transactionModel.StatusFailed))
+
+	suite.mockRepo.On("GetTransactionByID", mock.Anything, "TRX-1").
+		Return(&transactionModel.Transaction{
+			ID: "TRX-1", UserID: 1, Provider: transactionGateway.FakeProvider,
+			ExternalID: charge.ExternalID, Status: transactionModel.StatusPending,
+		}, nil).Times(1)
+	suite.mockRepo.On("SaveTransactionStatus", mock.Anything, mock.Anything).
+		Return(nil).Times(1)
+
+	transaction, err := suite.service.SyncTransaction(context.Background(), "TRX-1", "1")
+	suite.Nil(err)
+	suite.Equal(transactionModel.StatusFailed, transaction.Status)
+}
@@ -0,0 +1,32 @@
+package transactionService
+
+import (
+	"errors"
+
+	transactionModel "washit-api/internal/transaction/dto/model"
+)
+
+var ErrInvalidTransition = errors.New("invalid transaction status transition")
+
+// transitions lists the statuses a transaction may move to from each status.
+// Failed, expired and refunded transactions are final.
+var transitions = map[transactionModel.Status][]transactionModel.Status{
+	transactionModel.StatusPending: {
+		transactionModel.StatusPaid,
+		transactionModel.StatusFailed,
+		transactionModel.StatusExpired,
+	},
+	transactionModel.StatusPaid: {
+		transactionModel.StatusRefunded,
+	},
+}
+
+func canTransition(from transactionModel.Status, to transactionModel.Status) bool {
+	for _, status := range transitions[from] {
+		if status == to {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,148 @@
+package transactionService
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	transactionModel "washit-api/internal/transaction/dto/model"
+	transactionRequest "washit-api/internal/transaction/dto/request"
+	"washit-api/pkg/paging"
+)
+
+// HandleWebhook verifies a provider notification and applies it to the
+// transaction it refers to. Deliveries of an event that was already stored
+// are acknowledged without doing anything.
+func (s *TransactionService) HandleWebhook(c context.Context, provider string, header http.Header, body []byte) (*transactionModel.WebhookEvent, error) {
+	gateway, err := s.gateways.Get(provider)
+	if err != nil {
+		log.Printf("Webhook for unknown provider %s", provider)
+		return nil, err
+	}
+
+	event, err := gateway.VerifyWebhook(c, header, body)
+	if err != nil {
+		log.Printf("Failed to verify %s webhook: %v", provider, err)
+		return nil, err
+	}
+
+	if existing, err := s.repository.GetWebhookEvent(c, provider, event.ID); err == nil {
+		log.Printf("Webhook %s/%s was already received", provider, event.ID)
+		return existing, nil
+	}
+
+	record := &transactionModel.WebhookEvent{
+		Provider:   provider,
+		EventID:    event.ID,
+		Type:       event.Type,
+		ExternalID: event.ExternalID,
+		Status:     event.Status,
+		Amount:     event.Amount,
+		Fee:        event.Fee,
+		Payload:    string(body),
+		OccurredAt: event.OccurredAt,
+	}
+
+	if err := s.applyWebhookEvent(c, record); err != nil {
+		// A concurrent delivery of the same event won the unique index.
+		if existing, lookupErr := s.repository.GetWebhookEvent(c, provider, event.ID); lookupErr == nil {
+			return existing, nil
+		}
+
+		log.Printf("Failed to store webhook %s/%s: %v", provider, event.ID, err)
+		return nil, fmt.Errorf("failed to process webhook: %w", err)
+	}
+
+	return record, nil
+}
+
+func (s *TransactionService) GetWebhookEvents(c context.Context, req *transactionRequest.ListWebhookEvent) ([]*transactionModel.WebhookEvent, *paging.Pagination, error) {
+	events, pagination, err := s.repository.GetWebhookEvents(c, req)
+	if err != nil {
+		log.Printf("Failed to get webhook events: %v", err)
+		return nil, nil, fmt.Errorf("failed to get webhook events: %w", err)
+	}
+
+	return events, pagination, nil
+}
+
+// ReplayWebhookEvent applies a stored event again, e.g. once the event it
+// arrived ahead of has been processed. Events that already changed their
+// transaction are returned unchanged.
+func (s *TransactionService) ReplayWebhookEvent(c context.Context, eventID string) (*transactionModel.WebhookEvent, error) {
+	event, err := s.repository.GetWebhookEventByID(c, eventID)
+	if err != nil {
+		log.Printf("Failed to get webhook event by id: %v", err)
+		return nil, fmt.Errorf("webhook event not found: %v", eventID)
+	}
+
+	if event.State == transactionModel.WebhookProcessed {
+		return event, nil
+	}
+
+	if err := s.applyWebhookEvent(c, event); err != nil {
+		log.Printf("Failed to replay webhook event %s: %v", eventID, err)
+		return nil, fmt.Errorf("failed to replay webhook event: %w", err)
+	}
+
+	return event, nil
+}
+
+// applyWebhookEvent decides what an event means for its transaction and
+// stores both. Events that cannot be applied are stored with the reason.
+func (s *TransactionService) applyWebhookEvent(c context.Context, event *transactionModel.WebhookEvent) error {
+	transaction, err := s.repository.GetTransactionByExternalID(c, event.Provider, event.ExternalID)
+	if err != nil {
+		event.State = transactionModel.WebhookUnmatched
+		event.Error = fmt.Sprintf("no transaction for charge %s", event.ExternalID)
+		return s.repository.SaveWebhookEvent(c, event, nil)
+	}
+
+	event.TransactionID = transaction.ID
+	event.Error = ""
+
+	switch {
+	case event.Status == transaction.Status:
+		event.State = transactionModel.WebhookIgnored
+		return s.repository.SaveWebhookEvent(c, event, nil)
+	case event.Status == transactionModel.StatusPaid && event.Amount != nil &&
+		transaction.Amount != nil && !event.Amount.Equal(*transaction.Amount):
+		event.State = transactionModel.WebhookRejected
+		event.Error = fmt.Sprintf("paid amount %s does not match %s", event.Amount, transaction.Amount)
+		return s.repository.SaveWebhookEvent(c, event, nil)
+	case !canTransition(transaction.Status, event.Status):
+		event.State = transactionModel.WebhookOutOfOrder
+		event.Error = fmt.Sprintf("transaction is %s and cannot become %s", transaction.Status, event.Status)
+		return s.repository.SaveWebhookEvent(c, event, nil)
+	}
+
+	now := time.Now()
+	transaction.Status = event.Status
+
+	var records []any
+	if event.Status == transactionModel.StatusPaid {
+		transaction.PaidAt = &now
+
+		entries, err := s.ledger.PaymentEntries(c, transaction)
+		if err != nil {
+			log.Printf("Failed to prepare ledger entries of payment %s: %v", transaction.ID, err)
+			return fmt.Errorf("failed to prepare ledger entries: %w", err)
+		}
+		if event.Fee != nil && event.Fee.IsPositive() {
+			fees, err := s.ledger.FeeEntries(c, transaction, event.Provider+":"+event.EventID, *event.Fee)
+			if err != nil {
+				log.Printf("Failed to prepare fee entry of transaction %s: %v", transaction.ID, err)
+				return fmt.Errorf("failed to prepare ledger entries: %w", err)
+			}
+			entries = append(entries, fees...)
+		}
+		records = journalRecords(entries)
+	}
+
+	event.State = transactionModel.WebhookProcessed
+	event.ProcessedAt = &now
+
+	return s.repository.SaveWebhookEvent(c, event, transaction, records...)
+}
@@ -0,0 +1,129 @@
+package transactionService
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	ledgerModel "washit-api/internal/ledger/dto/model"
+	transactionModel "washit-api/internal/transaction/dto/model"
+	transactionGateway "washit-api/internal/transaction/gateway"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/mock"
+)
+
+func (suite *TransactionServiceTestSuite) signedWebhook(body string) http.Header {
+	header := http.Header{}
+	header.Set(transactionGateway.FakeSignatureHeader, suite.gateway.Sign([]byte(body)))
+	return header
+}
+
+// HandleWebhook
+// =================================================================
+
+func (suite *TransactionServiceTestSuite) TestHandleWebhookMarksTransactionPaid() {
+	body := `{"id":"EVT-1","type":"charge.paid","externalID":"FAKE-1","status":"paid","amount":"21000","fee":"500"}`
+	price := decimal.NewFromInt(21000)
+	payment := &ledgerModel.JournalEntry{ID: "JNL-1", Kind: ledgerModel.EntryPayment, Reference: "TRX-1"}
+	sale := &ledgerModel.JournalEntry{ID: "JNL-2", Kind: ledgerModel.EntrySale, Reference: "TRX-1"}
+	fee := &ledgerModel.JournalEntry{ID: "JNL-3", Kind: ledgerModel.EntryFee, Reference: "fake:EVT-1"}
+
+	suite.mockRepo.On("GetWebhookEvent", mock.Anything, "fake", "EVT-1").
+		Return(nil, errors.New("record not found")).Times(1)
+	suite.mockRepo.On("GetTransactionByExternalID", mock.Anything, "fake", "FAKE-1").
+		Return(&transactionModel.Transaction{
+			ID: "TRX-1", OrderID: "ORD-1", Status: transactionModel.StatusPending, Amount: &price,
+		}, nil).Times(1)
+	suite.mockLedger.On("PaymentEntries", mock.Anything, mock.Anything).
+		Return([]*ledgerModel.JournalEntry{payment, sale}, nil).Times(1)
+	suite.mockLedger.On("FeeEntries", mock.Anything, mock.Anything, "fake:EVT-1",
+		mock.MatchedBy(func(fee decimal.Decimal) bool { return fee.Equal(decimal.NewFromInt(500)) })).
+		Return([]*ledgerModel.JournalEntry{fee}, nil).Times(1)
+	suite.mockRepo.On("SaveWebhookEvent", mock.Anything,
+		mock.MatchedBy(func(event *transactionModel.WebhookEvent) bool {
+			return event.State == transactionModel.WebhookProcessed && event.TransactionID == "TRX-1"
+		}),
+		mock.MatchedBy(func(transaction *transactionModel.Transaction) bool {
+			return transaction != nil && transaction.Status == transactionModel.StatusPaid && transaction.PaidAt != nil
+		}), payment, sale, fee).
+		Return(nil).Times(1)
+
+	event, err := suite.service.HandleWebhook(context.Background(), "fake", suite.signedWebhook(body), []byte(body))
+	suite.Nil(err)
+	suite.Equal(transactionModel.WebhookProcessed, event.State)
+	suite.mockLedger.AssertNotCalled(suite.T(), "RecordPayment", mock.Anything, mock.Anything)
+}
+
+func (suite *TransactionServiceTestSuite) TestHandleWebhookPaidWithoutEntries() {
+	body := `{"id":"EVT-1","type":"charge.paid","externalID":"FAKE-1","status":"paid"}`
+
+	suite.mockRepo.On("GetWebhookEvent", mock.Anything, "fake", "EVT-1").
+		Return(nil, errors.New("record not found")).Times(2)
+	suite.mockRepo.On("GetTransactionByExternalID", mock.Anything, "fake", "FAKE-1").
+		Return(&transactionModel.Transaction{ID: "TRX-1", OrderID: "ORD-1", Status: transactionModel.StatusPending}, nil).Times(1)
+	suite.mockLedger.On("PaymentEntries", mock.Anything, mock.Anything).
+		Return(nil, errors.New("transaction TRX-1 has no amount")).Times(1)
+
+	event, err := suite.service.HandleWebhook(context.Background(), "fake", suite.signedWebhook(body), []byte(body))
+	suite.Nil(event)
+	suite.NotNil(err)
+	suite.mockRepo.AssertNotCalled(suite.T(), "SaveWebhookEvent", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *TransactionServiceTestSuite) TestHandleWebhookReplayIsNoop() {
+	body := `{"id":"EVT-1","type":"charge.paid","externalID":"FAKE-1","status":"paid"}`
+
+	suite.mockRepo.On("GetWebhookEvent", mock.Anything, "fake", "EVT-1").
+		Return(&transactionModel.WebhookEvent{ID: 1, EventID: "EVT-1", State: transactionModel.WebhookProcessed}, nil).Times(1)
+
+	event, err := suite.service.HandleWebhook(context.Background(), "fake", suite.signedWebhook(body), []byte(body))
+	suite.Nil(err)
+	suite.Equal(int64(1), event.ID)
+	suite.mockRepo.AssertNotCalled(suite.T(), "SaveWebhookEvent", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *TransactionServiceTestSuite) TestHandleWebhookInvalidSignature() {
+	body := `{"id":"EVT-1","type":"charge.paid","externalID":"FAKE-1","status":"paid"}`
+	header := http.Header{}
+	header.Set(transactionGateway.FakeSignatureHeader, "deadbeef")
+
+	event, err := suite.service.HandleWebhook(context.Background(), "fake", header, []byte(body))
+	suite.Nil(event)
+	suite.True(errors.Is(err, transactionGateway.ErrInvalidSignature))
+}
+
+func (suite *TransactionServiceTestSuite) TestHandleWebhookStoresUnmatchedEvent() {
+	body := `{"id":"EVT-2","type":"charge.paid","externalID":"FAKE-404","status":"paid"}`
+
+	suite.mockRepo.On("GetWebhookEvent", mock.Anything, "fake", "EVT-2").
+		Return(nil, errors.New("record not found")).Times(1)
+	suite.mockRepo.On("GetTransactionByExternalID", mock.Anything, "fake", "FAKE-404").
+		Return(nil, errors.New("record not found")).Times(1)
+	suite.mockRepo.On("SaveWebhookEvent", mock.Anything,
+		mock.MatchedBy(func(event *transactionModel.WebhookEvent) bool {
+			return event.State == transactionModel.WebhookUnmatched && event.Payload == body
+		}), (*transactionModel.Transaction)(nil)).
+		Return(nil).Times(1)
+
+	event, err := suite.service.HandleWebhook(context.Background(), "fake", suite.signedWebhook(body), []byte(body))
+	suite.Nil(err)
+	suite.Equal(transactionModel.WebhookUnmatched, event.State)
+}
+
+func (suite *TransactionServiceTestSuite) TestHandleWebhookStoresOutOfOrderEvent() {
+	body := `{"id":"EVT-3","type":"charge.refunded","externalID":"FAKE-1","status":"refunded"}`
+
+	suite.mockRepo.On("GetWebhookEvent", mock.Anything, "fake", "EVT-3").
+		Return(nil, errors.New("record not found")).Times(1)
+	suite.mockRepo.On("GetTransactionByExternalID", mock.Anything, "fake", "FAKE-1").
+		Return(&transactionModel.Transaction{ID: "TRX-1", Status: transactionModel.StatusPending}, nil).Times(1)
+	suite.mockRepo.On("SaveWebhookEvent", mock.Anything,
+		mock.MatchedBy(func(event *transactionModel.WebhookEvent) bool {
+			return event.State == transactionModel.WebhookOutOfOrder
+		}), (*transactionModel.Transaction)(nil)).
+		Return(nil).Times(1)
+
+	event, err := suite.service.HandleWebhook(context.Background(), "fake", suite.signedWebhook(body), []byte(body))
+	suite.Nil(err)
+	suite.Equal(transactionModel.WebhookOutOfOrder, event.State)
+}
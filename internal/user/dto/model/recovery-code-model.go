@@ -0,0 +1,13 @@
+package userModel
+
+import "time"
+
+// RecoveryCode is a single-use fallback for a lost authenticator. Only the
+// SHA-256 hash of the code is stored.
+type RecoveryCode struct {
+	ID        int64      `json:"id" gorm:"primaryKey"`
+	UserID    int64      `json:"userID" gorm:"index"`
+	CodeHash  string     `json:"-"`
+	UsedAt    *time.Time `json:"usedAt"`
+	CreatedAt time.Time  `json:"createdAt"`
+}
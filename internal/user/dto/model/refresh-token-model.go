@@ -0,0 +1,20 @@
+package userModel
+
+import "time"
+
+// RefreshToken is an issued refresh token. Every login starts a new family;
+// each refresh rotates the token into a child of the same family, with
+// ParentID pointing at the token that was exchanged for it.
+type RefreshToken struct {
+	ID         string     `json:"id" gorm:"primaryKey"`
+	FamilyID   string     `json:"familyID" gorm:"index"`
+	ParentID   string     `json:"parentID"`
+	UserID     int64      `json:"userID" gorm:"index"`
+	DeviceName string     `json:"deviceName"`
+	UserAgent  string     `json:"userAgent"`
+	IPAddress  string     `json:"ipAddress"`
+	ExpiresAt  time.Time  `json:"expiresAt"`
+	RotatedAt  *time.Time `json:"rotatedAt"`
+	RevokedAt  *time.Time `json:"revokedAt"`
+	CreatedAt  time.Time  `json:"createdAt"`
+}
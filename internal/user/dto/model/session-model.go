@@ -0,0 +1,18 @@
+package userModel
+
+import "time"
+
+// Session is a logged-in device. Its ID is the family ID shared by every
+// refresh token rotated from the login that started it.
+type Session struct {
+	ID         string     `json:"id" gorm:"primaryKey"`
+	UserID     int64      `json:"userID" gorm:"index"`
+	DeviceName string     `json:"deviceName"`
+	Platform   string     `json:"platform"`
+	FcmToken   string     `json:"fcmToken"`
+	UserAgent  string     `json:"userAgent"`
+	IPAddress  string     `json:"ipAddress"`
+	LastSeenAt time.Time  `json:"lastSeenAt"`
+	RevokedAt  *time.Time `json:"revokedAt"`
+	CreatedAt  time.Time  `json:"createdAt"`
+}
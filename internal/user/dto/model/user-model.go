@@ -3,15 +3,24 @@ package userModel
 import "time"
 
 type User struct {
-	ID        int64     `json:"id" gorm:"primaryKey unique"`
-	FirstName string    `json:"firstName"`
-	LastName  string    `json:"lastName"`
-	Email     string    `json:"email" gorm:"unique"`
-	Role      string    `json:"role" gorm:"default:customer"`
-	Password  string    `json:"-"`
-	FcmToken  string    `json:"fcmToken"`
-	Image     string    `json:"image"`
-	IsBanned  bool      `json:"isBanned" gorm:"default:false"`
-	CreatedAt time.Time `json:"createdAt"`
-	UpdatedAt time.Time `json:"updatedAt"`
+	ID        int64  `json:"id" gorm:"primaryKey unique"`
+	FirstName string `json:"firstName"`
+	LastName  string `json:"lastName"`
+	Email     string `json:"email" gorm:"unique"`
+	Role      string `json:"role" gorm:"default:customer"`
+	Password  string `json:"-"`
+	Image     string `json:"image"`
+	IsBanned  bool   `json:"isBanned" gorm:"default:false"`
+	// PendingEmail holds a requested email change until it is verified.
+	PendingEmail    string     `json:"pendingEmail"`
+	EmailVerifiedAt *time.Time `json:"emailVerifiedAt"`
+	// TwoFactorSecret is the TOTP secret, set once enrollment is confirmed.
+	TwoFactorSecret    string     `json:"-"`
+	TwoFactorEnabledAt *time.Time `json:"twoFactorEnabledAt"`
+	// OutletID binds a staff member to the outlet whose orders they work on.
+	OutletID *int64 `json:"outletID" gorm:"index"`
+	// TokenVersion is embedded in issued tokens; bumping it revokes all of them.
+	TokenVersion int64     `json:"-" gorm:"default:0"`
+	CreatedAt    time.Time `json:"createdAt"`
+	UpdatedAt    time.Time `json:"updatedAt"`
 }
@@ -7,16 +7,26 @@ type Register struct {
 	Password  string `json:"password" validate:"required,min=6,max=130"`
 }
 
+// Device describes the client a session is started from. Only the name is
+// read from the body; the rest is filled from the request.
+type Device struct {
+	DeviceName string `json:"deviceName"`
+	Platform   string `json:"platform" validate:"omitempty,oneof=android ios web"`
+	UserAgent  string `json:"-"`
+	IPAddress  string `json:"-"`
+}
+
 type Login struct {
 	Email    string `json:"email" validate:"required,email"`
 	Password string `json:"password" validate:"required"`
 	FcmToken string `json:"fcmToken"`
+	Device
 }
 
 type UpdateProfile struct {
 	FirstName string `json:"firstName" validate:"min=2"`
 	LastName  string `json:"lastName" validate:"min=2"`
-	Email     string `json:"email"`
+	Email     string `json:"email" validate:"omitempty,email"`
 }
 
 type UpdatePassword struct {
@@ -24,6 +34,21 @@ type UpdatePassword struct {
 	NewPassword     string `json:"newPassword" validate:"required,min=6,max=130"`
 	ConfirmPassword string `json:"confirmPassword" validate:"required,eqfield=NewPassword"`
 }
+
+type ForgotPassword struct {
+	Email string `json:"email" validate:"required,email"`
+}
+
+type VerifyEmail struct {
+	Token string `json:"token" validate:"required"`
+}
+
+type ResetPassword struct {
+	Token           string `json:"token" validate:"required"`
+	NewPassword     string `json:"newPassword" validate:"required,min=6,max=130"`
+	ConfirmPassword string `json:"confirmPassword" validate:"required,eqfield=NewPassword"`
+}
+
 type UpdatePicture struct {
 	Image []byte `json:"Image" validate:"required,file,ext=jpg|png|webp,max=2mb"`
 }
@@ -31,4 +56,29 @@ type UpdatePicture struct {
 type Google struct {
 	TokenID  string `json:"tokenID"`
 	FcmToken string `json:"fcmToken"`
+	Device
+}
+
+type Logout struct {
+	RefreshToken string `json:"refreshToken"`
+}
+
+type TwoFactorCode struct {
+	Code string `json:"code" validate:"required"`
+}
+
+// TwoFactorLogin completes a login that returned a challenge. Code is either
+// a TOTP code or a recovery code.
+type TwoFactorLogin struct {
+	ChallengeToken string `json:"challengeToken" validate:"required"`
+	Code           string `json:"code" validate:"required"`
+}
+
+type AssignRole struct {
+	Role string `json:"role" validate:"required"`
+}
+
+type DisableTwoFactor struct {
+	Password string `json:"password" validate:"required"`
+	Code     string `json:"code" validate:"required"`
 }
@@ -11,7 +11,12 @@ type User struct {
 	Email     string    `json:"email"`
 	Role      string    `json:"role"`
 	Image     string    `json:"image"`
+	OutletID  *int64    `json:"outletID"`
 	CreatedAt time.Time `json:"createdAt"`
+
+	PendingEmail       string     `json:"pendingEmail,omitempty"`
+	EmailVerifiedAt    *time.Time `json:"emailVerifiedAt"`
+	TwoFactorEnabledAt *time.Time `json:"twoFactorEnabledAt"`
 }
 
 type WithToken struct {
@@ -19,3 +24,34 @@ type WithToken struct {
 	AccessToken  string `json:"accessToken,omitempty"`
 	RefreshToken string `json:"refreshToken,omitempty"`
 }
+
+type Session struct {
+	ID         string    `json:"id"`
+	DeviceName string    `json:"deviceName"`
+	Platform   string    `json:"platform"`
+	UserAgent  string    `json:"userAgent"`
+	IPAddress  string    `json:"ipAddress"`
+	LastSeenAt time.Time `json:"lastSeenAt"`
+	CreatedAt  time.Time `json:"createdAt"`
+	Current    bool      `json:"current"`
+}
+
+type TwoFactorChallenge struct {
+	TwoFactorRequired bool      `json:"twoFactorRequired"`
+	ChallengeToken    string    `json:"challengeToken"`
+	ExpiresAt         time.Time `json:"expiresAt"`
+}
+
+type TwoFactorEnrollment struct {
+	Secret string `json:"secret"`
+	URI    string `json:"uri"`
+}
+
+type RecoveryCodes struct {
+	Codes []string `json:"codes"`
+}
+
+type Role struct {
+	Name        string   `json:"name"`
+	Permissions []string `json:"permissions"`
+}
@@ -3,17 +3,21 @@ package user
 import (
 	"context"
 	"errors"
+	"io"
 	"log"
+	"math"
 	"net/http"
 	"strconv"
 
 	fireBase "firebase.google.com/go"
 	"github.com/gin-gonic/gin"
 
+	userModel "washit-api/internal/user/dto/model"
 	userRequest "washit-api/internal/user/dto/request"
 	userResource "washit-api/internal/user/dto/resource"
 	userService "washit-api/internal/user/service"
 	"washit-api/pkg/configs"
+	"washit-api/pkg/rbac"
 	"washit-api/pkg/redis"
 	"washit-api/pkg/response"
 	jwt "washit-api/pkg/token"
@@ -36,30 +40,38 @@ func NewUserHandler(service userService.IUserService, cache redis.IRedis, app *f
 
 var MeCacheKey = "/api/v1/profile/me"
 
-// RefreshToken refreshes the user's access token
+// RefreshToken rotates the user's refresh token
 //
-//	@Summary	Refresh the user's access token
+//	@Summary	Exchange a refresh token for a new access and refresh token
 //	@Tags		User
 //	@Accept		json
 //	@Produce	json
-//	@Success	200	{object}	map[string]string	"accessToken"
-//	@Router		/auth/refresh-token [post]
+//	@Security	ApiKeyAuth
+//	@Success	200	{object}	map[string]string	"accessToken, refreshToken"
+//	@Router		/auth/refresh [post]
 func (h *UserHandler) RefreshToken(c *gin.Context) {
-	userID := c.GetString("userID")
-	if userID == "" {
-		log.Println("Failed to get userID from context")
-		response.Error(c, http.StatusInternalServerError, "Failed to get userID from context", errors.New("Failed to get userID from context"))
+	value, _ := c.Get("tokenClaims")
+	claims, ok := value.(*jwt.Claims)
+	if !ok {
+		log.Println("Failed to get token from context")
+		response.Error(c, http.StatusInternalServerError, "Failed to get token from context", errors.New("Failed to get token from context"))
 		return
 	}
 
-	accessToken, err := h.service.RefreshToken(c, userID)
+	device := userRequest.Device{UserAgent: c.Request.UserAgent(), IPAddress: c.ClientIP()}
+	accessToken, refreshToken, err := h.service.RefreshToken(c, claims, &device)
 	if err != nil {
 		log.Println("Failed to refresh token ", err)
-		response.Error(c, http.StatusInternalServerError, "Failed to refresh token", err)
+		code := http.StatusInternalServerError
+		if errors.Is(err, userService.ErrRefreshTokenRevoked) || errors.Is(err, userService.ErrRefreshTokenReused) {
+			code = http.StatusUnauthorized
+		}
+		response.Error(c, code, "Failed to refresh token", err)
 		return
 	}
 
-	response.Success(c, http.StatusOK, "Successfully refreshed token", gin.H{"accessToken": accessToken}, nil)
+	response.Success(c, http.StatusOK, "Successfully refreshed token",
+		gin.H{"accessToken": accessToken, "refreshToken": refreshToken}, nil)
 }
 
 // LoginWithGoogle handles user login via Google
@@ -70,6 +82,7 @@ func (h *UserHandler) RefreshToken(c *gin.Context) {
 //	@Produce	json
 //	@Param		_	body		userRequest.Google	true	"Body"
 //	@Success	200	{object}	userResource.WithToken
+//	@Success	202	{object}	userResource.TwoFactorChallenge
 //	@Router		/auth/login/google [post]
 func (h *UserHandler) LoginWithGoogle(c *gin.Context) {
 	var res userResource.WithToken
@@ -102,7 +115,12 @@ func (h *UserHandler) LoginWithGoogle(c *gin.Context) {
 		return
 	}
 
+	req.UserAgent = c.Request.UserAgent()
+	req.IPAddress = c.ClientIP()
 	user, accessToken, refreshToken, err := h.service.LoginWithGoogle(c, &req, userRecord.ProviderUserInfo[0])
+	if challenged(c, err) {
+		return
+	}
 	if err != nil {
 		log.Println("Failed to login with Google ", err)
 		response.Error(c, http.StatusInternalServerError, "Failed to login with Google", err)
@@ -123,6 +141,8 @@ func (h *UserHandler) LoginWithGoogle(c *gin.Context) {
 //	@Produce	json
 //	@Param		_	body		userRequest.Login	true	"Body"
 //	@Success	200	{object}	userResource.WithToken
+//	@Success	202	{object}	userResource.TwoFactorChallenge
+//	@Failure	429
 //	@Router		/auth/login [post]
 func (h *UserHandler) Login(c *gin.Context) {
 	var req userRequest.Login
@@ -134,7 +154,12 @@ func (h *UserHandler) Login(c *gin.Context) {
 		return
 	}
 
+	req.UserAgent = c.Request.UserAgent()
+	req.IPAddress = c.ClientIP()
 	user, accessToken, refreshToken, err := h.service.Login(c, &req)
+	if challenged(c, err) || throttled(c, err, "Too many failed login attempts") {
+		return
+	}
 	if err != nil {
 		log.Println("Failed to login as user ", err)
 		response.Error(c, http.StatusInternalServerError, "Failed to login", err)
@@ -186,13 +211,453 @@ func (h *UserHandler) Register(c *gin.Context) {
 //	@Accept		json
 //	@Produce	json
 //	@Security	ApiKeyAuth
+//	@Param		_	body		userRequest.Logout	false	"Body"
 //	@Success	200	{object}	userResource.User
 //	@Router		/auth/logout [post]
 func (h *UserHandler) Logout(c *gin.Context) {
+	value, _ := c.Get("tokenClaims")
+	claims, ok := value.(*jwt.Claims)
+	if !ok {
+		log.Println("Failed to get token from context")
+		response.Error(c, http.StatusInternalServerError, "Failed to get token from context", errors.New("Failed to get token from context"))
+		return
+	}
+
+	var req userRequest.Logout
+	if err := utils.ParseJson(c, &req); err != nil && !errors.Is(err, io.EOF) {
+		log.Println("Failed to parse request ", err)
+		response.Error(c, http.StatusBadRequest, "Failed to parse request", err)
+		return
+	}
+
+	if err := h.service.Logout(c, claims, &req); err != nil {
+		log.Println("Failed to logout ", err)
+		response.Error(c, http.StatusInternalServerError, "Failed to logout", err)
+		return
+	}
+
 	c.SetCookie("jwt", "", -1, "/", "localhost", false, true)
 	response.Success(c, http.StatusOK, "Successfully logged out", nil, nil)
 }
 
+// LogoutAll logs the user out of all devices
+//
+//	@Summary	Logout the current user from all devices
+//	@Tags		User
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Success	200
+//	@Router		/auth/logout/all [post]
+func (h *UserHandler) LogoutAll(c *gin.Context) {
+	if err := h.service.LogoutAll(c, c.GetString("userID")); err != nil {
+		log.Println("Failed to logout from all devices ", err)
+		response.Error(c, http.StatusInternalServerError, "Failed to logout from all devices", err)
+		return
+	}
+
+	c.SetCookie("jwt", "", -1, "/", "localhost", false, true)
+	response.Success(c, http.StatusOK, "Successfully logged out from all devices", nil, nil)
+}
+
+// GetSessions lists the devices the current user is logged in on
+//
+//	@Summary	Get sessions of the current user
+//	@Tags		User
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Success	200	{object}	[]userResource.Session
+//	@Router		/profile/sessions [get]
+func (h *UserHandler) GetSessions(c *gin.Context) {
+	sessions, err := h.service.GetSessions(c, c.GetString("userID"))
+	if err != nil {
+		log.Println("Failed to get sessions ", err)
+		response.Error(c, http.StatusInternalServerError, "Failed to get sessions", err)
+		return
+	}
+
+	var familyID string
+	if value, ok := c.Get("tokenClaims"); ok {
+		if claims, ok := value.(*jwt.Claims); ok {
+			familyID = claims.FamilyID
+		}
+	}
+
+	res := toSessions(sessions, familyID)
+	response.Success(c, http.StatusOK, "Successfully retrieved sessions", &res, nil)
+}
+
+// RevokeSession logs the current user out of one device
+//
+//	@Summary	Revoke a session of the current user
+//	@Tags		User
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		id	path	string	true	"Session ID"
+//	@Success	200
+//	@Router		/profile/sessions/{id} [delete]
+func (h *UserHandler) RevokeSession(c *gin.Context) {
+	if err := h.service.RevokeSession(c, c.GetString("userID"), c.Param("id")); err != nil {
+		log.Println("Failed to revoke session ", err)
+		response.Error(c, http.StatusNotFound, "Failed to revoke session", err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Session is revoked successfully", nil, nil)
+}
+
+// GetUserSessions lists the sessions of a user
+//
+//	@Summary	Get sessions of a user
+//	@Tags		User
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		id	path		string	true	"User ID"
+//	@Success	200	{object}	[]userResource.Session
+//	@Router		/user/{id}/sessions [get]
+func (h *UserHandler) GetUserSessions(c *gin.Context) {
+	sessions, err := h.service.GetSessions(c, c.Param("id"))
+	if err != nil {
+		log.Println("Failed to get sessions ", err)
+		response.Error(c, http.StatusInternalServerError, "Failed to get sessions", err)
+		return
+	}
+
+	res := toSessions(sessions, "")
+	response.Success(c, http.StatusOK, "Successfully retrieved sessions", &res, nil)
+}
+
+// RevokeUserSessions logs a user out of all devices
+//
+//	@Summary	Revoke all sessions of a user
+//	@Tags		User
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		id	path	string	true	"User ID"
+//	@Success	200
+//	@Router		/user/{id}/sessions [delete]
+func (h *UserHandler) RevokeUserSessions(c *gin.Context) {
+	if err := h.service.LogoutAll(c, c.Param("id")); err != nil {
+		log.Println("Failed to revoke sessions ", err)
+		response.Error(c, http.StatusInternalServerError, "Failed to revoke sessions", err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Sessions are revoked successfully", nil, nil)
+}
+
+func toSessions(sessions []*userModel.Session, currentID string) []userResource.Session {
+	res := make([]userResource.Session, len(sessions))
+	for i, session := range sessions {
+		utils.CopyTo(session, &res[i])
+		res[i].Current = session.ID == currentID
+	}
+
+	return res
+}
+
+// ForgotPassword sends a password reset link
+//
+//	@Summary	Request a password reset email
+//	@Tags		User
+//	@Accept		json
+//	@Produce	json
+//	@Param		_	body	userRequest.ForgotPassword	true	"Body"
+//	@Success	200
+//	@Failure	429
+//	@Router		/auth/password/forgot [post]
+func (h *UserHandler) ForgotPassword(c *gin.Context) {
+	var req userRequest.ForgotPassword
+	if err := utils.ParseJson(c, &req); err != nil {
+		log.Println("Failed to parse request ", err)
+		response.Error(c, http.StatusBadRequest, "Failed to parse request", err)
+		return
+	}
+
+	if err := h.service.ForgotPassword(c, &req); err != nil {
+		log.Println("Failed to request password reset ", err)
+		if throttled(c, err, "Too many password reset requests") {
+			return
+		}
+		response.Error(c, http.StatusInternalServerError, "Failed to request password reset", err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, "If the email is registered, a reset link has been sent", nil, nil)
+}
+
+// ResetPassword sets a new password using a reset token
+//
+//	@Summary	Reset password with a token from the reset email
+//	@Tags		User
+//	@Accept		json
+//	@Produce	json
+//	@Param		_	body	userRequest.ResetPassword	true	"Body"
+//	@Success	200
+//	@Router		/auth/password/reset [post]
+func (h *UserHandler) ResetPassword(c *gin.Context) {
+	var req userRequest.ResetPassword
+	if err := utils.ParseJson(c, &req); err != nil {
+		log.Println("Failed to parse request ", err)
+		response.Error(c, http.StatusBadRequest, "Failed to parse request", err)
+		return
+	}
+
+	if err := h.service.ResetPassword(c, &req); err != nil {
+		log.Println("Failed to reset password ", err)
+		code := http.StatusInternalServerError
+		if errors.Is(err, userService.ErrInvalidResetToken) {
+			code = http.StatusBadRequest
+		}
+		response.Error(c, code, "Failed to reset password", err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Password is reset successfully", nil, nil)
+}
+
+// VerifyEmail confirms an email address with a token from the verification email
+//
+//	@Summary	Verify email address
+//	@Tags		User
+//	@Accept		json
+//	@Produce	json
+//	@Param		_	body		userRequest.VerifyEmail	true	"Body"
+//	@Success	200	{object}	userResource.User
+//	@Router		/auth/email/verify [post]
+func (h *UserHandler) VerifyEmail(c *gin.Context) {
+	var req userRequest.VerifyEmail
+	var res userResource.User
+
+	if err := utils.ParseJson(c, &req); err != nil {
+		log.Println("Failed to parse request ", err)
+		response.Error(c, http.StatusBadRequest, "Failed to parse request", err)
+		return
+	}
+
+	user, err := h.service.VerifyEmail(c, &req)
+	if err != nil {
+		log.Println("Failed to verify email ", err)
+		code := http.StatusInternalServerError
+		if errors.Is(err, userService.ErrInvalidVerificationToken) {
+			code = http.StatusBadRequest
+		}
+		response.Error(c, code, "Failed to verify email", err)
+		return
+	}
+
+	utils.CopyTo(&user, &res)
+	response.Success(c, http.StatusOK, "Email is verified successfully", &res, nil)
+
+	_ = h.cache.Remove(MeCacheKey)
+}
+
+// ResendVerification sends a new verification email to the current user
+//
+//	@Summary	Resend the email verification link
+//	@Tags		User
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Success	200
+//	@Failure	429
+//	@Router		/auth/email/verify/resend [post]
+func (h *UserHandler) ResendVerification(c *gin.Context) {
+	if err := h.service.ResendVerification(c, c.GetString("userID")); err != nil {
+		log.Println("Failed to resend verification email ", err)
+		if throttled(c, err, "Too many verification requests") {
+			return
+		}
+		response.Error(c, http.StatusInternalServerError, "Failed to resend verification email", err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Verification email is sent successfully", nil, nil)
+}
+
+// VerifyTwoFactor completes a login with a two-factor code
+//
+//	@Summary	Complete a login with a TOTP or recovery code
+//	@Tags		User
+//	@Accept		json
+//	@Produce	json
+//	@Param		_	body		userRequest.TwoFactorLogin	true	"Body"
+//	@Success	200	{object}	userResource.WithToken
+//	@Router		/auth/2fa/verify [post]
+func (h *UserHandler) VerifyTwoFactor(c *gin.Context) {
+	var req userRequest.TwoFactorLogin
+	var res userResource.WithToken
+
+	if err := utils.ParseJson(c, &req); err != nil {
+		log.Println("Failed to parse request ", err)
+		response.Error(c, http.StatusBadRequest, "Failed to parse request", err)
+		return
+	}
+
+	user, accessToken, refreshToken, err := h.service.VerifyTwoFactorLogin(c, &req)
+	if err != nil {
+		log.Println("Failed to verify two-factor code ", err)
+		code := http.StatusInternalServerError
+		if errors.Is(err, userService.ErrInvalidTwoFactorCode) || errors.Is(err, userService.ErrInvalidChallenge) {
+			code = http.StatusUnauthorized
+		}
+		response.Error(c, code, "Failed to verify two-factor code", err)
+		return
+	}
+
+	c.SetCookie("jwt", accessToken, jwt.AccessTokenExpiredTime, "/", c.Request.Host, false, true)
+
+	utils.CopyTo(&user, &res.User)
+	utils.CopyTo(&accessToken, &res.AccessToken)
+	utils.CopyTo(&refreshToken, &res.RefreshToken)
+	response.Success(c, http.StatusOK, "Successfully logged in", &res, nil)
+}
+
+// EnrollTwoFactor starts two-factor enrollment for the current user
+//
+//	@Summary	Start TOTP enrollment
+//	@Tags		User
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Success	200	{object}	userResource.TwoFactorEnrollment
+//	@Router		/profile/2fa/enroll [post]
+func (h *UserHandler) EnrollTwoFactor(c *gin.Context) {
+	secret, uri, err := h.service.EnrollTwoFactor(c, c.GetString("userID"))
+	if err != nil {
+		log.Println("Failed to enroll two-factor authentication ", err)
+		response.Error(c, http.StatusInternalServerError, "Failed to enroll two-factor authentication", err)
+		return
+	}
+
+	res := userResource.TwoFactorEnrollment{Secret: secret, URI: uri}
+	response.Success(c, http.StatusOK, "Scan the URI and confirm with a code", &res, nil)
+}
+
+// ConfirmTwoFactor enables two-factor authentication
+//
+//	@Summary	Confirm TOTP enrollment
+//	@Tags		User
+//	@Accept		json
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		_	body		userRequest.TwoFactorCode	true	"Body"
+//	@Success	200	{object}	userResource.RecoveryCodes
+//	@Router		/profile/2fa/confirm [post]
+func (h *UserHandler) ConfirmTwoFactor(c *gin.Context) {
+	var req userRequest.TwoFactorCode
+	if err := utils.ParseJson(c, &req); err != nil {
+		log.Println("Failed to parse request ", err)
+		response.Error(c, http.StatusBadRequest, "Failed to parse request", err)
+		return
+	}
+
+	codes, err := h.service.ConfirmTwoFactor(c, c.GetString("userID"), &req)
+	if err != nil {
+		log.Println("Failed to confirm two-factor authentication ", err)
+		response.Error(c, twoFactorErrorCode(err), "Failed to confirm two-factor authentication", err)
+		return
+	}
+
+	res := userResource.RecoveryCodes{Codes: codes}
+	response.Success(c, http.StatusOK, "Two-factor authentication is enabled successfully", &res, nil)
+
+	_ = h.cache.Remove(MeCacheKey)
+}
+
+// RegenerateRecoveryCodes replaces the recovery codes of the current user
+//
+//	@Summary	Regenerate recovery codes
+//	@Tags		User
+//	@Accept		json
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		_	body		userRequest.TwoFactorCode	true	"Body"
+//	@Success	200	{object}	userResource.RecoveryCodes
+//	@Router		/profile/2fa/recovery-codes [post]
+func (h *UserHandler) RegenerateRecoveryCodes(c *gin.Context) {
+	var req userRequest.TwoFactorCode
+	if err := utils.ParseJson(c, &req); err != nil {
+		log.Println("Failed to parse request ", err)
+		response.Error(c, http.StatusBadRequest, "Failed to parse request", err)
+		return
+	}
+
+	codes, err := h.service.RegenerateRecoveryCodes(c, c.GetString("userID"), &req)
+	if err != nil {
+		log.Println("Failed to regenerate recovery codes ", err)
+		response.Error(c, twoFactorErrorCode(err), "Failed to regenerate recovery codes", err)
+		return
+	}
+
+	res := userResource.RecoveryCodes{Codes: codes}
+	response.Success(c, http.StatusOK, "Recovery codes are regenerated successfully", &res, nil)
+}
+
+// DisableTwoFactor turns two-factor authentication off
+//
+//	@Summary	Disable two-factor authentication
+//	@Tags		User
+//	@Accept		json
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		_	body	userRequest.DisableTwoFactor	true	"Body"
+//	@Success	200
+//	@Router		/profile/2fa [delete]
+func (h *UserHandler) DisableTwoFactor(c *gin.Context) {
+	var req userRequest.DisableTwoFactor
+	if err := utils.ParseJson(c, &req); err != nil {
+		log.Println("Failed to parse request ", err)
+		response.Error(c, http.StatusBadRequest, "Failed to parse request", err)
+		return
+	}
+
+	if err := h.service.DisableTwoFactor(c, c.GetString("userID"), &req); err != nil {
+		log.Println("Failed to disable two-factor authentication ", err)
+		response.Error(c, twoFactorErrorCode(err), "Failed to disable two-factor authentication", err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Two-factor authentication is disabled successfully", nil, nil)
+
+	_ = h.cache.Remove(MeCacheKey)
+}
+
+// challenged answers a login that needs a second factor with the challenge
+// token and reports whether it did.
+func challenged(c *gin.Context, err error) bool {
+	var required *userService.ErrTwoFactorRequired
+	if !errors.As(err, &required) {
+		return false
+	}
+
+	res := userResource.TwoFactorChallenge{
+		TwoFactorRequired: true,
+		ChallengeToken:    required.ChallengeToken,
+		ExpiresAt:         required.ExpiresAt,
+	}
+	response.Success(c, http.StatusAccepted, "Two-factor authentication required", &res, nil)
+	return true
+}
+
+// throttled answers a rate limited request with 429 and a Retry-After header
+// and reports whether it did.
+func throttled(c *gin.Context, err error, message string) bool {
+	var tooMany *userService.ErrTooManyRequests
+	if !errors.As(err, &tooMany) {
+		return false
+	}
+
+	c.Header("Retry-After", strconv.Itoa(int(math.Ceil(tooMany.RetryAfter.Seconds()))))
+	response.Error(c, http.StatusTooManyRequests, message, err)
+	return true
+}
+
+func twoFactorErrorCode(err error) int {
+	if errors.Is(err, userService.ErrInvalidTwoFactorCode) {
+		return http.StatusUnauthorized
+	}
+
+	return http.StatusInternalServerError
+}
+
 // BanUser bans a user by ID
 //
 //	@Summary	Ban a user
@@ -206,7 +671,7 @@ func (h *UserHandler) Logout(c *gin.Context) {
 func (h *UserHandler) BanUser(c *gin.Context) {
 	var res userResource.User
 
-	user, err := h.service.BanUser(c, c.GetString("userID"))
+	user, err := h.service.BanUser(c, c.Param("id"))
 	if err != nil {
 		log.Println("Failed to ban user ", err)
 		response.Error(c, http.StatusInternalServerError, "Failed to ban user", err)
@@ -241,6 +706,89 @@ func (h *UserHandler) UnbanUser(c *gin.Context) {
 	response.Success(c, http.StatusOK, user.FirstName+" is successfully unbanned", &res, links(res.ID))
 }
 
+// UnlockUser lifts a login lockout by user ID
+//
+//	@Summary	Unlock a user locked out by failed logins
+//	@Tags		User
+//	@Accept		json
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		id	path		string	true	"User ID"
+//	@Success	200	{object}	userResource.User
+//	@Router		/user/{id}/unlock [put]
+func (h *UserHandler) UnlockUser(c *gin.Context) {
+	var res userResource.User
+
+	user, err := h.service.UnlockUser(c, c.Param("id"))
+	if err != nil {
+		log.Println("Failed to unlock user ", err)
+		response.Error(c, http.StatusInternalServerError, "Failed to unlock user", err)
+		return
+	}
+
+	utils.CopyTo(&user, &res)
+	response.Success(c, http.StatusOK, user.FirstName+" is successfully unlocked", &res, links(res.ID))
+}
+
+// GetRoles lists the roles and their permissions
+//
+//	@Summary	Get all roles with their permissions
+//	@Tags		User
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Success	200	{object}	[]userResource.Role
+//	@Router		/roles [get]
+func (h *UserHandler) GetRoles(c *gin.Context) {
+	res := make([]userResource.Role, 0)
+	for _, name := range rbac.Roles() {
+		role := userResource.Role{Name: name, Permissions: make([]string, 0)}
+		for _, permission := range rbac.Permissions(name) {
+			role.Permissions = append(role.Permissions, string(permission))
+		}
+		res = append(res, role)
+	}
+
+	response.Success(c, http.StatusOK, "Roles are collected successfully", &res, nil)
+}
+
+// AssignRole changes the role of a user by ID
+//
+//	@Summary	Assign a role to a user
+//	@Tags		User
+//	@Accept		json
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		id	path		string					true	"User ID"
+//	@Param		_	body		userRequest.AssignRole	true	"Body"
+//	@Success	200	{object}	userResource.User
+//	@Router		/user/{id}/role [put]
+func (h *UserHandler) AssignRole(c *gin.Context) {
+	var req userRequest.AssignRole
+	var res userResource.User
+
+	if err := utils.ParseJson(c, &req); err != nil {
+		log.Println("Failed to parse request ", err)
+		response.Error(c, http.StatusBadRequest, "Failed to parse request", err)
+		return
+	}
+
+	user, err := h.service.AssignRole(c, c.GetString("userID"), c.Param("id"), &req)
+	if err != nil {
+		log.Println("Failed to assign role ", err)
+		code := http.StatusInternalServerError
+		if errors.Is(err, userService.ErrUnknownRole) || errors.Is(err, userService.ErrChangeOwnRole) {
+			code = http.StatusBadRequest
+		}
+		response.Error(c, code, "Failed to assign role", err)
+		return
+	}
+
+	utils.CopyTo(&user, &res)
+	response.Success(c, http.StatusOK, user.FirstName+" is now "+user.Role, &res, links(res.ID))
+
+	_ = h.cache.Remove(MeCacheKey)
+}
+
 // UpdateMe updates the current logged-in user's profile
 //
 //	@Summary	Update the current logged-in user
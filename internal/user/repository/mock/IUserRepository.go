@@ -4,9 +4,11 @@ package mocks
 
 import (
 	context "context"
-	userModel "washit-api/internal/user/dto/model"
+	time "time"
 
 	mock "github.com/stretchr/testify/mock"
+
+	userModel "washit-api/internal/user/dto/model"
 )
 
 // IUserRepository is an autogenerated mock type for the IUserRepository type
@@ -14,6 +16,24 @@ type IUserRepository struct {
 	mock.Mock
 }
 
+// CreateSession provides a mock function with given fields: ctx, session, token
+func (_m *IUserRepository) CreateSession(ctx context.Context, session *userModel.Session, token *userModel.RefreshToken) error {
+	ret := _m.Called(ctx, session, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSession")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *userModel.Session, *userModel.RefreshToken) error); ok {
+		r0 = rf(ctx, session, token)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // CreateUser provides a mock function with given fields: ctx, user
 func (_m *IUserRepository) CreateUser(ctx context.Context, user *userModel.User) error {
 	ret := _m.Called(ctx, user)
@@ -32,6 +52,24 @@ func (_m *IUserRepository) CreateUser(ctx context.Context, user *userModel.User)
 	return r0
 }
 
+// DisableTwoFactor provides a mock function with given fields: ctx, user
+func (_m *IUserRepository) DisableTwoFactor(ctx context.Context, user *userModel.User) error {
+	ret := _m.Called(ctx, user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DisableTwoFactor")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *userModel.User) error); ok {
+		r0 = rf(ctx, user)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // GetBannedUsers provides a mock function with given fields: ctx
 func (_m *IUserRepository) GetBannedUsers(ctx context.Context) ([]*userModel.User, error) {
 	ret := _m.Called(ctx)
@@ -62,6 +100,96 @@ func (_m *IUserRepository) GetBannedUsers(ctx context.Context) ([]*userModel.Use
 	return r0, r1
 }
 
+// GetRefreshTokenByID provides a mock function with given fields: ctx, tokenID
+func (_m *IUserRepository) GetRefreshTokenByID(ctx context.Context, tokenID string) (*userModel.RefreshToken, error) {
+	ret := _m.Called(ctx, tokenID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRefreshTokenByID")
+	}
+
+	var r0 *userModel.RefreshToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*userModel.RefreshToken, error)); ok {
+		return rf(ctx, tokenID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *userModel.RefreshToken); ok {
+		r0 = rf(ctx, tokenID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*userModel.RefreshToken)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, tokenID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetSessionByID provides a mock function with given fields: ctx, sessionID
+func (_m *IUserRepository) GetSessionByID(ctx context.Context, sessionID string) (*userModel.Session, error) {
+	ret := _m.Called(ctx, sessionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSessionByID")
+	}
+
+	var r0 *userModel.Session
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*userModel.Session, error)); ok {
+		return rf(ctx, sessionID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *userModel.Session); ok {
+		r0 = rf(ctx, sessionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*userModel.Session)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, sessionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetSessionsByUser provides a mock function with given fields: ctx, userID, activeSince
+func (_m *IUserRepository) GetSessionsByUser(ctx context.Context, userID string, activeSince time.Time) ([]*userModel.Session, error) {
+	ret := _m.Called(ctx, userID, activeSince)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSessionsByUser")
+	}
+
+	var r0 []*userModel.Session
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) ([]*userModel.Session, error)); ok {
+		return rf(ctx, userID, activeSince)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) []*userModel.Session); ok {
+		r0 = rf(ctx, userID, activeSince)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*userModel.Session)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, time.Time) error); ok {
+		r1 = rf(ctx, userID, activeSince)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetUserByEmail provides a mock function with given fields: ctx, email
 func (_m *IUserRepository) GetUserByEmail(ctx context.Context, email string) (*userModel.User, error) {
 	ret := _m.Called(ctx, email)
@@ -152,6 +280,108 @@ func (_m *IUserRepository) GetUsers(ctx context.Context) ([]*userModel.User, err
 	return r0, r1
 }
 
+// GetUsersByOutlet provides a mock function with given fields: ctx, outletID
+func (_m *IUserRepository) GetUsersByOutlet(ctx context.Context, outletID string) ([]*userModel.User, error) {
+	ret := _m.Called(ctx, outletID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUsersByOutlet")
+	}
+
+	var r0 []*userModel.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*userModel.User, error)); ok {
+		return rf(ctx, outletID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*userModel.User); ok {
+		r0 = rf(ctx, outletID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*userModel.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, outletID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReplaceRecoveryCodes provides a mock function with given fields: ctx, userID, codes
+func (_m *IUserRepository) ReplaceRecoveryCodes(ctx context.Context, userID int64, codes []*userModel.RecoveryCode) error {
+	ret := _m.Called(ctx, userID, codes)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplaceRecoveryCodes")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, []*userModel.RecoveryCode) error); ok {
+		r0 = rf(ctx, userID, codes)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RevokeRefreshTokenFamily provides a mock function with given fields: ctx, familyID
+func (_m *IUserRepository) RevokeRefreshTokenFamily(ctx context.Context, familyID string) error {
+	ret := _m.Called(ctx, familyID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeRefreshTokenFamily")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, familyID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RevokeRefreshTokensByUser provides a mock function with given fields: ctx, userID
+func (_m *IUserRepository) RevokeRefreshTokensByUser(ctx context.Context, userID string) error {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeRefreshTokensByUser")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RotateRefreshToken provides a mock function with given fields: ctx, parentID, token
+func (_m *IUserRepository) RotateRefreshToken(ctx context.Context, parentID string, token *userModel.RefreshToken) error {
+	ret := _m.Called(ctx, parentID, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RotateRefreshToken")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *userModel.RefreshToken) error); ok {
+		r0 = rf(ctx, parentID, token)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpdateUser provides a mock function with given fields: ctx, user
 func (_m *IUserRepository) UpdateUser(ctx context.Context, user *userModel.User) error {
 	ret := _m.Called(ctx, user)
@@ -170,6 +400,24 @@ func (_m *IUserRepository) UpdateUser(ctx context.Context, user *userModel.User)
 	return r0
 }
 
+// UseRecoveryCode provides a mock function with given fields: ctx, userID, codeHash
+func (_m *IUserRepository) UseRecoveryCode(ctx context.Context, userID int64, codeHash string) error {
+	ret := _m.Called(ctx, userID, codeHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UseRecoveryCode")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string) error); ok {
+		r0 = rf(ctx, userID, codeHash)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // NewIUserRepository creates a new instance of IUserRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewIUserRepository(t interface {
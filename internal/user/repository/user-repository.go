@@ -2,6 +2,8 @@ package userRepository
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	userModel "washit-api/internal/user/dto/model"
 	"washit-api/pkg/db/dbs"
@@ -13,9 +15,26 @@ type IUserRepository interface {
 	GetUserByEmail(ctx context.Context, email string) (*userModel.User, error)
 	GetUsers(ctx context.Context) ([]*userModel.User, error)
 	GetBannedUsers(ctx context.Context) ([]*userModel.User, error)
+	GetUsersByOutlet(ctx context.Context, outletID string) ([]*userModel.User, error)
 	UpdateUser(ctx context.Context, user *userModel.User) error
+	CreateSession(ctx context.Context, session *userModel.Session, token *userModel.RefreshToken) error
+	GetSessionsByUser(ctx context.Context, userID string, activeSince time.Time) ([]*userModel.Session, error)
+	GetSessionByID(ctx context.Context, sessionID string) (*userModel.Session, error)
+	GetRefreshTokenByID(ctx context.Context, tokenID string) (*userModel.RefreshToken, error)
+	RotateRefreshToken(ctx context.Context, parentID string, token *userModel.RefreshToken) error
+	RevokeRefreshTokenFamily(ctx context.Context, familyID string) error
+	RevokeRefreshTokensByUser(ctx context.Context, userID string) error
+	ReplaceRecoveryCodes(ctx context.Context, userID int64, codes []*userModel.RecoveryCode) error
+	UseRecoveryCode(ctx context.Context, userID int64, codeHash string) error
+	DisableTwoFactor(ctx context.Context, user *userModel.User) error
 }
 
+// ErrRefreshTokenRotated is returned when the parent token was already
+// exchanged or revoked by the time it is rotated.
+var ErrRefreshTokenRotated = errors.New("refresh token already rotated")
+
+var ErrRecoveryCodeNotFound = errors.New("recovery code not found")
+
 type UserRepository struct {
 	db dbs.IDatabase
 }
@@ -66,6 +85,183 @@ func (r *UserRepository) GetBannedUsers(ctx context.Context) ([]*userModel.User,
 	return users, nil
 }
 
+func (r *UserRepository) GetUsersByOutlet(ctx context.Context, outletID string) ([]*userModel.User, error) {
+	var users []*userModel.User
+	query := dbs.NewQuery("outlet_id = ?", outletID)
+	if err := r.db.Find(ctx, &users, dbs.WithQuery(query), dbs.WithOrder("id")); err != nil {
+		return nil, err
+	}
+
+	return users, nil
+}
+
 func (r *UserRepository) UpdateUser(ctx context.Context, user *userModel.User) error {
 	return r.db.Update(ctx, user)
 }
+
+// CreateSession stores a new session together with its first refresh token.
+// The session's FCM token is taken from any earlier session of the device,
+// so that pushes only reach whoever logged in on it last.
+func (r *UserRepository) CreateSession(ctx context.Context, session *userModel.Session, token *userModel.RefreshToken) error {
+	return r.db.WithTransaction(func(tx dbs.IDatabase) error {
+		if session.FcmToken != "" {
+			err := tx.GetDB().WithContext(ctx).Model(&userModel.Session{}).
+				Where("fcm_token = ?", session.FcmToken).
+				Update("fcm_token", "").Error
+			if err != nil {
+				return err
+			}
+		}
+
+		if err := tx.Create(ctx, session); err != nil {
+			return err
+		}
+
+		return tx.Create(ctx, token)
+	})
+}
+
+// GetSessionsByUser returns the sessions that are neither revoked nor idle
+// since before activeSince, most recently used first.
+func (r *UserRepository) GetSessionsByUser(ctx context.Context, userID string, activeSince time.Time) ([]*userModel.Session, error) {
+	var sessions []*userModel.Session
+	err := r.db.GetDB().WithContext(ctx).
+		Where("user_id = ? AND revoked_at IS NULL AND last_seen_at > ?", userID, activeSince).
+		Order("last_seen_at desc").
+		Find(&sessions).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return sessions, nil
+}
+
+func (r *UserRepository) GetSessionByID(ctx context.Context, sessionID string) (*userModel.Session, error) {
+	var session userModel.Session
+	if err := r.db.FindByID(ctx, sessionID, &session); err != nil {
+		return nil, err
+	}
+
+	return &session, nil
+}
+
+func (r *UserRepository) GetRefreshTokenByID(ctx context.Context, tokenID string) (*userModel.RefreshToken, error) {
+	var token userModel.RefreshToken
+	if err := r.db.FindByID(ctx, tokenID, &token); err != nil {
+		return nil, err
+	}
+
+	return &token, nil
+}
+
+// RotateRefreshToken marks the parent as rotated, stores its child and
+// touches the session in one transaction. The conditional update makes
+// concurrent rotations of the same parent fail with ErrRefreshTokenRotated
+// instead of both succeeding.
+func (r *UserRepository) RotateRefreshToken(ctx context.Context, parentID string, token *userModel.RefreshToken) error {
+	return r.db.WithTransaction(func(tx dbs.IDatabase) error {
+		result := tx.GetDB().WithContext(ctx).Model(&userModel.RefreshToken{}).
+			Where("id = ? AND rotated_at IS NULL AND revoked_at IS NULL", parentID).
+			Update("rotated_at", time.Now())
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return ErrRefreshTokenRotated
+		}
+
+		if err := tx.Create(ctx, token); err != nil {
+			return err
+		}
+
+		return tx.GetDB().WithContext(ctx).Model(&userModel.Session{}).
+			Where("id = ?", token.FamilyID).
+			Updates(map[string]any{
+				"last_seen_at": time.Now(),
+				"ip_address":   token.IPAddress,
+				"user_agent":   token.UserAgent,
+			}).Error
+	})
+}
+
+// RevokeRefreshTokenFamily revokes a session and every refresh token in it.
+func (r *UserRepository) RevokeRefreshTokenFamily(ctx context.Context, familyID string) error {
+	return r.db.WithTransaction(func(tx dbs.IDatabase) error {
+		now := time.Now()
+		err := tx.GetDB().WithContext(ctx).Model(&userModel.Session{}).
+			Where("id = ? AND revoked_at IS NULL", familyID).
+			Update("revoked_at", now).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.GetDB().WithContext(ctx).Model(&userModel.RefreshToken{}).
+			Where("family_id = ? AND revoked_at IS NULL", familyID).
+			Update("revoked_at", now).Error
+	})
+}
+
+// RevokeRefreshTokensByUser revokes every session and refresh token of a user.
+func (r *UserRepository) RevokeRefreshTokensByUser(ctx context.Context, userID string) error {
+	return r.db.WithTransaction(func(tx dbs.IDatabase) error {
+		now := time.Now()
+		err := tx.GetDB().WithContext(ctx).Model(&userModel.Session{}).
+			Where("user_id = ? AND revoked_at IS NULL", userID).
+			Update("revoked_at", now).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.GetDB().WithContext(ctx).Model(&userModel.RefreshToken{}).
+			Where("user_id = ? AND revoked_at IS NULL", userID).
+			Update("revoked_at", now).Error
+	})
+}
+
+// ReplaceRecoveryCodes drops every previous code of the user before storing
+// the new set.
+func (r *UserRepository) ReplaceRecoveryCodes(ctx context.Context, userID int64, codes []*userModel.RecoveryCode) error {
+	return r.db.WithTransaction(func(tx dbs.IDatabase) error {
+		err := tx.GetDB().WithContext(ctx).
+			Where("user_id = ?", userID).
+			Delete(&userModel.RecoveryCode{}).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.CreateInBatches(ctx, codes, len(codes))
+	})
+}
+
+// UseRecoveryCode marks an unused code as used. The conditional update keeps
+// a code from being redeemed twice.
+func (r *UserRepository) UseRecoveryCode(ctx context.Context, userID int64, codeHash string) error {
+	result := r.db.GetDB().WithContext(ctx).Model(&userModel.RecoveryCode{}).
+		Where("user_id = ? AND code_hash = ? AND used_at IS NULL", userID, codeHash).
+		Update("used_at", time.Now())
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return ErrRecoveryCodeNotFound
+	}
+
+	return nil
+}
+
+// DisableTwoFactor clears the TOTP secret and removes the recovery codes.
+func (r *UserRepository) DisableTwoFactor(ctx context.Context, user *userModel.User) error {
+	return r.db.WithTransaction(func(tx dbs.IDatabase) error {
+		user.TwoFactorSecret = ""
+		user.TwoFactorEnabledAt = nil
+		if err := tx.Update(ctx, user); err != nil {
+			return err
+		}
+
+		return tx.GetDB().WithContext(ctx).
+			Where("user_id = ?", user.ID).
+			Delete(&userModel.RecoveryCode{}).Error
+	})
+}
@@ -9,42 +9,65 @@ import (
 	userRepository "washit-api/internal/user/repository"
 	userService "washit-api/internal/user/service"
 	"washit-api/pkg/db/dbs"
+	"washit-api/pkg/mailer"
 	"washit-api/pkg/middleware"
+	"washit-api/pkg/rbac"
 	"washit-api/pkg/redis"
 )
 
 func Main(r *gin.RouterGroup, db dbs.IDatabase, cache redis.IRedis, app *firebase.App, validator *validator.Validate) {
 	repository := userRepository.NewUserRepository(db)
-	service := userService.NewUserService(repository, validator)
+	service := userService.NewUserService(repository, cache, mailer.FromEnvs(), validator)
 	handler := user.NewUserHandler(service, cache, app)
 
-	authMiddleware := middleware.JWTAuth()
-	adminAuthMiddleware := middleware.JWTAuthAdmin()
-	authRefreshMiddleware := middleware.JWTRefresh()
+	authMiddleware := middleware.JWTAuth(cache)
+	authRefreshMiddleware := middleware.JWTRefresh(cache)
+	enrollmentMiddleware := middleware.JWTEnrollment(cache)
 
 	r.POST("/auth/refresh", authRefreshMiddleware, handler.RefreshToken)
 
 	// Auth
 	r.POST("/auth/register", handler.Register)
 	r.POST("/auth/login", handler.Login)
-	r.POST("/auth/logout", authMiddleware, handler.Logout)
+	r.POST("/auth/logout", enrollmentMiddleware, handler.Logout)
+	r.POST("/auth/logout/all", enrollmentMiddleware, handler.LogoutAll)
 	r.POST("/auth/google", handler.LoginWithGoogle)
+	r.POST("/auth/password/forgot", handler.ForgotPassword)
+	r.POST("/auth/password/reset", handler.ResetPassword)
+	r.POST("/auth/email/verify", handler.VerifyEmail)
+	r.POST("/auth/email/verify/resend", authMiddleware, handler.ResendVerification)
+	r.POST("/auth/2fa/verify", handler.VerifyTwoFactor)
 	// r.POST("/auth/google/callback", handler.Login)
 
 	// Profile Get
 	r.GET("/profile/me", authMiddleware, handler.GetMe)
+	r.GET("/profile/sessions", authMiddleware, handler.GetSessions)
+	r.DELETE("/profile/sessions/:id", authMiddleware, handler.RevokeSession)
+
+	// Two-factor authentication
+	r.POST("/profile/2fa/enroll", enrollmentMiddleware, handler.EnrollTwoFactor)
+	r.POST("/profile/2fa/confirm", enrollmentMiddleware, handler.ConfirmTwoFactor)
+	r.POST("/profile/2fa/recovery-codes", authMiddleware, handler.RegenerateRecoveryCodes)
+	r.DELETE("/profile/2fa", authMiddleware, handler.DisableTwoFactor)
 
 	// Profile Put
 	r.PUT("/profile/update", authMiddleware, handler.UpdateMe)
 	r.PUT("/profile/update/password", authMiddleware, handler.UpdatePassword)
 	r.PUT("/profile/update/picture", authMiddleware, handler.UpdatePicture)
 
-	// Admin Authority
+	// Staff Authority
 
 	// Users
-	r.GET("/users", adminAuthMiddleware, handler.GetUsers)
-	r.GET("/users/banned", adminAuthMiddleware, handler.GetBannedUsers)
-	r.GET("/user/:id", adminAuthMiddleware, handler.GetUserByID)
-	r.PUT("/user/:id/ban", adminAuthMiddleware, handler.BanUser)
-	r.PUT("/user/:id/unban", adminAuthMiddleware, handler.UnbanUser)
+	r.GET("/users", middleware.JWTPermission(cache, rbac.UserRead), handler.GetUsers)
+	r.GET("/users/banned", middleware.JWTPermission(cache, rbac.UserRead), handler.GetBannedUsers)
+	r.GET("/user/:id", middleware.JWTPermission(cache, rbac.UserRead), handler.GetUserByID)
+	r.PUT("/user/:id/ban", middleware.JWTPermission(cache, rbac.UserBan), handler.BanUser)
+	r.PUT("/user/:id/unban", middleware.JWTPermission(cache, rbac.UserBan), handler.UnbanUser)
+	r.PUT("/user/:id/unlock", middleware.JWTPermission(cache, rbac.UserUnlock), handler.UnlockUser)
+	r.GET("/user/:id/sessions", middleware.JWTPermission(cache, rbac.UserSessions), handler.GetUserSessions)
+	r.DELETE("/user/:id/sessions", middleware.JWTPermission(cache, rbac.UserSessions), handler.RevokeUserSessions)
+
+	// Roles
+	r.GET("/roles", middleware.JWTPermission(cache, rbac.UserAssignRole), handler.GetRoles)
+	r.PUT("/user/:id/role", middleware.JWTPermission(cache, rbac.UserAssignRole), handler.AssignRole)
 }
@@ -0,0 +1,209 @@
+package userService
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	userModel "washit-api/internal/user/dto/model"
+	"washit-api/pkg/configs"
+)
+
+const (
+	loginAccountFailuresKey   = "login:failures:account:%s"
+	loginIPFailuresKey        = "login:failures:ip:%s"
+	loginTwoFactorFailuresKey = "login:failures:2fa:%s"
+	loginLockKey              = "login:lock:%s"
+
+	// loginMaxFailures failed passwords within the window lock the account.
+	loginMaxFailures = 5
+	// loginMaxTwoFactorFailures failed second factors within the window lock
+	// the account, however many challenges they were spread over. The
+	// password succeeding in between does not reset them.
+	loginMaxTwoFactorFailures = 5
+	// loginMaxIPFailures failed logins from one address within the window
+	// block that address regardless of the accounts tried.
+	loginMaxIPFailures = 20
+	// loginFreeFailures are allowed back to back before delays kick in.
+	loginFreeFailures = 2
+	loginMaxDelay     = 30 * time.Second
+)
+
+// checkLoginAllowed rejects a login attempt while the account is locked, the
+// client address is blocked or the delay since the last failure has not
+// passed yet. An attempt that is let through is counted against the account
+// right away and atomically, before the password is compared, so concurrent
+// guesses cannot all pass before the first of them fails. A successful login
+// clears it with clearLoginFailures. Redis errors let the attempt through.
+func (s *UserService) checkLoginAllowed(email string, ip string) error {
+	now := time.Now()
+	account := hashToken(strings.ToLower(email))
+
+	if err := s.checkAccountLocked(email); err != nil {
+		return err
+	}
+
+	if ip != "" {
+		if blocked, retryAfter := s.ipFailures.Exceeded(fmt.Sprintf(loginIPFailuresKey, ip)); blocked {
+			log.Printf("Login attempt from blocked address: %s", ip)
+			return &ErrTooManyRequests{RetryAfter: retryAfter}
+		}
+	}
+
+	failuresKey := fmt.Sprintf(loginAccountFailuresKey, account)
+	failures := s.accountFailures.Attempts(failuresKey)
+	if len(failures) > 0 {
+		if wait := failures[len(failures)-1].Add(loginDelay(len(failures))).Sub(now); wait > 0 {
+			return &ErrTooManyRequests{RetryAfter: wait}
+		}
+	}
+
+	allowed, retryAfter, err := s.accountFailures.Allow(failuresKey)
+	if err != nil {
+		log.Printf("Failed to count login attempt for %s: %v", email, err)
+		return nil
+	}
+	if !allowed {
+		log.Printf("Too many concurrent login attempts for account: %s", email)
+		return &ErrTooManyRequests{RetryAfter: retryAfter}
+	}
+
+	return nil
+}
+
+// recordLoginFailure counts a failed login against the client address and
+// locks the account once its attempts, counted by checkLoginAllowed, reach
+// loginMaxFailures. Unknown emails are counted as well so lockouts do not
+// reveal which accounts exist.
+func (s *UserService) recordLoginFailure(email string, ip string) {
+	account := hashToken(strings.ToLower(email))
+
+	if ip != "" {
+		if _, err := s.ipFailures.Record(fmt.Sprintf(loginIPFailuresKey, ip)); err != nil {
+			log.Printf("Failed to record login failure for address %s: %v", ip, err)
+		}
+	}
+
+	failuresKey := fmt.Sprintf(loginAccountFailuresKey, account)
+	failures := s.accountFailures.Attempts(failuresKey)
+	if len(failures) < loginMaxFailures {
+		return
+	}
+
+	if s.lockAccount(email) {
+		_ = s.accountFailures.Reset(failuresKey)
+		log.Printf("Locked account %s after %d failed logins", email, len(failures))
+	}
+}
+
+// checkTwoFactorAllowed rejects a second factor for a locked account and
+// otherwise counts it against the account before the code is checked, the
+// same way checkLoginAllowed counts passwords.
+func (s *UserService) checkTwoFactorAllowed(email string) error {
+	if err := s.checkAccountLocked(email); err != nil {
+		return err
+	}
+
+	allowed, retryAfter, err := s.twoFactorFailures.Allow(fmt.Sprintf(loginTwoFactorFailuresKey, hashToken(strings.ToLower(email))))
+	if err != nil {
+		log.Printf("Failed to count two-factor attempt for %s: %v", email, err)
+		return nil
+	}
+	if !allowed {
+		log.Printf("Too many two-factor attempts for account: %s", email)
+		return &ErrTooManyRequests{RetryAfter: retryAfter}
+	}
+
+	return nil
+}
+
+// recordTwoFactorFailure locks the account once its second factors, counted
+// by checkTwoFactorAllowed, reach loginMaxTwoFactorFailures.
+func (s *UserService) recordTwoFactorFailure(email string) {
+	failuresKey := fmt.Sprintf(loginTwoFactorFailuresKey, hashToken(strings.ToLower(email)))
+	failures := s.twoFactorFailures.Attempts(failuresKey)
+	if len(failures) < loginMaxTwoFactorFailures {
+		return
+	}
+
+	if s.lockAccount(email) {
+		_ = s.twoFactorFailures.Reset(failuresKey)
+		log.Printf("Locked account %s after %d failed second factors", email, len(failures))
+	}
+}
+
+// clearTwoFactorFailures forgets the failed second factors of an account
+// after it completed a login.
+func (s *UserService) clearTwoFactorFailures(email string) {
+	_ = s.twoFactorFailures.Reset(fmt.Sprintf(loginTwoFactorFailuresKey, hashToken(strings.ToLower(email))))
+}
+
+// checkAccountLocked rejects an account that is locked out.
+func (s *UserService) checkAccountLocked(email string) error {
+	now := time.Now()
+
+	var lockedUntil time.Time
+	if err := s.cache.Get(fmt.Sprintf(loginLockKey, hashToken(strings.ToLower(email))), &lockedUntil); err == nil && lockedUntil.After(now) {
+		log.Printf("Login attempt for locked account: %s", email)
+		return &ErrTooManyRequests{RetryAfter: lockedUntil.Sub(now)}
+	}
+
+	return nil
+}
+
+// lockAccount refuses logins of the account for LoginLockoutDuration and
+// reports whether the lock was stored.
+func (s *UserService) lockAccount(email string) bool {
+	lockedUntil := time.Now().Add(configs.LoginLockoutDuration)
+	if err := s.cache.SetWithExpiration(fmt.Sprintf(loginLockKey, hashToken(strings.ToLower(email))), lockedUntil, configs.LoginLockoutDuration); err != nil {
+		log.Printf("Failed to lock account %s: %v", email, err)
+		return false
+	}
+
+	return true
+}
+
+// clearLoginFailures forgets the failed logins of an account, and the
+// attempt counted for this one, after it successfully logged in.
+func (s *UserService) clearLoginFailures(email string) {
+	_ = s.accountFailures.Reset(fmt.Sprintf(loginAccountFailuresKey, hashToken(strings.ToLower(email))))
+}
+
+// UnlockUser lifts a lockout and forgets the failed logins and second
+// factors of a user.
+func (s *UserService) UnlockUser(c context.Context, userID string) (*userModel.User, error) {
+	user, err := s.repository.GetUserByID(c, userID)
+	if err != nil {
+		log.Printf("User not found with ID: %s, error: %v", userID, err)
+		return nil, fmt.Errorf("unable to find user with ID: %s", userID)
+	}
+
+	account := hashToken(strings.ToLower(user.Email))
+	err = s.cache.Remove(
+		fmt.Sprintf(loginLockKey, account),
+		fmt.Sprintf(loginAccountFailuresKey, account),
+		fmt.Sprintf(loginTwoFactorFailuresKey, account))
+	if err != nil {
+		log.Printf("Failed to unlock user %s: %v", userID, err)
+		return nil, fmt.Errorf("failed to unlock user: %v", err)
+	}
+
+	return user, nil
+}
+
+// loginDelay is how long to wait after the last of failures before another
+// attempt, doubling from one second after the free failures.
+func loginDelay(failures int) time.Duration {
+	if failures <= loginFreeFailures {
+		return 0
+	}
+
+	delay := time.Second << (failures - loginFreeFailures - 1)
+	if delay > loginMaxDelay {
+		return loginMaxDelay
+	}
+
+	return delay
+}
@@ -0,0 +1,147 @@
+package userService
+
+import (
+	"context"
+	"errors"
+	"time"
+	userModel "washit-api/internal/user/dto/model"
+	userRequest "washit-api/internal/user/dto/request"
+
+	"github.com/stretchr/testify/mock"
+)
+
+func (suite *UserServiceTestSuite) expectNoLoginFailures() {
+	suite.mockCache.On("Get", hasPrefix("login:lock:"), mock.Anything).
+		Return(errors.New("redis: nil"))
+	suite.mockCache.On("WindowRange", hasPrefix("login:failures:"), mock.Anything).
+		Return([]time.Time{}, nil)
+	suite.expectLoginAttemptCounted()
+	suite.mockCache.On("Remove", hasPrefix("login:failures:account:")).
+		Return(nil)
+}
+
+// expectLoginAttemptCounted expects the attempt to be counted against the
+// account before the password is compared.
+func (suite *UserServiceTestSuite) expectLoginAttemptCounted(failures ...time.Time) {
+	suite.mockCache.On("WindowAdd", hasPrefix("login:failures:account:"), mock.Anything, loginMaxFailures, 15*time.Minute).
+		Return(append(failures, time.Now()), true, nil)
+}
+
+func (suite *UserServiceTestSuite) expectLoginFailureRecorded() {
+	suite.mockCache.On("WindowAdd", hasPrefix("login:failures:ip:"), mock.Anything, 0, 15*time.Minute).
+		Return([]time.Time{time.Now()}, true, nil)
+}
+
+func (suite *UserServiceTestSuite) expectLoginFailures(failures ...time.Time) {
+	suite.mockCache.On("Get", hasPrefix("login:lock:"), mock.Anything).
+		Return(errors.New("redis: nil"))
+	suite.mockCache.On("WindowRange", hasPrefix("login:failures:account:"), mock.Anything).
+		Return(failures, nil)
+}
+
+func (suite *UserServiceTestSuite) TestLoginLockedAccount() {
+	suite.mockCache.On("Get", "login:lock:"+hashToken("test@test.com"), mock.Anything).
+		Run(func(args mock.Arguments) { *args.Get(1).(*time.Time) = time.Now().Add(10 * time.Minute) }).
+		Return(nil).Times(1)
+
+	_, _, _, err := suite.service.Login(context.Background(), &userRequest.Login{
+		Email: "Test@test.com", Password: "test123456",
+	})
+
+	var tooMany *ErrTooManyRequests
+	suite.ErrorAs(err, &tooMany)
+	suite.InDelta(10*time.Minute, tooMany.RetryAfter, float64(time.Second))
+	suite.mockRepo.AssertNotCalled(suite.T(), "GetUserByEmail", mock.Anything, mock.Anything)
+}
+
+func (suite *UserServiceTestSuite) TestLoginBlockedAddress() {
+	failures := make([]time.Time, loginMaxIPFailures)
+	for i := range failures {
+		failures[i] = time.Now().Add(-time.Minute)
+	}
+	suite.mockCache.On("Get", hasPrefix("login:lock:"), mock.Anything).
+		Return(errors.New("redis: nil")).Times(1)
+	suite.mockCache.On("WindowRange", "login:failures:ip:10.0.0.1", mock.Anything).
+		Return(failures, nil).Times(1)
+
+	_, _, _, err := suite.service.Login(context.Background(), &userRequest.Login{
+		Email: "test@test.com", Password: "test123456", Device: userRequest.Device{IPAddress: "10.0.0.1"},
+	})
+
+	var tooMany *ErrTooManyRequests
+	suite.ErrorAs(err, &tooMany)
+	suite.InDelta(14*time.Minute, tooMany.RetryAfter, float64(time.Second))
+}
+
+func (suite *UserServiceTestSuite) TestLoginProgressiveDelay() {
+	now := time.Now()
+	suite.expectLoginFailures(now.Add(-time.Minute), now.Add(-time.Minute), now)
+
+	_, _, _, err := suite.service.Login(context.Background(), &userRequest.Login{
+		Email: "test@test.com", Password: "test123456",
+	})
+
+	var tooMany *ErrTooManyRequests
+	suite.ErrorAs(err, &tooMany)
+	suite.LessOrEqual(tooMany.RetryAfter, time.Second)
+	suite.mockRepo.AssertNotCalled(suite.T(), "GetUserByEmail", mock.Anything, mock.Anything)
+}
+
+func (suite *UserServiceTestSuite) TestLoginConcurrentAttempts() {
+	old := time.Now().Add(-time.Minute)
+	suite.expectLoginFailures(old, old, old, old)
+	suite.mockCache.On("WindowAdd", "login:failures:account:"+hashToken("test@test.com"), mock.Anything, loginMaxFailures, 15*time.Minute).
+		Return([]time.Time{old, old, old, old, time.Now()}, false, nil).Times(1)
+
+	_, _, _, err := suite.service.Login(context.Background(), &userRequest.Login{
+		Email: "test@test.com", Password: "test123456",
+	})
+
+	var tooMany *ErrTooManyRequests
+	suite.ErrorAs(err, &tooMany)
+	suite.mockRepo.AssertNotCalled(suite.T(), "GetUserByEmail", mock.Anything, mock.Anything)
+}
+
+func (suite *UserServiceTestSuite) TestLoginLocksAccountAfterMaxFailures() {
+	old := time.Now().Add(-time.Minute)
+	suite.mockCache.On("Get", hasPrefix("login:lock:"), mock.Anything).
+		Return(errors.New("redis: nil")).Times(1)
+	suite.mockCache.On("WindowRange", "login:failures:account:"+hashToken("test@test.com"), mock.Anything).
+		Return([]time.Time{old, old, old, old}, nil).Once()
+	suite.expectLoginAttemptCounted(old, old, old, old)
+	suite.mockCache.On("WindowRange", "login:failures:account:"+hashToken("test@test.com"), mock.Anything).
+		Return([]time.Time{old, old, old, old, time.Now()}, nil).Once()
+	suite.mockCache.On("SetWithExpiration", "login:lock:"+hashToken("test@test.com"), mock.Anything, 15*time.Minute).
+		Return(nil).Times(1)
+	suite.mockCache.On("Remove", "login:failures:account:"+hashToken("test@test.com")).
+		Return(nil).Times(1)
+	suite.mockRepo.On("GetUserByEmail", mock.Anything, "test@test.com").
+		Return(nil, errors.New("record not found")).Times(1)
+
+	_, _, _, err := suite.service.Login(context.Background(), &userRequest.Login{
+		Email: "test@test.com", Password: "test123456",
+	})
+	suite.NotNil(err)
+	suite.mockCache.AssertExpectations(suite.T())
+}
+
+func (suite *UserServiceTestSuite) TestUnlockUser() {
+	suite.mockRepo.On("GetUserByID", mock.Anything, "1").
+		Return(&userModel.User{ID: 1, Email: "test@test.com"}, nil).Times(1)
+	suite.mockCache.On("Remove",
+		"login:lock:"+hashToken("test@test.com"),
+		"login:failures:account:"+hashToken("test@test.com"),
+		"login:failures:2fa:"+hashToken("test@test.com")).
+		Return(nil).Times(1)
+
+	user, err := suite.service.UnlockUser(context.Background(), "1")
+	suite.Nil(err)
+	suite.Equal(int64(1), user.ID)
+}
+
+func (suite *UserServiceTestSuite) TestLoginDelay() {
+	suite.Equal(time.Duration(0), loginDelay(2))
+	suite.Equal(time.Second, loginDelay(3))
+	suite.Equal(2*time.Second, loginDelay(4))
+	suite.Equal(loginMaxDelay, loginDelay(20))
+}
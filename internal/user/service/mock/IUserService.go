@@ -7,6 +7,8 @@ import (
 
 	auth "firebase.google.com/go/auth"
 
+	jwt "washit-api/pkg/token"
+
 	mock "github.com/stretchr/testify/mock"
 
 	userModel "washit-api/internal/user/dto/model"
@@ -19,6 +21,66 @@ type IUserService struct {
 	mock.Mock
 }
 
+// AssignOutlet provides a mock function with given fields: c, userID, outletID
+func (_m *IUserService) AssignOutlet(c context.Context, userID string, outletID *int64) (*userModel.User, error) {
+	ret := _m.Called(c, userID, outletID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AssignOutlet")
+	}
+
+	var r0 *userModel.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *int64) (*userModel.User, error)); ok {
+		return rf(c, userID, outletID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *int64) *userModel.User); ok {
+		r0 = rf(c, userID, outletID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*userModel.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *int64) error); ok {
+		r1 = rf(c, userID, outletID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AssignRole provides a mock function with given fields: c, actorID, userID, req
+func (_m *IUserService) AssignRole(c context.Context, actorID string, userID string, req *userRequest.AssignRole) (*userModel.User, error) {
+	ret := _m.Called(c, actorID, userID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AssignRole")
+	}
+
+	var r0 *userModel.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *userRequest.AssignRole) (*userModel.User, error)); ok {
+		return rf(c, actorID, userID, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *userRequest.AssignRole) *userModel.User); ok {
+		r0 = rf(c, actorID, userID, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*userModel.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, *userRequest.AssignRole) error); ok {
+		r1 = rf(c, actorID, userID, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // BanUser provides a mock function with given fields: c, userID
 func (_m *IUserService) BanUser(c context.Context, userID string) (*userModel.User, error) {
 	ret := _m.Called(c, userID)
@@ -49,6 +111,125 @@ func (_m *IUserService) BanUser(c context.Context, userID string) (*userModel.Us
 	return r0, r1
 }
 
+// ConfirmTwoFactor provides a mock function with given fields: c, userID, req
+func (_m *IUserService) ConfirmTwoFactor(c context.Context, userID string, req *userRequest.TwoFactorCode) ([]string, error) {
+	ret := _m.Called(c, userID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConfirmTwoFactor")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *userRequest.TwoFactorCode) ([]string, error)); ok {
+		return rf(c, userID, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *userRequest.TwoFactorCode) []string); ok {
+		r0 = rf(c, userID, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *userRequest.TwoFactorCode) error); ok {
+		r1 = rf(c, userID, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DisableTwoFactor provides a mock function with given fields: c, userID, req
+func (_m *IUserService) DisableTwoFactor(c context.Context, userID string, req *userRequest.DisableTwoFactor) error {
+	ret := _m.Called(c, userID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DisableTwoFactor")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *userRequest.DisableTwoFactor) error); ok {
+		r0 = rf(c, userID, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// EnrollTwoFactor provides a mock function with given fields: c, userID
+func (_m *IUserService) EnrollTwoFactor(c context.Context, userID string) (string, string, error) {
+	ret := _m.Called(c, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EnrollTwoFactor")
+	}
+
+	var r0 string
+	var r1 string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (string, string, error)); ok {
+		return rf(c, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) string); ok {
+		r0 = rf(c, userID)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) string); ok {
+		r1 = rf(c, userID)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string) error); ok {
+		r2 = rf(c, userID)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// EnsureEmailVerified provides a mock function with given fields: c, userID
+func (_m *IUserService) EnsureEmailVerified(c context.Context, userID string) error {
+	ret := _m.Called(c, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EnsureEmailVerified")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(c, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ForgotPassword provides a mock function with given fields: c, req
+func (_m *IUserService) ForgotPassword(c context.Context, req *userRequest.ForgotPassword) error {
+	ret := _m.Called(c, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ForgotPassword")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *userRequest.ForgotPassword) error); ok {
+		r0 = rf(c, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // GetBannedUsers provides a mock function with given fields: c
 func (_m *IUserService) GetBannedUsers(c context.Context) ([]*userModel.User, error) {
 	ret := _m.Called(c)
@@ -109,6 +290,36 @@ func (_m *IUserService) GetMe(c context.Context, userID string) (*userModel.User
 	return r0, r1
 }
 
+// GetSessions provides a mock function with given fields: c, userID
+func (_m *IUserService) GetSessions(c context.Context, userID string) ([]*userModel.Session, error) {
+	ret := _m.Called(c, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSessions")
+	}
+
+	var r0 []*userModel.Session
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*userModel.Session, error)); ok {
+		return rf(c, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*userModel.Session); ok {
+		r0 = rf(c, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*userModel.Session)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(c, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetUserByID provides a mock function with given fields: c, userID
 func (_m *IUserService) GetUserByID(c context.Context, userID string) (*userModel.User, error) {
 	ret := _m.Called(c, userID)
@@ -169,6 +380,36 @@ func (_m *IUserService) GetUsers(c context.Context) ([]*userModel.User, error) {
 	return r0, r1
 }
 
+// GetUsersByOutlet provides a mock function with given fields: c, outletID
+func (_m *IUserService) GetUsersByOutlet(c context.Context, outletID string) ([]*userModel.User, error) {
+	ret := _m.Called(c, outletID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUsersByOutlet")
+	}
+
+	var r0 []*userModel.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*userModel.User, error)); ok {
+		return rf(c, outletID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*userModel.User); ok {
+		r0 = rf(c, outletID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*userModel.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(c, outletID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Login provides a mock function with given fields: c, req
 func (_m *IUserService) Login(c context.Context, req *userRequest.Login) (*userModel.User, string, string, error) {
 	ret := _m.Called(c, req)
@@ -257,14 +498,32 @@ func (_m *IUserService) LoginWithGoogle(c context.Context, req *userRequest.Goog
 	return r0, r1, r2, r3
 }
 
-// Logout provides a mock function with given fields: c, userID
-func (_m *IUserService) Logout(c context.Context, userID string) error {
-	ret := _m.Called(c, userID)
+// Logout provides a mock function with given fields: c, claims, req
+func (_m *IUserService) Logout(c context.Context, claims *jwt.Claims, req *userRequest.Logout) error {
+	ret := _m.Called(c, claims, req)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Logout")
 	}
 
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *jwt.Claims, *userRequest.Logout) error); ok {
+		r0 = rf(c, claims, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// LogoutAll provides a mock function with given fields: c, userID
+func (_m *IUserService) LogoutAll(c context.Context, userID string) error {
+	ret := _m.Called(c, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LogoutAll")
+	}
+
 	var r0 error
 	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
 		r0 = rf(c, userID)
@@ -275,27 +534,64 @@ func (_m *IUserService) Logout(c context.Context, userID string) error {
 	return r0
 }
 
-// RefreshToken provides a mock function with given fields: c, userID
-func (_m *IUserService) RefreshToken(c context.Context, userID string) (string, error) {
-	ret := _m.Called(c, userID)
+// RefreshToken provides a mock function with given fields: c, claims, device
+func (_m *IUserService) RefreshToken(c context.Context, claims *jwt.Claims, device *userRequest.Device) (string, string, error) {
+	ret := _m.Called(c, claims, device)
 
 	if len(ret) == 0 {
 		panic("no return value specified for RefreshToken")
 	}
 
 	var r0 string
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (string, error)); ok {
-		return rf(c, userID)
+	var r1 string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *jwt.Claims, *userRequest.Device) (string, string, error)); ok {
+		return rf(c, claims, device)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) string); ok {
-		r0 = rf(c, userID)
+	if rf, ok := ret.Get(0).(func(context.Context, *jwt.Claims, *userRequest.Device) string); ok {
+		r0 = rf(c, claims, device)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(c, userID)
+	if rf, ok := ret.Get(1).(func(context.Context, *jwt.Claims, *userRequest.Device) string); ok {
+		r1 = rf(c, claims, device)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, *jwt.Claims, *userRequest.Device) error); ok {
+		r2 = rf(c, claims, device)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// RegenerateRecoveryCodes provides a mock function with given fields: c, userID, req
+func (_m *IUserService) RegenerateRecoveryCodes(c context.Context, userID string, req *userRequest.TwoFactorCode) ([]string, error) {
+	ret := _m.Called(c, userID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RegenerateRecoveryCodes")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *userRequest.TwoFactorCode) ([]string, error)); ok {
+		return rf(c, userID, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *userRequest.TwoFactorCode) []string); ok {
+		r0 = rf(c, userID, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *userRequest.TwoFactorCode) error); ok {
+		r1 = rf(c, userID, req)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -333,6 +629,60 @@ func (_m *IUserService) Register(c context.Context, req *userRequest.Register) (
 	return r0, r1
 }
 
+// ResendVerification provides a mock function with given fields: c, userID
+func (_m *IUserService) ResendVerification(c context.Context, userID string) error {
+	ret := _m.Called(c, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResendVerification")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(c, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ResetPassword provides a mock function with given fields: c, req
+func (_m *IUserService) ResetPassword(c context.Context, req *userRequest.ResetPassword) error {
+	ret := _m.Called(c, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResetPassword")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *userRequest.ResetPassword) error); ok {
+		r0 = rf(c, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RevokeSession provides a mock function with given fields: c, userID, sessionID
+func (_m *IUserService) RevokeSession(c context.Context, userID string, sessionID string) error {
+	ret := _m.Called(c, userID, sessionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeSession")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(c, userID, sessionID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UnbanUser provides a mock function with given fields: c, userID
 func (_m *IUserService) UnbanUser(c context.Context, userID string) (*userModel.User, error) {
 	ret := _m.Called(c, userID)
@@ -363,6 +713,36 @@ func (_m *IUserService) UnbanUser(c context.Context, userID string) (*userModel.
 	return r0, r1
 }
 
+// UnlockUser provides a mock function with given fields: c, userID
+func (_m *IUserService) UnlockUser(c context.Context, userID string) (*userModel.User, error) {
+	ret := _m.Called(c, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnlockUser")
+	}
+
+	var r0 *userModel.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*userModel.User, error)); ok {
+		return rf(c, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *userModel.User); ok {
+		r0 = rf(c, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*userModel.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(c, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdatePassword provides a mock function with given fields: c, userID, req
 func (_m *IUserService) UpdatePassword(c context.Context, userID string, req *userRequest.UpdatePassword) error {
 	ret := _m.Called(c, userID, req)
@@ -441,6 +821,80 @@ func (_m *IUserService) UpdateProfile(c context.Context, userID string, req *use
 	return r0, r1
 }
 
+// VerifyEmail provides a mock function with given fields: c, req
+func (_m *IUserService) VerifyEmail(c context.Context, req *userRequest.VerifyEmail) (*userModel.User, error) {
+	ret := _m.Called(c, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VerifyEmail")
+	}
+
+	var r0 *userModel.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *userRequest.VerifyEmail) (*userModel.User, error)); ok {
+		return rf(c, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *userRequest.VerifyEmail) *userModel.User); ok {
+		r0 = rf(c, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*userModel.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *userRequest.VerifyEmail) error); ok {
+		r1 = rf(c, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// VerifyTwoFactorLogin provides a mock function with given fields: c, req
+func (_m *IUserService) VerifyTwoFactorLogin(c context.Context, req *userRequest.TwoFactorLogin) (*userModel.User, string, string, error) {
+	ret := _m.Called(c, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VerifyTwoFactorLogin")
+	}
+
+	var r0 *userModel.User
+	var r1 string
+	var r2 string
+	var r3 error
+	if rf, ok := ret.Get(0).(func(context.Context, *userRequest.TwoFactorLogin) (*userModel.User, string, string, error)); ok {
+		return rf(c, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *userRequest.TwoFactorLogin) *userModel.User); ok {
+		r0 = rf(c, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*userModel.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *userRequest.TwoFactorLogin) string); ok {
+		r1 = rf(c, req)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, *userRequest.TwoFactorLogin) string); ok {
+		r2 = rf(c, req)
+	} else {
+		r2 = ret.Get(2).(string)
+	}
+
+	if rf, ok := ret.Get(3).(func(context.Context, *userRequest.TwoFactorLogin) error); ok {
+		r3 = rf(c, req)
+	} else {
+		r3 = ret.Error(3)
+	}
+
+	return r0, r1, r2, r3
+}
+
 // NewIUserService creates a new instance of IUserService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewIUserService(t interface {
@@ -0,0 +1,140 @@
+package userService
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	userRequest "washit-api/internal/user/dto/request"
+	auths "washit-api/pkg/auth"
+	"washit-api/pkg/configs"
+	generate "washit-api/pkg/generator"
+	"washit-api/pkg/mailer"
+)
+
+const (
+	passwordResetTokenKey = "password-reset:token:%s"
+	passwordResetUserKey  = "password-reset:user:%d"
+	passwordResetRateKey  = "password-reset:rate:%s"
+)
+
+var ErrInvalidResetToken = errors.New("invalid or expired reset token")
+
+// ErrTooManyRequests carries how long the caller has to wait.
+type ErrTooManyRequests struct {
+	RetryAfter time.Duration
+}
+
+func (e *ErrTooManyRequests) Error() string {
+	return fmt.Sprintf("too many requests, retry in %s", e.RetryAfter.Round(time.Second))
+}
+
+// ForgotPassword emails a single-use reset link. Unknown emails are not
+// reported so the endpoint cannot be used to find registered users.
+func (s *UserService) ForgotPassword(c context.Context, req *userRequest.ForgotPassword) error {
+	if err := s.validator.Struct(req); err != nil {
+		log.Printf("Validation error for forgot password request: %v", err)
+		return fmt.Errorf("validation error: %v", err)
+	}
+
+	email := strings.ToLower(req.Email)
+	allowed, retryAfter, err := s.resetLimiter.Allow(fmt.Sprintf(passwordResetRateKey, hashToken(email)))
+	if err != nil {
+		log.Printf("Failed to check password reset rate limit: %v", err)
+		return fmt.Errorf("failed to request password reset: %v", err)
+	}
+	if !allowed {
+		return &ErrTooManyRequests{RetryAfter: retryAfter}
+	}
+
+	user, err := s.repository.GetUserByEmail(c, req.Email)
+	if err != nil {
+		log.Printf("Password reset requested for unknown email: %s", req.Email)
+		return nil
+	}
+
+	token, err := generate.RandomToken(32)
+	if err != nil {
+		log.Printf("Failed to generate reset token: %v", err)
+		return fmt.Errorf("failed to generate reset token: %v", err)
+	}
+
+	// Only the latest link stays valid.
+	userKey := fmt.Sprintf(passwordResetUserKey, user.ID)
+	var previous string
+	if err := s.cache.Get(userKey, &previous); err == nil {
+		_ = s.cache.Remove(fmt.Sprintf(passwordResetTokenKey, previous))
+	}
+
+	hashed := hashToken(token)
+	if err := s.cache.SetWithExpiration(fmt.Sprintf(passwordResetTokenKey, hashed), user.ID, configs.PasswordResetTTL); err != nil {
+		log.Printf("Failed to store reset token: %v", err)
+		return fmt.Errorf("failed to store reset token: %v", err)
+	}
+	_ = s.cache.SetWithExpiration(userKey, hashed, configs.PasswordResetTTL)
+
+	message := mailer.Message{
+		To:      user.Email,
+		Subject: "Reset your WashIt password",
+		Body: fmt.Sprintf("Hi %s,\n\nUse the link below to choose a new password. It expires in %d minutes.\n\n%s?token=%s\n\nIf you did not request this, you can ignore this email.",
+			user.FirstName, int(configs.PasswordResetTTL.Minutes()), configs.Envs.PasswordResetURL, token),
+	}
+	if err := s.mailer.Send(c, message); err != nil {
+		log.Printf("Failed to send password reset email: %v", err)
+		return fmt.Errorf("failed to send password reset email: %v", err)
+	}
+
+	return nil
+}
+
+// ResetPassword consumes a reset token and sets the new password. All
+// sessions are revoked since the old password may have been compromised.
+func (s *UserService) ResetPassword(c context.Context, req *userRequest.ResetPassword) error {
+	if err := s.validator.Struct(req); err != nil {
+		log.Printf("Validation error for reset password request: %v", err)
+		return fmt.Errorf("validation error: %v", err)
+	}
+
+	var userID int64
+	if err := s.cache.GetDel(fmt.Sprintf(passwordResetTokenKey, hashToken(req.Token)), &userID); err != nil {
+		log.Printf("Failed to consume reset token: %v", err)
+		return ErrInvalidResetToken
+	}
+	_ = s.cache.Remove(fmt.Sprintf(passwordResetUserKey, userID))
+
+	user, err := s.repository.GetUserByID(c, strconv.FormatInt(userID, 10))
+	if err != nil {
+		log.Printf("Failed to get user by id: %v", err)
+		return fmt.Errorf("user not found: %v", userID)
+	}
+
+	hashedPassword, err := auths.HashPassword(req.NewPassword)
+	if err != nil {
+		log.Printf("Failed to hash new password: %v", err)
+		return fmt.Errorf("failed to hash new password: %v", err)
+	}
+
+	user.Password = hashedPassword
+
+	if err := s.repository.UpdateUser(c, user); err != nil {
+		log.Printf("Failed to update user password: %v", err)
+		return fmt.Errorf("failed to update password: %v", err)
+	}
+
+	if err := s.LogoutAll(c, strconv.FormatInt(userID, 10)); err != nil {
+		log.Printf("Failed to revoke sessions after password reset: %v", err)
+	}
+
+	return nil
+}
+
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,106 @@
+package userService
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+	userModel "washit-api/internal/user/dto/model"
+	userRequest "washit-api/internal/user/dto/request"
+	"washit-api/pkg/mailer"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// ForgotPassword
+// =================================================================
+
+func (suite *UserServiceTestSuite) TestForgotPasswordSendsHashedToken() {
+	rateKey := "password-reset:rate:" + hashToken("test@test.com")
+	suite.mockCache.On("WindowAdd", rateKey, mock.Anything, 3, time.Hour).
+		Return([]time.Time{time.Now()}, true, nil).Times(1)
+	suite.mockRepo.On("GetUserByEmail", mock.Anything, "test@test.com").
+		Return(&userModel.User{ID: 1, Email: "test@test.com"}, nil).Times(1)
+	suite.mockCache.On("Get", "password-reset:user:1", mock.Anything).
+		Return(errors.New("redis: nil")).Times(1)
+
+	var storedKey string
+	suite.mockCache.On("SetWithExpiration", mock.MatchedBy(func(key string) bool {
+		return strings.HasPrefix(key, "password-reset:token:")
+	}), int64(1), mock.Anything).
+		Run(func(args mock.Arguments) { storedKey = args.String(0) }).
+		Return(nil).Times(1)
+	suite.mockCache.On("SetWithExpiration", "password-reset:user:1", mock.Anything, mock.Anything).
+		Return(nil).Times(1)
+
+	var sent mailer.Message
+	suite.mockMailer.On("Send", mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) { sent = args.Get(1).(mailer.Message) }).
+		Return(nil).Times(1)
+
+	err := suite.service.ForgotPassword(context.Background(), &userRequest.ForgotPassword{Email: "test@test.com"})
+	suite.Nil(err)
+
+	token := sent.Body[strings.Index(sent.Body, "token=")+len("token="):]
+	token = strings.Fields(token)[0]
+	suite.Equal("password-reset:token:"+hashToken(token), storedKey)
+	suite.NotContains(storedKey, token)
+}
+
+func (suite *UserServiceTestSuite) TestForgotPasswordUnknownEmailIsSilent() {
+	suite.mockCache.On("WindowAdd", mock.Anything, mock.Anything, 3, time.Hour).
+		Return([]time.Time{time.Now()}, true, nil).Times(1)
+	suite.mockRepo.On("GetUserByEmail", mock.Anything, "nobody@test.com").
+		Return(nil, errors.New("record not found")).Times(1)
+
+	err := suite.service.ForgotPassword(context.Background(), &userRequest.ForgotPassword{Email: "nobody@test.com"})
+	suite.Nil(err)
+	suite.mockMailer.AssertNotCalled(suite.T(), "Send", mock.Anything, mock.Anything)
+}
+
+func (suite *UserServiceTestSuite) TestForgotPasswordRateLimited() {
+	now := time.Now()
+	suite.mockCache.On("WindowAdd", mock.Anything, mock.Anything, 3, time.Hour).
+		Return([]time.Time{now.Add(-30 * time.Minute), now.Add(-time.Minute), now}, false, nil).Times(1)
+
+	err := suite.service.ForgotPassword(context.Background(), &userRequest.ForgotPassword{Email: "test@test.com"})
+	var tooMany *ErrTooManyRequests
+	suite.ErrorAs(err, &tooMany)
+	suite.InDelta(30*time.Minute, tooMany.RetryAfter, float64(time.Second))
+	suite.mockRepo.AssertNotCalled(suite.T(), "GetUserByEmail", mock.Anything, mock.Anything)
+}
+
+// ResetPassword
+// =================================================================
+
+func (suite *UserServiceTestSuite) TestResetPasswordInvalidToken() {
+	suite.mockCache.On("GetDel", "password-reset:token:"+hashToken("bad"), mock.Anything).
+		Return(errors.New("redis: nil")).Times(1)
+
+	err := suite.service.ResetPassword(context.Background(), &userRequest.ResetPassword{
+		Token: "bad", NewPassword: "secret123", ConfirmPassword: "secret123",
+	})
+	suite.ErrorIs(err, ErrInvalidResetToken)
+}
+
+func (suite *UserServiceTestSuite) TestResetPasswordSuccess() {
+	suite.mockCache.On("GetDel", "password-reset:token:"+hashToken("good"), mock.Anything).
+		Run(func(args mock.Arguments) { *args.Get(1).(*int64) = 1 }).
+		Return(nil).Times(1)
+	suite.mockCache.On("Remove", "password-reset:user:1").
+		Return(nil).Times(1)
+	suite.mockRepo.On("GetUserByID", mock.Anything, "1").
+		Return(&userModel.User{ID: 1}, nil)
+	suite.mockRepo.On("UpdateUser", mock.Anything, mock.Anything).
+		Return(nil).Times(2)
+	suite.mockCache.On("Set", "token:version:1", int64(1)).
+		Return(nil).Times(1)
+	suite.mockRepo.On("RevokeRefreshTokensByUser", mock.Anything, "1").
+		Return(nil).Times(1)
+
+	err := suite.service.ResetPassword(context.Background(), &userRequest.ResetPassword{
+		Token: "good", NewPassword: "secret123", ConfirmPassword: "secret123",
+	})
+	suite.Nil(err)
+	suite.mockRepo.AssertExpectations(suite.T())
+}
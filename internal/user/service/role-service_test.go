@@ -0,0 +1,74 @@
+package userService
+
+import (
+	"context"
+	userModel "washit-api/internal/user/dto/model"
+	userRequest "washit-api/internal/user/dto/request"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// AssignRole
+// =================================================================
+
+func (suite *UserServiceTestSuite) TestAssignRoleLogsOutUser() {
+	suite.mockRepo.On("GetUserByID", mock.Anything, "2").
+		Return(&userModel.User{ID: 2, Role: "customer", TokenVersion: 1}, nil)
+	suite.mockRepo.On("UpdateUser", mock.Anything, mock.MatchedBy(func(user *userModel.User) bool {
+		return user.Role == "outlet_staff"
+	})).Return(nil)
+	suite.mockCache.On("Set", "token:version:2", mock.Anything).
+		Return(nil).Times(1)
+	suite.mockRepo.On("RevokeRefreshTokensByUser", mock.Anything, "2").
+		Return(nil).Times(1)
+
+	user, err := suite.service.AssignRole(context.Background(), "1", "2", &userRequest.AssignRole{Role: "outlet_staff"})
+	suite.Nil(err)
+	suite.Equal("outlet_staff", user.Role)
+	suite.mockRepo.AssertExpectations(suite.T())
+}
+
+func (suite *UserServiceTestSuite) TestAssignRoleUnknownRole() {
+	user, err := suite.service.AssignRole(context.Background(), "1", "2", &userRequest.AssignRole{Role: "janitor"})
+	suite.Nil(user)
+	suite.ErrorIs(err, ErrUnknownRole)
+	suite.mockRepo.AssertNotCalled(suite.T(), "UpdateUser", mock.Anything, mock.Anything)
+}
+
+func (suite *UserServiceTestSuite) TestAssignRoleOwnRole() {
+	user, err := suite.service.AssignRole(context.Background(), "1", "1", &userRequest.AssignRole{Role: "customer"})
+	suite.Nil(user)
+	suite.ErrorIs(err, ErrChangeOwnRole)
+}
+
+// AssignOutlet
+// =================================================================
+
+func (suite *UserServiceTestSuite) TestAssignOutletLogsOutUser() {
+	outletID := int64(4)
+	suite.mockRepo.On("GetUserByID", mock.Anything, "2").
+		Return(&userModel.User{ID: 2, Role: "outlet_staff", TokenVersion: 1}, nil)
+	suite.mockRepo.On("UpdateUser", mock.Anything, mock.MatchedBy(func(user *userModel.User) bool {
+		return user.OutletID != nil && *user.OutletID == outletID
+	})).Return(nil)
+	suite.mockCache.On("Set", "token:version:2", mock.Anything).
+		Return(nil).Times(1)
+	suite.mockRepo.On("RevokeRefreshTokensByUser", mock.Anything, "2").
+		Return(nil).Times(1)
+
+	user, err := suite.service.AssignOutlet(context.Background(), "2", &outletID)
+	suite.Nil(err)
+	suite.Equal(outletID, *user.OutletID)
+	suite.mockRepo.AssertExpectations(suite.T())
+}
+
+func (suite *UserServiceTestSuite) TestAssignOutletCustomer() {
+	outletID := int64(4)
+	suite.mockRepo.On("GetUserByID", mock.Anything, "2").
+		Return(&userModel.User{ID: 2, Role: "customer"}, nil)
+
+	user, err := suite.service.AssignOutlet(context.Background(), "2", &outletID)
+	suite.Nil(user)
+	suite.ErrorIs(err, ErrNotStaff)
+	suite.mockRepo.AssertNotCalled(suite.T(), "UpdateUser", mock.Anything, mock.Anything)
+}
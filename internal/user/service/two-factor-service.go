@@ -0,0 +1,350 @@
+package userService
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	userModel "washit-api/internal/user/dto/model"
+	userRequest "washit-api/internal/user/dto/request"
+	userRepository "washit-api/internal/user/repository"
+	auths "washit-api/pkg/auth"
+	"washit-api/pkg/configs"
+	generate "washit-api/pkg/generator"
+	"washit-api/pkg/ratelimit"
+	"washit-api/pkg/rbac"
+	"washit-api/pkg/totp"
+)
+
+const (
+	twoFactorIssuer       = "WashIt"
+	twoFactorEnrollKey    = "2fa:enroll:%s"
+	twoFactorChallengeKey = "2fa:challenge:%s"
+	twoFactorAttemptKey   = "2fa:attempts:%s"
+	twoFactorUsedKey      = "2fa:used:%d:%d"
+	twoFactorEnrollTTL    = 10 * time.Minute
+	twoFactorMaxAttempts  = 5
+	recoveryCodeCount     = 10
+)
+
+var (
+	ErrInvalidTwoFactorCode = errors.New("invalid two-factor code")
+	ErrInvalidChallenge     = errors.New("invalid or expired two-factor challenge")
+)
+
+// ErrTwoFactorRequired is returned by the login methods when the password was
+// correct but the account has 2FA enabled. The challenge token has to be
+// sent back to VerifyTwoFactorLogin together with a code.
+type ErrTwoFactorRequired struct {
+	ChallengeToken string
+	ExpiresAt      time.Time
+}
+
+func (e *ErrTwoFactorRequired) Error() string {
+	return "two-factor authentication required"
+}
+
+// twoFactorChallenge keeps what the first login step knew about the client
+// so the session can be created once the second step succeeds.
+type twoFactorChallenge struct {
+	UserID     int64  `json:"userID"`
+	FcmToken   string `json:"fcmToken"`
+	DeviceName string `json:"deviceName"`
+	Platform   string `json:"platform"`
+	UserAgent  string `json:"userAgent"`
+	IPAddress  string `json:"ipAddress"`
+}
+
+// EnrollTwoFactor starts enrollment with a new secret. It only becomes active
+// once ConfirmTwoFactor receives a valid code for it.
+func (s *UserService) EnrollTwoFactor(c context.Context, userID string) (string, string, error) {
+	user, err := s.repository.GetUserByID(c, userID)
+	if err != nil {
+		log.Printf("Failed to get user by id: %v", err)
+		return "", "", fmt.Errorf("user not found: %v", userID)
+	}
+
+	if user.TwoFactorEnabledAt != nil {
+		return "", "", fmt.Errorf("two-factor authentication is already enabled")
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		log.Printf("Failed to generate TOTP secret: %v", err)
+		return "", "", fmt.Errorf("failed to generate secret: %v", err)
+	}
+
+	if err := s.cache.SetWithExpiration(fmt.Sprintf(twoFactorEnrollKey, userID), secret, twoFactorEnrollTTL); err != nil {
+		log.Printf("Failed to store pending TOTP secret: %v", err)
+		return "", "", fmt.Errorf("failed to store secret: %v", err)
+	}
+
+	return secret, totp.URI(twoFactorIssuer, user.Email, secret), nil
+}
+
+// ConfirmTwoFactor enables 2FA and returns the recovery codes, which are
+// only ever shown this once.
+func (s *UserService) ConfirmTwoFactor(c context.Context, userID string, req *userRequest.TwoFactorCode) ([]string, error) {
+	if err := s.validator.Struct(req); err != nil {
+		log.Printf("Validation error for two-factor code: %v", err)
+		return nil, fmt.Errorf("validation error: %v", err)
+	}
+
+	var secret string
+	if err := s.cache.Get(fmt.Sprintf(twoFactorEnrollKey, userID), &secret); err != nil {
+		log.Printf("Failed to get pending TOTP secret: %v", err)
+		return nil, fmt.Errorf("two-factor enrollment not started or expired")
+	}
+
+	user, err := s.repository.GetUserByID(c, userID)
+	if err != nil {
+		log.Printf("Failed to get user by id: %v", err)
+		return nil, fmt.Errorf("user not found: %v", userID)
+	}
+
+	if !s.checkTOTP(user.ID, secret, req.Code) {
+		return nil, ErrInvalidTwoFactorCode
+	}
+
+	now := time.Now()
+	user.TwoFactorSecret = secret
+	user.TwoFactorEnabledAt = &now
+
+	if err := s.repository.UpdateUser(c, user); err != nil {
+		log.Printf("Failed to enable two-factor authentication: %v", err)
+		return nil, fmt.Errorf("failed to enable two-factor authentication: %v", err)
+	}
+	_ = s.cache.Remove(fmt.Sprintf(twoFactorEnrollKey, userID))
+
+	return s.replaceRecoveryCodes(c, user)
+}
+
+func (s *UserService) RegenerateRecoveryCodes(c context.Context, userID string, req *userRequest.TwoFactorCode) ([]string, error) {
+	if err := s.validator.Struct(req); err != nil {
+		log.Printf("Validation error for two-factor code: %v", err)
+		return nil, fmt.Errorf("validation error: %v", err)
+	}
+
+	user, err := s.repository.GetUserByID(c, userID)
+	if err != nil {
+		log.Printf("Failed to get user by id: %v", err)
+		return nil, fmt.Errorf("user not found: %v", userID)
+	}
+
+	if user.TwoFactorEnabledAt == nil {
+		return nil, fmt.Errorf("two-factor authentication is not enabled")
+	}
+
+	if !s.checkTOTP(user.ID, user.TwoFactorSecret, req.Code) {
+		return nil, ErrInvalidTwoFactorCode
+	}
+
+	return s.replaceRecoveryCodes(c, user)
+}
+
+func (s *UserService) DisableTwoFactor(c context.Context, userID string, req *userRequest.DisableTwoFactor) error {
+	if err := s.validator.Struct(req); err != nil {
+		log.Printf("Validation error for disable two-factor request: %v", err)
+		return fmt.Errorf("validation error: %v", err)
+	}
+
+	user, err := s.repository.GetUserByID(c, userID)
+	if err != nil {
+		log.Printf("Failed to get user by id: %v", err)
+		return fmt.Errorf("user not found: %v", userID)
+	}
+
+	if user.TwoFactorEnabledAt == nil {
+		return fmt.Errorf("two-factor authentication is not enabled")
+	}
+
+	if s.requireAdmin2FA && user.Role == rbac.RoleAdmin {
+		return fmt.Errorf("two-factor authentication is required for admins")
+	}
+
+	if !auths.ComparePasswords(user.Password, []byte(req.Password)) {
+		log.Printf("Invalid password for user: %v", userID)
+		return fmt.Errorf("invalid password")
+	}
+
+	if err := s.checkSecondFactor(c, user, req.Code); err != nil {
+		return err
+	}
+
+	if err := s.repository.DisableTwoFactor(c, user); err != nil {
+		log.Printf("Failed to disable two-factor authentication: %v", err)
+		return fmt.Errorf("failed to disable two-factor authentication: %v", err)
+	}
+
+	return nil
+}
+
+// VerifyTwoFactorLogin finishes a login that returned ErrTwoFactorRequired.
+// Each challenge allows a few attempts and can only be completed once. Failed
+// codes also count against the account across challenges and lock it once
+// they reach the limit, so new challenges cannot be opened to keep guessing.
+func (s *UserService) VerifyTwoFactorLogin(c context.Context, req *userRequest.TwoFactorLogin) (*userModel.User, string, string, error) {
+	if err := s.validator.Struct(req); err != nil {
+		log.Printf("Validation error for two-factor login: %v", err)
+		return nil, "", "", fmt.Errorf("validation error: %v", err)
+	}
+
+	hashed := hashToken(req.ChallengeToken)
+	key := fmt.Sprintf(twoFactorChallengeKey, hashed)
+
+	allowed, _, err := ratelimit.New(s.cache, twoFactorMaxAttempts, configs.TwoFactorChallengeTTL).
+		Allow(fmt.Sprintf(twoFactorAttemptKey, hashed))
+	if err != nil {
+		log.Printf("Failed to count two-factor attempts: %v", err)
+		return nil, "", "", fmt.Errorf("failed to verify two-factor code: %v", err)
+	}
+	if !allowed {
+		_ = s.cache.Remove(key)
+		return nil, "", "", ErrInvalidChallenge
+	}
+
+	var challenge twoFactorChallenge
+	if err := s.cache.Get(key, &challenge); err != nil {
+		log.Printf("Failed to get two-factor challenge: %v", err)
+		return nil, "", "", ErrInvalidChallenge
+	}
+
+	user, err := s.repository.GetUserByID(c, strconv.FormatInt(challenge.UserID, 10))
+	if err != nil {
+		log.Printf("Failed to get user by id: %v", err)
+		return nil, "", "", fmt.Errorf("user not found: %v", challenge.UserID)
+	}
+
+	if err := s.checkTwoFactorAllowed(user.Email); err != nil {
+		return nil, "", "", err
+	}
+
+	if err := s.checkSecondFactor(c, user, req.Code); err != nil {
+		if errors.Is(err, ErrInvalidTwoFactorCode) {
+			s.recordTwoFactorFailure(user.Email)
+		}
+		return nil, "", "", err
+	}
+	s.clearTwoFactorFailures(user.Email)
+
+	// Whoever removes the challenge first gets the tokens.
+	if err := s.cache.GetDel(key, &challenge); err != nil {
+		return nil, "", "", ErrInvalidChallenge
+	}
+
+	device := &userRequest.Device{
+		DeviceName: challenge.DeviceName,
+		Platform:   challenge.Platform,
+		UserAgent:  challenge.UserAgent,
+		IPAddress:  challenge.IPAddress,
+	}
+	accessToken, refreshToken, err := s.issueTokens(c, user, challenge.FcmToken, true, nil, device)
+	if err != nil {
+		return nil, "", "", err
+	}
+
+	return user, accessToken, refreshToken, nil
+}
+
+// startChallenge is called by the login methods for accounts with 2FA.
+func (s *UserService) startChallenge(user *userModel.User, fcmToken string, device *userRequest.Device) error {
+	token, err := generate.RandomToken(32)
+	if err != nil {
+		log.Printf("Failed to generate challenge token: %v", err)
+		return fmt.Errorf("failed to generate challenge token: %v", err)
+	}
+
+	challenge := twoFactorChallenge{
+		UserID:     user.ID,
+		FcmToken:   fcmToken,
+		DeviceName: device.DeviceName,
+		Platform:   device.Platform,
+		UserAgent:  device.UserAgent,
+		IPAddress:  device.IPAddress,
+	}
+	key := fmt.Sprintf(twoFactorChallengeKey, hashToken(token))
+	if err := s.cache.SetWithExpiration(key, challenge, configs.TwoFactorChallengeTTL); err != nil {
+		log.Printf("Failed to store challenge: %v", err)
+		return fmt.Errorf("failed to store challenge: %v", err)
+	}
+
+	return &ErrTwoFactorRequired{
+		ChallengeToken: token,
+		ExpiresAt:      time.Now().Add(configs.TwoFactorChallengeTTL),
+	}
+}
+
+// checkSecondFactor accepts a TOTP code or an unused recovery code.
+func (s *UserService) checkSecondFactor(c context.Context, user *userModel.User, code string) error {
+	if s.checkTOTP(user.ID, user.TwoFactorSecret, code) {
+		return nil
+	}
+
+	err := s.repository.UseRecoveryCode(c, user.ID, hashToken(normalizeRecoveryCode(code)))
+	if errors.Is(err, userRepository.ErrRecoveryCodeNotFound) {
+		return ErrInvalidTwoFactorCode
+	}
+	if err != nil {
+		log.Printf("Failed to use recovery code: %v", err)
+		return fmt.Errorf("failed to verify two-factor code: %v", err)
+	}
+
+	log.Printf("User %d logged in with a recovery code", user.ID)
+	return nil
+}
+
+// checkTOTP validates a code and remembers its time step so the same code
+// cannot be replayed while it is still valid.
+func (s *UserService) checkTOTP(userID int64, secret string, code string) bool {
+	if secret == "" {
+		return false
+	}
+
+	step, ok := totp.Validate(secret, code, time.Now())
+	if !ok {
+		return false
+	}
+
+	usedKey := fmt.Sprintf(twoFactorUsedKey, userID, step)
+	var used bool
+	if err := s.cache.Get(usedKey, &used); err == nil && used {
+		return false
+	}
+
+	_ = s.cache.SetWithExpiration(usedKey, true, (2*totp.Skew+1)*totp.Period*time.Second)
+	return true
+}
+
+func (s *UserService) replaceRecoveryCodes(c context.Context, user *userModel.User) ([]string, error) {
+	codes := make([]string, recoveryCodeCount)
+	records := make([]*userModel.RecoveryCode, recoveryCodeCount)
+	for i := range codes {
+		raw := make([]byte, 5)
+		if _, err := rand.Read(raw); err != nil {
+			log.Printf("Failed to generate recovery code: %v", err)
+			return nil, fmt.Errorf("failed to generate recovery codes: %v", err)
+		}
+
+		code := hex.EncodeToString(raw)
+		codes[i] = code[:5] + "-" + code[5:]
+		records[i] = &userModel.RecoveryCode{UserID: user.ID, CodeHash: hashToken(code)}
+	}
+
+	if err := s.repository.ReplaceRecoveryCodes(c, user.ID, records); err != nil {
+		log.Printf("Failed to store recovery codes: %v", err)
+		return nil, fmt.Errorf("failed to store recovery codes: %v", err)
+	}
+
+	return codes, nil
+}
+
+func normalizeRecoveryCode(code string) string {
+	code = strings.ToLower(strings.TrimSpace(code))
+	return strings.ReplaceAll(code, "-", "")
+}
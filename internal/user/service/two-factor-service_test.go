@@ -0,0 +1,231 @@
+package userService
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+	userModel "washit-api/internal/user/dto/model"
+	userRequest "washit-api/internal/user/dto/request"
+	userRepository "washit-api/internal/user/repository"
+	auths "washit-api/pkg/auth"
+	jwt "washit-api/pkg/token"
+	"washit-api/pkg/totp"
+
+	"github.com/stretchr/testify/mock"
+)
+
+func hasPrefix(prefix string) interface{} {
+	return mock.MatchedBy(func(key string) bool { return strings.HasPrefix(key, prefix) })
+}
+
+// Login
+// =================================================================
+
+func (suite *UserServiceTestSuite) TestLoginWithTwoFactorReturnsChallenge() {
+	hashedPassword, err := auths.HashPassword("test123456")
+	suite.NoError(err)
+
+	enabledAt := time.Now()
+	suite.expectNoLoginFailures()
+	suite.mockRepo.On("GetUserByEmail", mock.Anything, "admin@test.com").
+		Return(&userModel.User{ID: 1, Email: "admin@test.com", Password: hashedPassword, TwoFactorEnabledAt: &enabledAt}, nil).Times(1)
+	suite.mockCache.On("SetWithExpiration", hasPrefix("2fa:challenge:"),
+		twoFactorChallenge{UserID: 1, DeviceName: "Laptop", FcmToken: "fcm-1"}, 5*time.Minute).
+		Return(nil).Times(1)
+
+	user, accessToken, refreshToken, err := suite.service.Login(context.Background(), &userRequest.Login{
+		Email: "admin@test.com", Password: "test123456", FcmToken: "fcm-1", Device: userRequest.Device{DeviceName: "Laptop"},
+	})
+	suite.Nil(user)
+	suite.Empty(accessToken)
+	suite.Empty(refreshToken)
+
+	var required *ErrTwoFactorRequired
+	suite.ErrorAs(err, &required)
+	suite.NotEmpty(required.ChallengeToken)
+	suite.mockRepo.AssertNotCalled(suite.T(), "CreateSession", mock.Anything, mock.Anything, mock.Anything)
+	suite.mockRepo.AssertNotCalled(suite.T(), "UpdateUser", mock.Anything, mock.Anything)
+}
+
+// VerifyTwoFactorLogin
+// =================================================================
+
+func (suite *UserServiceTestSuite) expectChallenge(secret string) {
+	enabledAt := time.Now()
+	suite.mockCache.On("WindowAdd", hasPrefix("2fa:attempts:"), mock.Anything, twoFactorMaxAttempts, mock.Anything).
+		Return([]time.Time{time.Now()}, true, nil).Times(1)
+	suite.mockCache.On("Get", "2fa:challenge:"+hashToken("challenge"), mock.Anything).
+		Run(func(args mock.Arguments) {
+			*args.Get(1).(*twoFactorChallenge) = twoFactorChallenge{UserID: 1, DeviceName: "Laptop", FcmToken: "fcm-1"}
+		}).
+		Return(nil).Times(1)
+	suite.mockRepo.On("GetUserByID", mock.Anything, "1").
+		Return(&userModel.User{
+			ID: 1, Email: "admin@test.com", Role: "admin", TwoFactorSecret: secret, TwoFactorEnabledAt: &enabledAt,
+		}, nil).Times(1)
+	suite.mockCache.On("Get", "login:lock:"+hashToken("admin@test.com"), mock.Anything).
+		Return(errors.New("redis: nil"))
+	suite.mockCache.On("WindowAdd", "login:failures:2fa:"+hashToken("admin@test.com"), mock.Anything, loginMaxTwoFactorFailures, 15*time.Minute).
+		Return([]time.Time{time.Now()}, true, nil)
+	suite.mockCache.On("Remove", "login:failures:2fa:"+hashToken("admin@test.com")).
+		Return(nil)
+}
+
+func (suite *UserServiceTestSuite) TestVerifyTwoFactorLoginWithTOTP() {
+	secret, err := totp.GenerateSecret()
+	suite.NoError(err)
+	code, err := totp.Code(secret, time.Now())
+	suite.NoError(err)
+
+	suite.expectChallenge(secret)
+	suite.mockCache.On("Get", hasPrefix("2fa:used:1:"), mock.Anything).
+		Return(errors.New("redis: nil")).Times(1)
+	suite.mockCache.On("SetWithExpiration", hasPrefix("2fa:used:1:"), true, mock.Anything).
+		Return(nil).Times(1)
+	suite.mockCache.On("GetDel", "2fa:challenge:"+hashToken("challenge"), mock.Anything).
+		Return(nil).Times(1)
+	suite.mockRepo.On("CreateSession", mock.Anything,
+		mock.MatchedBy(func(session *userModel.Session) bool {
+			return session.DeviceName == "Laptop" && session.FcmToken == "fcm-1"
+		}),
+		mock.Anything).
+		Return(nil).Times(1)
+
+	user, accessToken, _, err := suite.service.VerifyTwoFactorLogin(context.Background(),
+		&userRequest.TwoFactorLogin{ChallengeToken: "challenge", Code: code})
+	suite.Nil(err)
+	suite.Equal(int64(1), user.ID)
+
+	claims, err := jwt.ParseToken(accessToken)
+	suite.NoError(err)
+	suite.Equal(true, claims.Payload["mfa"])
+}
+
+func (suite *UserServiceTestSuite) TestVerifyTwoFactorLoginWithRecoveryCode() {
+	secret, err := totp.GenerateSecret()
+	suite.NoError(err)
+
+	suite.expectChallenge(secret)
+	suite.mockRepo.On("UseRecoveryCode", mock.Anything, int64(1), hashToken("abcde12345")).
+		Return(nil).Times(1)
+	suite.mockCache.On("GetDel", "2fa:challenge:"+hashToken("challenge"), mock.Anything).
+		Return(nil).Times(1)
+	suite.mockRepo.On("CreateSession", mock.Anything, mock.Anything, mock.Anything).
+		Return(nil).Times(1)
+
+	_, _, _, err = suite.service.VerifyTwoFactorLogin(context.Background(),
+		&userRequest.TwoFactorLogin{ChallengeToken: "challenge", Code: "ABCDE-12345"})
+	suite.Nil(err)
+	suite.mockRepo.AssertExpectations(suite.T())
+}
+
+func (suite *UserServiceTestSuite) TestVerifyTwoFactorLoginWrongCode() {
+	secret, err := totp.GenerateSecret()
+	suite.NoError(err)
+
+	suite.expectChallenge(secret)
+	suite.mockRepo.On("UseRecoveryCode", mock.Anything, int64(1), mock.Anything).
+		Return(userRepository.ErrRecoveryCodeNotFound).Times(1)
+	suite.mockCache.On("WindowRange", "login:failures:2fa:"+hashToken("admin@test.com"), mock.Anything).
+		Return([]time.Time{time.Now()}, nil).Times(1)
+
+	_, _, _, err = suite.service.VerifyTwoFactorLogin(context.Background(),
+		&userRequest.TwoFactorLogin{ChallengeToken: "challenge", Code: "000000"})
+	suite.ErrorIs(err, ErrInvalidTwoFactorCode)
+	suite.mockCache.AssertNotCalled(suite.T(), "GetDel", mock.Anything, mock.Anything)
+	suite.mockCache.AssertNotCalled(suite.T(), "SetWithExpiration", hasPrefix("login:lock:"), mock.Anything, mock.Anything)
+}
+
+func (suite *UserServiceTestSuite) TestVerifyTwoFactorLoginLocksAccountAcrossChallenges() {
+	secret, err := totp.GenerateSecret()
+	suite.NoError(err)
+
+	failures := make([]time.Time, loginMaxTwoFactorFailures)
+	for i := range failures {
+		failures[i] = time.Now().Add(-time.Minute)
+	}
+
+	suite.expectChallenge(secret)
+	suite.mockRepo.On("UseRecoveryCode", mock.Anything, int64(1), mock.Anything).
+		Return(userRepository.ErrRecoveryCodeNotFound).Times(1)
+	suite.mockCache.On("WindowRange", "login:failures:2fa:"+hashToken("admin@test.com"), mock.Anything).
+		Return(failures, nil).Times(1)
+	suite.mockCache.On("SetWithExpiration", "login:lock:"+hashToken("admin@test.com"), mock.Anything, 15*time.Minute).
+		Return(nil).Times(1)
+
+	_, _, _, err = suite.service.VerifyTwoFactorLogin(context.Background(),
+		&userRequest.TwoFactorLogin{ChallengeToken: "challenge", Code: "000000"})
+	suite.ErrorIs(err, ErrInvalidTwoFactorCode)
+	suite.mockCache.AssertExpectations(suite.T())
+}
+
+func (suite *UserServiceTestSuite) TestVerifyTwoFactorLoginLockedAccount() {
+	enabledAt := time.Now()
+	suite.mockCache.On("WindowAdd", hasPrefix("2fa:attempts:"), mock.Anything, twoFactorMaxAttempts, mock.Anything).
+		Return([]time.Time{time.Now()}, true, nil).Times(1)
+	suite.mockCache.On("Get", "2fa:challenge:"+hashToken("challenge"), mock.Anything).
+		Run(func(args mock.Arguments) {
+			*args.Get(1).(*twoFactorChallenge) = twoFactorChallenge{UserID: 1}
+		}).
+		Return(nil).Times(1)
+	suite.mockRepo.On("GetUserByID", mock.Anything, "1").
+		Return(&userModel.User{ID: 1, Email: "admin@test.com", TwoFactorEnabledAt: &enabledAt}, nil).Times(1)
+	suite.mockCache.On("Get", "login:lock:"+hashToken("admin@test.com"), mock.Anything).
+		Run(func(args mock.Arguments) { *args.Get(1).(*time.Time) = time.Now().Add(10 * time.Minute) }).
+		Return(nil).Times(1)
+
+	_, _, _, err := suite.service.VerifyTwoFactorLogin(context.Background(),
+		&userRequest.TwoFactorLogin{ChallengeToken: "challenge", Code: "000000"})
+
+	var tooMany *ErrTooManyRequests
+	suite.ErrorAs(err, &tooMany)
+	suite.mockRepo.AssertNotCalled(suite.T(), "UseRecoveryCode", mock.Anything, mock.Anything, mock.Anything)
+}
+
+// ConfirmTwoFactor
+// =================================================================
+
+func (suite *UserServiceTestSuite) TestConfirmTwoFactorEnablesAndReturnsRecoveryCodes() {
+	secret, err := totp.GenerateSecret()
+	suite.NoError(err)
+	code, err := totp.Code(secret, time.Now())
+	suite.NoError(err)
+
+	suite.mockCache.On("Get", "2fa:enroll:1", mock.Anything).
+		Run(func(args mock.Arguments) { *args.Get(1).(*string) = secret }).
+		Return(nil).Times(1)
+	suite.mockRepo.On("GetUserByID", mock.Anything, "1").
+		Return(&userModel.User{ID: 1}, nil).Times(1)
+	suite.mockCache.On("Get", hasPrefix("2fa:used:1:"), mock.Anything).
+		Return(errors.New("redis: nil")).Times(1)
+	suite.mockCache.On("SetWithExpiration", hasPrefix("2fa:used:1:"), true, mock.Anything).
+		Return(nil).Times(1)
+	suite.mockRepo.On("UpdateUser", mock.Anything, mock.MatchedBy(func(user *userModel.User) bool {
+		return user.TwoFactorSecret == secret && user.TwoFactorEnabledAt != nil
+	})).Return(nil).Times(1)
+	suite.mockCache.On("Remove", "2fa:enroll:1").
+		Return(nil).Times(1)
+	suite.mockRepo.On("ReplaceRecoveryCodes", mock.Anything, int64(1), mock.MatchedBy(func(codes []*userModel.RecoveryCode) bool {
+		return len(codes) == 10
+	})).Return(nil).Times(1)
+
+	codes, err := suite.service.ConfirmTwoFactor(context.Background(), "1", &userRequest.TwoFactorCode{Code: code})
+	suite.Nil(err)
+	suite.Len(codes, 10)
+}
+
+// DisableTwoFactor
+// =================================================================
+
+func (suite *UserServiceTestSuite) TestDisableTwoFactorRequiredForAdmins() {
+	suite.service.(*UserService).requireAdmin2FA = true
+	enabledAt := time.Now()
+	suite.mockRepo.On("GetUserByID", mock.Anything, "1").
+		Return(&userModel.User{ID: 1, Role: "admin", TwoFactorEnabledAt: &enabledAt}, nil).Times(1)
+
+	err := suite.service.DisableTwoFactor(context.Background(), "1",
+		&userRequest.DisableTwoFactor{Password: "secret", Code: "123456"})
+	suite.NotNil(err)
+	suite.mockRepo.AssertNotCalled(suite.T(), "DisableTwoFactor", mock.Anything, mock.Anything)
+}
@@ -2,6 +2,7 @@ package userService
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"strconv"
@@ -11,67 +12,232 @@ import (
 	userRequest "washit-api/internal/user/dto/request"
 	userRepository "washit-api/internal/user/repository"
 	auths "washit-api/pkg/auth"
+	"washit-api/pkg/configs"
 	generate "washit-api/pkg/generator"
+	"washit-api/pkg/mailer"
+	"washit-api/pkg/ratelimit"
+	"washit-api/pkg/redis"
 	jwt "washit-api/pkg/token"
 
 	"firebase.google.com/go/auth"
 	"github.com/fatih/camelcase"
 	"github.com/gin-gonic/gin"
 	"github.com/go-playground/validator"
+	"github.com/google/uuid"
 )
 
 type IUserService interface {
-	RefreshToken(c context.Context, userID string) (string, error)
+	RefreshToken(c context.Context, claims *jwt.Claims, device *userRequest.Device) (string, string, error)
 	Register(c context.Context, req *userRequest.Register) (*userModel.User, error)
 	LoginWithGoogle(c context.Context, req *userRequest.Google, userInfo *auth.UserInfo) (*userModel.User, string, string, error)
 	Login(c context.Context, req *userRequest.Login) (*userModel.User, string, string, error)
-	Logout(c context.Context, userID string) error
+	Logout(c context.Context, claims *jwt.Claims, req *userRequest.Logout) error
+	LogoutAll(c context.Context, userID string) error
+	GetSessions(c context.Context, userID string) ([]*userModel.Session, error)
+	RevokeSession(c context.Context, userID string, sessionID string) error
 	BanUser(c context.Context, userID string) (*userModel.User, error)
 	UnbanUser(c context.Context, userID string) (*userModel.User, error)
+	UnlockUser(c context.Context, userID string) (*userModel.User, error)
+	AssignRole(c context.Context, actorID string, userID string, req *userRequest.AssignRole) (*userModel.User, error)
+	AssignOutlet(c context.Context, userID string, outletID *int64) (*userModel.User, error)
+	GetUsersByOutlet(c context.Context, outletID string) ([]*userModel.User, error)
 	GetMe(c context.Context, userID string) (*userModel.User, error)
 	GetUserByID(c context.Context, userID string) (*userModel.User, error)
 	GetUsers(c context.Context) ([]*userModel.User, error)
 	GetBannedUsers(c context.Context) ([]*userModel.User, error)
 	UpdateProfile(c context.Context, userID string, req *userRequest.UpdateProfile) (*userModel.User, error)
 	UpdatePassword(c context.Context, userID string, req *userRequest.UpdatePassword) error
+	ForgotPassword(c context.Context, req *userRequest.ForgotPassword) error
+	ResetPassword(c context.Context, req *userRequest.ResetPassword) error
+	VerifyEmail(c context.Context, req *userRequest.VerifyEmail) (*userModel.User, error)
+	ResendVerification(c context.Context, userID string) error
+	EnsureEmailVerified(c context.Context, userID string) error
+	EnrollTwoFactor(c context.Context, userID string) (string, string, error)
+	ConfirmTwoFactor(c context.Context, userID string, req *userRequest.TwoFactorCode) ([]string, error)
+	RegenerateRecoveryCodes(c context.Context, userID string, req *userRequest.TwoFactorCode) ([]string, error)
+	DisableTwoFactor(c context.Context, userID string, req *userRequest.DisableTwoFactor) error
+	VerifyTwoFactorLogin(c context.Context, req *userRequest.TwoFactorLogin) (*userModel.User, string, string, error)
 	UpdatePicture(c context.Context, userID string, req *userRequest.UpdatePicture) (*userModel.User, error)
 }
 
+var (
+	ErrRefreshTokenRevoked = errors.New("refresh token is revoked")
+	ErrRefreshTokenReused  = errors.New("refresh token reuse detected, session revoked")
+)
+
 type UserService struct {
-	repository userRepository.IUserRepository
-	validator  *validator.Validate
+	repository      userRepository.IUserRepository
+	cache           redis.IRedis
+	mailer          mailer.IMailer
+	resetLimiter    *ratelimit.Window
+	verifyLimiter   *ratelimit.Window
+	accountFailures *ratelimit.Window
+	ipFailures      *ratelimit.Window
+	// twoFactorFailures counts failed second factors per account.
+	twoFactorFailures *ratelimit.Window
+	validator         *validator.Validate
+
+	requireVerifiedEmail bool
+	requireAdmin2FA      bool
 }
 
 func NewUserService(
-	repository userRepository.IUserRepository, validator *validator.Validate) *UserService {
+	repository userRepository.IUserRepository, cache redis.IRedis, mailer mailer.IMailer, validator *validator.Validate) *UserService {
 	return &UserService{
-		repository: repository,
-		validator:  validator,
+		repository:        repository,
+		cache:             cache,
+		mailer:            mailer,
+		resetLimiter:      ratelimit.New(cache, 3, time.Hour),
+		verifyLimiter:     ratelimit.New(cache, 3, time.Hour),
+		accountFailures:   ratelimit.New(cache, loginMaxFailures, configs.LoginFailureWindow),
+		ipFailures:        ratelimit.New(cache, loginMaxIPFailures, configs.LoginFailureWindow),
+		twoFactorFailures: ratelimit.New(cache, loginMaxTwoFactorFailures, configs.LoginFailureWindow),
+		validator:         validator,
+
+		requireVerifiedEmail: configs.Envs.RequireVerifiedEmail,
+		requireAdmin2FA:      configs.Envs.RequireAdmin2FA,
 	}
 }
 
-func (s *UserService) RefreshToken(c context.Context, userID string) (string, error) {
-	user, err := s.repository.GetUserByID(c, userID)
+// RefreshToken exchanges a refresh token for a new access and refresh token
+// pair. A token that was already exchanged is evidence of theft, so presenting
+// it again revokes its whole family.
+func (s *UserService) RefreshToken(c context.Context, claims *jwt.Claims, device *userRequest.Device) (string, string, error) {
+	stored, err := s.repository.GetRefreshTokenByID(c, claims.ID)
+	if err != nil {
+		log.Printf("Failed to get refresh token by id: %v", err)
+		return "", "", fmt.Errorf("refresh token not found: %v", claims.ID)
+	}
+
+	if stored.RevokedAt != nil {
+		return "", "", ErrRefreshTokenRevoked
+	}
+
+	if stored.RotatedAt != nil {
+		log.Printf("Refresh token %s reused, revoking family %s", stored.ID, stored.FamilyID)
+		s.revokeFamily(c, stored.FamilyID)
+		return "", "", ErrRefreshTokenReused
+	}
+
+	user, err := s.repository.GetUserByID(c, claims.UserID)
 	if err != nil {
 		log.Printf("Failed to get user by id: %v", err)
-		return "", fmt.Errorf("user not found: %v", userID)
+		return "", "", fmt.Errorf("user not found: %v", claims.UserID)
+	}
+
+	if user.IsBanned {
+		return "", "", fmt.Errorf("user is banned")
+	}
+
+	// The cached version may be missing, so check the stored one as well.
+	if claims.Version < user.TokenVersion {
+		log.Printf("Refresh token %s predates token version %d of user %d", stored.ID, user.TokenVersion, user.ID)
+		return "", "", ErrRefreshTokenRevoked
+	}
+
+	fcmToken, _ := claims.Payload["fcm_token"].(string)
+	mfa, _ := claims.Payload["mfa"].(bool)
+	accessToken, refreshToken, err := s.issueTokens(c, user, fcmToken, mfa, stored, device)
+	if errors.Is(err, userRepository.ErrRefreshTokenRotated) {
+		log.Printf("Refresh token %s rotated concurrently, revoking family %s", stored.ID, stored.FamilyID)
+		s.revokeFamily(c, stored.FamilyID)
+		return "", "", ErrRefreshTokenReused
+	}
+	if err != nil {
+		return "", "", err
+	}
+
+	return accessToken, refreshToken, nil
+}
+
+// issueTokens generates an access and refresh token pair and persists the
+// refresh token. Without a parent a new session is started, otherwise the
+// parent is rotated into the new token. mfa records whether the session was
+// started with a second factor. The device's FCM token is registered with
+// the new session, so only once login has fully succeeded.
+func (s *UserService) issueTokens(c context.Context, user *userModel.User, fcmToken string, mfa bool, parent *userModel.RefreshToken, device *userRequest.Device) (string, string, error) {
+	record := &userModel.RefreshToken{
+		FamilyID:   uuid.New().String(),
+		UserID:     user.ID,
+		DeviceName: device.DeviceName,
+		UserAgent:  device.UserAgent,
+		IPAddress:  device.IPAddress,
+	}
+	if parent != nil {
+		record.FamilyID = parent.FamilyID
+		record.ParentID = parent.ID
+		if record.DeviceName == "" {
+			record.DeviceName = parent.DeviceName
+		}
 	}
 
 	tokenData := gin.H{
-		"id":   strconv.FormatInt(user.ID, 10),
-		"role": user.Role,
+		"id":        strconv.FormatInt(user.ID, 10),
+		"role":      user.Role,
+		"fcm_token": fcmToken,
+		"ver":       user.TokenVersion,
+		"fid":       record.FamilyID,
+		"mfa":       mfa,
+		"outlet":    outletClaim(user),
 	}
 
 	accessToken, err := jwt.GenerateAccessToken(tokenData)
 	if err != nil {
 		log.Printf("Failed to generate access token: %v", err)
-		return "", fmt.Errorf("failed to generate access token: %v", err)
+		return "", "", fmt.Errorf("failed to generate access token: %v", err)
 	}
 
-	return accessToken, nil
+	refreshToken, claims, err := jwt.GenerateRefreshToken(tokenData)
+	if err != nil {
+		log.Printf("Failed to generate refresh token: %v", err)
+		return "", "", fmt.Errorf("failed to generate refresh token: %v", err)
+	}
+
+	record.ID = claims.ID
+	record.ExpiresAt = claims.ExpiresAt
+
+	if parent == nil {
+		session := &userModel.Session{
+			ID:         record.FamilyID,
+			UserID:     user.ID,
+			DeviceName: device.DeviceName,
+			Platform:   device.Platform,
+			FcmToken:   fcmToken,
+			UserAgent:  device.UserAgent,
+			IPAddress:  device.IPAddress,
+			LastSeenAt: time.Now(),
+		}
+		err = s.repository.CreateSession(c, session, record)
+	} else {
+		err = s.repository.RotateRefreshToken(c, parent.ID, record)
+	}
+	if errors.Is(err, userRepository.ErrRefreshTokenRotated) {
+		return "", "", err
+	}
+	if err != nil {
+		log.Printf("Failed to store refresh token: %v", err)
+		return "", "", fmt.Errorf("failed to store refresh token: %v", err)
+	}
+
+	return accessToken, refreshToken, nil
+}
+
+func (s *UserService) revokeFamily(c context.Context, familyID string) {
+	if err := s.repository.RevokeRefreshTokenFamily(c, familyID); err != nil {
+		log.Printf("Failed to revoke refresh token family %s: %v", familyID, err)
+	}
+
+	if err := jwt.RevokeFamily(s.cache, familyID); err != nil {
+		log.Printf("Failed to revoke token family %s: %v", familyID, err)
+	}
 }
 
 func (s *UserService) LoginWithGoogle(c context.Context, req *userRequest.Google, userInfo *auth.UserInfo) (*userModel.User, string, string, error) {
+	if err := s.validator.Struct(req); err != nil {
+		log.Printf("Validation error for google login request: %v", err)
+		return nil, "", "", fmt.Errorf("validation error: %v", err)
+	}
+
 	user, err := s.repository.GetUserByEmail(c, userInfo.Email)
 	if err != nil {
 		randomPassword, err := generate.RandomPassword()
@@ -107,13 +273,16 @@ func (s *UserService) LoginWithGoogle(c context.Context, req *userRequest.Google
 			lastName = splittedName[1]
 		}
 
+		// Google only hands out verified addresses.
+		verifiedAt := time.Now()
 		newUser := &userModel.User{
-			ID:        snoflakeID,
-			FirstName: firstName,
-			LastName:  lastName,
-			Email:     userInfo.Email,
-			Password:  hashedPassword,
-			Image:     imagePath,
+			ID:              snoflakeID,
+			FirstName:       firstName,
+			LastName:        lastName,
+			Email:           userInfo.Email,
+			Password:        hashedPassword,
+			Image:           imagePath,
+			EmailVerifiedAt: &verifiedAt,
 		}
 
 		if err := s.repository.CreateUser(c, newUser); err != nil {
@@ -128,34 +297,17 @@ func (s *UserService) LoginWithGoogle(c context.Context, req *userRequest.Google
 		}
 	}
 
-	if req.FcmToken != "" {
-		user.FcmToken = req.FcmToken
-		if err := s.repository.UpdateUser(c, user); err != nil {
-			log.Printf("Failed to update FCM token: %v", err)
-			return nil, "", "", fmt.Errorf("failed to update FCM token: %v", err)
-		}
-	}
-
 	if user.IsBanned {
 		return nil, "", "", fmt.Errorf("user is banned")
 	}
 
-	tokenData := gin.H{
-		"id":        strconv.FormatInt(user.ID, 10),
-		"role":      user.Role,
-		"fcm_token": req.FcmToken,
-	}
-
-	accessToken, err := jwt.GenerateAccessToken(tokenData)
-	if err != nil {
-		log.Printf("Failed to generate access token: %v", err)
-		return nil, "", "", fmt.Errorf("failed to generate access token: %v", err)
+	if user.TwoFactorEnabledAt != nil {
+		return nil, "", "", s.startChallenge(user, req.FcmToken, &req.Device)
 	}
 
-	refreshToken, err := jwt.GenerateRefreshToken(tokenData)
+	accessToken, refreshToken, err := s.issueTokens(c, user, req.FcmToken, false, nil, &req.Device)
 	if err != nil {
-		log.Printf("Failed to generate refresh token: %v", err)
-		return nil, "", "", fmt.Errorf("failed to generate refresh token: %v", err)
+		return nil, "", "", err
 	}
 
 	return user, accessToken, refreshToken, nil
@@ -167,46 +319,36 @@ func (s *UserService) Login(c context.Context, req *userRequest.Login) (*userMod
 		return nil, "", "", fmt.Errorf("validation error: %v", err)
 	}
 
+	if err := s.checkLoginAllowed(req.Email, req.IPAddress); err != nil {
+		return nil, "", "", err
+	}
+
 	user, err := s.repository.GetUserByEmail(c, req.Email)
 	if err != nil {
 		log.Printf("User not found with email: %s, error: %v", req.Email, err)
+		s.recordLoginFailure(req.Email, req.IPAddress)
 		return nil, "", "", fmt.Errorf("unable to find user with email: %s", req.Email)
 	}
 
 	if !auths.ComparePasswords(user.Password, []byte(req.Password)) {
 		log.Printf("Invalid password for user: %s", req.Email)
+		s.recordLoginFailure(req.Email, req.IPAddress)
 		return nil, "", "", fmt.Errorf("invalid password")
 	}
+	s.clearLoginFailures(req.Email)
 
 	if user.IsBanned {
 		log.Printf("User is banned: %s", req.Email)
 		return nil, "", "", fmt.Errorf("user is banned")
 	}
 
-	if req.FcmToken != "" {
-		user.FcmToken = req.FcmToken
-		if err := s.repository.UpdateUser(c, user); err != nil {
-			log.Printf("Failed to update FCM token for user: %s, error: %v", req.Email, err)
-			return nil, "", "", fmt.Errorf("failed to update FCM token: %v", err)
-		}
-	}
-
-	tokenData := gin.H{
-		"id":        strconv.FormatInt(user.ID, 10),
-		"role":      user.Role,
-		"fcm_token": req.FcmToken,
+	if user.TwoFactorEnabledAt != nil {
+		return nil, "", "", s.startChallenge(user, req.FcmToken, &req.Device)
 	}
 
-	accessToken, err := jwt.GenerateAccessToken(tokenData)
-	if err != nil {
-		log.Printf("Failed to generate access token: %v", err)
-		return nil, "", "", fmt.Errorf("failed to generate access token: %v", err)
-	}
-
-	refreshToken, err := jwt.GenerateRefreshToken(tokenData)
+	accessToken, refreshToken, err := s.issueTokens(c, user, req.FcmToken, false, nil, &req.Device)
 	if err != nil {
-		log.Printf("Failed to generate refresh token: %v", err)
-		return nil, "", "", fmt.Errorf("failed to generate refresh token: %v", err)
+		return nil, "", "", err
 	}
 
 	return user, accessToken, refreshToken, nil
@@ -256,10 +398,111 @@ func (s *UserService) Register(c context.Context, req *userRequest.Register) (*u
 		return nil, fmt.Errorf("failed to create user: %v", err)
 	}
 
+	// The account exists either way; the user can ask for another link.
+	if err := s.sendVerification(c, user, user.Email); err != nil {
+		log.Printf("Failed to send verification email to %s: %v", user.Email, err)
+	}
+
 	return user, nil
 }
 
-func (s *UserService) Logout(c context.Context, userID string) error {
+// Logout revokes the access token of the current request together with the
+// refresh token family it was issued from and, when given, the refresh token.
+func (s *UserService) Logout(c context.Context, claims *jwt.Claims, req *userRequest.Logout) error {
+	if err := jwt.Revoke(s.cache, claims); err != nil {
+		log.Printf("Failed to revoke access token: %v", err)
+		return fmt.Errorf("failed to revoke access token: %v", err)
+	}
+
+	if claims.FamilyID != "" {
+		s.revokeFamily(c, claims.FamilyID)
+	}
+
+	if req.RefreshToken == "" {
+		return nil
+	}
+
+	refresh, err := jwt.ParseToken(req.RefreshToken)
+	if err != nil {
+		// An expired or malformed refresh token cannot be used anymore anyway.
+		log.Printf("Skipping revocation of invalid refresh token: %v", err)
+		return nil
+	}
+
+	if refresh.Type != jwt.RefreshTokenType || refresh.UserID != claims.UserID {
+		return fmt.Errorf("refresh token does not belong to user: %v", claims.UserID)
+	}
+
+	if err := jwt.Revoke(s.cache, refresh); err != nil {
+		log.Printf("Failed to revoke refresh token: %v", err)
+		return fmt.Errorf("failed to revoke refresh token: %v", err)
+	}
+
+	return nil
+}
+
+// LogoutAll bumps the token version of the user, which invalidates every
+// access and refresh token issued before, and revokes all of their sessions.
+func (s *UserService) LogoutAll(c context.Context, userID string) error {
+	user, err := s.repository.GetUserByID(c, userID)
+	if err != nil {
+		log.Printf("Failed to get user by id: %v", err)
+		return fmt.Errorf("user not found: %v", userID)
+	}
+
+	user.TokenVersion++
+
+	if err := s.repository.UpdateUser(c, user); err != nil {
+		log.Printf("Failed to update token version: %v", err)
+		return fmt.Errorf("failed to update token version: %v", err)
+	}
+
+	if err := jwt.SetTokenVersion(s.cache, userID, user.TokenVersion); err != nil {
+		log.Printf("Failed to store token version: %v", err)
+		return fmt.Errorf("failed to store token version: %v", err)
+	}
+
+	if err := s.repository.RevokeRefreshTokensByUser(c, userID); err != nil {
+		log.Printf("Failed to revoke refresh tokens of user %s: %v", userID, err)
+	}
+
+	return nil
+}
+
+func (s *UserService) GetSessions(c context.Context, userID string) ([]*userModel.Session, error) {
+	activeSince := time.Now().Add(-time.Second * jwt.RefreshTokenExpiredTime)
+	sessions, err := s.repository.GetSessionsByUser(c, userID, activeSince)
+	if err != nil {
+		log.Printf("Failed to get sessions for user %s: %v", userID, err)
+		return nil, fmt.Errorf("failed to get sessions: %v", err)
+	}
+
+	return sessions, nil
+}
+
+// RevokeSession logs a single device out. Sessions of other users are
+// reported as not found.
+func (s *UserService) RevokeSession(c context.Context, userID string, sessionID string) error {
+	session, err := s.repository.GetSessionByID(c, sessionID)
+	if err != nil {
+		log.Printf("Failed to get session by id: %v", err)
+		return fmt.Errorf("session not found: %v", sessionID)
+	}
+
+	if strconv.FormatInt(session.UserID, 10) != userID || session.RevokedAt != nil {
+		return fmt.Errorf("session not found: %v", sessionID)
+	}
+
+	if err := s.repository.RevokeRefreshTokenFamily(c, sessionID); err != nil {
+		log.Printf("Failed to revoke session %s: %v", sessionID, err)
+		return fmt.Errorf("failed to revoke session: %v", err)
+	}
+
+	if err := jwt.RevokeFamily(s.cache, sessionID); err != nil {
+		log.Printf("Failed to revoke token family %s: %v", sessionID, err)
+		return fmt.Errorf("failed to revoke session: %v", err)
+	}
+
 	return nil
 }
 
@@ -281,6 +524,10 @@ func (s *UserService) BanUser(c context.Context, userID string) (*userModel.User
 		return nil, fmt.Errorf("failed to ban user: %v", err)
 	}
 
+	if err := s.LogoutAll(c, userID); err != nil {
+		log.Printf("Failed to revoke sessions of banned user %s: %v", userID, err)
+	}
+
 	return user, nil
 }
 
@@ -323,8 +570,14 @@ func (s *UserService) UpdateProfile(c context.Context, userID string, req *userR
 	if req.LastName != "" {
 		user.LastName = req.LastName
 	}
-	if req.Email != "" {
-		user.Email = req.Email
+
+	// A new email only replaces the current one once it is verified.
+	emailChanged := req.Email != "" && req.Email != user.Email
+	if emailChanged {
+		if _, err := s.repository.GetUserByEmail(c, req.Email); err == nil {
+			return nil, fmt.Errorf("user with email %s already exists", req.Email)
+		}
+		user.PendingEmail = req.Email
 	}
 
 	if err := s.repository.UpdateUser(c, user); err != nil {
@@ -332,6 +585,12 @@ func (s *UserService) UpdateProfile(c context.Context, userID string, req *userR
 		return nil, fmt.Errorf("failed to update user: %v", err)
 	}
 
+	if emailChanged {
+		if err := s.sendVerification(c, user, user.PendingEmail); err != nil {
+			return nil, err
+		}
+	}
+
 	return user, nil
 }
 
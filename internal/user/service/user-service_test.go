@@ -4,10 +4,15 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 	userModel "washit-api/internal/user/dto/model"
 	userRequest "washit-api/internal/user/dto/request"
+	userRepository "washit-api/internal/user/repository"
 	mocks "washit-api/internal/user/repository/mock"
 	auths "washit-api/pkg/auth"
+	mailerMocks "washit-api/pkg/mailer/mocks"
+	redisMocks "washit-api/pkg/redis/mocks"
+	jwt "washit-api/pkg/token"
 
 	"github.com/go-playground/validator"
 	"github.com/stretchr/testify/mock"
@@ -16,14 +21,18 @@ import (
 
 type UserServiceTestSuite struct {
 	suite.Suite
-	mockRepo *mocks.IUserRepository
-	service  IUserService
+	mockRepo   *mocks.IUserRepository
+	mockCache  *redisMocks.IRedis
+	mockMailer *mailerMocks.IMailer
+	service    IUserService
 }
 
 func (suite *UserServiceTestSuite) SetupTest() {
 	validator := validator.New()
 	suite.mockRepo = new(mocks.IUserRepository)
-	suite.service = NewUserService(suite.mockRepo, validator)
+	suite.mockCache = new(redisMocks.IRedis)
+	suite.mockMailer = new(mailerMocks.IMailer)
+	suite.service = NewUserService(suite.mockRepo, suite.mockCache, suite.mockMailer, validator)
 }
 
 func TestUserServiceTestSuite(t *testing.T) {
@@ -38,6 +47,8 @@ func (suite *UserServiceTestSuite) TestLoginGetUserByEmailFail() {
 		Email:    "test@test.com",
 		Password: "test123456",
 	}
+	suite.expectNoLoginFailures()
+	suite.expectLoginFailureRecorded()
 	suite.mockRepo.On("GetUserByEmail", mock.Anything, req.Email).
 		Return(nil, errors.New("error")).Times(1)
 
@@ -70,6 +81,8 @@ func (suite *UserServiceTestSuite) TestLoginWrongPassword() {
 	hashedPassword, err := auths.HashPassword("password")
 	suite.NoError(err)
 
+	suite.expectNoLoginFailures()
+	suite.expectLoginFailureRecorded()
 	suite.mockRepo.On("GetUserByEmail", mock.Anything, req.Email).
 		Return(&userModel.User{
 			Email:    "test@test.com",
@@ -87,10 +100,13 @@ func (suite *UserServiceTestSuite) TestLoginSuccess() {
 	req := &userRequest.Login{
 		Email:    "test@test.com",
 		Password: "test123456",
+		FcmToken: "fcm-1",
+		Device:   userRequest.Device{DeviceName: "Pixel", Platform: "android"},
 	}
 	hashedPassword, err := auths.HashPassword("test123456")
 	suite.NoError(err)
 
+	suite.expectNoLoginFailures()
 	suite.mockRepo.On("GetUserByEmail", mock.Anything, req.Email).
 		Return(
 			&userModel.User{
@@ -99,6 +115,13 @@ func (suite *UserServiceTestSuite) TestLoginSuccess() {
 			},
 			nil,
 		).Times(1)
+	suite.mockRepo.On("CreateSession", mock.Anything,
+		mock.MatchedBy(func(session *userModel.Session) bool {
+			return session.ID != "" && session.DeviceName == "Pixel" && session.FcmToken == "fcm-1"
+		}),
+		mock.MatchedBy(func(token *userModel.RefreshToken) bool {
+			return token.ID != "" && token.FamilyID != "" && token.ParentID == ""
+		})).Return(nil).Times(1)
 
 	user, accessToken, refreshToken, err := suite.service.Login(context.Background(), req)
 	suite.NotNil(user)
@@ -167,7 +190,7 @@ func (suite *UserServiceTestSuite) TestUpdateProfileSuccess() {
 	}
 
 	suite.mockRepo.On("GetUserByID", mock.Anything, mock.Anything).
-		Return(&userModel.User{}, nil).Times(1)
+		Return(&userModel.User{Email: "test@test.com"}, nil).Times(1)
 
 	suite.mockRepo.On("UpdateUser", mock.Anything, mock.Anything).
 		Return(nil).Times(1)
@@ -200,7 +223,7 @@ func (suite *UserServiceTestSuite) TestUpdateProfileUpdateUserFail() {
 	}
 
 	suite.mockRepo.On("GetUserByID", mock.Anything, mock.Anything).
-		Return(&userModel.User{}, nil).Times(1)
+		Return(&userModel.User{Email: "test@test.com"}, nil).Times(1)
 
 	suite.mockRepo.On("UpdateUser", mock.Anything, mock.Anything).
 		Return(errors.New("error")).Times(1)
@@ -283,3 +306,193 @@ func (suite *UserServiceTestSuite) TestUpdatePasswordWrongOldPassword() {
 	err = suite.service.UpdatePassword(context.Background(), "0", req)
 	suite.NotNil(err)
 }
+
+// Logout
+// =================================================================
+
+func (suite *UserServiceTestSuite) TestLogoutRevokesAccessAndRefreshToken() {
+	refreshToken, refresh, err := jwt.GenerateRefreshToken(map[string]interface{}{"id": "1"})
+	suite.NoError(err)
+
+	access := &jwt.Claims{ID: "access-jti", UserID: "1", ExpiresAt: time.Now().Add(time.Hour)}
+
+	suite.mockCache.On("SetWithExpiration", "token:revoked:access-jti", true,
+		mock.MatchedBy(func(ttl time.Duration) bool { return ttl > 0 && ttl <= time.Hour })).
+		Return(nil).Times(1)
+	suite.mockCache.On("SetWithExpiration", "token:revoked:"+refresh.ID, true, mock.Anything).
+		Return(nil).Times(1)
+
+	err = suite.service.Logout(context.Background(), access, &userRequest.Logout{RefreshToken: refreshToken})
+	suite.Nil(err)
+	suite.mockCache.AssertExpectations(suite.T())
+}
+
+func (suite *UserServiceTestSuite) TestLogoutRejectsForeignRefreshToken() {
+	refreshToken, _, err := jwt.GenerateRefreshToken(map[string]interface{}{"id": "2"})
+	suite.NoError(err)
+
+	access := &jwt.Claims{ID: "access-jti", UserID: "1", ExpiresAt: time.Now().Add(time.Hour)}
+	suite.mockCache.On("SetWithExpiration", "token:revoked:access-jti", true, mock.Anything).
+		Return(nil).Times(1)
+
+	err = suite.service.Logout(context.Background(), access, &userRequest.Logout{RefreshToken: refreshToken})
+	suite.NotNil(err)
+}
+
+func (suite *UserServiceTestSuite) TestLogoutAllBumpsTokenVersion() {
+	suite.mockRepo.On("GetUserByID", mock.Anything, "1").
+		Return(&userModel.User{ID: 1, TokenVersion: 3}, nil).Times(1)
+	suite.mockRepo.On("UpdateUser", mock.Anything, mock.MatchedBy(func(user *userModel.User) bool {
+		return user.TokenVersion == 4
+	})).Return(nil).Times(1)
+	suite.mockCache.On("Set", "token:version:1", int64(4)).
+		Return(nil).Times(1)
+	suite.mockRepo.On("RevokeRefreshTokensByUser", mock.Anything, "1").
+		Return(nil).Times(1)
+
+	err := suite.service.LogoutAll(context.Background(), "1")
+	suite.Nil(err)
+	suite.mockCache.AssertExpectations(suite.T())
+}
+
+// RefreshToken
+// =================================================================
+
+func (suite *UserServiceTestSuite) refreshClaims() *jwt.Claims {
+	_, claims, err := jwt.GenerateRefreshToken(map[string]interface{}{
+		"id": "1", "role": "customer", "fcm_token": "fcm-1", "fid": "family-1",
+	})
+	suite.NoError(err)
+	claims.UserID = "1"
+	claims.FamilyID = "family-1"
+	return claims
+}
+
+func (suite *UserServiceTestSuite) TestRefreshTokenRotatesWithinFamily() {
+	claims := suite.refreshClaims()
+	suite.mockRepo.On("GetRefreshTokenByID", mock.Anything, claims.ID).
+		Return(&userModel.RefreshToken{ID: claims.ID, FamilyID: "family-1", UserID: 1, DeviceName: "Pixel"}, nil).Times(1)
+	suite.mockRepo.On("GetUserByID", mock.Anything, "1").
+		Return(&userModel.User{ID: 1, Role: "customer"}, nil).Times(1)
+	suite.mockRepo.On("RotateRefreshToken", mock.Anything, claims.ID, mock.MatchedBy(func(token *userModel.RefreshToken) bool {
+		return token.FamilyID == "family-1" && token.ParentID == claims.ID && token.ID != claims.ID && token.DeviceName == "Pixel"
+	})).Return(nil).Times(1)
+
+	accessToken, refreshToken, err := suite.service.RefreshToken(context.Background(), claims, &userRequest.Device{})
+	suite.Nil(err)
+	suite.NotEmpty(refreshToken)
+
+	access, err := jwt.ParseToken(accessToken)
+	suite.NoError(err)
+	suite.Equal("fcm-1", access.Payload["fcm_token"])
+	suite.Equal("family-1", access.FamilyID)
+}
+
+func (suite *UserServiceTestSuite) TestRefreshTokenReuseRevokesFamily() {
+	claims := suite.refreshClaims()
+	rotatedAt := time.Now().Add(-time.Minute)
+	suite.mockRepo.On("GetRefreshTokenByID", mock.Anything, claims.ID).
+		Return(&userModel.RefreshToken{ID: claims.ID, FamilyID: "family-1", UserID: 1, RotatedAt: &rotatedAt}, nil).Times(1)
+	suite.mockRepo.On("RevokeRefreshTokenFamily", mock.Anything, "family-1").
+		Return(nil).Times(1)
+	suite.mockCache.On("SetWithExpiration", "token:family:family-1", true, mock.Anything).
+		Return(nil).Times(1)
+
+	accessToken, refreshToken, err := suite.service.RefreshToken(context.Background(), claims, &userRequest.Device{})
+	suite.ErrorIs(err, ErrRefreshTokenReused)
+	suite.Empty(accessToken)
+	suite.Empty(refreshToken)
+	suite.mockRepo.AssertExpectations(suite.T())
+	suite.mockCache.AssertExpectations(suite.T())
+}
+
+func (suite *UserServiceTestSuite) TestRefreshTokenConcurrentRotationRevokesFamily() {
+	claims := suite.refreshClaims()
+	suite.mockRepo.On("GetRefreshTokenByID", mock.Anything, claims.ID).
+		Return(&userModel.RefreshToken{ID: claims.ID, FamilyID: "family-1", UserID: 1}, nil).Times(1)
+	suite.mockRepo.On("GetUserByID", mock.Anything, "1").
+		Return(&userModel.User{ID: 1}, nil).Times(1)
+	suite.mockRepo.On("RotateRefreshToken", mock.Anything, claims.ID, mock.Anything).
+		Return(userRepository.ErrRefreshTokenRotated).Times(1)
+	suite.mockRepo.On("RevokeRefreshTokenFamily", mock.Anything, "family-1").
+		Return(nil).Times(1)
+	suite.mockCache.On("SetWithExpiration", "token:family:family-1", true, mock.Anything).
+		Return(nil).Times(1)
+
+	_, _, err := suite.service.RefreshToken(context.Background(), claims, &userRequest.Device{})
+	suite.ErrorIs(err, ErrRefreshTokenReused)
+}
+
+func (suite *UserServiceTestSuite) TestRefreshTokenRevoked() {
+	claims := suite.refreshClaims()
+	revokedAt := time.Now()
+	suite.mockRepo.On("GetRefreshTokenByID", mock.Anything, claims.ID).
+		Return(&userModel.RefreshToken{ID: claims.ID, FamilyID: "family-1", RevokedAt: &revokedAt}, nil).Times(1)
+
+	_, _, err := suite.service.RefreshToken(context.Background(), claims, &userRequest.Device{})
+	suite.ErrorIs(err, ErrRefreshTokenRevoked)
+}
+
+func (suite *UserServiceTestSuite) TestRefreshTokenOlderVersion() {
+	claims := suite.refreshClaims()
+	suite.mockRepo.On("GetRefreshTokenByID", mock.Anything, claims.ID).
+		Return(&userModel.RefreshToken{ID: claims.ID, FamilyID: "family-1", UserID: 1}, nil).Times(1)
+	suite.mockRepo.On("GetUserByID", mock.Anything, "1").
+		Return(&userModel.User{ID: 1, Role: "customer", TokenVersion: 2}, nil).Times(1)
+
+	_, _, err := suite.service.RefreshToken(context.Background(), claims, &userRequest.Device{})
+	suite.ErrorIs(err, ErrRefreshTokenRevoked)
+	suite.mockRepo.AssertNotCalled(suite.T(), "RotateRefreshToken", mock.Anything, mock.Anything, mock.Anything)
+}
+
+// Sessions
+// =================================================================
+
+func (suite *UserServiceTestSuite) TestGetSessionsOnlyActive() {
+	suite.mockRepo.On("GetSessionsByUser", mock.Anything, "1",
+		mock.MatchedBy(func(since time.Time) bool { return since.Before(time.Now().Add(-29 * 24 * time.Hour)) })).
+		Return([]*userModel.Session{{ID: "family-1", UserID: 1}}, nil).Times(1)
+
+	sessions, err := suite.service.GetSessions(context.Background(), "1")
+	suite.Nil(err)
+	suite.Len(sessions, 1)
+}
+
+func (suite *UserServiceTestSuite) TestRevokeSessionSuccess() {
+	suite.mockRepo.On("GetSessionByID", mock.Anything, "family-1").
+		Return(&userModel.Session{ID: "family-1", UserID: 1}, nil).Times(1)
+	suite.mockRepo.On("RevokeRefreshTokenFamily", mock.Anything, "family-1").
+		Return(nil).Times(1)
+	suite.mockCache.On("SetWithExpiration", "token:family:family-1", true, mock.Anything).
+		Return(nil).Times(1)
+
+	err := suite.service.RevokeSession(context.Background(), "1", "family-1")
+	suite.Nil(err)
+	suite.mockRepo.AssertExpectations(suite.T())
+	suite.mockCache.AssertExpectations(suite.T())
+}
+
+func (suite *UserServiceTestSuite) TestRevokeSessionOfOtherUser() {
+	suite.mockRepo.On("GetSessionByID", mock.Anything, "family-1").
+		Return(&userModel.Session{ID: "family-1", UserID: 2}, nil).Times(1)
+
+	err := suite.service.RevokeSession(context.Background(), "1", "family-1")
+	suite.NotNil(err)
+	suite.mockRepo.AssertNotCalled(suite.T(), "RevokeRefreshTokenFamily", mock.Anything, mock.Anything)
+}
+
+func (suite *UserServiceTestSuite) TestBanUserRevokesSessions() {
+	suite.mockRepo.On("GetUserByID", mock.Anything, "2").
+		Return(&userModel.User{ID: 2}, nil)
+	suite.mockRepo.On("UpdateUser", mock.Anything, mock.Anything).
+		Return(nil).Times(2)
+	suite.mockCache.On("Set", "token:version:2", int64(1)).
+		Return(nil).Times(1)
+	suite.mockRepo.On("RevokeRefreshTokensByUser", mock.Anything, "2").
+		Return(nil).Times(1)
+
+	user, err := suite.service.BanUser(context.Background(), "2")
+	suite.Nil(err)
+	suite.True(user.IsBanned)
+	suite.mockRepo.AssertExpectations(suite.T())
+}
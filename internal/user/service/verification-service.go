@@ -0,0 +1,152 @@
+package userService
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	userModel "washit-api/internal/user/dto/model"
+	userRequest "washit-api/internal/user/dto/request"
+	"washit-api/pkg/configs"
+	generate "washit-api/pkg/generator"
+	"washit-api/pkg/mailer"
+)
+
+const (
+	emailVerificationTokenKey = "email-verify:token:%s"
+	emailVerificationRateKey  = "email-verify:rate:%s"
+)
+
+var (
+	ErrInvalidVerificationToken = errors.New("invalid or expired verification token")
+	ErrEmailNotVerified         = errors.New("email is not verified")
+)
+
+// emailVerification is stored under the hashed token. Email pins the address
+// the link was sent to, so links for a superseded address stop working.
+type emailVerification struct {
+	UserID int64  `json:"userID"`
+	Email  string `json:"email"`
+}
+
+// VerifyEmail consumes a verification token. A token sent for a pending
+// email change also switches the account over to the new address.
+func (s *UserService) VerifyEmail(c context.Context, req *userRequest.VerifyEmail) (*userModel.User, error) {
+	if err := s.validator.Struct(req); err != nil {
+		log.Printf("Validation error for verify email request: %v", err)
+		return nil, fmt.Errorf("validation error: %v", err)
+	}
+
+	var claim emailVerification
+	if err := s.cache.GetDel(fmt.Sprintf(emailVerificationTokenKey, hashToken(req.Token)), &claim); err != nil {
+		log.Printf("Failed to consume verification token: %v", err)
+		return nil, ErrInvalidVerificationToken
+	}
+
+	user, err := s.repository.GetUserByID(c, strconv.FormatInt(claim.UserID, 10))
+	if err != nil {
+		log.Printf("Failed to get user by id: %v", err)
+		return nil, fmt.Errorf("user not found: %v", claim.UserID)
+	}
+
+	switch {
+	case user.PendingEmail != "" && claim.Email == user.PendingEmail:
+		if existing, err := s.repository.GetUserByEmail(c, claim.Email); err == nil && existing.ID != user.ID {
+			return nil, fmt.Errorf("user with email %s already exists", claim.Email)
+		}
+		user.Email = user.PendingEmail
+		user.PendingEmail = ""
+	case claim.Email != user.Email:
+		return nil, ErrInvalidVerificationToken
+	}
+
+	now := time.Now()
+	user.EmailVerifiedAt = &now
+
+	if err := s.repository.UpdateUser(c, user); err != nil {
+		log.Printf("Failed to verify email of user %d: %v", user.ID, err)
+		return nil, fmt.Errorf("failed to verify email: %v", err)
+	}
+
+	return user, nil
+}
+
+// ResendVerification sends a new link to the pending email, or to the
+// current one while it is unverified.
+func (s *UserService) ResendVerification(c context.Context, userID string) error {
+	user, err := s.repository.GetUserByID(c, userID)
+	if err != nil {
+		log.Printf("Failed to get user by id: %v", err)
+		return fmt.Errorf("user not found: %v", userID)
+	}
+
+	email := user.PendingEmail
+	if email == "" {
+		if user.EmailVerifiedAt != nil {
+			return fmt.Errorf("email is already verified")
+		}
+		email = user.Email
+	}
+
+	allowed, retryAfter, err := s.verifyLimiter.Allow(fmt.Sprintf(emailVerificationRateKey, userID))
+	if err != nil {
+		log.Printf("Failed to check verification rate limit: %v", err)
+		return fmt.Errorf("failed to resend verification email: %v", err)
+	}
+	if !allowed {
+		return &ErrTooManyRequests{RetryAfter: retryAfter}
+	}
+
+	return s.sendVerification(c, user, email)
+}
+
+// EnsureEmailVerified enforces the verified email policy. It is a no-op
+// unless REQUIRE_VERIFIED_EMAIL is enabled.
+func (s *UserService) EnsureEmailVerified(c context.Context, userID string) error {
+	if !s.requireVerifiedEmail {
+		return nil
+	}
+
+	user, err := s.repository.GetUserByID(c, userID)
+	if err != nil {
+		log.Printf("Failed to get user by id: %v", err)
+		return fmt.Errorf("user not found: %v", userID)
+	}
+
+	if user.EmailVerifiedAt == nil {
+		return ErrEmailNotVerified
+	}
+
+	return nil
+}
+
+func (s *UserService) sendVerification(c context.Context, user *userModel.User, email string) error {
+	token, err := generate.RandomToken(32)
+	if err != nil {
+		log.Printf("Failed to generate verification token: %v", err)
+		return fmt.Errorf("failed to generate verification token: %v", err)
+	}
+
+	claim := emailVerification{UserID: user.ID, Email: email}
+	key := fmt.Sprintf(emailVerificationTokenKey, hashToken(token))
+	if err := s.cache.SetWithExpiration(key, claim, configs.EmailVerificationTTL); err != nil {
+		log.Printf("Failed to store verification token: %v", err)
+		return fmt.Errorf("failed to store verification token: %v", err)
+	}
+
+	message := mailer.Message{
+		To:      email,
+		Subject: "Verify your WashIt email",
+		Body: fmt.Sprintf("Hi %s,\n\nConfirm your email address with the link below. It expires in %d hours.\n\n%s?token=%s",
+			user.FirstName, int(configs.EmailVerificationTTL.Hours()), configs.Envs.EmailVerifyURL, token),
+	}
+	if err := s.mailer.Send(c, message); err != nil {
+		log.Printf("Failed to send verification email: %v", err)
+		return fmt.Errorf("failed to send verification email: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,92 @@
+package userService
+
+import (
+	"context"
+	"errors"
+	"time"
+	userModel "washit-api/internal/user/dto/model"
+	userRequest "washit-api/internal/user/dto/request"
+	"washit-api/pkg/mailer"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// VerifyEmail
+// =================================================================
+
+func (suite *UserServiceTestSuite) TestVerifyEmailSwitchesPendingEmail() {
+	suite.mockCache.On("GetDel", "email-verify:token:"+hashToken("token"), mock.Anything).
+		Run(func(args mock.Arguments) {
+			*args.Get(1).(*emailVerification) = emailVerification{UserID: 1, Email: "new@test.com"}
+		}).
+		Return(nil).Times(1)
+	suite.mockRepo.On("GetUserByID", mock.Anything, "1").
+		Return(&userModel.User{ID: 1, Email: "old@test.com", PendingEmail: "new@test.com"}, nil).Times(1)
+	suite.mockRepo.On("GetUserByEmail", mock.Anything, "new@test.com").
+		Return(nil, errors.New("record not found")).Times(1)
+	suite.mockRepo.On("UpdateUser", mock.Anything, mock.Anything).
+		Return(nil).Times(1)
+
+	user, err := suite.service.VerifyEmail(context.Background(), &userRequest.VerifyEmail{Token: "token"})
+	suite.Nil(err)
+	suite.Equal("new@test.com", user.Email)
+	suite.Empty(user.PendingEmail)
+	suite.NotNil(user.EmailVerifiedAt)
+}
+
+func (suite *UserServiceTestSuite) TestVerifyEmailRejectsSupersededAddress() {
+	suite.mockCache.On("GetDel", "email-verify:token:"+hashToken("token"), mock.Anything).
+		Run(func(args mock.Arguments) {
+			*args.Get(1).(*emailVerification) = emailVerification{UserID: 1, Email: "older@test.com"}
+		}).
+		Return(nil).Times(1)
+	suite.mockRepo.On("GetUserByID", mock.Anything, "1").
+		Return(&userModel.User{ID: 1, Email: "old@test.com", PendingEmail: "new@test.com"}, nil).Times(1)
+
+	user, err := suite.service.VerifyEmail(context.Background(), &userRequest.VerifyEmail{Token: "token"})
+	suite.Nil(user)
+	suite.ErrorIs(err, ErrInvalidVerificationToken)
+	suite.mockRepo.AssertNotCalled(suite.T(), "UpdateUser", mock.Anything, mock.Anything)
+}
+
+// UpdateProfile
+// =================================================================
+
+func (suite *UserServiceTestSuite) TestUpdateProfileEmailChangeNeedsVerification() {
+	suite.mockRepo.On("GetUserByID", mock.Anything, "1").
+		Return(&userModel.User{ID: 1, Email: "old@test.com"}, nil).Times(1)
+	suite.mockRepo.On("GetUserByEmail", mock.Anything, "new@test.com").
+		Return(nil, errors.New("record not found")).Times(1)
+	suite.mockRepo.On("UpdateUser", mock.Anything, mock.Anything).
+		Return(nil).Times(1)
+	suite.mockCache.On("SetWithExpiration", mock.Anything,
+		emailVerification{UserID: 1, Email: "new@test.com"}, 24*time.Hour).
+		Return(nil).Times(1)
+	suite.mockMailer.On("Send", mock.Anything, mock.MatchedBy(func(message mailer.Message) bool {
+		return message.To == "new@test.com"
+	})).Return(nil).Times(1)
+
+	user, err := suite.service.UpdateProfile(context.Background(), "1", &userRequest.UpdateProfile{
+		FirstName: "John", LastName: "Doe", Email: "new@test.com",
+	})
+	suite.Nil(err)
+	suite.Equal("old@test.com", user.Email)
+	suite.Equal("new@test.com", user.PendingEmail)
+}
+
+// EnsureEmailVerified
+// =================================================================
+
+func (suite *UserServiceTestSuite) TestEnsureEmailVerifiedDisabledByDefault() {
+	suite.Nil(suite.service.EnsureEmailVerified(context.Background(), "1"))
+	suite.mockRepo.AssertNotCalled(suite.T(), "GetUserByID", mock.Anything, mock.Anything)
+}
+
+func (suite *UserServiceTestSuite) TestEnsureEmailVerifiedBlocksUnverified() {
+	suite.service.(*UserService).requireVerifiedEmail = true
+	suite.mockRepo.On("GetUserByID", mock.Anything, "1").
+		Return(&userModel.User{ID: 1}, nil).Times(1)
+
+	err := suite.service.EnsureEmailVerified(context.Background(), "1")
+	suite.ErrorIs(err, ErrEmailNotVerified)
+}
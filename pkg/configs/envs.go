@@ -10,9 +10,15 @@ import (
 )
 
 const (
-	ProductionEnv      = "production"
-	DatabaseTimeout    = 5 * time.Second
-	ProductCachingTime = 1 * time.Minute
+	ProductionEnv         = "production"
+	DatabaseTimeout       = 5 * time.Second
+	ProductCachingTime    = 1 * time.Minute
+	TransactionExpiry     = 24 * time.Hour
+	PasswordResetTTL      = 30 * time.Minute
+	EmailVerificationTTL  = 24 * time.Hour
+	TwoFactorChallengeTTL = 5 * time.Minute
+	LoginFailureWindow    = 15 * time.Minute
+	LoginLockoutDuration  = 15 * time.Minute
 )
 
 type Config struct {
@@ -28,6 +34,32 @@ type Config struct {
 	RedisPassword string
 	RedisDB       int
 	AuthSecret    string
+
+	PaymentProvider   string
+	FakePaymentSecret string
+	TaxRate           float64
+
+	MailDriver       string
+	SMTPHost         string
+	SMTPPort         string
+	SMTPUsername     string
+	SMTPPassword     string
+	MailFrom         string
+	MailDir          string
+	PasswordResetURL string
+	EmailVerifyURL   string
+
+	RequireVerifiedEmail bool
+	RequireAdmin2FA      bool
+
+	BusinessOpen       string
+	BusinessClose      string
+	BusinessClosedDays string
+	BusinessTimezone   string
+
+	EstimateLoadKg         float64
+	EstimateLoadHours      int
+	EstimateBacklogPerHour int
 }
 
 var Envs = initConfig()
@@ -53,6 +85,32 @@ func initConfig() Config {
 		RedisPassword: getEnv("REDIS_PASSWORD", ""),
 		RedisDB:       getEnvAsInt("REDIS_DB", 0),
 		AuthSecret:    getEnv("AUTH_SECRET", "secret"),
+
+		PaymentProvider:   getEnv("PAYMENT_PROVIDER", "fake"),
+		FakePaymentSecret: getEnv("PAYMENT_FAKE_SECRET", "secret"),
+		TaxRate:           getEnvAsFloat("TAX_RATE", 0),
+
+		MailDriver:       getEnv("MAIL_DRIVER", "log"),
+		SMTPHost:         getEnv("SMTP_HOST", "127.0.0.1"),
+		SMTPPort:         getEnv("SMTP_PORT", "587"),
+		SMTPUsername:     getEnv("SMTP_USERNAME", ""),
+		SMTPPassword:     getEnv("SMTP_PASSWORD", ""),
+		MailFrom:         getEnv("MAIL_FROM", "no-reply@washit.local"),
+		MailDir:          getEnv("MAIL_DIR", ""),
+		PasswordResetURL: getEnv("PASSWORD_RESET_URL", "http://localhost:3000/reset-password"),
+		EmailVerifyURL:   getEnv("EMAIL_VERIFY_URL", "http://localhost:3000/verify-email"),
+
+		RequireVerifiedEmail: getEnvAsBool("REQUIRE_VERIFIED_EMAIL", false),
+		RequireAdmin2FA:      getEnvAsBool("REQUIRE_ADMIN_2FA", false),
+
+		BusinessOpen:       getEnv("BUSINESS_OPEN", "08:00"),
+		BusinessClose:      getEnv("BUSINESS_CLOSE", "20:00"),
+		BusinessClosedDays: getEnv("BUSINESS_CLOSED_DAYS", "sunday"),
+		BusinessTimezone:   getEnv("BUSINESS_TIMEZONE", ""),
+
+		EstimateLoadKg:         getEnvAsFloat("ESTIMATE_LOAD_KG", 8),
+		EstimateLoadHours:      getEnvAsInt("ESTIMATE_LOAD_HOURS", 1),
+		EstimateBacklogPerHour: getEnvAsInt("ESTIMATE_BACKLOG_PER_HOUR", 10),
 	}
 }
 
@@ -76,3 +134,29 @@ func getEnvAsInt(key string, fallback int) int {
 
 	return fallback
 }
+
+func getEnvAsFloat(key string, fallback float64) float64 {
+	if value, ok := os.LookupEnv(key); ok {
+		f, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return fallback
+		}
+
+		return f
+	}
+
+	return fallback
+}
+
+func getEnvAsBool(key string, fallback bool) bool {
+	if value, ok := os.LookupEnv(key); ok {
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return fallback
+		}
+
+		return b
+	}
+
+	return fallback
+}
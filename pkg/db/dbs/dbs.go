@@ -15,7 +15,7 @@ const DatabaseTimeout = 5 * time.Second
 type IDatabase interface {
 	GetDB() *gorm.DB
 	AutoMigrate(models ...any) error
-	WithTransaction(function func() error) error
+	WithTransaction(function func(tx IDatabase) error) error
 	Create(ctx context.Context, doc any) error
 	CreateInBatches(ctx context.Context, docs any, batchSize int) error
 	Update(ctx context.Context, doc any) error
@@ -63,6 +63,11 @@ func NewDatabase(uri string) (*Database, error) {
 	}, nil
 }
 
+// Wrap uses an open gorm connection, e.g. one in DryRun mode.
+func Wrap(db *gorm.DB) *Database {
+	return &Database{db: db}
+}
+
 func (d *Database) AutoMigrate(models ...any) error {
 	return d.db.AutoMigrate(models...)
 }
@@ -75,22 +80,10 @@ func (d *Database) DropTable(models ...any) error {
 	return d.db.Migrator().DropTable(models...)
 }
 
-func (d *Database) WithTransaction(function func() error) error {
-	callback := func(_ *gorm.DB) error {
-		return function()
-	}
-
-	tx := d.db.Begin()
-	if err := callback(tx); err != nil {
-		tx.Rollback()
-		return err
-	}
-
-	if err := tx.Commit().Error; err != nil {
-		return err
-	}
-
-	return nil
+func (d *Database) WithTransaction(function func(tx IDatabase) error) error {
+	return d.db.Transaction(func(tx *gorm.DB) error {
+		return function(&Database{db: tx})
+	})
 }
 
 func (d *Database) Preload(query string, args ...interface{}) IDatabase {
@@ -191,7 +184,7 @@ func (d *Database) applyOptions(opts ...FindOption) *gorm.DB {
 
 	if opt.query != nil {
 		for _, q := range opt.query {
-			query = query.Where(q.Query, q.Args)
+			query = query.Where(q.Query, q.Args...)
 		}
 	}
 
@@ -1,10 +1,12 @@
 package generate
 
 import (
+	"encoding/base64"
 	"errors"
 	"fmt"
 	"math/big"
 	"os"
+	"path/filepath"
 	"time"
 
 	random "crypto/rand"
@@ -30,6 +32,33 @@ func RandomPassword() (string, error) {
 	return string(password), nil
 }
 
+// RandomToken returns size random bytes encoded as URL-safe base64.
+func RandomToken(size int) (string, error) {
+	token := make([]byte, size)
+	if _, err := random.Read(token); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(token), nil
+}
+
+// TagCode returns prefix and 8 random characters from an alphabet without
+// look-alikes such as 0/O and 1/I, so codes can be typed in from a label.
+func TagCode(prefix string) (string, error) {
+	const charset = "23456789ABCDEFGHJKLMNPQRSTUVWXYZ"
+	code := make([]byte, 8)
+
+	for i := range code {
+		index, err := random.Int(random.Reader, big.NewInt(int64(len(charset))))
+		if err != nil {
+			return "", err
+		}
+		code[i] = charset[index.Int64()]
+	}
+
+	return prefix + "-" + string(code), nil
+}
+
 func ImageFromUrl(imageUrl string) (imagePath string, err error) {
 	timeID := time.Now().UnixNano()
 	savePath := fmt.Sprintf("./public/profilePic/%d.jpg", timeID)
@@ -85,7 +114,7 @@ func AlphaNumericID(prefix string) (id string, err error) {
 }
 
 func SaveMediaToFile(imageData []byte, savePath string) error {
-	err := os.MkdirAll("media/pelaporan", os.ModePerm)
+	err := os.MkdirAll(filepath.Dir(savePath), os.ModePerm)
 	if err != nil {
 		return fmt.Errorf("failed to create directory: %w", err)
 	}
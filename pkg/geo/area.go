@@ -0,0 +1,213 @@
+package geo
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// Polygon is an outer ring followed by any holes cut out of it. Rings list
+// their vertices in order; repeating the first vertex at the end is optional.
+type Polygon [][]Point
+
+// Area is a service area made of one or more polygons.
+type Area []Polygon
+
+// Contains reports whether p lies inside any polygon of the area.
+func (a Area) Contains(p Point) bool {
+	for _, polygon := range a {
+		if polygon.Contains(p) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Contains reports whether p lies inside the outer ring and outside every
+// hole.
+func (polygon Polygon) Contains(p Point) bool {
+	if len(polygon) == 0 || !inRing(polygon[0], p) {
+		return false
+	}
+
+	for _, hole := range polygon[1:] {
+		if inRing(hole, p) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// inRing casts a ray from p towards increasing longitude and counts the
+// edges it crosses; an odd count means p is inside.
+func inRing(ring []Point, p Point) bool {
+	inside := false
+	for i, j := 0, len(ring)-1; i < len(ring); j, i = i, i+1 {
+		a, b := ring[i], ring[j]
+		if (a.Lat > p.Lat) != (b.Lat > p.Lat) &&
+			p.Lng < (b.Lng-a.Lng)*(p.Lat-a.Lat)/(b.Lat-a.Lat)+a.Lng {
+			inside = !inside
+		}
+	}
+
+	return inside
+}
+
+// GeoJSON is a GeoJSON document kept verbatim. It is stored as text and
+// encoded to JSON as the document itself rather than as a string.
+type GeoJSON []byte
+
+func (g GeoJSON) MarshalJSON() ([]byte, error) {
+	if len(g) == 0 {
+		return []byte("null"), nil
+	}
+
+	return g, nil
+}
+
+func (g *GeoJSON) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*g = nil
+		return nil
+	}
+
+	*g = append((*g)[:0], data...)
+	return nil
+}
+
+func (g GeoJSON) Value() (driver.Value, error) {
+	if len(g) == 0 {
+		return nil, nil
+	}
+
+	return string(g), nil
+}
+
+func (g *GeoJSON) Scan(value any) error {
+	switch v := value.(type) {
+	case nil:
+		*g = nil
+	case string:
+		*g = GeoJSON(v)
+	case []byte:
+		*g = append(GeoJSON(nil), v...)
+	default:
+		return fmt.Errorf("cannot scan %T into GeoJSON", value)
+	}
+
+	return nil
+}
+
+var ErrInvalidArea = errors.New("invalid service area")
+
+type geometry struct {
+	Type        string          `json:"type"`
+	Coordinates json.RawMessage `json:"coordinates"`
+	Geometry    *geometry       `json:"geometry"`
+	Features    []geometry      `json:"features"`
+}
+
+// ParseArea reads a GeoJSON Polygon or MultiPolygon, or a Feature or
+// FeatureCollection of them. Positions are [longitude, latitude] as the
+// GeoJSON specification orders them.
+func ParseArea(doc GeoJSON) (Area, error) {
+	var g geometry
+	if err := json.Unmarshal(doc, &g); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidArea, err)
+	}
+
+	area, err := g.area()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(area) == 0 {
+		return nil, fmt.Errorf("%w: no polygon", ErrInvalidArea)
+	}
+
+	return area, nil
+}
+
+func (g *geometry) area() (Area, error) {
+	switch g.Type {
+	case "Polygon":
+		var rings [][][2]float64
+		if err := json.Unmarshal(g.Coordinates, &rings); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidArea, err)
+		}
+
+		polygon, err := toPolygon(rings)
+		if err != nil {
+			return nil, err
+		}
+
+		return Area{polygon}, nil
+	case "MultiPolygon":
+		var polygons [][][][2]float64
+		if err := json.Unmarshal(g.Coordinates, &polygons); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidArea, err)
+		}
+
+		area := make(Area, 0, len(polygons))
+		for _, rings := range polygons {
+			polygon, err := toPolygon(rings)
+			if err != nil {
+				return nil, err
+			}
+			area = append(area, polygon)
+		}
+
+		return area, nil
+	case "Feature":
+		if g.Geometry == nil {
+			return nil, fmt.Errorf("%w: feature without geometry", ErrInvalidArea)
+		}
+
+		return g.Geometry.area()
+	case "FeatureCollection":
+		var area Area
+		for i := range g.Features {
+			part, err := g.Features[i].area()
+			if err != nil {
+				return nil, err
+			}
+			area = append(area, part...)
+		}
+
+		return area, nil
+	default:
+		return nil, fmt.Errorf("%w: unsupported type %q", ErrInvalidArea, g.Type)
+	}
+}
+
+func toPolygon(rings [][][2]float64) (Polygon, error) {
+	if len(rings) == 0 {
+		return nil, fmt.Errorf("%w: polygon without rings", ErrInvalidArea)
+	}
+
+	polygon := make(Polygon, 0, len(rings))
+	for _, positions := range rings {
+		ring := make([]Point, 0, len(positions))
+		for _, position := range positions {
+			p := Point{Lat: position[1], Lng: position[0]}
+			if p.Lat < -90 || p.Lat > 90 || p.Lng < -180 || p.Lng > 180 {
+				return nil, fmt.Errorf("%w: position %v out of range", ErrInvalidArea, position)
+			}
+			ring = append(ring, p)
+		}
+
+		if len(ring) > 1 && ring[0] == ring[len(ring)-1] {
+			ring = ring[:len(ring)-1]
+		}
+		if len(ring) < 3 {
+			return nil, fmt.Errorf("%w: a ring needs at least 3 positions", ErrInvalidArea)
+		}
+
+		polygon = append(polygon, ring)
+	}
+
+	return polygon, nil
+}
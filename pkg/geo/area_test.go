@@ -0,0 +1,92 @@
+package geo
+
+import (
+	"errors"
+	"testing"
+)
+
+// square is 0..10 in both axes with a 4..6 hole in the middle.
+var square = GeoJSON(`{
+	"type": "Polygon",
+	"coordinates": [
+		[[0, 0], [10, 0], [10, 10], [0, 10], [0, 0]],
+		[[4, 4], [6, 4], [6, 6], [4, 6], [4, 4]]
+	]
+}`)
+
+func TestParseAreaPolygon(t *testing.T) {
+	area, err := ParseArea(square)
+	if err != nil {
+		t.Fatalf("ParseArea: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		p    Point
+		want bool
+	}{
+		{"inside", Point{Lat: 2, Lng: 2}, true},
+		{"in the hole", Point{Lat: 5, Lng: 5}, false},
+		{"outside", Point{Lat: 5, Lng: 11}, false},
+		{"south", Point{Lat: -1, Lng: 5}, false},
+	}
+	for _, tt := range tests {
+		if got := area.Contains(tt.p); got != tt.want {
+			t.Errorf("%s: Contains(%v) = %v, want %v", tt.name, tt.p, got, tt.want)
+		}
+	}
+}
+
+func TestParseAreaFeatureCollection(t *testing.T) {
+	doc := GeoJSON(`{
+		"type": "FeatureCollection",
+		"features": [
+			{"type": "Feature", "geometry": {"type": "Polygon", "coordinates": [[[0, 0], [1, 0], [1, 1]]]}},
+			{"type": "Feature", "geometry": {"type": "MultiPolygon", "coordinates": [
+				[[[20, 20], [21, 20], [21, 21], [20, 21]]]
+			]}}
+		]
+	}`)
+
+	area, err := ParseArea(doc)
+	if err != nil {
+		t.Fatalf("ParseArea: %v", err)
+	}
+
+	if len(area) != 2 {
+		t.Fatalf("got %d polygons, want 2", len(area))
+	}
+	if !area.Contains(Point{Lat: 20.5, Lng: 20.5}) {
+		t.Error("point in the second feature should be inside")
+	}
+	if area.Contains(Point{Lat: 0.9, Lng: 0.1}) {
+		t.Error("point across the triangle's diagonal should be outside")
+	}
+}
+
+func TestParseAreaInvalid(t *testing.T) {
+	docs := []string{
+		`not json`,
+		`{"type": "Point", "coordinates": [1, 2]}`,
+		`{"type": "Polygon", "coordinates": [[[0, 0], [1, 1], [0, 0]]]}`,
+		`{"type": "Polygon", "coordinates": [[[0, 0], [200, 0], [0, 1]]]}`,
+		`{"type": "FeatureCollection", "features": []}`,
+	}
+
+	for _, doc := range docs {
+		if _, err := ParseArea(GeoJSON(doc)); !errors.Is(err, ErrInvalidArea) {
+			t.Errorf("ParseArea(%s) error = %v, want ErrInvalidArea", doc, err)
+		}
+	}
+}
+
+func TestGeoJSONMarshal(t *testing.T) {
+	var empty GeoJSON
+	if b, _ := empty.MarshalJSON(); string(b) != "null" {
+		t.Errorf("empty GeoJSON marshals to %s, want null", b)
+	}
+
+	if b, _ := square.MarshalJSON(); string(b) != string(square) {
+		t.Error("GeoJSON should marshal to the document itself")
+	}
+}
@@ -0,0 +1,34 @@
+package geo
+
+import "math"
+
+// EarthRadiusKm is the mean radius of the earth used for distances.
+const EarthRadiusKm = 6371.0
+
+// Point is a position in decimal degrees.
+type Point struct {
+	Lat float64
+	Lng float64
+}
+
+// IsZero reports whether p was never set. Addresses saved without a location
+// have both coordinates at zero.
+func (p Point) IsZero() bool {
+	return p.Lat == 0 && p.Lng == 0
+}
+
+// Distance returns the great-circle distance between a and b in kilometres.
+func Distance(a, b Point) float64 {
+	lat1, lat2 := radians(a.Lat), radians(b.Lat)
+	dLat := lat2 - lat1
+	dLng := radians(b.Lng - a.Lng)
+
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLng/2)*math.Sin(dLng/2)
+
+	return 2 * EarthRadiusKm * math.Asin(math.Min(1, math.Sqrt(h)))
+}
+
+func radians(deg float64) float64 {
+	return deg * math.Pi / 180
+}
@@ -0,0 +1,29 @@
+package geo
+
+import (
+	"math"
+	"testing"
+)
+
+func TestDistance(t *testing.T) {
+	jakarta := Point{Lat: -6.2088, Lng: 106.8456}
+	bandung := Point{Lat: -6.9175, Lng: 107.6191}
+
+	got := Distance(jakarta, bandung)
+	if math.Abs(got-116) > 2 {
+		t.Fatalf("Distance(jakarta, bandung) = %.1f km, want about 116 km", got)
+	}
+
+	if d := Distance(jakarta, jakarta); d != 0 {
+		t.Fatalf("Distance to itself = %v, want 0", d)
+	}
+}
+
+func TestPointIsZero(t *testing.T) {
+	if !(Point{}).IsZero() {
+		t.Fatal("zero point should be zero")
+	}
+	if (Point{Lat: 0, Lng: 1}).IsZero() {
+		t.Fatal("point with a longitude should not be zero")
+	}
+}
@@ -0,0 +1,86 @@
+package ical
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	dateLayout     = "20060102"
+	dateTimeLayout = "20060102T150405Z"
+	lineLimit      = 75
+)
+
+// Event is an all-day VEVENT spanning Start to End inclusive.
+type Event struct {
+	UID     string
+	Summary string
+	Start   time.Time
+	End     time.Time
+	// Yearly repeats the event every year on the same date.
+	Yearly  bool
+	Updated time.Time
+}
+
+// Encode writes events as an RFC 5545 calendar named name.
+func Encode(name string, events []Event) []byte {
+	var buf bytes.Buffer
+
+	writeLine(&buf, "BEGIN:VCALENDAR")
+	writeLine(&buf, "VERSION:2.0")
+	writeLine(&buf, "PRODID:-//Washit//Business Calendar//EN")
+	writeLine(&buf, "CALSCALE:GREGORIAN")
+	writeLine(&buf, "X-WR-CALNAME:"+escape(name))
+
+	for _, event := range events {
+		writeLine(&buf, "BEGIN:VEVENT")
+		writeLine(&buf, "UID:"+escape(event.UID))
+		writeLine(&buf, "DTSTAMP:"+event.Updated.UTC().Format(dateTimeLayout))
+		writeLine(&buf, "DTSTART;VALUE=DATE:"+event.Start.Format(dateLayout))
+		// DTEND of an all-day event is exclusive.
+		writeLine(&buf, "DTEND;VALUE=DATE:"+event.End.AddDate(0, 0, 1).Format(dateLayout))
+		if event.Yearly {
+			writeLine(&buf, "RRULE:FREQ=YEARLY")
+		}
+		writeLine(&buf, "SUMMARY:"+escape(event.Summary))
+		writeLine(&buf, "TRANSP:TRANSPARENT")
+		writeLine(&buf, "END:VEVENT")
+	}
+
+	writeLine(&buf, "END:VCALENDAR")
+
+	return buf.Bytes()
+}
+
+func escape(text string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+	).Replace(text)
+}
+
+// writeLine ends line with CRLF, folding it so no line exceeds 75 octets
+// without splitting a UTF-8 character.
+func writeLine(buf *bytes.Buffer, line string) {
+	limit := lineLimit
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !isRuneStart(line[cut]) {
+			cut--
+		}
+		fmt.Fprintf(buf, "%s\r\n ", line[:cut])
+		line = line[cut:]
+		// Continuation lines start with a space that counts towards the limit.
+		limit = lineLimit - 1
+	}
+	buf.WriteString(line + "\r\n")
+}
+
+func isRuneStart(b byte) bool {
+	return b&0xC0 != 0x80
+}
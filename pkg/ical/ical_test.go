@@ -0,0 +1,55 @@
+package ical
+
+import (
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+)
+
+func TestEncodeAllDayEvents(t *testing.T) {
+	updated := time.Date(2026, time.October, 1, 12, 0, 0, 0, time.UTC)
+	out := string(Encode("Closures", []Event{
+		{
+			UID:     "closure-1@washit",
+			Summary: "Renovation; back soon, promise",
+			Start:   time.Date(2026, time.December, 30, 0, 0, 0, 0, time.UTC),
+			End:     time.Date(2026, time.December, 31, 0, 0, 0, 0, time.UTC),
+			Updated: updated,
+		},
+		{
+			UID:     "holiday-2@washit",
+			Summary: "Christmas",
+			Start:   time.Date(2026, time.December, 25, 0, 0, 0, 0, time.UTC),
+			End:     time.Date(2026, time.December, 25, 0, 0, 0, 0, time.UTC),
+			Yearly:  true,
+			Updated: updated,
+		},
+	}))
+
+	for _, want := range []string{
+		"BEGIN:VCALENDAR\r\n",
+		"DTSTAMP:20261001T120000Z\r\n",
+		"DTSTART;VALUE=DATE:20261230\r\nDTEND;VALUE=DATE:20270101\r\n",
+		`SUMMARY:Renovation\; back soon\, promise` + "\r\n",
+		"DTEND;VALUE=DATE:20261226\r\nRRULE:FREQ=YEARLY\r\n",
+		"END:VCALENDAR\r\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("calendar is missing %q:\n%s", want, out)
+		}
+	}
+}
+
+func TestWriteLineFoldsLongLines(t *testing.T) {
+	out := string(Encode(strings.Repeat("é", 60), nil))
+
+	for _, line := range strings.Split(strings.TrimSuffix(out, "\r\n"), "\r\n") {
+		if len(line) > lineLimit {
+			t.Errorf("line of %d octets exceeds the limit: %q", len(line), line)
+		}
+		if !utf8.ValidString(line) {
+			t.Errorf("line splits a character: %q", line)
+		}
+	}
+}
@@ -0,0 +1,201 @@
+package label
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/draw"
+	"image/png"
+
+	"github.com/boombuler/barcode"
+	"github.com/boombuler/barcode/code128"
+	"github.com/boombuler/barcode/qr"
+	"github.com/go-pdf/fpdf"
+)
+
+// Symbology is the kind of barcode printed on a label.
+type Symbology string
+
+const (
+	Code128 Symbology = "code128"
+	QR      Symbology = "qr"
+)
+
+const (
+	// barModule and qrModule are the pixels per barcode module, about 0.25 mm
+	// at the 203 dpi of common thermal label printers.
+	barModule = 2
+	qrModule  = 4
+	barHeight = 80
+	quietZone = 20
+	textScale = 2
+
+	// Labels are printed one per page on 62 x 40 mm stock.
+	pageWidth  = 62.0
+	pageHeight = 40.0
+	pageMargin = 3.0
+)
+
+var ErrSymbology = errors.New("unknown barcode symbology")
+
+// Label is one printed tag. Code is what the barcode encodes; Title and Lines
+// are printed beside it on PDF labels.
+type Label struct {
+	Code  string
+	Title string
+	Lines []string
+}
+
+// Barcode encodes code in symbology at printing size.
+func Barcode(code string, symbology Symbology) (image.Image, error) {
+	switch symbology {
+	case Code128:
+		bc, err := code128.Encode(code)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode code128: %w", err)
+		}
+		return barcode.Scale(bc, bc.Bounds().Dx()*barModule, barHeight)
+	case QR:
+		bc, err := qr.Encode(code, qr.M, qr.Auto)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode qr: %w", err)
+		}
+		return barcode.Scale(bc, bc.Bounds().Dx()*qrModule, bc.Bounds().Dy()*qrModule)
+	}
+
+	return nil, fmt.Errorf("%w: %s", ErrSymbology, symbology)
+}
+
+// Image renders the barcode of code inside its quiet zone with the code
+// printed underneath, so a tag can still be typed in when it won't scan.
+func Image(code string, symbology Symbology) (*image.Gray, error) {
+	bc, err := Barcode(code, symbology)
+	if err != nil {
+		return nil, err
+	}
+
+	codeWidth, codeHeight := bc.Bounds().Dx(), bc.Bounds().Dy()
+	textWidth, textHeight := textSize(code, textScale)
+	width := max(codeWidth, textWidth) + 2*quietZone
+	height := quietZone + codeHeight + quietZone/2 + textHeight + quietZone
+
+	img := image.NewGray(image.Rect(0, 0, width, height))
+	draw.Draw(img, img.Bounds(), image.White, image.Point{}, draw.Src)
+
+	left := (width - codeWidth) / 2
+	draw.Draw(img, image.Rect(left, quietZone, left+codeWidth, quietZone+codeHeight), bc, bc.Bounds().Min, draw.Src)
+	drawText(img, code, (width-textWidth)/2, quietZone+codeHeight+quietZone/2, textScale)
+
+	return img, nil
+}
+
+// PNG renders labels one under another on a single sheet.
+func PNG(labels []Label, symbology Symbology) ([]byte, error) {
+	if len(labels) == 0 {
+		return nil, errors.New("no labels to render")
+	}
+
+	images := make([]*image.Gray, 0, len(labels))
+	width, height := 0, 0
+	for _, label := range labels {
+		img, err := Image(label.Code, symbology)
+		if err != nil {
+			return nil, err
+		}
+		images = append(images, img)
+		width = max(width, img.Bounds().Dx())
+		height += img.Bounds().Dy()
+	}
+
+	sheet := image.NewGray(image.Rect(0, 0, width, height))
+	draw.Draw(sheet, sheet.Bounds(), image.White, image.Point{}, draw.Src)
+
+	top := 0
+	for _, img := range images {
+		draw.Draw(sheet, img.Bounds().Add(image.Pt(0, top)), img, image.Point{}, draw.Src)
+		top += img.Bounds().Dy()
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, sheet); err != nil {
+		return nil, fmt.Errorf("failed to encode png: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// PDF renders one label per page with its title and lines. Code128 labels
+// print the text above a full width barcode, QR labels beside the code.
+func PDF(labels []Label, symbology Symbology) ([]byte, error) {
+	if len(labels) == 0 {
+		return nil, errors.New("no labels to render")
+	}
+
+	pdf := fpdf.NewCustom(&fpdf.InitType{
+		UnitStr: "mm",
+		Size:    fpdf.SizeType{Wd: pageWidth, Ht: pageHeight},
+	})
+	pdf.SetMargins(pageMargin, pageMargin, pageMargin)
+	pdf.SetAutoPageBreak(false, 0)
+	translate := pdf.UnicodeTranslatorFromDescriptor("")
+
+	textWidth := pageWidth - 2*pageMargin
+	barX, barY, barW, barH := pageMargin, 17.0, textWidth, pageHeight-17.0-pageMargin
+	if symbology == QR {
+		textWidth = 28.0
+		barX, barY, barW, barH = pageMargin+textWidth+1, pageMargin, pageWidth-textWidth-1-2*pageMargin, pageHeight-2*pageMargin
+	}
+
+	for i, label := range labels {
+		img, err := Image(label.Code, symbology)
+		if err != nil {
+			return nil, err
+		}
+
+		var buf bytes.Buffer
+		if err := png.Encode(&buf, img); err != nil {
+			return nil, fmt.Errorf("failed to encode png: %w", err)
+		}
+
+		name := fmt.Sprintf("label-%d", i)
+		options := fpdf.ImageOptions{ImageType: "PNG"}
+		pdf.RegisterImageOptionsReader(name, options, &buf)
+
+		pdf.AddPage()
+		pdf.SetXY(pageMargin, pageMargin)
+		pdf.SetFont("Helvetica", "B", 9)
+		pdf.CellFormat(textWidth, 4.5, fit(pdf, translate(label.Title), textWidth), "", 2, "L", false, 0, "")
+		pdf.SetFont("Helvetica", "", 7)
+		for _, line := range label.Lines {
+			pdf.CellFormat(textWidth, 3.5, fit(pdf, translate(line), textWidth), "", 2, "L", false, 0, "")
+		}
+
+		// Keep the aspect ratio of the barcode and centre it in its box.
+		bounds := img.Bounds()
+		scale := min(barW/float64(bounds.Dx()), barH/float64(bounds.Dy()))
+		w, h := float64(bounds.Dx())*scale, float64(bounds.Dy())*scale
+		pdf.ImageOptions(name, barX+(barW-w)/2, barY+(barH-h)/2, w, h, false, options, 0, "")
+	}
+
+	var out bytes.Buffer
+	if err := pdf.Output(&out); err != nil {
+		return nil, fmt.Errorf("failed to render pdf: %w", err)
+	}
+
+	return out.Bytes(), nil
+}
+
+// fit shortens text, already translated to the single byte encoding of the
+// core fonts, with an ellipsis until it fits in width at the current font.
+func fit(pdf *fpdf.Fpdf, text string, width float64) string {
+	if pdf.GetStringWidth(text) <= width {
+		return text
+	}
+
+	for len(text) > 0 && pdf.GetStringWidth(text+"...") > width {
+		text = text[:len(text)-1]
+	}
+
+	return text + "..."
+}
@@ -0,0 +1,117 @@
+package label
+
+import (
+	"bytes"
+	"errors"
+	"image/png"
+	"strings"
+	"testing"
+)
+
+// modules reads the middle row of a Code128 image as a string of bars (1)
+// and spaces (0), one character per module.
+func modules(t *testing.T, code string) string {
+	t.Helper()
+
+	img, err := Barcode(code, Code128)
+	if err != nil {
+		t.Fatalf("Barcode: %v", err)
+	}
+
+	var row strings.Builder
+	y := img.Bounds().Dy() / 2
+	for x := img.Bounds().Min.X; x < img.Bounds().Max.X; x += barModule {
+		r, _, _, _ := img.At(x, y).RGBA()
+		if r == 0 {
+			row.WriteByte('1')
+		} else {
+			row.WriteByte('0')
+		}
+	}
+
+	return row.String()
+}
+
+func TestBarcodeCode128Patterns(t *testing.T) {
+	row := modules(t, "BAG-7KQ4X2HM")
+
+	// Start B, then 12 symbols and the check symbol of 11 modules each,
+	// then the 13 module stop pattern.
+	if !strings.HasPrefix(row, "11010010000") {
+		t.Errorf("row starts with %s, want start code B", row[:11])
+	}
+	if !strings.HasSuffix(row, "1100011101011") {
+		t.Errorf("row ends with %s, want the stop pattern", row[len(row)-13:])
+	}
+	if len(row) != 11+12*11+11+13 {
+		t.Errorf("got %d modules, want %d", len(row), 11+12*11+11+13)
+	}
+}
+
+func TestBarcodeUnknownSymbology(t *testing.T) {
+	if _, err := Barcode("BAG-7KQ4X2HM", "ean13"); !errors.Is(err, ErrSymbology) {
+		t.Errorf("got %v, want ErrSymbology", err)
+	}
+}
+
+func TestImagePrintsCode(t *testing.T) {
+	img, err := Image("GMT-23456789", QR)
+	if err != nil {
+		t.Fatalf("Image: %v", err)
+	}
+
+	// The code is printed in the band below the barcode.
+	bc, _ := Barcode("GMT-23456789", QR)
+	top := quietZone + bc.Bounds().Dy() + quietZone/2
+	_, textHeight := textSize("GMT-23456789", textScale)
+
+	dark := 0
+	for y := top; y < top+textHeight; y++ {
+		for x := 0; x < img.Bounds().Dx(); x++ {
+			if img.GrayAt(x, y).Y == 0 {
+				dark++
+			}
+		}
+	}
+	if dark == 0 {
+		t.Error("no text printed under the barcode")
+	}
+}
+
+func TestPNGStacksLabels(t *testing.T) {
+	labels := []Label{{Code: "BAG-7KQ4X2HM"}, {Code: "GMT-23456789"}, {Code: "GMT-ABCDEFGH"}}
+	data, err := PNG(labels, Code128)
+	if err != nil {
+		t.Fatalf("PNG: %v", err)
+	}
+
+	sheet, err := png.Decode(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+
+	one, _ := Image("BAG-7KQ4X2HM", Code128)
+	if got, want := sheet.Bounds().Dy(), 3*one.Bounds().Dy(); got != want {
+		t.Errorf("sheet is %d px high, want %d", got, want)
+	}
+}
+
+func TestPDFOnePagePerLabel(t *testing.T) {
+	labels := []Label{
+		{Code: "BAG-7KQ4X2HM", Title: "ORD-a1B2c3D4e5", Lines: []string{"Zoë Hartono", "dry_clean / express", "3 items"}},
+		{Code: "GMT-23456789", Title: "ORD-a1B2c3D4e5 1/3", Lines: []string{"2x shirt, navy, with a very long brand name that does not fit"}},
+	}
+
+	for _, symbology := range []Symbology{Code128, QR} {
+		data, err := PDF(labels, symbology)
+		if err != nil {
+			t.Fatalf("PDF %s: %v", symbology, err)
+		}
+		if !bytes.HasPrefix(data, []byte("%PDF-")) {
+			t.Errorf("PDF %s does not start with a PDF header", symbology)
+		}
+		if pages := bytes.Count(data, []byte("/Type /Page\n")); pages != len(labels) {
+			t.Errorf("PDF %s has %d pages, want %d", symbology, pages, len(labels))
+		}
+	}
+}
@@ -0,0 +1,34 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// logMailer is meant for development. It writes every message to a .eml
+// file in dir, or to the application log when no dir is configured.
+type logMailer struct {
+	dir string
+}
+
+func NewLogMailer(dir string) IMailer {
+	return &logMailer{dir: dir}
+}
+
+func (m *logMailer) Send(ctx context.Context, message Message) error {
+	if m.dir == "" {
+		log.Printf("Mail to %s: %s\n%s", message.To, message.Subject, message.Body)
+		return nil
+	}
+
+	if err := os.MkdirAll(m.dir, 0o755); err != nil {
+		return err
+	}
+
+	name := fmt.Sprintf("%d-%s.eml", time.Now().UnixNano(), message.To)
+	return os.WriteFile(filepath.Join(m.dir, name), []byte(message.String()), 0o644)
+}
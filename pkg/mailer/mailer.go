@@ -0,0 +1,66 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+
+	"washit-api/pkg/configs"
+)
+
+const (
+	DriverSMTP = "smtp"
+	DriverLog  = "log"
+)
+
+// IMailer delivers transactional emails.
+//
+//go:generate mockery --name=IMailer
+type IMailer interface {
+	Send(ctx context.Context, message Message) error
+}
+
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Config mailer
+type Config struct {
+	Driver   string
+	Host     string
+	Port     string
+	Username string
+	Password string
+	From     string
+	Dir      string
+}
+
+// New mailer for the configured driver. Unknown drivers fall back to the log
+// mailer so development setups never send real emails by accident.
+func New(config Config) IMailer {
+	switch config.Driver {
+	case DriverSMTP:
+		return NewSMTPMailer(config)
+	default:
+		return NewLogMailer(config.Dir)
+	}
+}
+
+// FromEnvs creates the mailer configured through the MAIL_* and SMTP_* envs.
+func FromEnvs() IMailer {
+	return New(Config{
+		Driver:   configs.Envs.MailDriver,
+		Host:     configs.Envs.SMTPHost,
+		Port:     configs.Envs.SMTPPort,
+		Username: configs.Envs.SMTPUsername,
+		Password: configs.Envs.SMTPPassword,
+		From:     configs.Envs.MailFrom,
+		Dir:      configs.Envs.MailDir,
+	})
+}
+
+func (m Message) String() string {
+	return fmt.Sprintf("To: %s\r\nSubject: %s\r\nContent-Type: text/plain; charset=UTF-8\r\n\r\n%s\r\n",
+		m.To, m.Subject, m.Body)
+}
@@ -0,0 +1,47 @@
+// Code generated by mockery v2.50.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	mailer "washit-api/pkg/mailer"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// IMailer is an autogenerated mock type for the IMailer type
+type IMailer struct {
+	mock.Mock
+}
+
+// Send provides a mock function with given fields: ctx, message
+func (_m *IMailer) Send(ctx context.Context, message mailer.Message) error {
+	ret := _m.Called(ctx, message)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Send")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, mailer.Message) error); ok {
+		r0 = rf(ctx, message)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewIMailer creates a new instance of IMailer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIMailer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IMailer {
+	mock := &IMailer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,38 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/smtp"
+)
+
+type smtpMailer struct {
+	config Config
+}
+
+func NewSMTPMailer(config Config) IMailer {
+	return &smtpMailer{config: config}
+}
+
+func (m *smtpMailer) Send(ctx context.Context, message Message) error {
+	var auth smtp.Auth
+	if m.config.Username != "" {
+		auth = smtp.PlainAuth("", m.config.Username, m.config.Password, m.config.Host)
+	}
+
+	body := fmt.Sprintf("From: %s\r\n%s", m.config.From, message.String())
+	address := net.JoinHostPort(m.config.Host, m.config.Port)
+
+	done := make(chan error, 1)
+	go func() {
+		done <- smtp.SendMail(address, auth, m.config.From, []string{message.To}, []byte(body))
+	}()
+
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,90 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+
+	"washit-api/pkg/configs"
+	"washit-api/pkg/rbac"
+	redisMocks "washit-api/pkg/redis/mocks"
+	jwt "washit-api/pkg/token"
+)
+
+// serve runs a request with an access token for role through handler and
+// returns the status code.
+func serve(t *testing.T, handler gin.HandlerFunc, role string, mfa bool) int {
+	t.Helper()
+
+	token, err := jwt.GenerateAccessToken(map[string]interface{}{"id": "1", "role": role, "mfa": mfa})
+	if err != nil {
+		t.Fatalf("GenerateAccessToken: %v", err)
+	}
+
+	gin.SetMode(gin.TestMode)
+	engine := gin.New()
+	engine.GET("/", handler, func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	rec := httptest.NewRecorder()
+	engine.ServeHTTP(rec, req)
+
+	return rec.Code
+}
+
+func cache() *redisMocks.IRedis {
+	cache := new(redisMocks.IRedis)
+	cache.On("Get", mock.Anything, mock.Anything).Return(errors.New("cache miss"))
+	return cache
+}
+
+func requireAdmin2FA(t *testing.T, required bool) {
+	t.Helper()
+
+	previous := configs.Envs.RequireAdmin2FA
+	configs.Envs.RequireAdmin2FA = required
+	t.Cleanup(func() { configs.Envs.RequireAdmin2FA = previous })
+}
+
+func TestJWTAuthRequiresAdminSecondFactor(t *testing.T) {
+	requireAdmin2FA(t, true)
+
+	if code := serve(t, JWTAuth(cache()), rbac.RoleAdmin, false); code != http.StatusForbidden {
+		t.Errorf("admin without mfa got %d, want %d", code, http.StatusForbidden)
+	}
+	if code := serve(t, JWTAuth(cache()), rbac.RoleAdmin, true); code != http.StatusOK {
+		t.Errorf("admin with mfa got %d, want %d", code, http.StatusOK)
+	}
+	if code := serve(t, JWTAuth(cache()), rbac.RoleCustomer, false); code != http.StatusOK {
+		t.Errorf("customer got %d, want %d", code, http.StatusOK)
+	}
+}
+
+func TestJWTPermissionRequiresAdminSecondFactor(t *testing.T) {
+	requireAdmin2FA(t, true)
+
+	if code := serve(t, JWTPermission(cache(), rbac.OrderReadAll), rbac.RoleAdmin, false); code != http.StatusForbidden {
+		t.Errorf("admin without mfa got %d, want %d", code, http.StatusForbidden)
+	}
+}
+
+func TestJWTEnrollmentAllowsAdminWithoutSecondFactor(t *testing.T) {
+	requireAdmin2FA(t, true)
+
+	if code := serve(t, JWTEnrollment(cache()), rbac.RoleAdmin, false); code != http.StatusOK {
+		t.Errorf("admin without mfa got %d, want %d", code, http.StatusOK)
+	}
+}
+
+func TestJWTAuthWithoutRequirement(t *testing.T) {
+	requireAdmin2FA(t, false)
+
+	if code := serve(t, JWTAuth(cache()), rbac.RoleAdmin, false); code != http.StatusOK {
+		t.Errorf("admin without mfa got %d, want %d", code, http.StatusOK)
+	}
+}
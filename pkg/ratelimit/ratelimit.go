@@ -0,0 +1,94 @@
+package ratelimit
+
+import (
+	"time"
+
+	"washit-api/pkg/redis"
+)
+
+// Window is a sliding window log stored in redis: the key holds the times of
+// the attempts made within the window. Attempts are counted atomically in
+// redis, so concurrent callers cannot slip past the limit.
+type Window struct {
+	cache  redis.IRedis
+	limit  int
+	window time.Duration
+}
+
+func New(cache redis.IRedis, limit int, window time.Duration) *Window {
+	return &Window{
+		cache:  cache,
+		limit:  limit,
+		window: window,
+	}
+}
+
+// Allow records an attempt for key unless the limit is already reached, in
+// which case it returns false and how long until the oldest attempt leaves
+// the window.
+func (w *Window) Allow(key string) (bool, time.Duration, error) {
+	now := time.Now()
+	attempts, added, err := w.cache.WindowAdd(key, now, w.limit, w.window)
+	if err != nil {
+		return false, 0, err
+	}
+
+	if !added {
+		return false, w.retryAfter(attempts, now), nil
+	}
+
+	return true, 0, nil
+}
+
+// Record adds an attempt for key regardless of the limit and returns the
+// attempts now within the window, oldest first.
+func (w *Window) Record(key string) ([]time.Time, error) {
+	attempts, _, err := w.cache.WindowAdd(key, time.Now(), 0, w.window)
+	if err != nil {
+		return nil, err
+	}
+
+	return attempts, nil
+}
+
+// Attempts returns the attempts for key still within the window, oldest
+// first.
+func (w *Window) Attempts(key string) []time.Time {
+	return w.recent(key, time.Now())
+}
+
+// Exceeded reports whether the limit for key is reached and, if so, how long
+// until the oldest attempt leaves the window.
+func (w *Window) Exceeded(key string) (bool, time.Duration) {
+	now := time.Now()
+	attempts := w.recent(key, now)
+	if len(attempts) < w.limit {
+		return false, 0
+	}
+
+	return true, w.retryAfter(attempts, now)
+}
+
+// Reset forgets every attempt recorded for key.
+func (w *Window) Reset(key string) error {
+	return w.cache.Remove(key)
+}
+
+func (w *Window) recent(key string, now time.Time) []time.Time {
+	attempts, err := w.cache.WindowRange(key, now.Add(-w.window))
+	if err != nil {
+		return nil
+	}
+
+	return attempts
+}
+
+// retryAfter is how long until enough attempts leave the window for another
+// one to fit.
+func (w *Window) retryAfter(attempts []time.Time, now time.Time) time.Duration {
+	if len(attempts) < w.limit {
+		return 0
+	}
+
+	return attempts[len(attempts)-w.limit].Add(w.window).Sub(now)
+}
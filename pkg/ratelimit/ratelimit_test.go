@@ -0,0 +1,150 @@
+package ratelimit
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+// memoryWindows keeps sliding windows in memory with the same atomic
+// guarantees as the redis script.
+type memoryWindows struct {
+	mu      sync.Mutex
+	windows map[string][]time.Time
+}
+
+func newMemoryWindows() *memoryWindows {
+	return &memoryWindows{windows: map[string][]time.Time{}}
+}
+
+func (m *memoryWindows) IsConnected() bool                { return true }
+func (m *memoryWindows) Get(string, interface{}) error    { return nil }
+func (m *memoryWindows) GetDel(string, interface{}) error { return nil }
+func (m *memoryWindows) Set(string, interface{}) error    { return nil }
+func (m *memoryWindows) Keys(string) ([]string, error)    { return nil, nil }
+func (m *memoryWindows) RemovePattern(string) error       { return nil }
+func (m *memoryWindows) SetWithExpiration(string, interface{}, time.Duration) error {
+	return nil
+}
+
+func (m *memoryWindows) Remove(keys ...string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, key := range keys {
+		delete(m.windows, key)
+	}
+
+	return nil
+}
+
+func (m *memoryWindows) WindowAdd(key string, at time.Time, limit int, window time.Duration) ([]time.Time, bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	entries := after(m.windows[key], at.Add(-window))
+	added := limit <= 0 || len(entries) < limit
+	if added {
+		entries = append(entries, at)
+	}
+	m.windows[key] = entries
+
+	return append([]time.Time(nil), entries...), added, nil
+}
+
+func (m *memoryWindows) WindowRange(key string, since time.Time) ([]time.Time, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return after(m.windows[key], since), nil
+}
+
+func after(entries []time.Time, since time.Time) []time.Time {
+	var recent []time.Time
+	for _, entry := range entries {
+		if entry.After(since) {
+			recent = append(recent, entry)
+		}
+	}
+
+	return recent
+}
+
+func TestAllowStopsAtLimit(t *testing.T) {
+	window := New(newMemoryWindows(), 3, time.Minute)
+
+	for i := 0; i < 3; i++ {
+		if allowed, _, err := window.Allow("key"); err != nil || !allowed {
+			t.Fatalf("attempt %d: allowed %v, err %v", i+1, allowed, err)
+		}
+	}
+
+	allowed, retryAfter, err := window.Allow("key")
+	if err != nil || allowed {
+		t.Fatalf("attempt 4: allowed %v, err %v", allowed, err)
+	}
+	if retryAfter <= 0 || retryAfter > time.Minute {
+		t.Errorf("retry after %v, want within the window", retryAfter)
+	}
+}
+
+func TestAllowConcurrentAttempts(t *testing.T) {
+	window := New(newMemoryWindows(), 5, time.Minute)
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	allowed := 0
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if ok, _, err := window.Allow("key"); err == nil && ok {
+				mu.Lock()
+				allowed++
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if allowed != 5 {
+		t.Errorf("%d attempts allowed, want 5", allowed)
+	}
+}
+
+func TestRecordConcurrentAttempts(t *testing.T) {
+	window := New(newMemoryWindows(), 5, time.Minute)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := window.Record("key"); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if attempts := window.Attempts("key"); len(attempts) != 50 {
+		t.Errorf("%d attempts recorded, want 50", len(attempts))
+	}
+	if exceeded, _ := window.Exceeded("key"); !exceeded {
+		t.Error("limit not exceeded after 50 attempts")
+	}
+}
+
+func TestResetForgetsAttempts(t *testing.T) {
+	window := New(newMemoryWindows(), 1, time.Minute)
+
+	if _, err := window.Record("key"); err != nil {
+		t.Fatal(err)
+	}
+	if err := window.Reset("key"); err != nil {
+		t.Fatal(err)
+	}
+	if allowed, _, _ := window.Allow("key"); !allowed {
+		t.Error("attempt refused after reset")
+	}
+}
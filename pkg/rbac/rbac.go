@@ -0,0 +1,105 @@
+package rbac
+
+import "sort"
+
+// Permission names a single action a role may take, as resource:action.
+type Permission string
+
+const (
+	OrderReadAll Permission = "order:read_all"
+	OrderAccept  Permission = "order:accept"
+	OrderWeigh   Permission = "order:weigh"
+	OrderProcess Permission = "order:process"
+	OrderDeliver Permission = "order:deliver"
+	OrderCancel  Permission = "order:cancel"
+
+	HistoryReadAll Permission = "history:read_all"
+
+	UserRead       Permission = "user:read"
+	UserBan        Permission = "user:ban"
+	UserUnlock     Permission = "user:unlock"
+	UserSessions   Permission = "user:sessions"
+	UserAssignRole Permission = "user:assign_role"
+
+	TransactionReadAll Permission = "transaction:read_all"
+	TransactionManage  Permission = "transaction:manage"
+	RefundApprove      Permission = "refund:approve"
+	LedgerRead         Permission = "ledger:read"
+
+	CatalogManage  Permission = "catalog:manage"
+	PricingManage  Permission = "pricing:manage"
+	SlotManage     Permission = "slot:manage"
+	CalendarManage Permission = "calendar:manage"
+	OutletManage   Permission = "outlet:manage"
+
+	CourierManage Permission = "courier:manage"
+	CourierJobs   Permission = "courier:jobs"
+)
+
+const (
+	RoleAdmin       = "admin"
+	RoleCustomer    = "customer"
+	RoleCourier     = "courier"
+	RoleOutletStaff = "outlet_staff"
+	RoleSupport     = "support"
+)
+
+// All lists every permission; admins hold all of them.
+var All = []Permission{
+	OrderReadAll, OrderAccept, OrderWeigh, OrderProcess, OrderDeliver, OrderCancel,
+	HistoryReadAll,
+	UserRead, UserBan, UserUnlock, UserSessions, UserAssignRole,
+	TransactionReadAll, TransactionManage, RefundApprove, LedgerRead,
+	CatalogManage, PricingManage, SlotManage, CalendarManage, OutletManage,
+	CourierManage, CourierJobs,
+}
+
+// roles maps each role to what it may do beyond managing the user's own
+// profile, orders and payments, which every authenticated user may.
+var roles = map[string][]Permission{
+	RoleAdmin:    All,
+	RoleCustomer: {},
+	RoleCourier:  {OrderReadAll, OrderDeliver, CourierJobs},
+	RoleOutletStaff: {
+		OrderReadAll, OrderAccept, OrderWeigh, OrderProcess, OrderCancel,
+		HistoryReadAll,
+	},
+	RoleSupport: {
+		OrderReadAll, HistoryReadAll,
+		UserRead, UserUnlock, UserSessions,
+		TransactionReadAll,
+	},
+}
+
+// Can reports whether role holds permission. Unknown roles hold nothing.
+func Can(role string, permission Permission) bool {
+	for _, p := range roles[role] {
+		if p == permission {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsRole reports whether role is defined.
+func IsRole(role string) bool {
+	_, ok := roles[role]
+	return ok
+}
+
+// Roles returns the defined role names in alphabetical order.
+func Roles() []string {
+	names := make([]string, 0, len(roles))
+	for name := range roles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// Permissions returns the permissions of role.
+func Permissions(role string) []Permission {
+	return roles[role]
+}
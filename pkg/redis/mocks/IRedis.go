@@ -0,0 +1,257 @@
+// Code generated by mockery v2.50.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// IRedis is an autogenerated mock type for the IRedis type
+type IRedis struct {
+	mock.Mock
+}
+
+// Get provides a mock function with given fields: key, value
+func (_m *IRedis) Get(key string, value interface{}) error {
+	ret := _m.Called(key, value)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, interface{}) error); ok {
+		r0 = rf(key, value)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetDel provides a mock function with given fields: key, value
+func (_m *IRedis) GetDel(key string, value interface{}) error {
+	ret := _m.Called(key, value)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDel")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, interface{}) error); ok {
+		r0 = rf(key, value)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IsConnected provides a mock function with no fields
+func (_m *IRedis) IsConnected() bool {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsConnected")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// Keys provides a mock function with given fields: pattern
+func (_m *IRedis) Keys(pattern string) ([]string, error) {
+	ret := _m.Called(pattern)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Keys")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]string, error)); ok {
+		return rf(pattern)
+	}
+	if rf, ok := ret.Get(0).(func(string) []string); ok {
+		r0 = rf(pattern)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(pattern)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Remove provides a mock function with given fields: keys
+func (_m *IRedis) Remove(keys ...string) error {
+	_va := make([]interface{}, len(keys))
+	for _i := range keys {
+		_va[_i] = keys[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Remove")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(...string) error); ok {
+		r0 = rf(keys...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RemovePattern provides a mock function with given fields: pattern
+func (_m *IRedis) RemovePattern(pattern string) error {
+	ret := _m.Called(pattern)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemovePattern")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(pattern)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Set provides a mock function with given fields: key, value
+func (_m *IRedis) Set(key string, value interface{}) error {
+	ret := _m.Called(key, value)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Set")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, interface{}) error); ok {
+		r0 = rf(key, value)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetWithExpiration provides a mock function with given fields: key, value, expiration
+func (_m *IRedis) SetWithExpiration(key string, value interface{}, expiration time.Duration) error {
+	ret := _m.Called(key, value, expiration)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetWithExpiration")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, interface{}, time.Duration) error); ok {
+		r0 = rf(key, value, expiration)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WindowAdd provides a mock function with given fields: key, at, limit, window
+func (_m *IRedis) WindowAdd(key string, at time.Time, limit int, window time.Duration) ([]time.Time, bool, error) {
+	ret := _m.Called(key, at, limit, window)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WindowAdd")
+	}
+
+	var r0 []time.Time
+	var r1 bool
+	var r2 error
+	if rf, ok := ret.Get(0).(func(string, time.Time, int, time.Duration) ([]time.Time, bool, error)); ok {
+		return rf(key, at, limit, window)
+	}
+	if rf, ok := ret.Get(0).(func(string, time.Time, int, time.Duration) []time.Time); ok {
+		r0 = rf(key, at, limit, window)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]time.Time)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, time.Time, int, time.Duration) bool); ok {
+		r1 = rf(key, at, limit, window)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	if rf, ok := ret.Get(2).(func(string, time.Time, int, time.Duration) error); ok {
+		r2 = rf(key, at, limit, window)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// WindowRange provides a mock function with given fields: key, since
+func (_m *IRedis) WindowRange(key string, since time.Time) ([]time.Time, error) {
+	ret := _m.Called(key, since)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WindowRange")
+	}
+
+	var r0 []time.Time
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, time.Time) ([]time.Time, error)); ok {
+		return rf(key, since)
+	}
+	if rf, ok := ret.Get(0).(func(string, time.Time) []time.Time); ok {
+		r0 = rf(key, since)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]time.Time)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, time.Time) error); ok {
+		r1 = rf(key, since)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewIRedis creates a new instance of IRedis. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIRedis(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IRedis {
+	mock := &IRedis{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -3,10 +3,13 @@ package redis
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"log"
+	"strconv"
 	"time"
 
 	goredis "github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
 )
 
 const (
@@ -19,11 +22,14 @@ const (
 type IRedis interface {
 	IsConnected() bool
 	Get(key string, value interface{}) error
+	GetDel(key string, value interface{}) error
 	Set(key string, value interface{}) error
 	SetWithExpiration(key string, value interface{}, expiration time.Duration) error
 	Remove(keys ...string) error
 	Keys(pattern string) ([]string, error)
 	RemovePattern(pattern string) error
+	WindowAdd(key string, at time.Time, limit int, window time.Duration) ([]time.Time, bool, error)
+	WindowRange(key string, since time.Time) ([]time.Time, error)
 }
 
 // Config redis
@@ -91,6 +97,19 @@ func (r *redis) Get(key string, value interface{}) error {
 	return nil
 }
 
+// GetDel reads and removes a key atomically, so only one caller can consume it.
+func (r *redis) GetDel(key string, value interface{}) error {
+	ctx, cancel := context.WithTimeout(context.Background(), Timeout*time.Second)
+	defer cancel()
+
+	strValue, err := r.cmd.GetDel(ctx, key).Result()
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal([]byte(strValue), value)
+}
+
 func (r *redis) SetWithExpiration(key string, value interface{}, expiration time.Duration) error {
 	ctx, cancel := context.WithTimeout(context.Background(), Timeout*time.Second)
 	defer cancel()
@@ -158,3 +177,88 @@ func (r *redis) RemovePattern(pattern string) error {
 
 	return nil
 }
+
+// windowAdd trims a sliding window kept as a sorted set scored by time in
+// microseconds, adds the new entry unless the limit is reached and returns
+// whether it did along with the entries left. Keys still holding the old JSON
+// list are replaced.
+var windowAdd = goredis.NewScript(`
+if redis.call('TYPE', KEYS[1]).ok ~= 'zset' then
+	redis.call('DEL', KEYS[1])
+end
+redis.call('ZREMRANGEBYSCORE', KEYS[1], '-inf', ARGV[1])
+local added = 0
+local limit = tonumber(ARGV[4])
+if limit <= 0 or redis.call('ZCARD', KEYS[1]) < limit then
+	redis.call('ZADD', KEYS[1], ARGV[2], ARGV[3])
+	added = 1
+end
+redis.call('PEXPIRE', KEYS[1], ARGV[5])
+return {added, redis.call('ZRANGE', KEYS[1], 0, -1, 'WITHSCORES')}
+`)
+
+// WindowAdd atomically drops the entries of the sliding window key that are
+// older than window and records at, unless limit entries remain. A limit of
+// zero or less records at regardless. It returns the entries within the
+// window, oldest first, and whether at was recorded.
+func (r *redis) WindowAdd(key string, at time.Time, limit int, window time.Duration) ([]time.Time, bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), Timeout*time.Second)
+	defer cancel()
+
+	member := strconv.FormatInt(at.UnixMicro(), 10) + ":" + uuid.NewString()
+	result, err := windowAdd.Run(ctx, r.cmd, []string{key},
+		at.Add(-window).UnixMicro(), at.UnixMicro(), member, limit, window.Milliseconds()).Slice()
+	if err != nil {
+		return nil, false, err
+	}
+
+	if len(result) != 2 {
+		return nil, false, fmt.Errorf("unexpected window result: %v", result)
+	}
+
+	added, _ := result[0].(int64)
+	entries, _ := result[1].([]interface{})
+	times, err := windowTimes(entries)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return times, added == 1, nil
+}
+
+// WindowRange returns the entries of the sliding window key recorded after
+// since, oldest first.
+func (r *redis) WindowRange(key string, since time.Time) ([]time.Time, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), Timeout*time.Second)
+	defer cancel()
+
+	entries, err := r.cmd.ZRangeByScoreWithScores(ctx, key, &goredis.ZRangeBy{
+		Min: "(" + strconv.FormatInt(since.UnixMicro(), 10),
+		Max: "+inf",
+	}).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	times := make([]time.Time, 0, len(entries))
+	for _, entry := range entries {
+		times = append(times, time.UnixMicro(int64(entry.Score)))
+	}
+
+	return times, nil
+}
+
+// windowTimes reads the member, score pairs of ZRANGE WITHSCORES.
+func windowTimes(entries []interface{}) ([]time.Time, error) {
+	times := make([]time.Time, 0, len(entries)/2)
+	for i := 1; i < len(entries); i += 2 {
+		score, _ := entries[i].(string)
+		micros, err := strconv.ParseFloat(score, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid window score %v: %w", entries[i], err)
+		}
+		times = append(times, time.UnixMicro(int64(micros)))
+	}
+
+	return times, nil
+}
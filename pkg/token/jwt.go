@@ -9,6 +9,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/golang-jwt/jwt"
+	"github.com/google/uuid"
 
 	"washit-api/pkg/configs"
 	"washit-api/pkg/utils"
@@ -21,9 +22,22 @@ const (
 	RefreshTokenType        = "x-refresh"    // 30 days
 )
 
+// Claims is the decoded content of a validated token. ID is the jti claim
+// used to revoke a single token.
+type Claims struct {
+	ID        string
+	UserID    string
+	FamilyID  string
+	Type      string
+	Version   int64
+	ExpiresAt time.Time
+	Payload   map[string]interface{}
+}
+
 func GenerateAccessToken(payload map[string]interface{}) (string, error) {
 	payload["type"] = AccessTokenType
 	tokenContent := jwt.MapClaims{
+		"jti":     uuid.New().String(),
 		"payload": payload,
 		"exp":     time.Now().Add(time.Second * AccessTokenExpiredTime).Unix(),
 	}
@@ -37,23 +51,43 @@ func GenerateAccessToken(payload map[string]interface{}) (string, error) {
 	return token, nil
 }
 
-func GenerateRefreshToken(payload map[string]interface{}) (string, error) {
+// GenerateRefreshToken also returns the claims of the new token so callers can
+// persist its ID and expiry.
+func GenerateRefreshToken(payload map[string]interface{}) (string, *Claims, error) {
 	payload["type"] = RefreshTokenType
+	claims := &Claims{
+		ID:        uuid.New().String(),
+		Type:      RefreshTokenType,
+		ExpiresAt: time.Now().Add(time.Second * RefreshTokenExpiredTime),
+		Payload:   payload,
+	}
 	tokenContent := jwt.MapClaims{
+		"jti":     claims.ID,
 		"payload": payload,
-		"exp":     time.Now().Add(time.Second * RefreshTokenExpiredTime).Unix(),
+		"exp":     claims.ExpiresAt.Unix(),
 	}
 	jwtToken := jwt.NewWithClaims(jwt.GetSigningMethod("HS256"), tokenContent)
 	token, err := jwtToken.SignedString([]byte(configs.Envs.AuthSecret))
 	if err != nil {
 		log.Println("Failed to generate refresh token: ", err)
-		return "", err
+		return "", nil, err
 	}
 
-	return token, nil
+	return token, claims, nil
 }
 
 func ValidateToken(jwtToken string) (map[string]interface{}, error) {
+	claims, err := ParseToken(jwtToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return claims.Payload, nil
+}
+
+// ParseToken validates a token and returns its claims, including the jti and
+// expiry needed to revoke it.
+func ParseToken(jwtToken string) (*Claims, error) {
 	cleanJWT := strings.Replace(jwtToken, "Bearer ", "", -1)
 	tokenData := jwt.MapClaims{}
 	token, err := jwt.ParseWithClaims(cleanJWT, tokenData, func(token *jwt.Token) (interface{}, error) {
@@ -70,7 +104,22 @@ func ValidateToken(jwtToken string) (map[string]interface{}, error) {
 
 	var data map[string]interface{}
 	utils.CopyTo(tokenData["payload"], &data)
-	return data, nil
+
+	claims := &Claims{Payload: data}
+	claims.ID, _ = tokenData["jti"].(string)
+	if exp, ok := tokenData["exp"].(float64); ok {
+		claims.ExpiresAt = time.Unix(int64(exp), 0)
+	}
+	if data != nil {
+		claims.UserID, _ = data["id"].(string)
+		claims.Type, _ = data["type"].(string)
+		claims.FamilyID, _ = data["fid"].(string)
+		if version, ok := data["ver"].(float64); ok {
+			claims.Version = int64(version)
+		}
+	}
+
+	return claims, nil
 }
 
 func ParseJson(c *gin.Context, v any) error {
@@ -0,0 +1,71 @@
+package jwt
+
+import (
+	"fmt"
+	"time"
+
+	"washit-api/pkg/redis"
+)
+
+const (
+	revokedTokenKey  = "token:revoked:%s"
+	revokedFamilyKey = "token:family:%s"
+	tokenVersionKey  = "token:version:%s"
+)
+
+// Revoke puts the token on the denylist until it would have expired anyway.
+func Revoke(cache redis.IRedis, claims *Claims) error {
+	if claims.ID == "" {
+		return fmt.Errorf("token has no id")
+	}
+
+	ttl := time.Until(claims.ExpiresAt)
+	if ttl <= 0 {
+		return nil
+	}
+
+	return cache.SetWithExpiration(fmt.Sprintf(revokedTokenKey, claims.ID), true, ttl)
+}
+
+// RevokeFamily rejects every token issued within a refresh token family,
+// including access tokens minted from it.
+func RevokeFamily(cache redis.IRedis, familyID string) error {
+	return cache.SetWithExpiration(fmt.Sprintf(revokedFamilyKey, familyID), true,
+		time.Second*RefreshTokenExpiredTime)
+}
+
+// SetTokenVersion stores the current token version of a user. Tokens carrying
+// an older version are rejected, which logs the user out of every device.
+// The key is kept without an expiry so the check does not depend on it
+// outliving every token issued before the bump.
+func SetTokenVersion(cache redis.IRedis, userID string, version int64) error {
+	return cache.Set(fmt.Sprintf(tokenVersionKey, userID), version)
+}
+
+// IsRevoked reports whether the token was revoked on its own, with its family
+// or through a token version bump. Lookup failures are treated as not revoked so a cache
+// outage does not lock every user out.
+func IsRevoked(cache redis.IRedis, claims *Claims) bool {
+	if claims.ID != "" {
+		var revoked bool
+		if err := cache.Get(fmt.Sprintf(revokedTokenKey, claims.ID), &revoked); err == nil && revoked {
+			return true
+		}
+	}
+
+	if claims.FamilyID != "" {
+		var revoked bool
+		if err := cache.Get(fmt.Sprintf(revokedFamilyKey, claims.FamilyID), &revoked); err == nil && revoked {
+			return true
+		}
+	}
+
+	if claims.UserID != "" {
+		var version int64
+		if err := cache.Get(fmt.Sprintf(tokenVersionKey, claims.UserID), &version); err == nil && claims.Version < version {
+			return true
+		}
+	}
+
+	return false
+}
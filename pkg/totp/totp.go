@@ -0,0 +1,107 @@
+package totp
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// RFC 6238 parameters understood by every common authenticator app.
+const (
+	Period = 30
+	Digits = 6
+	Skew   = 1
+)
+
+var encoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// GenerateSecret returns a random 160-bit secret encoded as base32.
+func GenerateSecret() (string, error) {
+	secret := make([]byte, 20)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+
+	return encoding.EncodeToString(secret), nil
+}
+
+// URI builds the otpauth:// URI authenticator apps scan as a QR code.
+func URI(issuer string, account string, secret string) string {
+	label := url.PathEscape(issuer + ":" + account)
+	query := url.Values{}
+	query.Set("secret", secret)
+	query.Set("issuer", issuer)
+	query.Set("algorithm", "SHA1")
+	query.Set("digits", fmt.Sprint(Digits))
+	query.Set("period", fmt.Sprint(Period))
+
+	return fmt.Sprintf("otpauth://totp/%s?%s", label, query.Encode())
+}
+
+// Code returns the code for the time step containing t.
+func Code(secret string, t time.Time) (string, error) {
+	return code(secret, uint64(t.Unix()/Period))
+}
+
+// Validate checks code against the current time step and Skew steps around
+// it to tolerate clock drift. It returns the matching step so callers can
+// reject a code that was already used.
+func Validate(secret string, code string, t time.Time) (int64, bool) {
+	code = strings.TrimSpace(code)
+	if len(code) != Digits {
+		return 0, false
+	}
+
+	current := t.Unix() / Period
+	for step := current - Skew; step <= current+Skew; step++ {
+		expected, err := codeAt(secret, step)
+		if err != nil {
+			return 0, false
+		}
+
+		if subtle.ConstantTimeCompare([]byte(expected), []byte(code)) == 1 {
+			return step, true
+		}
+	}
+
+	return 0, false
+}
+
+func codeAt(secret string, step int64) (string, error) {
+	if step < 0 {
+		return "", fmt.Errorf("invalid time step: %d", step)
+	}
+
+	return code(secret, uint64(step))
+}
+
+func code(secret string, counter uint64) (string, error) {
+	key, err := encoding.DecodeString(strings.ToUpper(strings.TrimRight(secret, "=")))
+	if err != nil {
+		return "", fmt.Errorf("invalid secret: %w", err)
+	}
+
+	var message [8]byte
+	binary.BigEndian.PutUint64(message[:], counter)
+
+	mac := hmac.New(sha1.New, key)
+	mac.Write(message[:])
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	modulo := uint32(1)
+	for i := 0; i < Digits; i++ {
+		modulo *= 10
+	}
+
+	return fmt.Sprintf("%0*d", Digits, value%modulo), nil
+}
@@ -0,0 +1,49 @@
+package totp
+
+import (
+	"encoding/base32"
+	"testing"
+	"time"
+)
+
+// Test vectors from RFC 6238 appendix B (SHA1), truncated to 6 digits.
+func TestCodeMatchesRFCVectors(t *testing.T) {
+	secret := base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString([]byte("12345678901234567890"))
+
+	vectors := map[int64]string{
+		59:          "287082",
+		1111111109:  "081804",
+		1111111111:  "050471",
+		1234567890:  "005924",
+		2000000000:  "279037",
+		20000000000: "353130",
+	}
+
+	for unix, expected := range vectors {
+		code, err := Code(secret, time.Unix(unix, 0))
+		if err != nil {
+			t.Fatalf("code at %d: %v", unix, err)
+		}
+		if code != expected {
+			t.Errorf("code at %d = %s, want %s", unix, code, expected)
+		}
+	}
+}
+
+func TestValidateToleratesOneStepOfDrift(t *testing.T) {
+	secret, err := GenerateSecret()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Unix(1700000000, 0)
+	previous, _ := Code(secret, now.Add(-Period*time.Second))
+	if _, ok := Validate(secret, previous, now); !ok {
+		t.Error("code of the previous step should be accepted")
+	}
+
+	stale, _ := Code(secret, now.Add(-2*Period*time.Second))
+	if _, ok := Validate(secret, stale, now); ok {
+		t.Error("code two steps old should be rejected")
+	}
+}
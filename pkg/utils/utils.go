@@ -2,12 +2,49 @@ package utils
 
 import (
 	"strconv"
+	addressModel "washit-api/internal/address/dto/model"
+	calendarModel "washit-api/internal/calendar/dto/model"
+	courierModel "washit-api/internal/courier/dto/model"
 	historyModel "washit-api/internal/history/dto/model"
+	ledgerModel "washit-api/internal/ledger/dto/model"
 	orderModel "washit-api/internal/order/dto/model"
+	outletModel "washit-api/internal/outlet/dto/model"
+	pricingModel "washit-api/internal/pricing/dto/model"
+	serviceModel "washit-api/internal/service/dto/model"
+	slotModel "washit-api/internal/slot/dto/model"
+	transactionModel "washit-api/internal/transaction/dto/model"
 	userModel "washit-api/internal/user/dto/model"
 )
 
-var ModelList = []interface{}{&userModel.User{}, &orderModel.Order{}, &historyModel.History{}}
+var ModelList = []interface{}{
+	&userModel.User{},
+	&userModel.RefreshToken{},
+	&userModel.Session{},
+	&userModel.RecoveryCode{},
+	&orderModel.Order{},
+	&orderModel.OrderStatusEvent{},
+	&orderModel.OrderItem{},
+	&historyModel.History{},
+	&pricingModel.PriceList{},
+	&pricingModel.Surcharge{},
+	&pricingModel.OutletPrice{},
+	&pricingModel.DeliveryFee{},
+	&serviceModel.Service{},
+	&addressModel.Address{},
+	&transactionModel.Transaction{},
+	&transactionModel.WebhookEvent{},
+	&transactionModel.Refund{},
+	&ledgerModel.JournalEntry{},
+	&ledgerModel.JournalLine{},
+	&courierModel.Courier{},
+	&courierModel.Assignment{},
+	&slotModel.PickupWindow{},
+	&slotModel.PickupSlot{},
+	&calendarModel.OpeningHours{},
+	&calendarModel.Holiday{},
+	&calendarModel.Closure{},
+	&outletModel.Outlet{},
+}
 
 func StringToInt64(s string) (int64, error) {
     i, err := strconv.ParseInt(s, 10, 64)
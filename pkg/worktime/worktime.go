@@ -0,0 +1,169 @@
+package worktime
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"washit-api/pkg/configs"
+)
+
+// maxScanDays bounds how far Add looks for open days, so a calendar that is
+// never open fails instead of looping forever.
+const maxScanDays = 366
+
+var ErrNeverOpen = errors.New("no working hours within a year")
+
+// Calendar tells when the laundry is working.
+type Calendar interface {
+	// Hours returns when work starts and stops on the calendar day of t, or
+	// false when the laundry is closed that day.
+	Hours(t time.Time) (time.Time, time.Time, bool)
+	// Location is the time zone the calendar days are counted in.
+	Location() *time.Location
+}
+
+// Weekly is a Calendar with the same opening hours on every day that is not
+// a closed weekday.
+type Weekly struct {
+	Open   time.Duration
+	Close  time.Duration
+	Closed map[time.Weekday]bool
+	Zone   *time.Location
+}
+
+// ParseWeekly builds a Weekly from opening and closing times as HH:MM and a
+// comma separated list of closed weekdays such as "saturday,sunday".
+func ParseWeekly(open string, close string, closedDays string, location *time.Location) (*Weekly, error) {
+	openAt, err := ParseClock(open)
+	if err != nil {
+		return nil, fmt.Errorf("invalid opening time %q: %w", open, err)
+	}
+
+	closeAt, err := ParseClock(close)
+	if err != nil {
+		return nil, fmt.Errorf("invalid closing time %q: %w", close, err)
+	}
+
+	if closeAt <= openAt {
+		return nil, fmt.Errorf("closing time %s is not after opening time %s", close, open)
+	}
+
+	closed := make(map[time.Weekday]bool)
+	for _, name := range strings.Split(closedDays, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+
+		day, err := ParseWeekday(name)
+		if err != nil {
+			return nil, err
+		}
+		closed[day] = true
+	}
+
+	return &Weekly{Open: openAt, Close: closeAt, Closed: closed, Zone: location}, nil
+}
+
+// FromEnvs creates the calendar configured through the BUSINESS_* envs. An
+// invalid configuration is logged and replaced by 08:00-20:00 every day.
+func FromEnvs() *Weekly {
+	zone := Zone()
+	weekly, err := ParseWeekly(
+		configs.Envs.BusinessOpen, configs.Envs.BusinessClose, configs.Envs.BusinessClosedDays, zone)
+	if err != nil {
+		log.Printf("Invalid business hours, using 08:00-20:00: %v", err)
+		return &Weekly{Open: 8 * time.Hour, Close: 20 * time.Hour, Zone: zone}
+	}
+
+	return weekly
+}
+
+// Zone returns the time zone set by BUSINESS_TIMEZONE, such as Asia/Jakarta.
+// It falls back to the server time zone when unset or unknown.
+func Zone() *time.Location {
+	if configs.Envs.BusinessTimezone == "" {
+		return time.Local
+	}
+
+	zone, err := time.LoadLocation(configs.Envs.BusinessTimezone)
+	if err != nil {
+		log.Printf("Invalid business timezone %q, using server time zone: %v", configs.Envs.BusinessTimezone, err)
+		return time.Local
+	}
+
+	return zone
+}
+
+func (w *Weekly) Hours(t time.Time) (time.Time, time.Time, bool) {
+	t = t.In(w.Zone)
+	if w.Closed[t.Weekday()] {
+		return time.Time{}, time.Time{}, false
+	}
+
+	midnight := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, w.Zone)
+	return midnight.Add(w.Open), midnight.Add(w.Close), true
+}
+
+func (w *Weekly) Location() *time.Location {
+	return w.Zone
+}
+
+// Add returns the moment d of working time after from. Time outside the
+// working hours of calendar does not count, so work left at closing time
+// continues when the laundry next opens.
+func Add(calendar Calendar, from time.Time, d time.Duration) (time.Time, error) {
+	t := from.In(calendar.Location())
+	for i := 0; i < maxScanDays; i++ {
+		open, close, ok := calendar.Hours(t)
+		if ok {
+			if t.Before(open) {
+				t = open
+			}
+			if t.Before(close) {
+				available := close.Sub(t)
+				if d <= available {
+					return t.Add(d), nil
+				}
+				d -= available
+			}
+		}
+
+		t = nextDay(t)
+	}
+
+	return time.Time{}, ErrNeverOpen
+}
+
+// ParseWeekday parses an English weekday name, ignoring case.
+func ParseWeekday(name string) (time.Weekday, error) {
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		if strings.EqualFold(day.String(), name) {
+			return day, nil
+		}
+	}
+
+	return 0, fmt.Errorf("invalid weekday %q", name)
+}
+
+// ParseClock parses an HH:MM wall clock time into the time since midnight.
+func ParseClock(value string) (time.Duration, error) {
+	clock, err := time.Parse("15:04", value)
+	if err != nil {
+		return 0, err
+	}
+
+	return time.Duration(clock.Hour())*time.Hour + time.Duration(clock.Minute())*time.Minute, nil
+}
+
+// FormatClock formats a time since midnight as HH:MM.
+func FormatClock(d time.Duration) string {
+	return fmt.Sprintf("%02d:%02d", int(d.Hours()), int(d.Minutes())%60)
+}
+
+func nextDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, t.Location())
+}
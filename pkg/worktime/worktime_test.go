@@ -0,0 +1,77 @@
+package worktime
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestAddCarriesWorkOverClosedDays(t *testing.T) {
+	calendar, err := ParseWeekly("08:00", "18:00", "sunday", time.UTC)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		name string
+		from time.Time
+		d    time.Duration
+		want time.Time
+	}{
+		{
+			name: "within the day",
+			from: time.Date(2026, time.October, 19, 9, 0, 0, 0, time.UTC),
+			d:    3 * time.Hour,
+			want: time.Date(2026, time.October, 19, 12, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "before opening",
+			from: time.Date(2026, time.October, 19, 5, 0, 0, 0, time.UTC),
+			d:    2 * time.Hour,
+			want: time.Date(2026, time.October, 19, 10, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "over closing time",
+			from: time.Date(2026, time.October, 19, 16, 0, 0, 0, time.UTC),
+			d:    4 * time.Hour,
+			want: time.Date(2026, time.October, 20, 10, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "over a closed sunday",
+			from: time.Date(2026, time.October, 24, 17, 0, 0, 0, time.UTC),
+			d:    3 * time.Hour,
+			want: time.Date(2026, time.October, 26, 10, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, c := range cases {
+		got, err := Add(calendar, c.from, c.d)
+		if err != nil {
+			t.Fatalf("%s: %v", c.name, err)
+		}
+		if !got.Equal(c.want) {
+			t.Errorf("%s: Add = %s, want %s", c.name, got, c.want)
+		}
+	}
+}
+
+func TestAddFailsWhenNeverOpen(t *testing.T) {
+	calendar, err := ParseWeekly("08:00", "18:00",
+		"sunday,monday,tuesday,wednesday,thursday,friday,saturday", time.UTC)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := Add(calendar, time.Now(), time.Hour); !errors.Is(err, ErrNeverOpen) {
+		t.Errorf("Add error = %v, want ErrNeverOpen", err)
+	}
+}
+
+func TestParseWeeklyRejectsInvalidHours(t *testing.T) {
+	if _, err := ParseWeekly("18:00", "08:00", "", time.UTC); err == nil {
+		t.Error("expected an error for closing before opening")
+	}
+	if _, err := ParseWeekly("08:00", "18:00", "someday", time.UTC); err == nil {
+		t.Error("expected an error for an unknown weekday")
+	}
+}